@@ -2,6 +2,7 @@ package accessor
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/concourse/concourse/atc"
@@ -39,18 +40,24 @@ type Verification struct {
 
 type access struct {
 	verification           Verification
-	requiredRole           string
+	action                 string
+	requiredRoles          map[string]string
 	systemClaimKey         string
 	systemClaimValues      []string
 	teams                  []db.Team
 	teamRoles              map[string][]string
+	teamCustomRoles        map[string]atc.CustomRoles
 	isAdmin                bool
 	displayUserIdGenerator atc.DisplayUserIdGenerator
 }
 
+// NewAccessor returns the access of the request to perform the given action.
+// requiredRoles maps each action to the least privileged built-in role which
+// permits it; actions without a role may only be performed by admins.
 func NewAccessor(
 	verification Verification,
-	requiredRole string,
+	action string,
+	requiredRoles map[string]string,
 	systemClaimKey string,
 	systemClaimValues []string,
 	teams []db.Team,
@@ -58,7 +65,8 @@ func NewAccessor(
 ) *access {
 	a := &access{
 		verification:           verification,
-		requiredRole:           requiredRole,
+		action:                 action,
+		requiredRoles:          requiredRoles,
 		systemClaimKey:         systemClaimKey,
 		systemClaimValues:      systemClaimValues,
 		teams:                  teams,
@@ -70,13 +78,29 @@ func NewAccessor(
 
 func (a *access) computeTeamRoles() {
 	a.teamRoles = map[string][]string{}
+	a.teamCustomRoles = map[string]atc.CustomRoles{}
+
+	saTeam, saRole, isServiceAccount := a.serviceAccount()
 
 	for _, team := range a.teams {
-		roles := a.rolesForTeam(team.Auth())
+		var roles []string
+		if isServiceAccount {
+			// a service account only has the role its token grants, on its
+			// own team
+			if team.Name() == saTeam {
+				roles = []string{saRole}
+			}
+		} else {
+			roles = a.rolesForTeam(team.Auth())
+		}
+
 		if len(roles) > 0 {
 			a.teamRoles[team.Name()] = roles
+			a.teamCustomRoles[team.Name()] = team.CustomRoles()
 		}
-		if team.Admin() && contains(roles, "owner") {
+		// a service account is scoped to its own team, so even an owner token
+		// on an admin team does not make it an admin of the whole cluster
+		if team.Admin() && contains(roles, "owner") && !isServiceAccount {
 			a.isAdmin = true
 		}
 	}
@@ -148,13 +172,13 @@ func (a *access) IsAuthenticated() bool {
 }
 
 func (a *access) IsAuthorized(teamName string) bool {
-	return a.isAdmin || a.hasPermission(a.teamRoles[teamName])
+	return a.isAdmin || a.hasPermission(teamName, a.action)
 }
 
 func (a *access) TeamNames() []string {
 	teamNames := []string{}
 	for _, team := range a.teams {
-		if a.isAdmin || a.hasPermission(a.teamRoles[team.Name()]) {
+		if a.isAdmin || a.hasPermission(team.Name(), a.action) {
 			teamNames = append(teamNames, team.Name())
 		}
 	}
@@ -162,19 +186,42 @@ func (a *access) TeamNames() []string {
 	return teamNames
 }
 
-func (a *access) hasPermission(roles []string) bool {
-	allow := false
-	for _, role := range roles {
-		allow = allow || a.hasRequiredRole(role)
-		if allow {
+// hasPermission returns whether any of the roles on the team permit the
+// action, either by being a built-in role at least as privileged as the one
+// required or by being a custom role of the team which lists the action.
+func (a *access) hasPermission(teamName string, action string) bool {
+	requiredRole := a.requiredRoles[action]
+	customRoles := a.teamCustomRoles[teamName]
+
+	for _, role := range a.teamRoles[teamName] {
+		if hasRequiredRole(requiredRole, role) || customRoles.Permits(role, action) {
 			return true
 		}
 	}
 	return false
 }
 
-func (a *access) hasRequiredRole(role string) bool {
-	switch a.requiredRole {
+// teamActions returns the actions the user may perform on each team they have
+// a role on.
+func (a *access) teamActions() map[string][]string {
+	teamActions := map[string][]string{}
+	for teamName := range a.teamRoles {
+		actions := []string{}
+		for action := range a.requiredRoles {
+			if a.isAdmin || a.hasPermission(teamName, action) {
+				actions = append(actions, action)
+			}
+		}
+
+		sort.Strings(actions)
+		teamActions[teamName] = actions
+	}
+
+	return teamActions
+}
+
+func hasRequiredRole(requiredRole string, role string) bool {
+	switch requiredRole {
 	case OwnerRole:
 		return role == OwnerRole
 	case MemberRole:
@@ -252,6 +299,27 @@ func (a *access) groups() []string {
 	return groups
 }
 
+// serviceAccount returns the team and role granted by a service account
+// token, if that is what the request was made with.
+func (a *access) serviceAccount() (string, string, bool) {
+	if a.connectorID() != db.ServiceAccountConnector {
+		return "", "", false
+	}
+
+	claim, ok := a.claims()[db.ServiceAccountClaim].(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+
+	team, _ := claim["team"].(string)
+	role, _ := claim["role"].(string)
+	if team == "" || role == "" {
+		return "", "", false
+	}
+
+	return team, role, true
+}
+
 func (a *access) IsAdmin() bool {
 	return a.isAdmin
 }
@@ -285,15 +353,16 @@ func (a *access) Claims() Claims {
 func (a *access) UserInfo() atc.UserInfo {
 	claims := a.Claims()
 	return atc.UserInfo{
-		Sub:       claims.Sub,
-		Name:      claims.UserName,
-		UserId:    claims.UserID,
-		UserName:  claims.PreferredUsername,
-		Email:     claims.Email,
-		Connector: claims.Connector,
-		IsAdmin:   a.IsAdmin(),
-		IsSystem:  a.IsSystem(),
-		Teams:     a.TeamRoles(),
+		Sub:         claims.Sub,
+		Name:        claims.UserName,
+		UserId:      claims.UserID,
+		UserName:    claims.PreferredUsername,
+		Email:       claims.Email,
+		Connector:   claims.Connector,
+		IsAdmin:     a.IsAdmin(),
+		IsSystem:    a.IsSystem(),
+		Teams:       a.TeamRoles(),
+		TeamActions: a.teamActions(),
 		DisplayUserId: a.displayUserIdGenerator.DisplayUserId(
 			claims.Connector,
 			claims.UserID,
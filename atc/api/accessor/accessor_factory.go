@@ -26,6 +26,7 @@ func NewAccessFactory(
 	systemClaimKey string,
 	systemClaimValues []string,
 	displayUserIdGenerator atc.DisplayUserIdGenerator,
+	customRoles map[string]string,
 ) AccessFactory {
 	return &accessFactory{
 		tokenVerifier:          tokenVerifier,
@@ -33,6 +34,7 @@ func NewAccessFactory(
 		systemClaimKey:         systemClaimKey,
 		systemClaimValues:      systemClaimValues,
 		displayUserIdGenerator: displayUserIdGenerator,
+		requiredRoles:          requiredRoles(customRoles),
 	}
 }
 
@@ -42,14 +44,15 @@ type accessFactory struct {
 	systemClaimKey         string
 	systemClaimValues      []string
 	displayUserIdGenerator atc.DisplayUserIdGenerator
+	requiredRoles          map[string]string
 }
 
-func (a *accessFactory) Create(req *http.Request, role string) (Access, error) {
+func (a *accessFactory) Create(req *http.Request, action string) (Access, error) {
 	teams, err := a.teamFetcher.GetTeams()
 	if err != nil {
 		return nil, fmt.Errorf("fetch teams: %w", err)
 	}
-	return NewAccessor(a.verifyToken(req), role, a.systemClaimKey, a.systemClaimValues, teams, a.displayUserIdGenerator), nil
+	return NewAccessor(a.verifyToken(req), action, a.requiredRoles, a.systemClaimKey, a.systemClaimValues, teams, a.displayUserIdGenerator), nil
 }
 
 func (a *accessFactory) verifyToken(req *http.Request) Verification {
@@ -26,7 +26,8 @@ var _ = Describe("AccessorFactory", func() {
 
 		fakeDisplayUserIdGenerator *atcfakes.FakeDisplayUserIdGenerator
 
-		role string
+		action      string
+		customRoles map[string]string
 	)
 
 	BeforeEach(func() {
@@ -39,7 +40,8 @@ var _ = Describe("AccessorFactory", func() {
 
 		fakeDisplayUserIdGenerator = new(atcfakes.FakeDisplayUserIdGenerator)
 
-		role = "viewer"
+		action = atc.GetPipeline
+		customRoles = map[string]string{}
 	})
 
 	Describe("Create", func() {
@@ -50,8 +52,8 @@ var _ = Describe("AccessorFactory", func() {
 		)
 
 		JustBeforeEach(func() {
-			factory := accessor.NewAccessFactory(fakeTokenVerifier, fakeTeamFetcher, systemClaimKey, systemClaimValues, fakeDisplayUserIdGenerator)
-			access, err = factory.Create(dummyRequest, role)
+			factory := accessor.NewAccessFactory(fakeTokenVerifier, fakeTeamFetcher, systemClaimKey, systemClaimValues, fakeDisplayUserIdGenerator, customRoles)
+			access, err = factory.Create(dummyRequest, action)
 		})
 
 		Context("when the token is valid", func() {
@@ -82,6 +84,36 @@ var _ = Describe("AccessorFactory", func() {
 			It("returns an accessor with the correct teams", func() {
 				Expect(access.TeamNames()).To(ConsistOf("t1", "t3"))
 			})
+
+			Context("when the action requires a more privileged role by default", func() {
+				BeforeEach(func() {
+					action = atc.SaveConfig
+				})
+
+				It("is not authorized", func() {
+					Expect(access.IsAuthorized("t1")).To(BeFalse())
+				})
+
+				Context("when the action's role has been customized", func() {
+					BeforeEach(func() {
+						customRoles = map[string]string{atc.SaveConfig: accessor.ViewerRole}
+					})
+
+					It("is authorized", func() {
+						Expect(access.IsAuthorized("t1")).To(BeTrue())
+					})
+				})
+			})
+
+			Context("when the action has no role (admin actions don't have defaults)", func() {
+				BeforeEach(func() {
+					action = atc.ListAuditEvents
+				})
+
+				It("is not authorized", func() {
+					Expect(access.IsAuthorized("t1")).To(BeFalse())
+				})
+			})
 		})
 
 		Context("when the team fetcher returns an error", func() {
@@ -42,7 +42,7 @@ var _ = Describe("Accessor", func() {
 	})
 
 	JustBeforeEach(func() {
-		access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+		access = accessor.NewAccessor(verification, "some-action", map[string]string{"some-action": requiredRole}, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
 	})
 
 	Describe("HasToken", func() {
@@ -195,7 +195,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, "some-action", map[string]string{"some-action": requiredRole}, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -242,7 +242,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, "some-action", map[string]string{"some-action": requiredRole}, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -303,7 +303,7 @@ var _ = Describe("Accessor", func() {
 				})
 			}
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, "some-action", map[string]string{"some-action": requiredRole}, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -447,6 +447,153 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("custom roles", func() {
+		var requiredRoles map[string]string
+
+		BeforeEach(func() {
+			requiredRoles = map[string]string{
+				atc.CreateJobBuild: accessor.OperatorRole,
+				atc.GetPipeline:    accessor.ViewerRole,
+				atc.SaveConfig:     accessor.MemberRole,
+			}
+
+			verification = accessor.Verification{
+				HasToken:     true,
+				IsTokenValid: true,
+				RawClaims: map[string]interface{}{
+					"preferred_username": "some-user",
+					"federated_claims": map[string]interface{}{
+						"connector_id": "github",
+					},
+				},
+			}
+
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"releaser": {"users": {"github:some-user"}},
+			})
+			fakeTeam1.CustomRolesReturns(atc.CustomRoles{
+				"releaser": {atc.CreateJobBuild},
+			})
+
+			fakeTeam2.AuthReturns(atc.TeamAuth{
+				"viewer": {"users": {"github:some-user"}},
+			})
+		})
+
+		It("permits the role's actions on the team", func() {
+			access = accessor.NewAccessor(verification, atc.CreateJobBuild, requiredRoles, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+			Expect(access.TeamNames()).To(ConsistOf("some-team-1"))
+		})
+
+		It("does not permit other actions, even less privileged ones", func() {
+			access = accessor.NewAccessor(verification, atc.GetPipeline, requiredRoles, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+			Expect(access.TeamNames()).To(ConsistOf("some-team-2"))
+		})
+
+		It("does not permit the role's actions on other teams", func() {
+			fakeTeam2.AuthReturns(atc.TeamAuth{
+				"releaser": {"users": {"github:some-user"}},
+			})
+
+			access = accessor.NewAccessor(verification, atc.CreateJobBuild, requiredRoles, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			Expect(access.IsAuthorized("some-team-2")).To(BeFalse())
+		})
+
+		It("lists the effective actions on each team", func() {
+			access = accessor.NewAccessor(verification, atc.GetPipeline, requiredRoles, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			Expect(access.TeamRoles()).To(Equal(map[string][]string{
+				"some-team-1": {"releaser"},
+				"some-team-2": {"viewer"},
+			}))
+			Expect(access.UserInfo().TeamActions).To(Equal(map[string][]string{
+				"some-team-1": {atc.CreateJobBuild},
+				"some-team-2": {atc.GetPipeline},
+			}))
+		})
+	})
+
+	Describe("service accounts", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"name": "ci",
+				"federated_claims": map[string]interface{}{
+					"connector_id": db.ServiceAccountConnector,
+					"user_id":      "some-team-2/ci",
+				},
+				db.ServiceAccountClaim: map[string]interface{}{
+					"team": "some-team-2",
+					"role": "member",
+				},
+			}
+
+			// a team which lets every user in must not let the account in
+			fakeTeam1.AuthReturns(atc.TeamAuth{"owner": map[string][]string{}})
+		})
+
+		It("only has the token's role on its own team", func() {
+			Expect(access.TeamRoles()).To(Equal(map[string][]string{
+				"some-team-2": {"member"},
+			}))
+		})
+
+		Context("when the action requires a role the token grants", func() {
+			BeforeEach(func() {
+				requiredRole = "pipeline-operator"
+			})
+
+			It("is authorized on its team", func() {
+				Expect(access.IsAuthorized("some-team-2")).To(BeTrue())
+				Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+			})
+		})
+
+		Context("when the action requires a role above the token's", func() {
+			BeforeEach(func() {
+				requiredRole = "owner"
+			})
+
+			It("is not authorized", func() {
+				Expect(access.IsAuthorized("some-team-2")).To(BeFalse())
+			})
+		})
+
+		Context("when the token grants owner on an admin team", func() {
+			BeforeEach(func() {
+				verification.RawClaims[db.ServiceAccountClaim] = map[string]interface{}{
+					"team": "some-team-2",
+					"role": "owner",
+				}
+				fakeTeam2.AdminReturns(true)
+			})
+
+			It("owns its team without being an admin", func() {
+				Expect(access.TeamRoles()).To(Equal(map[string][]string{
+					"some-team-2": {"owner"},
+				}))
+				Expect(access.IsAdmin()).To(BeFalse())
+			})
+		})
+
+		Context("when the claim comes from another connector", func() {
+			BeforeEach(func() {
+				verification.RawClaims["federated_claims"] = map[string]interface{}{
+					"connector_id": "github",
+					"user_id":      "ci",
+				}
+			})
+
+			It("is ignored", func() {
+				Expect(access.TeamRoles()).To(Equal(map[string][]string{
+					"some-team-1": {"owner"},
+				}))
+			})
+		})
+	})
+
 	Describe("IsAdmin", func() {
 		var result bool
 
@@ -709,6 +856,7 @@ var _ = Describe("Accessor", func() {
 					IsAdmin:       false,
 					IsSystem:      false,
 					Teams:         map[string][]string{},
+					TeamActions:   map[string][]string{},
 					Connector:     "some-connector",
 					DisplayUserId: "some-user-id",
 				}))
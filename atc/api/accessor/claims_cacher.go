@@ -2,6 +2,7 @@ package accessor
 
 import (
 	"encoding/json"
+	"strings"
 	"sync"
 
 	"github.com/concourse/concourse/atc/db"
@@ -40,6 +41,12 @@ func NewClaimsCacher(
 }
 
 func (c *claimsCacher) GetAccessToken(rawToken string) (db.AccessToken, bool, error) {
+	// service account tokens can be revoked at any time, so they are always
+	// looked up
+	if strings.HasPrefix(rawToken, db.ServiceAccountTokenPrefix) {
+		return c.accessTokenFetcher.GetAccessToken(rawToken)
+	}
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -54,6 +54,13 @@ var _ = Describe("ClaimsCacher", func() {
 		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(1), "did not cache claims")
 	})
 
+	It("always fetches service account tokens from the DB, as they can be revoked", func() {
+		fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{}, true, nil)
+		claimsCacher.GetAccessToken(db.ServiceAccountTokenPrefix + "token")
+		claimsCacher.GetAccessToken(db.ServiceAccountTokenPrefix + "token")
+		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(2))
+	})
+
 	It("doesn't cache claims when cache size is exceeded", func() {
 		fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{
 			Claims: db.Claims{RawClaims: map[string]interface{}{"a": stringWithLen(2000)}},
@@ -1,12 +1,17 @@
 package accessor
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
+	"mime"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/felixge/httpsnoop"
 )
 
 //go:generate counterfeiter net/http.Handler
@@ -16,7 +21,7 @@ import (
 const accessorContextKey atc.ContextKey = "accessor"
 
 type AccessFactory interface {
-	Create(req *http.Request, role string) (Access, error)
+	Create(req *http.Request, action string) (Access, error)
 }
 
 func NewHandler(
@@ -25,7 +30,6 @@ func NewHandler(
 	handler http.Handler,
 	accessFactory AccessFactory,
 	auditor auditor.Auditor,
-	customRoles map[string]string,
 ) http.Handler {
 	return &accessorHandler{
 		logger:        logger,
@@ -33,7 +37,6 @@ func NewHandler(
 		accessFactory: accessFactory,
 		action:        action,
 		auditor:       auditor,
-		customRoles:   customRoles,
 	}
 }
 
@@ -43,16 +46,10 @@ type accessorHandler struct {
 	handler       http.Handler
 	accessFactory AccessFactory
 	auditor       auditor.Auditor
-	customRoles   map[string]string
 }
 
 func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	requiredRole := h.customRoles[h.action]
-	if requiredRole == "" {
-		requiredRole = DefaultRoles[h.action]
-	}
-
-	acc, err := h.accessFactory.Create(r, requiredRole)
+	acc, err := h.accessFactory.Create(r, h.action)
 	if err != nil {
 		h.logger.Error("failed-to-construct-accessor", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -63,8 +60,39 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	ctx := context.WithValue(r.Context(), accessorContextKey, acc)
 
-	h.auditor.Audit(h.action, claims.UserName, r)
-	h.handler.ServeHTTP(w, r.WithContext(ctx))
+	// the handler may consume the request's body or change its params, so
+	// they are parsed from a copy up front, leaving the request it handles as
+	// it was
+	audited := auditedRequest(r)
+
+	metrics := httpsnoop.CaptureMetrics(h.handler, w, r.WithContext(ctx))
+
+	h.auditor.Audit(h.action, claims.UserName, audited, metrics.Code)
+}
+
+// maxAuditedFormSize matches the limit net/http places on form bodies.
+const maxAuditedFormSize = 10 << 20
+
+func auditedRequest(r *http.Request) *http.Request {
+	audited := r.Clone(r.Context())
+	audited.Body = http.NoBody
+
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if r.Body != nil && contentType == "application/x-www-form-urlencoded" {
+		// whatever is read is put back in front of the rest of the body
+		body, _ := ioutil.ReadAll(io.LimitReader(r.Body, maxAuditedFormSize))
+		r.Body = readCloser{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		audited.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	_ = audited.ParseForm()
+
+	return audited
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
 }
 
 func GetAccessor(r *http.Request) Access {
@@ -4,9 +4,10 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
-	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
@@ -25,8 +26,7 @@ var _ = Describe("Handler", func() {
 
 		createAccessError error
 
-		action      string
-		customRoles map[string]string
+		action string
 
 		r *http.Request
 		w *httptest.ResponseRecorder
@@ -41,7 +41,6 @@ var _ = Describe("Handler", func() {
 		fakeAuditor = new(auditorfakes.FakeAuditor)
 
 		action = "some-action"
-		customRoles = map[string]string{"some-action": "some-role"}
 
 		var err error
 		r, err = http.NewRequest("GET", "localhost:8080", nil)
@@ -58,61 +57,17 @@ var _ = Describe("Handler", func() {
 			fakeHandler,
 			fakeAccessorFactory,
 			fakeAuditor,
-			customRoles,
 		)
 
 		handler.ServeHTTP(w, r)
 	})
 
 	Describe("Accessor Handler", func() {
-		Context("when there's a default role for the given action", func() {
-			BeforeEach(func() {
-				action = atc.SaveConfig
-			})
-
-			Context("when the role has not been customized", func() {
-				BeforeEach(func() {
-					customRoles = map[string]string{}
-				})
-
-				It("finds the role", func() {
-					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
-					Expect(role).To(Equal(accessor.MemberRole))
-				})
-			})
-
-			Context("when the role has been customized", func() {
-				BeforeEach(func() {
-					customRoles = map[string]string{
-						atc.SaveConfig: accessor.ViewerRole,
-					}
-				})
-
-				It("finds the role", func() {
-					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
-					Expect(role).To(Equal(accessor.ViewerRole))
-				})
-			})
-		})
-
-		Context("when there's no default role for the given action", func() {
-			BeforeEach(func() {
-				action = "some-admin-role"
-			})
-
-			Context("when the role has not been customized", func() {
-				BeforeEach(func() {
-					customRoles = map[string]string{}
-				})
-
-				It("sends a blank role (admin roles don't have defaults)", func() {
-					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
-					Expect(role).To(BeEmpty())
-				})
-			})
+		It("creates the access for the action", func() {
+			Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
+			req, createdAction := fakeAccessorFactory.CreateArgsForCall(0)
+			Expect(req).To(Equal(r))
+			Expect(createdAction).To(Equal("some-action"))
 		})
 
 		Context("when the request is authenticated", func() {
@@ -127,10 +82,12 @@ var _ = Describe("Handler", func() {
 
 			It("audits the event", func() {
 				Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
-				action, userName, req := fakeAuditor.AuditArgsForCall(0)
+				action, userName, req, statusCode := fakeAuditor.AuditArgsForCall(0)
 				Expect(action).To(Equal("some-action"))
 				Expect(userName).To(Equal("some-user"))
-				Expect(req).To(Equal(r))
+				Expect(req.Method).To(Equal(r.Method))
+				Expect(req.URL).To(Equal(r.URL))
+				Expect(statusCode).To(Equal(http.StatusOK))
 			})
 
 			It("invokes the handler", func() {
@@ -138,6 +95,44 @@ var _ = Describe("Handler", func() {
 				_, r := fakeHandler.ServeHTTPArgsForCall(0)
 				Expect(accessor.GetAccessor(r)).To(Equal(fakeAccess))
 			})
+
+			Context("when the request has a form body", func() {
+				BeforeEach(func() {
+					var err error
+					r, err = http.NewRequest("POST", "localhost:8080?some=query", strings.NewReader("some-field=some-value"))
+					Expect(err).NotTo(HaveOccurred())
+					r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+					fakeHandler.ServeHTTPStub = func(w http.ResponseWriter, r *http.Request) {
+						Expect(r.Form).To(BeNil())
+						Expect(r.FormValue("some-field")).To(Equal("some-value"))
+						r.Form.Set("some-field", "changed-by-handler")
+					}
+				})
+
+				It("audits the params the request was made with", func() {
+					Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
+					_, _, req, _ := fakeAuditor.AuditArgsForCall(0)
+					Expect(req.Form).To(Equal(url.Values{
+						"some":       {"query"},
+						"some-field": {"some-value"},
+					}))
+				})
+			})
+
+			Context("when the handler responds with an error", func() {
+				BeforeEach(func() {
+					fakeHandler.ServeHTTPStub = func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+					}
+				})
+
+				It("audits the status code", func() {
+					Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
+					_, _, _, statusCode := fakeAuditor.AuditArgsForCall(0)
+					Expect(statusCode).To(Equal(http.StatusNotFound))
+				})
+			})
 		})
 
 		Context("when the request is not authenticated", func() {
@@ -148,10 +143,12 @@ var _ = Describe("Handler", func() {
 
 			It("audits the anonymous request", func() {
 				Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
-				action, userName, req := fakeAuditor.AuditArgsForCall(0)
+				action, userName, req, statusCode := fakeAuditor.AuditArgsForCall(0)
 				Expect(action).To(Equal("some-action"))
 				Expect(userName).To(Equal(""))
-				Expect(req).To(Equal(r))
+				Expect(req.Method).To(Equal(r.Method))
+				Expect(req.URL).To(Equal(r.URL))
+				Expect(statusCode).To(Equal(http.StatusOK))
 			})
 
 			It("invokes the handler", func() {
@@ -1,6 +1,8 @@
 package accessor
 
 import (
+	"fmt"
+
 	"github.com/concourse/concourse/atc"
 )
 
@@ -11,14 +13,27 @@ const (
 	ViewerRole   = "viewer"
 )
 
+// IsTeamRole returns whether role is one of the roles which can be granted
+// on a team.
+func IsTeamRole(role string) bool {
+	switch role {
+	case OwnerRole, MemberRole, OperatorRole, ViewerRole:
+		return true
+	default:
+		return false
+	}
+}
+
 var DefaultRoles = map[string]string{
 	atc.SaveConfig:                    MemberRole,
 	atc.GetConfig:                     ViewerRole,
 	atc.GetCC:                         ViewerRole,
 	atc.GetBuild:                      ViewerRole,
 	atc.GetBuildPlan:                  ViewerRole,
+	atc.GetBuildProvenance:            ViewerRole,
 	atc.CreateBuild:                   MemberRole,
 	atc.ListBuilds:                    ViewerRole,
+	atc.ListBuildQueue:                ViewerRole,
 	atc.BuildEvents:                   ViewerRole,
 	atc.BuildResources:                ViewerRole,
 	atc.AbortBuild:                    OperatorRole,
@@ -69,6 +84,9 @@ var DefaultRoles = map[string]string{
 	atc.ListPipelineBuilds:            ViewerRole,
 	atc.CreatePipelineBuild:           MemberRole,
 	atc.PipelineBadge:                 ViewerRole,
+	atc.ListPipelineNotifications:     ViewerRole,
+	atc.ListPipelineConfigHistory:     ViewerRole,
+	atc.GetPipelineConfigRevision:     ViewerRole,
 	atc.RegisterWorker:                MemberRole,
 	atc.LandWorker:                    MemberRole,
 	atc.RetireWorker:                  MemberRole,
@@ -89,14 +107,59 @@ var DefaultRoles = map[string]string{
 	atc.ListVolumes:                   ViewerRole,
 	atc.ListDestroyingVolumes:         ViewerRole,
 	atc.ReportWorkerVolumes:           MemberRole,
+	atc.ReportVolumeSizes:             MemberRole,
 	atc.ListTeams:                     ViewerRole,
 	atc.GetTeam:                       ViewerRole,
 	atc.SetTeam:                       OwnerRole,
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
+	atc.SearchBuildLogs:               ViewerRole,
+	atc.ListServiceAccounts:           OwnerRole,
+	atc.CreateServiceAccount:          OwnerRole,
+	atc.DeleteServiceAccount:          OwnerRole,
+	atc.CreateServiceAccountToken:     OwnerRole,
+	atc.RevokeServiceAccountToken:     OwnerRole,
 	atc.CreateArtifact:                MemberRole,
 	atc.GetArtifact:                   MemberRole,
 	atc.ListBuildArtifacts:            ViewerRole,
 	atc.GetWall:                       ViewerRole,
 }
+
+// ValidateCustomRoles checks that a team's custom roles do not shadow the
+// built-in roles and only permit actions which can be granted on a team.
+func ValidateCustomRoles(roles atc.CustomRoles) error {
+	err := roles.Validate()
+	if err != nil {
+		return err
+	}
+
+	for role, actions := range roles {
+		if IsTeamRole(role) {
+			return fmt.Errorf("custom role '%s' conflicts with the built-in role", role)
+		}
+
+		for _, action := range actions {
+			if _, ok := DefaultRoles[action]; !ok {
+				return fmt.Errorf("custom role '%s' permits unknown action '%s'", role, action)
+			}
+		}
+	}
+
+	return nil
+}
+
+// requiredRoles returns the least privileged built-in role required for each
+// action, with the given customizations applied.
+func requiredRoles(customRoles map[string]string) map[string]string {
+	roles := map[string]string{}
+	for action, role := range DefaultRoles {
+		roles[action] = role
+	}
+
+	for action, role := range customRoles {
+		roles[action] = role
+	}
+
+	return roles
+}
@@ -0,0 +1,30 @@
+package accessor_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateCustomRoles", func() {
+	It("allows roles which permit team actions", func() {
+		roles := atc.CustomRoles{"releaser": {atc.CreateJobBuild, atc.PinResourceVersion, atc.UnpinResource}}
+		Expect(accessor.ValidateCustomRoles(roles)).To(Succeed())
+	})
+
+	It("rejects roles which shadow a built-in role", func() {
+		roles := atc.CustomRoles{accessor.ViewerRole: {atc.SaveConfig}}
+		Expect(accessor.ValidateCustomRoles(roles)).To(MatchError("custom role 'viewer' conflicts with the built-in role"))
+	})
+
+	It("rejects admin and unknown actions", func() {
+		roles := atc.CustomRoles{"releaser": {atc.ListAuditEvents}}
+		Expect(accessor.ValidateCustomRoles(roles)).To(MatchError("custom role 'releaser' permits unknown action 'ListAuditEvents'"))
+	})
+
+	It("rejects roles without actions", func() {
+		roles := atc.CustomRoles{"releaser": {}}
+		Expect(accessor.ValidateCustomRoles(roles)).To(HaveOccurred())
+	})
+})
@@ -67,6 +67,12 @@ func (v *verifier) verify(rawToken string) (map[string]interface{}, error) {
 		return nil, ErrVerificationTokenExpired
 	}
 
+	// service account tokens are not issued to a client, so they have no
+	// audience to check
+	if strings.HasPrefix(rawToken, db.ServiceAccountTokenPrefix) {
+		return claims.RawClaims, nil
+	}
+
 	for _, aud := range v.audience {
 		if claims.Audience.Contains(aud) {
 			return claims.RawClaims, nil
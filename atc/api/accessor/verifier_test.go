@@ -125,6 +125,29 @@ var _ = Describe("Verifier", func() {
 			})
 		})
 
+		Context("when a service account token is given", func() {
+			BeforeEach(func() {
+				req.Header.Set("Authorization", "bearer "+db.ServiceAccountTokenPrefix+"1234567890")
+				accessToken.Claims = db.Claims{
+					RawClaims: map[string]interface{}{"name": "ci"},
+				}
+			})
+
+			It("does not check the audience", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when it has expired", func() {
+				BeforeEach(func() {
+					accessToken.Claims.Expiry = jwt.NewNumericDate(time.Now().Add(-1 * time.Hour))
+				})
+
+				It("fails verification", func() {
+					Expect(err).To(Equal(accessor.ErrVerificationTokenExpired))
+				})
+			})
+		})
+
 		Context("when the claims are valid", func() {
 			BeforeEach(func() {
 				oneHourFromNow := jwt.NewNumericDate(time.Now().Add(1 * time.Hour))
@@ -61,6 +61,9 @@ var (
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	dbPolicyDecisions       *dbfakes.FakePolicyDecisionRepository
+	dbAuditEvents           *dbfakes.FakeAuditEventRepository
+	dbEncryptionRotation    *dbfakes.FakeEncryptionRotation
 	fakeSecretManager       *credsfakes.FakeSecrets
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
@@ -109,6 +112,9 @@ var _ = BeforeEach(func() {
 	dbUserFactory = new(dbfakes.FakeUserFactory)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	dbPolicyDecisions = new(dbfakes.FakePolicyDecisionRepository)
+	dbAuditEvents = new(dbfakes.FakeAuditEventRepository)
+	dbEncryptionRotation = new(dbfakes.FakeEncryptionRotation)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -217,6 +223,9 @@ var _ = BeforeEach(func() {
 		interceptTimeoutFactory,
 		time.Second,
 		dbWall,
+		dbPolicyDecisions,
+		dbAuditEvents,
+		dbEncryptionRotation,
 		fakeClock,
 	)
 
@@ -230,7 +239,6 @@ var _ = BeforeEach(func() {
 		handler,
 		fakeAccessor,
 		new(auditorfakes.FakeAuditor),
-		map[string]string{},
 	)
 
 	handler = wrappa.LoggerHandler{
@@ -0,0 +1,155 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit API", func() {
+	Describe("GET /api/v1/audit", func() {
+		var (
+			response *http.Response
+			query    string
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/audit"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbAuditEvents.AuditEventsReturns([]atc.AuditEvent{
+					{
+						ID:     3,
+						Time:   1620945615,
+						User:   "some-user",
+						Team:   "some-team",
+						Action: atc.SaveConfig,
+						Target: "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+						Params: map[string][]string{"check_creds": {"true"}},
+						Status: 200,
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				Expect(response).Should(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+			})
+
+			It("returns the events", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"id": 3,
+						"time": 1620945615,
+						"user": "some-user",
+						"team": "some-team",
+						"action": "SaveConfig",
+						"target": "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+						"params": {"check_creds": ["true"]},
+						"status": 200
+					}
+				]`))
+			})
+
+			It("limits the events by default", func() {
+				Expect(dbAuditEvents.AuditEventsArgsForCall(0)).To(Equal(db.AuditEventFilter{
+					Limit: 100,
+				}))
+			})
+
+			Context("when filters are given", func() {
+				BeforeEach(func() {
+					query = "?team=some-team&user=some-user&action=SaveConfig&since=1620945000&until=1620946000&limit=10"
+				})
+
+				It("filters the events", func() {
+					Expect(dbAuditEvents.AuditEventsArgsForCall(0)).To(Equal(db.AuditEventFilter{
+						Team:   "some-team",
+						User:   "some-user",
+						Action: "SaveConfig",
+						Since:  time.Unix(1620945000, 0),
+						Until:  time.Unix(1620946000, 0),
+						Limit:  10,
+					}))
+				})
+			})
+
+			Context("when the limit is malformed", func() {
+				BeforeEach(func() {
+					query = "?limit=lots"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when a time is malformed", func() {
+				BeforeEach(func() {
+					query = "?since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when getting the events fails", func() {
+				BeforeEach(func() {
+					dbAuditEvents.AuditEventsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+})
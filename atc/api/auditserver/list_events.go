@@ -0,0 +1,66 @@
+package auditserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+const defaultEventsLimit = 100
+
+func (s *Server) ListEvents(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-audit-events")
+
+	filter := db.AuditEventFilter{
+		Team:   r.FormValue("team"),
+		User:   r.FormValue("user"),
+		Action: r.FormValue("action"),
+		Limit:  defaultEventsLimit,
+	}
+
+	if limit := r.FormValue("limit"); limit != "" {
+		var err error
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit <= 0 {
+			logger.Info("malformed-limit", lager.Data{"limit": limit})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	for param, dest := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		value := r.FormValue(param)
+		if value == "" {
+			continue
+		}
+
+		unix, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			logger.Info("malformed-time", lager.Data{param: value})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		*dest = time.Unix(unix, 0)
+	}
+
+	events, err := s.events.AuditEvents(filter)
+	if err != nil {
+		logger.Error("failed-to-get-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(events)
+	if err != nil {
+		logger.Error("failed-to-encode-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,21 @@
+package auditserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger lager.Logger
+	events db.AuditEventRepository
+}
+
+func NewServer(
+	logger lager.Logger,
+	events db.AuditEventRepository,
+) *Server {
+	return &Server{
+		logger: logger,
+		events: events,
+	}
+}
@@ -59,7 +59,6 @@ var _ = Describe("CheckAdminHandler", func() {
 			innerHandler,
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
-			map[string]string{},
 		))
 
 		client = &http.Client{
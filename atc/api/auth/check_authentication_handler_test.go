@@ -72,7 +72,6 @@ var _ = Describe("AuthenticationHandler", func() {
 				innerHandler,
 				fakeAccessor,
 				new(auditorfakes.FakeAuditor),
-				map[string]string{},
 			))
 		})
 
@@ -130,7 +129,6 @@ var _ = Describe("AuthenticationHandler", func() {
 				innerHandler,
 				fakeAccessor,
 				new(auditorfakes.FakeAuditor),
-				map[string]string{},
 			))
 		})
 
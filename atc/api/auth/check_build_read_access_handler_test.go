@@ -114,7 +114,6 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 				innerHandler,
 				fakeAccessor,
 				new(auditorfakes.FakeAuditor),
-				map[string]string{},
 			)
 		})
 
@@ -246,7 +245,6 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 				innerHandler,
 				fakeAccessor,
 				new(auditorfakes.FakeAuditor),
-				map[string]string{},
 			)
 		})
 
@@ -51,7 +51,6 @@ var _ = Describe("CheckBuildWriteAccessHandler", func() {
 			innerHandler,
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
-			map[string]string{},
 		)
 	})
 
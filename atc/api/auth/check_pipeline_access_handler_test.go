@@ -50,7 +50,6 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 			innerHandler,
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
-			map[string]string{},
 		)
 	})
 
@@ -471,6 +471,127 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/queue", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			build1 := new(dbfakes.FakeBuild)
+			build1.IDReturns(4)
+			build1.NameReturns("2")
+			build1.JobNameReturns("job2")
+			build1.PipelineIDReturns(1)
+			build1.PipelineNameReturns("pipeline2")
+			build1.TeamNameReturns("some-team")
+			build1.StatusReturns(db.BuildStatusPending)
+			build1.PriorityReturns(atc.JobPriorityHigh)
+			build1.QueueReasonReturns(atc.QueueReasonMaxInFlight)
+			build1.CreateTimeReturns(time.Unix(1, 0))
+
+			build2 := new(dbfakes.FakeBuild)
+			build2.IDReturns(3)
+			build2.NameReturns("1")
+			build2.JobNameReturns("job1")
+			build2.PipelineIDReturns(2)
+			build2.PipelineNameReturns("pipeline1")
+			build2.TeamNameReturns("some-team")
+			build2.StatusReturns(db.BuildStatusStarted)
+			build2.PriorityReturns(atc.JobPriorityNormal)
+			build2.QueueReasonReturns(atc.QueueReasonNoWorker)
+
+			dbBuildFactory.AllQueuedBuildsReturns([]db.Build{build1, build2}, nil)
+			dbBuildFactory.VisibleQueuedBuildsReturns([]db.Build{build1, build2}, nil)
+			fakeAccess.TeamNamesReturns([]string{"some-team"})
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/queue")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not an admin", func() {
+			It("returns 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				expectedHeaderEntries := map[string]string{
+					"Content-Type": "application/json",
+				}
+				Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+			})
+
+			It("only lists builds of the teams the user can see", func() {
+				Expect(dbBuildFactory.AllQueuedBuildsCallCount()).To(BeZero())
+				Expect(dbBuildFactory.VisibleQueuedBuildsCallCount()).To(Equal(1))
+				Expect(dbBuildFactory.VisibleQueuedBuildsArgsForCall(0)).To(ConsistOf("some-team"))
+			})
+
+			It("returns the queued builds in order", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 4,
+						"name": "2",
+						"status": "pending",
+						"team_name": "some-team",
+						"pipeline_id": 1,
+						"pipeline_name": "pipeline2",
+						"job_name": "job2",
+						"priority": "high",
+						"reason": "max-in-flight",
+						"create_time": 1
+					},
+					{
+						"id": 3,
+						"name": "1",
+						"status": "started",
+						"team_name": "some-team",
+						"pipeline_id": 2,
+						"pipeline_name": "pipeline1",
+						"job_name": "job1",
+						"priority": "normal",
+						"reason": "no-worker"
+					}
+				]`))
+			})
+
+			Context("when getting the queued builds fails", func() {
+				BeforeEach(func() {
+					dbBuildFactory.VisibleQueuedBuildsReturns(nil, errors.New("oh no!"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("lists the builds of all teams", func() {
+				Expect(dbBuildFactory.VisibleQueuedBuildsCallCount()).To(BeZero())
+				Expect(dbBuildFactory.AllQueuedBuildsCallCount()).To(Equal(1))
+			})
+
+			Context("when getting the queued builds fails", func() {
+				BeforeEach(func() {
+					dbBuildFactory.AllQueuedBuildsReturns(nil, errors.New("oh no!"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id", func() {
 		var response *http.Response
 
@@ -1530,4 +1651,90 @@ var _ = Describe("Builds API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/builds/:build_id/provenance", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/provenance")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				build.TeamNameReturns("some-team")
+				build.JobIDReturns(42)
+				build.JobNameReturns("job1")
+				build.PipelineIDReturns(42)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authenticated and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(false)
+					build.PipelineReturns(fakePipeline, true, nil)
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when the build has provenance", func() {
+					BeforeEach(func() {
+						build.ProvenanceReturns(atc.ProvenanceEnvelope{
+							PayloadType: atc.ProvenancePayloadType,
+							Payload:     "c29tZS1wYXlsb2Fk",
+							Signatures: []atc.ProvenanceSignature{
+								{KeyID: "some-key", Sig: "c29tZS1zaWc="},
+							},
+						}, true, nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns the signed envelope", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"payloadType": "application/vnd.in-toto+json",
+							"payload": "c29tZS1wYXlsb2Fk",
+							"signatures": [{"keyid": "some-key", "sig": "c29tZS1zaWc="}]
+						}`))
+					})
+				})
+
+				Context("when the build has no provenance", func() {
+					BeforeEach(func() {
+						build.ProvenanceReturns(atc.ProvenanceEnvelope{}, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when getting the provenance fails", func() {
+					BeforeEach(func() {
+						build.ProvenanceReturns(atc.ProvenanceEnvelope{}, false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package buildserver
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,7 +15,35 @@ import (
 const ProtocolVersionHeader = "X-ATC-Stream-Version"
 const CurrentProtocolVersion = "2.0"
 
+//go:generate counterfeiter . EventArchive
+
+// EventArchive provides the events of builds which were archived before being
+// reaped from the database.
+type EventArchive interface {
+	Events(ctx context.Context, location string, from uint) (db.EventSource, error)
+}
+
+// NewArchivedEventHandlerFactory returns an EventHandlerFactory which streams
+// a build's events from the archive once they have been reaped.
+func NewArchivedEventHandlerFactory(archive EventArchive) EventHandlerFactory {
+	return func(logger lager.Logger, build db.Build) http.Handler {
+		if build.LogArchive() == "" || build.ReapTime().IsZero() {
+			return NewEventHandler(logger, build)
+		}
+
+		return newEventHandler(logger, build, func(r *http.Request, from uint) (db.EventSource, error) {
+			return archive.Events(r.Context(), build.LogArchive(), from)
+		})
+	}
+}
+
 func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
+	return newEventHandler(logger, build, func(r *http.Request, from uint) (db.EventSource, error) {
+		return build.Events(from)
+	})
+}
+
+func newEventHandler(logger lager.Logger, build db.Build, openEvents func(*http.Request, uint) (db.EventSource, error)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var eventID uint = 0
 		if r.Header.Get("Last-Event-ID") != "" {
@@ -39,7 +68,7 @@ func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
 			responseFlusher: w.(http.Flusher),
 		}
 
-		events, err := build.Events(eventID)
+		events, err := openEvents(r, eventID)
 		if err != nil {
 			logger.Error("failed-to-get-build-events", err, lager.Data{"build-id": build.ID(), "start": eventID})
 			w.WriteHeader(http.StatusInternalServerError)
@@ -11,6 +11,7 @@ import (
 
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/concourse/concourse/atc/api/buildserver"
+	"github.com/concourse/concourse/atc/api/buildserver/buildserverfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
@@ -288,3 +289,103 @@ var _ = Describe("Handler", func() {
 		})
 	})
 })
+
+var _ = Describe("ArchivedEventHandlerFactory", func() {
+	var (
+		build       *dbfakes.FakeBuild
+		fakeArchive *buildserverfakes.FakeEventArchive
+		archivedSrc *dbfakes.FakeEventSource
+		databaseSrc *dbfakes.FakeEventSource
+		lastEventID string
+		events      []sse.Event
+	)
+
+	BeforeEach(func() {
+		build = new(dbfakes.FakeBuild)
+		fakeArchive = new(buildserverfakes.FakeEventArchive)
+		lastEventID = ""
+
+		archivedSrc = new(dbfakes.FakeEventSource)
+		archivedSrc.NextReturnsOnCall(0, fakeEvent(`{"archived":true}`), nil)
+		archivedSrc.NextReturnsOnCall(1, event.Envelope{}, db.ErrEndOfBuildEventStream)
+		fakeArchive.EventsReturns(archivedSrc, nil)
+
+		databaseSrc = new(dbfakes.FakeEventSource)
+		databaseSrc.NextReturnsOnCall(0, fakeEvent(`{"archived":false}`), nil)
+		databaseSrc.NextReturnsOnCall(1, event.Envelope{}, db.ErrEndOfBuildEventStream)
+		build.EventsReturns(databaseSrc, nil)
+	})
+
+	JustBeforeEach(func() {
+		handler := NewArchivedEventHandlerFactory(fakeArchive)(lagertest.NewTestLogger("test"), build)
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		request, err := http.NewRequest("GET", server.URL, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		if lastEventID != "" {
+			request.Header.Set("Last-Event-ID", lastEventID)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+
+		reader := sse.NewReadCloser(response.Body)
+		defer reader.Close()
+
+		events = []sse.Event{}
+		for {
+			ev, err := reader.Next()
+			Expect(err).NotTo(HaveOccurred())
+
+			events = append(events, ev)
+			if ev.Name == "end" {
+				break
+			}
+		}
+	})
+
+	Context("when the build's events have been archived and reaped", func() {
+		BeforeEach(func() {
+			build.LogArchiveReturns("builds/42/events.json.gz")
+			build.ReapTimeReturns(time.Now())
+			lastEventID = "4"
+		})
+
+		It("streams the events from the archive", func() {
+			Expect(build.EventsCallCount()).To(BeZero())
+
+			Expect(fakeArchive.EventsCallCount()).To(Equal(1))
+			_, location, from := fakeArchive.EventsArgsForCall(0)
+			Expect(location).To(Equal("builds/42/events.json.gz"))
+			Expect(from).To(Equal(uint(5)))
+
+			Expect(events[0].Data).To(MatchJSON(`{"data":{"archived":true},"event":"fake","version":"42.0"}`))
+		})
+	})
+
+	Context("when the build's events have not been reaped", func() {
+		BeforeEach(func() {
+			build.LogArchiveReturns("builds/42/events.json.gz")
+		})
+
+		It("streams the events from the database", func() {
+			Expect(fakeArchive.EventsCallCount()).To(BeZero())
+			Expect(build.EventsCallCount()).To(Equal(1))
+
+			Expect(events[0].Data).To(MatchJSON(`{"data":{"archived":false},"event":"fake","version":"42.0"}`))
+		})
+	})
+
+	Context("when the build's events were reaped without being archived", func() {
+		BeforeEach(func() {
+			build.ReapTimeReturns(time.Now())
+		})
+
+		It("streams the events from the database", func() {
+			Expect(fakeArchive.EventsCallCount()).To(BeZero())
+			Expect(build.EventsCallCount()).To(Equal(1))
+		})
+	})
+})
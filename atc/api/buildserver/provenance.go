@@ -0,0 +1,34 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildProvenance(build db.Build) http.Handler {
+	hLog := s.logger.Session("get-build-provenance")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		envelope, found, err := build.Provenance()
+		if err != nil {
+			hLog.Error("failed-to-get-build-provenance", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(envelope)
+		if err != nil {
+			hLog.Error("failed-to-encode-build-provenance", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+}
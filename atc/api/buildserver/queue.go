@@ -0,0 +1,47 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListBuildQueue(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-build-queue")
+
+	var (
+		builds []db.Build
+		err    error
+	)
+
+	acc := accessor.GetAccessor(r)
+	if acc.IsAdmin() {
+		builds, err = s.buildFactory.AllQueuedBuilds()
+	} else {
+		builds, err = s.buildFactory.VisibleQueuedBuilds(acc.TeamNames())
+	}
+
+	if err != nil {
+		logger.Error("failed-to-get-queued-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	queue := make([]atc.QueuedBuild, len(builds))
+	for i, build := range builds {
+		queue[i] = present.QueuedBuild(build)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(queue)
+	if err != nil {
+		logger.Error("failed-to-encode-queued-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -338,6 +339,8 @@ var _ = Describe("Config API", func() {
 				"pipeline_name": "a-pipeline",
 			}, nil)
 			Expect(err).NotTo(HaveOccurred())
+
+			fakeAccess.UserInfoReturns(atc.UserInfo{DisplayUserId: "some-user"})
 		})
 
 		JustBeforeEach(func() {
@@ -448,7 +451,7 @@ var _ = Describe("Config API", func() {
 						})
 
 						It("does not save anything", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 						})
 					})
 
@@ -479,7 +482,7 @@ var _ = Describe("Config API", func() {
 						})
 
 						It("does not save anything", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 						})
 					})
 				})
@@ -511,18 +514,34 @@ var _ = Describe("Config API", func() {
 						})
 
 						It("saves it initially paused", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+							Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(1))
 
-							ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+							ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineWithChangeArgsForCall(0)
 							Expect(ref.Name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
 						})
 
+						It("records the user who saved it", func() {
+							_, _, _, _, change := dbTeam.SavePipelineWithChangeArgsForCall(0)
+							Expect(change).To(Equal(db.ConfigChange{Author: "some-user"}))
+						})
+
+						Context("when a message is given", func() {
+							BeforeEach(func() {
+								request.URL.RawQuery = url.Values{atc.SaveConfigMessage: {"fix the build"}}.Encode()
+							})
+
+							It("records it with the config", func() {
+								_, _, _, _, change := dbTeam.SavePipelineWithChangeArgsForCall(0)
+								Expect(change.Message).To(Equal("fix the build"))
+							})
+						})
+
 						Context("and saving it fails", func() {
 							BeforeEach(func() {
-								dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
+								dbTeam.SavePipelineWithChangeReturns(nil, false, errors.New("oh no!"))
 							})
 
 							It("returns 500", func() {
@@ -534,10 +553,32 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when a job has a matrix", func() {
+							BeforeEach(func() {
+								pipelineConfig.Jobs[0].Matrix = []atc.MatrixVarConfig{
+									{Var: "os", Values: []interface{}{"linux", "windows"}},
+								}
+
+								payload, err := json.Marshal(pipelineConfig)
+								Expect(err).NotTo(HaveOccurred())
+								request.Body = gbytes.BufferWithBytes(payload)
+							})
+
+							It("saves the expanded jobs", func() {
+								Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(1))
+
+								_, savedConfig, _, _, _ := dbTeam.SavePipelineWithChangeArgsForCall(0)
+								Expect(savedConfig.Jobs).To(HaveLen(2))
+								Expect(savedConfig.Jobs[0].Name).To(Equal("some-job-linux"))
+								Expect(savedConfig.Jobs[1].Name).To(Equal("some-job-windows"))
+								Expect(savedConfig.Groups[0].Jobs).To(Equal([]string{"some-job-linux", "some-job-windows"}))
+							})
+						})
+
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
-								dbTeam.SavePipelineReturns(returnedPipeline, true, nil)
+								dbTeam.SavePipelineWithChangeReturns(returnedPipeline, true, nil)
 							})
 
 							It("returns 201", func() {
@@ -578,7 +619,7 @@ var _ = Describe("Config API", func() {
 							})
 
 							It("does not save it", func() {
-								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 							})
 						})
 					})
@@ -609,9 +650,9 @@ var _ = Describe("Config API", func() {
 						})
 
 						It("saves it initially paused", func() {
-							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+							Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(1))
 
-							ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+							ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineWithChangeArgsForCall(0)
 							Expect(ref.Name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -656,9 +697,9 @@ jobs:
 							})
 
 							It("saves it", func() {
-								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+								Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(1))
 
-								ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+								ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineWithChangeArgsForCall(0)
 								Expect(ref.Name).To(Equal("a-pipeline"))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
@@ -724,7 +765,7 @@ jobs:
 									})
 
 									It("passes validation", func() {
-										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+										Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(1))
 									})
 
 									It("returns 200 ok", func() {
@@ -740,7 +781,7 @@ jobs:
 									})
 
 									It("fail validation", func() {
-										Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+										Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 									})
 
 									It("returns 400", func() {
@@ -965,9 +1006,9 @@ jobs:
 									})
 
 									It("passes validation and saves it un-interpolated", func() {
-										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+										Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(1))
 
-										ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+										ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineWithChangeArgsForCall(0)
 										Expect(ref.Name).To(Equal("a-pipeline"))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 										Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -1015,7 +1056,7 @@ jobs:
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
-								dbTeam.SavePipelineReturns(returnedPipeline, true, nil)
+								dbTeam.SavePipelineWithChangeReturns(returnedPipeline, true, nil)
 							})
 
 							It("returns 201", func() {
@@ -1029,7 +1070,7 @@ jobs:
 
 						Context("and saving it fails", func() {
 							BeforeEach(func() {
-								dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
+								dbTeam.SavePipelineWithChangeReturns(nil, false, errors.New("oh no!"))
 							})
 
 							It("returns 500", func() {
@@ -1070,7 +1111,7 @@ jobs:
 							})
 
 							It("does not save it", func() {
-								Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+								Expect(dbTeam.SavePipelineWithChangeCallCount()).To(BeZero())
 							})
 						})
 
@@ -1103,7 +1144,7 @@ jobs:
 								})
 
 								It("does not save anything", func() {
-									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+									Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 								})
 							})
 
@@ -1115,9 +1156,9 @@ jobs:
 								})
 
 								It("saves an instanced pipeline", func() {
-									Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+									Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(1))
 
-									ref, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
+									ref, _, _, _, _ := dbTeam.SavePipelineWithChangeArgsForCall(0)
 									Expect(ref).To(Equal(atc.PipelineRef{
 										Name:         "a-pipeline",
 										InstanceVars: atc.InstanceVars{"branch": "feature"},
@@ -1175,7 +1216,7 @@ jobs:
 					})
 
 					It("does not save it", func() {
-						Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+						Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 					})
 				})
 
@@ -1215,9 +1256,9 @@ jobs:
 					})
 
 					It("saves it", func() {
-						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+						Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(1))
 
-						ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+						ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineWithChangeArgsForCall(0)
 						Expect(ref.Name).To(Equal("a-pipeline"))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
@@ -1269,7 +1310,7 @@ jobs:
 					})
 
 					It("does not save it", func() {
-						Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+						Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 					})
 				})
 			})
@@ -1300,7 +1341,7 @@ jobs:
 				})
 
 				It("does not save it", func() {
-					Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+					Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 				})
 			})
 		})
@@ -1315,7 +1356,7 @@ jobs:
 			})
 
 			It("does not save the config", func() {
-				Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+				Expect(dbTeam.SavePipelineWithChangeCallCount()).To(Equal(0))
 			})
 		})
 	})
@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -68,6 +69,13 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	config, err := config.ExpandJobMatrices()
+	if err != nil {
+		session.Error("failed-to-expand-job-matrices", err)
+		s.handleBadRequest(w, fmt.Sprintf("failed to expand job matrices: %s", err))
+		return
+	}
+
 	pipelineName := rata.Param(r, "pipeline_name")
 	warning, err := atc.ValidateIdentifier(pipelineName, "pipeline")
 	if err != nil {
@@ -128,7 +136,12 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineRef, config, version, true)
+	change := db.ConfigChange{
+		Author:  accessor.GetAccessor(r).UserInfo().DisplayUserId,
+		Message: query.Get(atc.SaveConfigMessage),
+	}
+
+	_, created, err := team.SavePipelineWithChange(pipelineRef, config, version, true, change)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
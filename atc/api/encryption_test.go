@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encryption API", func() {
+	Describe("GET /api/v1/encryption", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/encryption", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbEncryptionRotation.ProgressReturns(atc.EncryptionProgress{
+					ActiveKey: "some-key",
+					Total:     3,
+					Pending:   1,
+					Failed:    1,
+					Columns: []atc.EncryptedColumnProgress{
+						{Table: "pipelines", Column: "var_sources", Total: 3, Pending: 1, Failed: 1},
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				Expect(response).Should(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+			})
+
+			It("returns the progress", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"active_key": "some-key",
+					"total": 3,
+					"pending": 1,
+					"failed": 1,
+					"columns": [
+						{"table": "pipelines", "column": "var_sources", "total": 3, "pending": 1, "failed": 1}
+					]
+				}`))
+			})
+
+			Context("when getting the progress fails", func() {
+				BeforeEach(func() {
+					dbEncryptionRotation.ProgressReturns(atc.EncryptionProgress{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package encryptionserver
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (s *Server) GetProgress(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-encryption-progress")
+
+	if s.rotation == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	progress, err := s.rotation.Progress()
+	if err != nil {
+		logger.Error("failed-to-get-encryption-progress", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(progress)
+	if err != nil {
+		logger.Error("failed-to-encode-encryption-progress", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,23 @@
+package encryptionserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger   lager.Logger
+	rotation db.EncryptionRotation
+}
+
+// NewServer returns a Server reporting the progress of the given rotation,
+// which is nil when no keyring is configured.
+func NewServer(
+	logger lager.Logger,
+	rotation db.EncryptionRotation,
+) *Server {
+	return &Server{
+		logger:   logger,
+		rotation: rotation,
+	}
+}
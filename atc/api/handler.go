@@ -9,17 +9,21 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/artifactserver"
+	"github.com/concourse/concourse/atc/api/auditserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
 	"github.com/concourse/concourse/atc/api/configserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/api/encryptionserver"
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
+	"github.com/concourse/concourse/atc/api/policyserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
+	"github.com/concourse/concourse/atc/api/serviceaccountserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
@@ -31,6 +35,7 @@ import (
 	"github.com/concourse/concourse/atc/mainredirect"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
+	"github.com/concourse/concourse/skymarshal/token"
 	"github.com/tedsuo/rata"
 )
 
@@ -73,6 +78,9 @@ func NewHandler(
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
 	dbWall db.Wall,
+	dbPolicyDecisions db.PolicyDecisionRepository,
+	dbAuditEvents db.AuditEventRepository,
+	dbEncryptionRotation db.EncryptionRotation,
 	clock clock.Clock,
 ) (http.Handler, error) {
 
@@ -103,6 +111,10 @@ func NewHandler(
 	artifactServer := artifactserver.NewServer(logger, workerPool)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	policyServer := policyserver.NewServer(logger, dbPolicyDecisions)
+	auditServer := auditserver.NewServer(logger, dbAuditEvents)
+	encryptionServer := encryptionserver.NewServer(logger, dbEncryptionRotation)
+	serviceAccountServer := serviceaccountserver.NewServer(logger, token.GenerateServiceAccountToken)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -111,11 +123,13 @@ func NewHandler(
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
 		atc.ListBuilds:          http.HandlerFunc(buildServer.ListBuilds),
+		atc.ListBuildQueue:      http.HandlerFunc(buildServer.ListBuildQueue),
 		atc.CreateBuild:         teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
 		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
 		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
 		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
+		atc.GetBuildProvenance:  buildHandlerFactory.HandlerFor(buildServer.GetBuildProvenance),
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
@@ -155,6 +169,10 @@ func NewHandler(
 		atc.CreatePipelineBuild: pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:       pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
 
+		atc.ListPipelineNotifications: pipelineHandlerFactory.HandlerFor(pipelineServer.ListPipelineNotifications),
+		atc.ListPipelineConfigHistory: pipelineHandlerFactory.HandlerFor(pipelineServer.ListConfigHistory),
+		atc.GetPipelineConfigRevision: pipelineHandlerFactory.HandlerFor(pipelineServer.GetConfigRevision),
+
 		atc.ListAllResources:        http.HandlerFunc(resourceServer.ListAllResources),
 		atc.ListResources:           pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
 		atc.ListResourceTypes:       pipelineHandlerFactory.HandlerFor(resourceServer.ListVersionedResourceTypes),
@@ -201,13 +219,21 @@ func NewHandler(
 		atc.ListVolumes:           teamHandlerFactory.HandlerFor(volumesServer.ListVolumes),
 		atc.ListDestroyingVolumes: http.HandlerFunc(volumesServer.ListDestroyingVolumes),
 		atc.ReportWorkerVolumes:   http.HandlerFunc(volumesServer.ReportWorkerVolumes),
-
-		atc.ListTeams:      http.HandlerFunc(teamServer.ListTeams),
-		atc.GetTeam:        teamHandlerFactory.HandlerFor(teamServer.GetTeam),
-		atc.SetTeam:        http.HandlerFunc(teamServer.SetTeam),
-		atc.RenameTeam:     teamHandlerFactory.HandlerFor(teamServer.RenameTeam),
-		atc.DestroyTeam:    teamHandlerFactory.HandlerFor(teamServer.DestroyTeam),
-		atc.ListTeamBuilds: teamHandlerFactory.HandlerFor(teamServer.ListTeamBuilds),
+		atc.ReportVolumeSizes:     http.HandlerFunc(volumesServer.ReportVolumeSizes),
+
+		atc.ListTeams:       http.HandlerFunc(teamServer.ListTeams),
+		atc.GetTeam:         teamHandlerFactory.HandlerFor(teamServer.GetTeam),
+		atc.SetTeam:         http.HandlerFunc(teamServer.SetTeam),
+		atc.RenameTeam:      teamHandlerFactory.HandlerFor(teamServer.RenameTeam),
+		atc.DestroyTeam:     teamHandlerFactory.HandlerFor(teamServer.DestroyTeam),
+		atc.ListTeamBuilds:  teamHandlerFactory.HandlerFor(teamServer.ListTeamBuilds),
+		atc.SearchBuildLogs: teamHandlerFactory.HandlerFor(teamServer.SearchBuildLogs),
+
+		atc.ListServiceAccounts:       teamHandlerFactory.HandlerFor(serviceAccountServer.ListServiceAccounts),
+		atc.CreateServiceAccount:      teamHandlerFactory.HandlerFor(serviceAccountServer.CreateServiceAccount),
+		atc.DeleteServiceAccount:      teamHandlerFactory.HandlerFor(serviceAccountServer.DeleteServiceAccount),
+		atc.CreateServiceAccountToken: teamHandlerFactory.HandlerFor(serviceAccountServer.CreateServiceAccountToken),
+		atc.RevokeServiceAccountToken: teamHandlerFactory.HandlerFor(serviceAccountServer.RevokeServiceAccountToken),
 
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
@@ -215,6 +241,11 @@ func NewHandler(
 		atc.GetWall:   http.HandlerFunc(wallServer.GetWall),
 		atc.SetWall:   http.HandlerFunc(wallServer.SetWall),
 		atc.ClearWall: http.HandlerFunc(wallServer.ClearWall),
+
+		atc.ListPolicyDecisions: http.HandlerFunc(policyServer.ListDecisions),
+		atc.ListAuditEvents:     http.HandlerFunc(auditServer.ListEvents),
+
+		atc.GetEncryptionProgress: http.HandlerFunc(encryptionServer.GetProgress),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
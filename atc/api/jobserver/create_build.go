@@ -75,6 +75,7 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 					resourceTypes,
 					version,
 					true,
+					false,
 				)
 				if err != nil {
 					logger.Error("failed-to-create-check", err)
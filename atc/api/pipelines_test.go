@@ -1549,6 +1549,312 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/notifications", func() {
+		var response *http.Response
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/notifications"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+			})
+
+			Context("when getting the deliveries works", func() {
+				BeforeEach(func() {
+					delivery := new(dbfakes.FakeNotificationDelivery)
+					delivery.IDReturns(3)
+					delivery.TeamNameReturns("a-team")
+					delivery.PipelineIDReturns(1)
+					delivery.PipelineNameReturns("a-pipeline")
+					delivery.JobNameReturns("some-job")
+					delivery.BuildIDReturns(42)
+					delivery.BuildNameReturns("7")
+					delivery.BuildStatusReturns(db.BuildStatusFailed)
+					delivery.SinkReturns("some-sink")
+					delivery.StatusReturns(atc.NotificationDeliveryFailed)
+					delivery.AttemptsReturns(5)
+					delivery.LastErrorReturns("unexpected response 500: oops")
+					delivery.CreatedAtReturns(time.Unix(1, 0))
+					delivery.UpdatedAtReturns(time.Unix(100, 0))
+
+					dbPipeline.NotificationDeliveriesReturns([]db.NotificationDelivery{delivery}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns application/json", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("uses the default limit", func() {
+					Expect(dbPipeline.NotificationDeliveriesCallCount()).To(Equal(1))
+					Expect(dbPipeline.NotificationDeliveriesArgsForCall(0)).To(Equal(atc.PaginationAPIDefaultLimit))
+				})
+
+				It("returns the deliveries", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 3,
+							"team_name": "a-team",
+							"pipeline_id": 1,
+							"pipeline_name": "a-pipeline",
+							"job_name": "some-job",
+							"build_id": 42,
+							"build_name": "7",
+							"build_status": "failed",
+							"sink": "some-sink",
+							"status": "failed",
+							"attempts": 5,
+							"last_error": "unexpected response 500: oops",
+							"created_at": 1,
+							"updated_at": 100
+						}
+					]`))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=2"
+					})
+
+					It("passes it along", func() {
+						Expect(dbPipeline.NotificationDeliveriesArgsForCall(0)).To(Equal(2))
+					})
+				})
+			})
+
+			Context("when getting the deliveries fails", func() {
+				BeforeEach(func() {
+					dbPipeline.NotificationDeliveriesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/history", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/config/history", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+			})
+
+			Context("when getting the history works", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigHistoryReturns([]atc.PipelineConfigRevision{
+						{Revision: 2, Author: "some-user", Message: "add a job", SavedAt: 100},
+						{Revision: 1, SavedAt: 1},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns application/json", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the revisions", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"revision": 2,
+							"author": "some-user",
+							"message": "add a job",
+							"saved_at": 100
+						},
+						{
+							"revision": 1,
+							"saved_at": 1
+						}
+					]`))
+				})
+			})
+
+			Context("when getting the history fails", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigHistoryReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/history/:revision", func() {
+		var response *http.Response
+		var revision string
+
+		BeforeEach(func() {
+			revision = "1"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/config/history/"+revision, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+			})
+
+			Context("when the revision exists", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigRevisionReturns(atc.PipelineConfigRevision{
+						Revision: 1,
+						Author:   "some-user",
+						SavedAt:  1,
+						Config: &atc.Config{
+							Jobs: atc.JobConfigs{{Name: "some-job"}},
+						},
+					}, true, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("looks up the requested revision", func() {
+					Expect(dbPipeline.ConfigRevisionCallCount()).To(Equal(1))
+					Expect(dbPipeline.ConfigRevisionArgsForCall(0)).To(Equal(1))
+				})
+
+				It("returns the revision with its config", func() {
+					var body atc.PipelineConfigRevision
+					err := json.NewDecoder(response.Body).Decode(&body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body.Revision).To(Equal(1))
+					Expect(body.Author).To(Equal("some-user"))
+					Expect(body.Config).ToNot(BeNil())
+					Expect(body.Config.Jobs[0].Name).To(Equal("some-job"))
+				})
+			})
+
+			Context("when the revision does not exist", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigRevisionReturns(atc.PipelineConfigRevision{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the revision is not a number", func() {
+				BeforeEach(func() {
+					revision = "latest"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not look anything up", func() {
+					Expect(dbPipeline.ConfigRevisionCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the revision fails", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigRevisionReturns(atc.PipelineConfigRevision{}, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/rename", func() {
 		var response *http.Response
 		var requestBody string
@@ -0,0 +1,64 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListConfigHistory(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-config-history")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		history, err := pipeline.ConfigHistory()
+		if err != nil {
+			logger.Error("failed-to-get-config-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(history)
+		if err != nil {
+			logger.Error("failed-to-encode-config-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) GetConfigRevision(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-config-revision")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		number, err := strconv.Atoi(r.FormValue(":revision"))
+		if err != nil {
+			logger.Info("malformed-revision", lager.Data{"revision": r.FormValue(":revision")})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		revision, found, err := pipeline.ConfigRevision(number)
+		if err != nil {
+			logger.Error("failed-to-get-config-revision", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(revision)
+		if err != nil {
+			logger.Error("failed-to-encode-config-revision", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
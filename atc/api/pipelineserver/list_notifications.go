@@ -0,0 +1,42 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListPipelineNotifications(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-pipeline-notifications")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+		if limit <= 0 {
+			limit = atc.PaginationAPIDefaultLimit
+		}
+
+		deliveries, err := pipeline.NotificationDeliveries(limit)
+		if err != nil {
+			logger.Error("failed-to-get-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := make([]atc.NotificationDelivery, len(deliveries))
+		for i, delivery := range deliveries {
+			presented[i] = present.NotificationDelivery(delivery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
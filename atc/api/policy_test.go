@@ -0,0 +1,142 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy API", func() {
+	Describe("GET /api/v1/policy/decisions", func() {
+		var (
+			response *http.Response
+			query    string
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/policy/decisions"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbPolicyDecisions.DecisionsReturns([]atc.PolicyDecision{
+					{
+						ID:        2,
+						InputHash: "some-hash",
+						Action:    "RunTask",
+						Team:      "some-team",
+						Pipeline:  "some-pipeline",
+						Result:    atc.PolicyDecisionWarned,
+						Reasons:   []string{"no privileged tasks"},
+						Time:      1620859215,
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				Expect(response).Should(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+			})
+
+			It("returns the decisions", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"id": 2,
+						"input_hash": "some-hash",
+						"action": "RunTask",
+						"team": "some-team",
+						"pipeline": "some-pipeline",
+						"result": "warned",
+						"reasons": ["no privileged tasks"],
+						"time": 1620859215
+					}
+				]`))
+			})
+
+			It("limits the decisions by default", func() {
+				Expect(dbPolicyDecisions.DecisionsArgsForCall(0)).To(Equal(db.PolicyDecisionFilter{
+					Limit: 100,
+				}))
+			})
+
+			Context("when filters are given", func() {
+				BeforeEach(func() {
+					query = "?team=some-team&action=RunTask&result=warned&limit=10"
+				})
+
+				It("filters the decisions", func() {
+					Expect(dbPolicyDecisions.DecisionsArgsForCall(0)).To(Equal(db.PolicyDecisionFilter{
+						Team:   "some-team",
+						Action: "RunTask",
+						Result: "warned",
+						Limit:  10,
+					}))
+				})
+			})
+
+			Context("when the limit is malformed", func() {
+				BeforeEach(func() {
+					query = "?limit=lots"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when getting the decisions fails", func() {
+				BeforeEach(func() {
+					dbPolicyDecisions.DecisionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/policy"
 )
@@ -50,5 +51,11 @@ func (h policyCheckingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if result.Warned {
+		for _, reason := range result.Reasons {
+			w.Header().Add(atc.PolicyCheckWarningHeader, reason)
+		}
+	}
+
 	h.handler.ServeHTTP(w, r)
 }
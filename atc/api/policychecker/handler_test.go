@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/lager/lagertest"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
 	"github.com/concourse/concourse/atc/policy"
@@ -61,6 +62,27 @@ var _ = Describe("Handler", func() {
 		})
 	})
 
+	Context("policy check doesn't pass in warn mode", func() {
+		BeforeEach(func() {
+			fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+				Allowed: true,
+				Warned:  true,
+				Reasons: []string{"a policy says you shouldn't do that", "another policy agrees"},
+			}, nil)
+		})
+
+		It("calls the inner handler", func() {
+			Expect(innerHandlerCalled).To(BeTrue())
+		})
+
+		It("returns the reasons as warnings", func() {
+			Expect(responseWriter.Header().Values(atc.PolicyCheckWarningHeader)).To(Equal([]string{
+				"a policy says you shouldn't do that",
+				"another policy agrees",
+			}))
+		})
+	})
+
 	Context("policy check doesn't pass", func() {
 		BeforeEach(func() {
 			fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
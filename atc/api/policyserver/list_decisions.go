@@ -0,0 +1,49 @@
+package policyserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+const defaultDecisionsLimit = 100
+
+func (s *Server) ListDecisions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-policy-decisions")
+
+	filter := db.PolicyDecisionFilter{
+		Team:   r.FormValue("team"),
+		Action: r.FormValue("action"),
+		Result: r.FormValue("result"),
+		Limit:  defaultDecisionsLimit,
+	}
+
+	if limit := r.FormValue("limit"); limit != "" {
+		var err error
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit <= 0 {
+			logger.Info("malformed-limit", lager.Data{"limit": limit})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	decisions, err := s.decisions.Decisions(filter)
+	if err != nil {
+		logger.Error("failed-to-get-policy-decisions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(decisions)
+	if err != nil {
+		logger.Error("failed-to-encode-policy-decisions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
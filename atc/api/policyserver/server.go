@@ -0,0 +1,21 @@
+package policyserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger    lager.Logger
+	decisions db.PolicyDecisionRepository
+}
+
+func NewServer(
+	logger lager.Logger,
+	decisions db.PolicyDecisionRepository,
+) *Server {
+	return &Server{
+		logger:    logger,
+		decisions: decisions,
+	}
+}
@@ -30,6 +30,7 @@ func Build(build db.Build) atc.Build {
 		Status:               atc.BuildStatus(build.Status()),
 		APIURL:               apiURL,
 		CreatedBy:            build.CreatedBy(),
+		Cause:                build.Cause(),
 	}
 
 	if build.RerunOf() != 0 {
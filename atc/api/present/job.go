@@ -49,6 +49,11 @@ func Job(
 		})
 	}
 
+	var nextFireTime int64
+	if !job.NextFireTime().IsZero() {
+		nextFireTime = job.NextFireTime().Unix()
+	}
+
 	return atc.Job{
 		ID: job.ID(),
 
@@ -64,6 +69,8 @@ func Job(
 		NextBuild:            presentedNextBuild,
 		TransitionBuild:      presentedTransitionBuild,
 		HasNewInputs:         job.HasNewInputs(),
+		Flakiness:            job.Flakiness(),
+		NextFireTime:         nextFireTime,
 
 		Inputs:  sanitizedInputs,
 		Outputs: sanitizedOutputs,
@@ -0,0 +1,26 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func NotificationDelivery(delivery db.NotificationDelivery) atc.NotificationDelivery {
+	return atc.NotificationDelivery{
+		ID:                   delivery.ID(),
+		TeamName:             delivery.TeamName(),
+		PipelineID:           delivery.PipelineID(),
+		PipelineName:         delivery.PipelineName(),
+		PipelineInstanceVars: delivery.PipelineInstanceVars(),
+		JobName:              delivery.JobName(),
+		BuildID:              delivery.BuildID(),
+		BuildName:            delivery.BuildName(),
+		BuildStatus:          atc.BuildStatus(delivery.BuildStatus()),
+		Sink:                 delivery.Sink(),
+		Status:               delivery.Status(),
+		Attempts:             delivery.Attempts(),
+		LastError:            delivery.LastError(),
+		CreatedAt:            delivery.CreatedAt().Unix(),
+		UpdatedAt:            delivery.UpdatedAt().Unix(),
+	}
+}
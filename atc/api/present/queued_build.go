@@ -0,0 +1,27 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func QueuedBuild(build db.Build) atc.QueuedBuild {
+	queuedBuild := atc.QueuedBuild{
+		ID:                   build.ID(),
+		Name:                 build.Name(),
+		Status:               atc.BuildStatus(build.Status()),
+		TeamName:             build.TeamName(),
+		PipelineID:           build.PipelineID(),
+		PipelineName:         build.PipelineName(),
+		PipelineInstanceVars: build.PipelineInstanceVars(),
+		JobName:              build.JobName(),
+		Priority:             build.Priority(),
+		Reason:               build.QueueReason(),
+	}
+
+	if !build.CreateTime().IsZero() {
+		queuedBuild.CreateTime = build.CreateTime().Unix()
+	}
+
+	return queuedBuild
+}
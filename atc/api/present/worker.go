@@ -22,24 +22,33 @@ func Worker(workerInfo db.Worker) atc.Worker {
 	if err != nil {
 		activeTasks = 0
 	}
+	reservedCPU, reservedMemory, err := workerInfo.ReservedResources()
+	if err != nil {
+		reservedCPU, reservedMemory = 0, 0
+	}
 
 	atcWorker := atc.Worker{
-		GardenAddr:       gardenAddr,
-		BaggageclaimURL:  baggageclaimURL,
-		HTTPProxyURL:     workerInfo.HTTPProxyURL(),
-		HTTPSProxyURL:    workerInfo.HTTPSProxyURL(),
-		NoProxy:          workerInfo.NoProxy(),
-		ActiveContainers: workerInfo.ActiveContainers(),
-		ActiveVolumes:    workerInfo.ActiveVolumes(),
-		ActiveTasks:      activeTasks,
-		ResourceTypes:    workerInfo.ResourceTypes(),
-		Platform:         workerInfo.Platform(),
-		Tags:             workerInfo.Tags(),
-		Name:             workerInfo.Name(),
-		Team:             workerInfo.TeamName(),
-		State:            string(workerInfo.State()),
-		Version:          version,
-		Ephemeral:        workerInfo.Ephemeral(),
+		GardenAddr:        gardenAddr,
+		BaggageclaimURL:   baggageclaimURL,
+		HTTPProxyURL:      workerInfo.HTTPProxyURL(),
+		HTTPSProxyURL:     workerInfo.HTTPSProxyURL(),
+		NoProxy:           workerInfo.NoProxy(),
+		ActiveContainers:  workerInfo.ActiveContainers(),
+		ActiveVolumes:     workerInfo.ActiveVolumes(),
+		ActiveTasks:       activeTasks,
+		AllocatableCPU:    workerInfo.AllocatableCPU(),
+		AllocatableMemory: workerInfo.AllocatableMemory(),
+		ReservedCPU:       reservedCPU,
+		ReservedMemory:    reservedMemory,
+		ResourceTypes:     workerInfo.ResourceTypes(),
+		Platform:          workerInfo.Platform(),
+		Tags:              workerInfo.Tags(),
+		Labels:            workerInfo.Labels(),
+		Name:              workerInfo.Name(),
+		Team:              workerInfo.TeamName(),
+		State:             string(workerInfo.State()),
+		Version:           version,
+		Ephemeral:         workerInfo.Ephemeral(),
 	}
 
 	if !workerInfo.StartTime().IsZero() {
@@ -678,11 +678,12 @@ var _ = Describe("Resources API", func() {
 
 					It("checks with no version specified", func() {
 						Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
-						_, actualResource, actualResourceTypes, actualFromVersion, manuallyTriggered := dbCheckFactory.TryCreateCheckArgsForCall(0)
+						_, actualResource, actualResourceTypes, actualFromVersion, manuallyTriggered, webhook := dbCheckFactory.TryCreateCheckArgsForCall(0)
 						Expect(actualResource).To(Equal(fakeResource))
 						Expect(actualResourceTypes).To(Equal(fakeResourceTypes))
 						Expect(actualFromVersion).To(BeNil())
 						Expect(manuallyTriggered).To(BeTrue())
+						Expect(webhook).To(BeFalse())
 					})
 
 					Context("when checking with a version specified", func() {
@@ -696,11 +697,12 @@ var _ = Describe("Resources API", func() {
 
 						It("checks with the version specified", func() {
 							Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
-							_, actualResource, actualResourceTypes, actualFromVersion, manuallyTriggered := dbCheckFactory.TryCreateCheckArgsForCall(0)
+							_, actualResource, actualResourceTypes, actualFromVersion, manuallyTriggered, webhook := dbCheckFactory.TryCreateCheckArgsForCall(0)
 							Expect(actualResource).To(Equal(fakeResource))
 							Expect(actualResourceTypes).To(Equal(fakeResourceTypes))
 							Expect(actualFromVersion).To(Equal(checkRequestBody.From))
 							Expect(manuallyTriggered).To(BeTrue())
+							Expect(webhook).To(BeFalse())
 						})
 					})
 
@@ -1363,11 +1365,12 @@ var _ = Describe("Resources API", func() {
 
 					It("checks with no version specified", func() {
 						Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
-						_, actualResourceType, actualResourceTypes, actualFromVersion, manuallyTriggered := dbCheckFactory.TryCreateCheckArgsForCall(0)
+						_, actualResourceType, actualResourceTypes, actualFromVersion, manuallyTriggered, webhook := dbCheckFactory.TryCreateCheckArgsForCall(0)
 						Expect(actualResourceType).To(Equal(fakeResourceType))
 						Expect(actualResourceTypes).To(Equal(fakeResourceTypes))
 						Expect(actualFromVersion).To(BeNil())
 						Expect(manuallyTriggered).To(BeTrue())
+						Expect(webhook).To(BeFalse())
 					})
 
 					Context("when checking with a version specified", func() {
@@ -1381,11 +1384,12 @@ var _ = Describe("Resources API", func() {
 
 						It("checks with no version specified", func() {
 							Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
-							_, actualResourceType, actualResourceTypes, actualFromVersion, manuallyTriggered := dbCheckFactory.TryCreateCheckArgsForCall(0)
+							_, actualResourceType, actualResourceTypes, actualFromVersion, manuallyTriggered, webhook := dbCheckFactory.TryCreateCheckArgsForCall(0)
 							Expect(actualResourceType).To(Equal(fakeResourceType))
 							Expect(actualResourceTypes).To(Equal(fakeResourceTypes))
 							Expect(actualFromVersion).To(Equal(checkRequestBody.From))
 							Expect(manuallyTriggered).To(BeTrue())
+							Expect(webhook).To(BeFalse())
 						})
 					})
 
@@ -1519,11 +1523,12 @@ var _ = Describe("Resources API", func() {
 
 					It("checks with a nil version", func() {
 						Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
-						_, actualResource, actualResourceTypes, actualFromVersion, manuallyTriggered := dbCheckFactory.TryCreateCheckArgsForCall(0)
+						_, actualResource, actualResourceTypes, actualFromVersion, manuallyTriggered, webhook := dbCheckFactory.TryCreateCheckArgsForCall(0)
 						Expect(actualResource).To(Equal(fakeResource))
 						Expect(actualResourceTypes).To(Equal(fakeResourceTypes))
 						Expect(actualFromVersion).To(BeNil())
 						Expect(manuallyTriggered).To(BeTrue())
+						Expect(webhook).To(BeTrue())
 					})
 
 					Context("when checking fails", func() {
@@ -0,0 +1,279 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Accounts API", func() {
+	var response *http.Response
+
+	BeforeEach(func() {
+		fakeAccess.IsAuthenticatedReturns(true)
+		fakeAccess.IsAuthorizedReturns(true)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/service-accounts", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/service-accounts")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the accounts can be listed", func() {
+			BeforeEach(func() {
+				dbTeam.ServiceAccountsReturns([]atc.ServiceAccount{
+					{
+						Name:      "deployer",
+						TeamName:  "some-team",
+						CreatedAt: 1621032015,
+						Tokens: []atc.ServiceAccountToken{
+							{Name: "ci", Role: "member", CreatedAt: 1621032016},
+						},
+					},
+				}, nil)
+			})
+
+			It("returns 200 with the accounts", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"name": "deployer",
+						"team_name": "some-team",
+						"created_at": 1621032015,
+						"tokens": [{"name": "ci", "role": "member", "created_at": 1621032016}]
+					}
+				]`))
+			})
+		})
+
+		Context("when listing fails", func() {
+			BeforeEach(func() {
+				dbTeam.ServiceAccountsReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/service-accounts/:service_account_name", func() {
+		var name string
+
+		BeforeEach(func() {
+			name = "deployer"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/service-accounts/"+name, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the account is created", func() {
+			BeforeEach(func() {
+				dbTeam.CreateServiceAccountReturns(true, nil)
+			})
+
+			It("returns 201", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				Expect(dbTeam.CreateServiceAccountArgsForCall(0)).To(Equal("deployer"))
+			})
+		})
+
+		Context("when the account already exists", func() {
+			BeforeEach(func() {
+				dbTeam.CreateServiceAccountReturns(false, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when the name is not a valid identifier", func() {
+			BeforeEach(func() {
+				name = "Deployer"
+			})
+
+			It("returns 400 without creating anything", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbTeam.CreateServiceAccountCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/service-accounts/:service_account_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/service-accounts/deployer", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the account is deleted", func() {
+			BeforeEach(func() {
+				dbTeam.DeleteServiceAccountReturns(true, nil)
+			})
+
+			It("returns 204", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(dbTeam.DeleteServiceAccountArgsForCall(0)).To(Equal("deployer"))
+			})
+		})
+
+		Context("when the account does not exist", func() {
+			BeforeEach(func() {
+				dbTeam.DeleteServiceAccountReturns(false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/service-accounts/:service_account_name/tokens", func() {
+		var request atc.ServiceAccountToken
+
+		BeforeEach(func() {
+			request = atc.ServiceAccountToken{Name: "ci", Role: "member"}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/service-accounts/deployer/tokens", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				dbTeam.CreateServiceAccountTokenStub = func(account string, token atc.ServiceAccountToken) (atc.ServiceAccountToken, error) {
+					token.CreatedAt = 1621032015
+					return token, nil
+				}
+			})
+
+			It("returns 201 with the generated token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				var token atc.ServiceAccountToken
+				Expect(json.NewDecoder(response.Body).Decode(&token)).To(Succeed())
+				Expect(token.Name).To(Equal("ci"))
+				Expect(token.Role).To(Equal("member"))
+				Expect(token.Token).To(HavePrefix(db.ServiceAccountTokenPrefix))
+			})
+
+			It("saves the token against the account", func() {
+				account, token := dbTeam.CreateServiceAccountTokenArgsForCall(0)
+				Expect(account).To(Equal("deployer"))
+				Expect(token.Token).To(HavePrefix(db.ServiceAccountTokenPrefix))
+			})
+		})
+
+		Context("when the role is unknown", func() {
+			BeforeEach(func() {
+				request.Role = "admin"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbTeam.CreateServiceAccountTokenCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the expiry is in the past", func() {
+			BeforeEach(func() {
+				request.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbTeam.CreateServiceAccountTokenCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the account does not exist", func() {
+			BeforeEach(func() {
+				dbTeam.CreateServiceAccountTokenReturns(atc.ServiceAccountToken{}, db.ErrServiceAccountNotFound)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when a token with the same name exists", func() {
+			BeforeEach(func() {
+				dbTeam.CreateServiceAccountTokenReturns(atc.ServiceAccountToken{}, db.ErrServiceAccountTokenExists)
+			})
+
+			It("returns 409", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/service-accounts/:service_account_name/tokens/:token_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/service-accounts/deployer/tokens/ci", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the token is revoked", func() {
+			BeforeEach(func() {
+				dbTeam.RevokeServiceAccountTokenReturns(true, nil)
+			})
+
+			It("returns 204", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				account, token := dbTeam.RevokeServiceAccountTokenArgsForCall(0)
+				Expect(account).To(Equal("deployer"))
+				Expect(token).To(Equal("ci"))
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				dbTeam.RevokeServiceAccountTokenReturns(false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,90 @@
+package serviceaccountserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListServiceAccounts(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-service-accounts")
+
+		accounts, err := team.ServiceAccounts()
+		if err != nil {
+			logger.Error("failed-to-get-service-accounts", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(accounts)
+		if err != nil {
+			logger.Error("failed-to-encode-service-accounts", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) CreateServiceAccount(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("create-service-account")
+
+		name := r.FormValue(":service_account_name")
+
+		// service accounts are new, so unlike teams and pipelines there are no
+		// legacy names to tolerate; identifier warnings are treated as errors
+		warning, err := atc.ValidateIdentifier(name, "service account")
+		if err != nil {
+			logger.Info("invalid-name", lager.Data{"name": name})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err.Error())
+			return
+		}
+
+		if warning != nil {
+			logger.Info("invalid-name", lager.Data{"name": name})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, warning.Message)
+			return
+		}
+
+		created, err := team.CreateServiceAccount(name)
+		if err != nil {
+			logger.Error("failed-to-create-service-account", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if created {
+			w.WriteHeader(http.StatusCreated)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+}
+
+func (s *Server) DeleteServiceAccount(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("delete-service-account")
+
+		deleted, err := team.DeleteServiceAccount(r.FormValue(":service_account_name"))
+		if err != nil {
+			logger.Error("failed-to-delete-service-account", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !deleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,21 @@
+package serviceaccountserver
+
+import (
+	"code.cloudfoundry.org/lager"
+)
+
+type Server struct {
+	logger lager.Logger
+
+	generateToken func() (string, error)
+}
+
+func NewServer(
+	logger lager.Logger,
+	generateToken func() (string, error),
+) *Server {
+	return &Server{
+		logger:        logger,
+		generateToken: generateToken,
+	}
+}
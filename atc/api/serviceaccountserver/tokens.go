@@ -0,0 +1,105 @@
+package serviceaccountserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) CreateServiceAccountToken(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("create-service-account-token")
+
+		var request atc.ServiceAccountToken
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = validateToken(request)
+		if err != nil {
+			logger.Info("invalid-token", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err.Error())
+			return
+		}
+
+		request.Token, err = s.generateToken()
+		if err != nil {
+			logger.Error("failed-to-generate-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		token, err := team.CreateServiceAccountToken(r.FormValue(":service_account_name"), request)
+		switch err {
+		case nil:
+		case db.ErrServiceAccountNotFound:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case db.ErrServiceAccountTokenExists:
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "token '%s' already exists\n", request.Name)
+			return
+		default:
+			logger.Error("failed-to-create-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(token)
+		if err != nil {
+			logger.Error("failed-to-encode-token", err)
+		}
+	})
+}
+
+func (s *Server) RevokeServiceAccountToken(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("revoke-service-account-token")
+
+		revoked, err := team.RevokeServiceAccountToken(
+			r.FormValue(":service_account_name"),
+			r.FormValue(":token_name"),
+		)
+		if err != nil {
+			logger.Error("failed-to-revoke-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !revoked {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func validateToken(token atc.ServiceAccountToken) error {
+	if token.Name == "" {
+		return fmt.Errorf("token name must be given")
+	}
+
+	if !accessor.IsTeamRole(token.Role) {
+		return fmt.Errorf("unknown role '%s'", token.Role)
+	}
+
+	if token.ExpiresAt != 0 && time.Unix(token.ExpiresAt, 0).Before(time.Now()) {
+		return fmt.Errorf("token expiry must be in the future")
+	}
+
+	return nil
+}
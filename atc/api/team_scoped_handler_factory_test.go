@@ -46,7 +46,6 @@ var _ = Describe("TeamScopedHandlerFactory", func() {
 			innerHandler,
 			fakeAccessor,
 			new(auditorfakes.FakeAuditor),
-			map[string]string{},
 		)
 	})
 
@@ -302,6 +302,54 @@ var _ = Describe("Teams API", func() {
 						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
 					})
 				})
+
+				It("clears the custom roles when none are given", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateCustomRolesCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateCustomRolesArgsForCall(0)).To(BeEmpty())
+				})
+
+				Context("when custom roles are given", func() {
+					BeforeEach(func() {
+						atcTeam.Auth["releaser"] = map[string][]string{
+							"groups": {"github:org:releasers"},
+						}
+						atcTeam.CustomRoles = atc.CustomRoles{
+							"releaser": {atc.CreateJobBuild, atc.PinResourceVersion},
+						}
+					})
+
+					It("updates the custom roles", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateCustomRolesCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateCustomRolesArgsForCall(0)).To(Equal(atc.CustomRoles{
+							"releaser": {atc.CreateJobBuild, atc.PinResourceVersion},
+						}))
+					})
+
+					Context("when updating the custom roles fails", func() {
+						BeforeEach(func() {
+							fakeTeam.UpdateCustomRolesReturns(errors.New("nope"))
+						})
+
+						It("returns 500 Internal Server error", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when a custom role permits an unknown action", func() {
+						BeforeEach(func() {
+							atcTeam.CustomRoles["releaser"] = []string{"DoEverything"}
+						})
+
+						It("returns 400 Bad Request without updating the team", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("custom role 'releaser' permits unknown action 'DoEverything'"))
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+							Expect(fakeTeam.UpdateCustomRolesCallCount()).To(Equal(0))
+						})
+					})
+				})
 			})
 		}
 
@@ -314,6 +362,57 @@ var _ = Describe("Teams API", func() {
 
 			authorizedTeamTests()
 
+			Context("when the team exists and a quota is given", func() {
+				BeforeEach(func() {
+					atcTeam.Quota = &atc.TeamQuota{
+						MaxConcurrentBuilds: 5,
+						MaxVolumeDisk:       1024,
+					}
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("updates the quota", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateQuotaCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateQuotaArgsForCall(0)).To(Equal(atc.TeamQuota{
+						MaxConcurrentBuilds: 5,
+						MaxVolumeDisk:       1024,
+					}))
+				})
+
+				Context("when updating the quota fails", func() {
+					BeforeEach(func() {
+						fakeTeam.UpdateQuotaReturns(errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the quota is invalid", func() {
+					BeforeEach(func() {
+						atcTeam.Quota.MaxContainers = -1
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeTeam.UpdateQuotaCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the team exists and no quota is given", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("leaves the quota untouched", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateQuotaCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the team is not found", func() {
 				BeforeEach(func() {
 					dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -382,6 +481,19 @@ var _ = Describe("Teams API", func() {
 
 			authorizedTeamTests()
 
+			Context("when a quota is given", func() {
+				BeforeEach(func() {
+					atcTeam.Quota = &atc.TeamQuota{MaxContainers: 100}
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("does not let the team change its own quota", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+					Expect(fakeTeam.UpdateQuotaCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the team is not found", func() {
 				BeforeEach(func() {
 					dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -789,4 +901,158 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/builds/search", func() {
+		var (
+			response    *http.Response
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			queryParams = "?q=connection+refused"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/builds/search" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeTeam.SearchBuildLogsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated but not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.SearchBuildLogsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			Context("when only the query is given", func() {
+				It("searches with the default limit", func() {
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(1))
+					Expect(fakeTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+						Query: "connection refused",
+						Limit: atc.BuildLogSearchDefaultLimit,
+					}))
+				})
+			})
+
+			Context("when all the params are given", func() {
+				BeforeEach(func() {
+					queryParams = "?q=oops&pipeline=some-pipeline&vars.branch=%22main%22&job=some-job&since=100&until=200&context=50&limit=5"
+				})
+
+				It("passes them through, capping the context", func() {
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(1))
+					Expect(fakeTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+						Query: "oops",
+						Pipeline: &atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "main"},
+						},
+						Job:     "some-job",
+						Since:   time.Unix(100, 0),
+						Until:   time.Unix(200, 0),
+						Context: atc.BuildLogSearchMaxContext,
+						Limit:   5,
+					}))
+				})
+			})
+
+			Context("when the query is missing", func() {
+				BeforeEach(func() {
+					queryParams = "?job=some-job"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a time is not a unix timestamp", func() {
+				BeforeEach(func() {
+					queryParams = "?q=oops&since=yesterday"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the search succeeds", func() {
+				BeforeEach(func() {
+					fakeTeam.SearchBuildLogsReturns([]atc.BuildLogMatch{
+						{
+							BuildID:      42,
+							BuildName:    "7",
+							PipelineName: "some-pipeline",
+							JobName:      "some-job",
+							Origin:       "some-origin",
+							Time:         100,
+							LogLineMatch: atc.LogLineMatch{
+								Line:   "error: connection refused",
+								Before: []string{"dialing"},
+							},
+						},
+					}, nil)
+				})
+
+				It("returns the matches", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"build_id": 42,
+							"build_name": "7",
+							"pipeline_name": "some-pipeline",
+							"job_name": "some-job",
+							"origin": "some-origin",
+							"time": 100,
+							"line": "error: connection refused",
+							"before": ["dialing"]
+						}
+					]`))
+				})
+			})
+
+			Context("when the search fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SearchBuildLogsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
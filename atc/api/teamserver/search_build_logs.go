@@ -0,0 +1,114 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SearchBuildLogs(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("search-build-logs")
+
+		search, err := buildLogSearchFromRequest(r)
+		if err != nil {
+			logger.Info("invalid-search", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err.Error())
+			return
+		}
+
+		matches, err := team.SearchBuildLogs(search)
+		if err != nil {
+			logger.Error("failed-to-search-build-logs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(matches)
+		if err != nil {
+			logger.Error("failed-to-encode-matches", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func buildLogSearchFromRequest(r *http.Request) (db.BuildLogSearch, error) {
+	search := db.BuildLogSearch{
+		Query: r.FormValue(atc.BuildLogSearchQueryText),
+		Job:   r.FormValue(atc.BuildLogSearchQueryJob),
+		Limit: atc.BuildLogSearchDefaultLimit,
+	}
+
+	if search.Query == "" {
+		return db.BuildLogSearch{}, errors.New("query must not be empty")
+	}
+
+	if pipelineName := r.FormValue(atc.BuildLogSearchQueryPipeline); pipelineName != "" {
+		instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+		if err != nil {
+			return db.BuildLogSearch{}, err
+		}
+
+		search.Pipeline = &atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars}
+	}
+
+	var err error
+	search.Since, err = unixTimeParam(r, atc.BuildLogSearchQuerySince)
+	if err != nil {
+		return db.BuildLogSearch{}, err
+	}
+
+	search.Until, err = unixTimeParam(r, atc.BuildLogSearchQueryUntil)
+	if err != nil {
+		return db.BuildLogSearch{}, err
+	}
+
+	if value := r.FormValue(atc.BuildLogSearchQueryContext); value != "" {
+		search.Context, err = strconv.Atoi(value)
+		if err != nil || search.Context < 0 {
+			return db.BuildLogSearch{}, errors.New("context must be a positive number")
+		}
+
+		if search.Context > atc.BuildLogSearchMaxContext {
+			search.Context = atc.BuildLogSearchMaxContext
+		}
+	}
+
+	if value := r.FormValue(atc.BuildLogSearchQueryLimit); value != "" {
+		search.Limit, err = strconv.Atoi(value)
+		if err != nil || search.Limit <= 0 {
+			return db.BuildLogSearch{}, errors.New("limit must be a positive number")
+		}
+
+		if search.Limit > atc.BuildLogSearchMaxLimit {
+			search.Limit = atc.BuildLogSearchMaxLimit
+		}
+	}
+
+	return search, nil
+}
+
+func unixTimeParam(r *http.Request, name string) (time.Time, error) {
+	value := r.FormValue(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.New(name + " must be a unix timestamp")
+	}
+
+	return time.Unix(seconds, 0), nil
+}
@@ -2,6 +2,7 @@ package teamserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -40,6 +41,13 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := accessor.ValidateCustomRoles(atcTeam.CustomRoles); err != nil {
+		hLog.Info("invalid-custom-roles", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, err.Error())
+		return
+	}
+
 	atcTeam.Name = teamName
 
 	team, found, err := s.teamFactory.FindTeam(teamName)
@@ -51,6 +59,13 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 
 	response := SetTeamResponse{}
 	if found {
+		// team owners must not be able to lift their own quota
+		if atcTeam.Quota != nil && !acc.IsAdmin() {
+			hLog.Info("quota-requires-admin", lager.Data{"teamName": teamName})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		hLog.Debug("updating-credentials")
 		err = team.UpdateProviderAuth(atcTeam.Auth)
 		if err != nil {
@@ -59,6 +74,24 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		hLog.Debug("updating-custom-roles")
+		err = team.UpdateCustomRoles(atcTeam.CustomRoles)
+		if err != nil {
+			hLog.Error("failed-to-update-team-custom-roles", err, lager.Data{"teamName": teamName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if atcTeam.Quota != nil {
+			hLog.Debug("updating-quota")
+			err = team.UpdateQuota(*atcTeam.Quota)
+			if err != nil {
+				hLog.Error("failed-to-update-team-quota", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
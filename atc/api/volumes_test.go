@@ -532,4 +532,101 @@ var _ = Describe("Volumes API", func() {
 			})
 		})
 	})
+
+	Describe("PUT /api/v1/volumes/sizes", func() {
+		var response *http.Response
+		var req *http.Request
+		var body io.Reader
+		var err error
+
+		BeforeEach(func() {
+			body = bytes.NewBufferString(`
+				{
+					"handle1": 1024,
+					"handle2": 0
+				}
+			`)
+		})
+
+		JustBeforeEach(func() {
+			req, err = http.NewRequest("PUT", server.URL+"/api/v1/volumes/sizes", body)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set("Content-Type", "application/json")
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				response, err = client.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated as system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsSystemReturns(true)
+			})
+
+			Context("with no params", func() {
+				It("returns 404", func() {
+					response, err = client.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVolumeRepository.UpdateVolumeSizesCallCount()).To(Equal(0))
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("querying with worker name", func() {
+				JustBeforeEach(func() {
+					req.URL.RawQuery = url.Values{
+						"worker_name": []string{"some-worker-name"},
+					}.Encode()
+				})
+
+				Context("with invalid json", func() {
+					BeforeEach(func() {
+						body = bytes.NewBufferString(`["handle1"]`)
+					})
+
+					It("returns 400", func() {
+						response, err = client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when updating the sizes fails", func() {
+					BeforeEach(func() {
+						fakeVolumeRepository.UpdateVolumeSizesReturns(errors.New("some error"))
+					})
+
+					It("returns 500", func() {
+						response, err = client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				It("records the sizes of the worker's volumes", func() {
+					response, err = client.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					Expect(fakeVolumeRepository.UpdateVolumeSizesCallCount()).To(Equal(1))
+
+					workerName, sizes := fakeVolumeRepository.UpdateVolumeSizesArgsForCall(0)
+					Expect(workerName).To(Equal("some-worker-name"))
+					Expect(sizes).To(Equal(map[string]uint64{
+						"handle1": 1024,
+						"handle2": 0,
+					}))
+				})
+			})
+		})
+	})
 })
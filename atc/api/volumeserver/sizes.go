@@ -0,0 +1,46 @@
+package volumeserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// ReportVolumeSizes provides an API endpoint for workers to report the disk
+// used by each of their volumes
+func (s *Server) ReportVolumeSizes(w http.ResponseWriter, r *http.Request) {
+	workerName := r.URL.Query().Get("worker_name")
+	w.Header().Set("Content-Type", "application/json")
+
+	logger := s.logger.Session("report-volume-sizes-for-worker", lager.Data{"name": workerName})
+
+	if workerName == "" {
+		logger.Info("missing-worker-name")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var sizes map[string]uint64
+	err := json.NewDecoder(r.Body).Decode(&sizes)
+	if err != nil {
+		logger.Error("failed-to-unmarshal-body", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	logger.Debug("sizes-info", lager.Data{
+		"handles-count": len(sizes),
+	})
+
+	err = s.repository.UpdateVolumeSizes(workerName, sizes)
+	if err != nil {
+		logger.Error("failed-to-update-volume-sizes", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
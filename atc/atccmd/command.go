@@ -29,6 +29,7 @@ import (
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/component"
 	"github.com/concourse/concourse/atc/compression"
@@ -39,14 +40,22 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/flakiness"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/keyrotation"
 	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/logarchive"
+	"github.com/concourse/concourse/atc/logsearch"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/notifications"
 	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/provenance"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/taskcache"
+	"github.com/concourse/concourse/atc/timer"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -111,6 +120,7 @@ type RunCommand struct {
 	Logger flag.Lager
 
 	varSourcePool creds.VarSourcePool
+	keyring       *encryption.Keyring
 
 	BindIP   flag.IP `long:"bind-ip"   default:"0.0.0.0" description:"IP address on which to listen for web traffic."`
 	BindPort uint16  `long:"bind-port" default:"8080"    description:"Port on which to listen for HTTP traffic."`
@@ -139,6 +149,8 @@ type RunCommand struct {
 	EncryptionKey    flag.Cipher `long:"encryption-key"     description:"A 16 or 32 length key used to encrypt sensitive information before storing it in the database."`
 	OldEncryptionKey flag.Cipher `long:"old-encryption-key" description:"Encryption key previously used for encrypting sensitive information. If provided without a new key, data is encrypted. If provided with a new key, data is re-encrypted."`
 
+	EncryptionKeyring encryption.KeyringConfig `group:"Encryption Keyring" namespace:"encryption-keyring"`
+
 	DebugBindIP   flag.IP `long:"debug-bind-ip"   default:"127.0.0.1" description:"IP address on which to listen for the pprof debugger endpoints."`
 	DebugBindPort uint16  `long:"debug-bind-port" default:"8079"      description:"Port on which to listen for the pprof debugger endpoints."`
 
@@ -174,6 +186,8 @@ type RunCommand struct {
 
 	PolicyCheckers struct {
 		Filter policy.Filter
+
+		RecordAllowedDecisions bool `long:"policy-check-record-allowed-decisions" description:"Record allowed policy decisions too, rather than only those which were denied or warned about."`
 	} `group:"Policy Checking"`
 
 	Server struct {
@@ -195,6 +209,9 @@ type RunCommand struct {
 		FailedGracePeriod      time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
 		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
 		VarSourceRecyclePeriod time.Duration `long:"var-source-recycle-period" default:"5m" description:"Period after which to reap var_sources that are not used."`
+		AuditRetention         time.Duration `long:"audit-retention" description:"Period after which audit events are deleted. 0 means they are kept forever."`
+
+		PolicyDecisionRetention time.Duration `long:"policy-decision-retention" default:"720h" description:"Period after which recorded policy decisions are deleted. 0 means they are kept forever."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
@@ -207,6 +224,19 @@ type RunCommand struct {
 	DefaultDaysToRetainBuildLogs uint64 `long:"default-days-to-retain-build-logs" description:"Default days to retain build logs. 0 means unlimited"`
 	MaxDaysToRetainBuildLogs     uint64 `long:"max-days-to-retain-build-logs" description:"Maximum days to retain build logs, 0 means not specified. Will override values configured in jobs"`
 
+	FlakinessAnalysisInterval time.Duration `long:"flakiness-analysis-interval" default:"5m" description:"Interval on which jobs with new builds are analysed for flakiness."`
+	FlakinessAnalysisWindow   int           `long:"flakiness-analysis-window" default:"100" description:"Number of each job's most recent builds to look at when analysing its flakiness."`
+
+	ScheduleTriggerInterval time.Duration `long:"schedule-trigger-interval" default:"10s" description:"Interval on which jobs with a schedule are checked for builds to trigger."`
+
+	BuildLogArchive blobstore.Config `group:"Build Log Archive" namespace:"build-log-archive"`
+
+	RemoteTaskCache blobstore.Config `group:"Remote Task Cache" namespace:"remote-task-cache"`
+
+	Provenance struct {
+		SigningKey flag.File `long:"provenance-signing-key" description:"File containing a PEM-encoded ed25519 private key with which to sign the provenance of builds which put versions. Provenance is only recorded when set."`
+	} `group:"Build Provenance"`
+
 	JobSchedulingMaxInFlight uint64 `long:"job-scheduling-max-in-flight" default:"32" description:"Maximum number of jobs to be scheduling at the same time"`
 
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
@@ -230,8 +260,26 @@ type RunCommand struct {
 		Transport     string        `long:"syslog-transport" description:"Transport protocol for syslog messages (Currently supporting tcp, udp & tls)."`
 		DrainInterval time.Duration `long:"syslog-drain-interval" description:"Interval over which checking is done for new build logs to send to syslog server (duration measurement units are s/m/h; eg. 30s/30m/1h)" default:"30s"`
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
+
+		HTTPURL     string            `long:"syslog-http-url" description:"URL to POST build logs to, e.g. a Loki push endpoint or an Elasticsearch index's _bulk endpoint."`
+		HTTPFormat  string            `long:"syslog-http-format" default:"ndjson" choice:"ndjson" choice:"loki" choice:"elastic-bulk" description:"Format to POST build logs to the HTTP sink in."`
+		HTTPHeaders map[string]string `long:"syslog-http-header" description:"Header to send along with build logs POSTed to the HTTP sink. Can be specified multiple times."`
+		HTTPTimeout time.Duration     `long:"syslog-http-timeout" default:"30s" description:"Timeout for requests to the HTTP sink."`
+
+		FilePath       string `long:"syslog-file-path" description:"Local file to append build logs to as JSON lines."`
+		FileMaxSize    uint64 `long:"syslog-file-max-size" default:"104857600" description:"Size in bytes at which the build log file is rotated. 0 disables rotation."`
+		FileMaxBackups int    `long:"syslog-file-max-backups" default:"5" description:"Number of rotated build log files to keep."`
+
+		DrainAuditEvents bool `long:"syslog-drain-audit-events" description:"Send the audit events to the build log sinks too."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	Notifications struct {
+		Interval      time.Duration `long:"notifications-interval" default:"10s" description:"Interval on which to deliver pipeline notifications."`
+		MaxAttempts   int           `long:"notifications-max-attempts" default:"5" description:"Maximum number of attempts to deliver a notification before giving up."`
+		RetryInterval time.Duration `long:"notifications-retry-interval" default:"30s" description:"Initial interval to wait before retrying a failed notification delivery. Doubles on every attempt."`
+		Timeout       time.Duration `long:"notifications-timeout" default:"30s" description:"Timeout for a single notification delivery."`
+	} `group:"Pipeline Notifications"`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
@@ -567,6 +615,11 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	cmd.keyring, err = cmd.constructKeyring()
+	if err != nil {
+		return nil, err
+	}
+
 	lockConn, err := constructLockConn(retryingDriverName, cmd.Postgres.ConnectionString())
 	if err != nil {
 		return nil, err
@@ -676,6 +729,13 @@ func (cmd *RunCommand) constructMembers(
 		return nil, err
 	}
 
+	policyChecker = policy.NewDecisionLoggingChecker(
+		logger.Session("policy-decisions"),
+		policyChecker,
+		db.NewPolicyDecisionRepository(backendConn),
+		cmd.PolicyCheckers.RecordAllowedDecisions,
+	)
+
 	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, workerConn, storage, lockFactory, secretManager, policyChecker)
 	if err != nil {
 		return nil, err
@@ -816,6 +876,9 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbAccessTokenFactory := db.NewAccessTokenFactory(dbConn)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbPolicyDecisions := db.NewPolicyDecisionRepository(dbConn)
+	dbAuditEvents := db.NewAuditEventRepository(dbConn)
+	dbEncryptionRotation := cmd.encryptionRotation(dbConn)
 
 	tokenVerifier := cmd.constructTokenVerifier(dbAccessTokenFactory)
 
@@ -832,12 +895,18 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
+	customRoles, err := cmd.parseCustomRoles()
+	if err != nil {
+		return nil, err
+	}
+
 	accessFactory := accessor.NewAccessFactory(
 		tokenVerifier,
 		teamsCacher,
 		cmd.SystemClaimKey,
 		cmd.SystemClaimValues,
 		displayUserIdGenerator,
+		customRoles,
 	)
 
 	middleware := token.NewMiddleware(cmd.Auth.AuthFlags.SecureCookies)
@@ -863,6 +932,9 @@ func (cmd *RunCommand) constructAPIMembers(
 		credsManagers,
 		accessFactory,
 		dbWall,
+		dbPolicyDecisions,
+		dbAuditEvents,
+		dbEncryptionRotation,
 		policyChecker,
 	)
 	if err != nil {
@@ -998,9 +1070,42 @@ func (cmd *RunCommand) backendComponents(
 		return nil, fmt.Errorf("syslog Drainer is misconfigured, cannot configure a drainer without a transport")
 	}
 
-	syslogDrainConfigured := true
-	if cmd.Syslog.Address == "" {
-		syslogDrainConfigured = false
+	var syslogSinks []syslog.Sink
+	if cmd.Syslog.Address != "" {
+		syslogSinks = append(syslogSinks, syslog.NewSyslogSink(
+			cmd.Syslog.Transport,
+			cmd.Syslog.Address,
+			cmd.Syslog.CACerts,
+		))
+	}
+
+	if cmd.Syslog.HTTPURL != "" {
+		syslogSinks = append(syslogSinks, syslog.NewHTTPSink(
+			&http.Client{Timeout: cmd.Syslog.HTTPTimeout},
+			cmd.Syslog.HTTPURL,
+			syslog.HTTPFormat(cmd.Syslog.HTTPFormat),
+			cmd.Syslog.HTTPHeaders,
+		))
+	}
+
+	if cmd.Syslog.FilePath != "" {
+		syslogSinks = append(syslogSinks, syslog.NewFileSink(
+			cmd.Syslog.FilePath,
+			int64(cmd.Syslog.FileMaxSize),
+			cmd.Syslog.FileMaxBackups,
+		))
+	}
+
+	syslogDrainConfigured := len(syslogSinks) > 0
+
+	var buildLogArchiver gc.BuildLogArchiver
+	archiver, err := cmd.buildLogArchiver()
+	if err != nil {
+		return nil, err
+	}
+
+	if archiver != nil {
+		buildLogArchiver = archiver
 	}
 
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
@@ -1079,6 +1184,16 @@ func (cmd *RunCommand) backendComponents(
 		clock.NewClock(),
 	)
 
+	provenanceRecorder, err := cmd.provenanceRecorder()
+	if err != nil {
+		return nil, err
+	}
+
+	remoteTaskCache, err := cmd.remoteTaskCache(dbConn, compressionLib)
+	if err != nil {
+		return nil, err
+	}
+
 	engine := cmd.constructEngine(
 		pool,
 		artifactStreamer,
@@ -1095,6 +1210,8 @@ func (cmd *RunCommand) backendComponents(
 		lockFactory,
 		rateLimiter,
 		policyChecker,
+		provenanceRecorder,
+		remoteTaskCache,
 	)
 
 	// In case that a user configures resource-checking-interval, but forgets to
@@ -1161,22 +1278,84 @@ func (cmd *RunCommand) backendComponents(
 					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
+				buildLogArchiver,
+			),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentNotifier,
+				Interval: cmd.Notifications.Interval,
+			},
+			Runnable: notifications.NewNotifier(
+				db.NewNotificationFactory(dbConn),
+				dbBuildFactory,
+				notifications.NewSinkFactory(&http.Client{Timeout: cmd.Notifications.Timeout}, cmd.Notifications.Timeout),
+				secretManager,
+				cmd.varSourcePool,
+				cmd.ExternalURL.String(),
+				cmd.Notifications.MaxAttempts,
+				cmd.Notifications.RetryInterval,
+			),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentQuotaReporter,
+				Interval: 30 * time.Second,
+			},
+			Runnable: metric.NewQuotaReporter(teamFactory),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentLogSearchIndexer,
+				Interval: time.Minute,
+			},
+			Runnable: logsearch.NewIndexer(db.NewBuildLogSearchIndexer(dbConn)),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentFlakinessAnalyzer,
+				Interval: cmd.FlakinessAnalysisInterval,
+			},
+			Runnable: flakiness.NewAnalyzer(
+				dbJobFactory,
+				cmd.FlakinessAnalysisWindow,
 			),
 		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentScheduleTrigger,
+				Interval: cmd.ScheduleTriggerInterval,
+			},
+			Runnable: timer.NewTrigger(dbJobFactory, clock.NewClock()),
+		},
+	}
+
+	if rotation := cmd.encryptionRotation(dbConn); rotation != nil {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentReencrypter,
+				Interval: cmd.EncryptionKeyring.RotationInterval,
+			},
+			Runnable: keyrotation.NewReencrypter(rotation, cmd.EncryptionKeyring.RotationBatchSize),
+		})
 	}
 
 	if syslogDrainConfigured {
+		var auditEvents db.AuditEventRepository
+		if cmd.Syslog.DrainAuditEvents {
+			auditEvents = db.NewAuditEventRepository(dbConn)
+		}
+
 		components = append(components, RunnableComponent{
 			Component: atc.Component{
 				Name:     atc.ComponentSyslogDrainer,
 				Interval: cmd.Syslog.DrainInterval,
 			},
 			Runnable: syslog.NewDrainer(
-				cmd.Syslog.Transport,
-				cmd.Syslog.Address,
 				cmd.Syslog.Hostname,
-				cmd.Syslog.CACerts,
+				syslogSinks,
 				dbBuildFactory,
+				auditEvents,
 			),
 		})
 	}
@@ -1226,6 +1405,33 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorChecks:            gc.NewChecksCollector(dbCheckLifecycle),
 	}
 
+	if cmd.RemoteTaskCache.IsConfigured() {
+		store, err := cmd.RemoteTaskCache.Store()
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure remote task cache: %w", err)
+		}
+
+		collectors[atc.ComponentCollectorTaskCaches] = gc.NewTaskCacheCollector(
+			db.NewRemoteTaskCacheRepository(gcConn),
+			db.NewTeamFactory(gcConn, lockFactory),
+			store,
+		)
+	}
+
+	if cmd.GC.AuditRetention > 0 {
+		collectors[atc.ComponentCollectorAuditEvents] = gc.NewAuditEventCollector(
+			db.NewAuditEventRepository(gcConn),
+			cmd.GC.AuditRetention,
+		)
+	}
+
+	if cmd.GC.PolicyDecisionRetention > 0 {
+		collectors[atc.ComponentCollectorPolicyDecisions] = gc.NewPolicyDecisionCollector(
+			db.NewPolicyDecisionRepository(gcConn),
+			cmd.GC.PolicyDecisionRetention,
+		)
+	}
+
 	var components []RunnableComponent
 	for collectorName, collector := range collectors {
 		components = append(components, RunnableComponent{
@@ -1357,6 +1563,41 @@ func (cmd *RunCommand) oldKey() *encryption.Key {
 	return oldKey
 }
 
+// constructKeyring returns the configured keyring, if any. Data encrypted
+// with --encryption-key or --old-encryption-key stays readable through it
+// until it has been re-encrypted with the active keyring key.
+func (cmd *RunCommand) constructKeyring() (*encryption.Keyring, error) {
+	if !cmd.EncryptionKeyring.IsConfigured() {
+		return nil, nil
+	}
+
+	var legacy encryption.Strategy
+	newKey, oldKey := cmd.newKey(), cmd.oldKey()
+	switch {
+	case newKey != nil && oldKey != nil:
+		legacy = encryption.NewFallbackStrategy(newKey, oldKey)
+	case newKey != nil:
+		legacy = newKey
+	case oldKey != nil:
+		legacy = oldKey
+	}
+
+	keyring, err := cmd.EncryptionKeyring.Keyring(legacy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure encryption keyring: %w", err)
+	}
+
+	return keyring, nil
+}
+
+func (cmd *RunCommand) encryptionRotation(conn db.Conn) db.EncryptionRotation {
+	if cmd.keyring == nil {
+		return nil
+	}
+
+	return db.NewEncryptionRotation(conn, cmd.keyring)
+}
+
 func (cmd *RunCommand) constructWebHandler(logger lager.Logger) (http.Handler, error) {
 	webHandler, err := web.NewHandler(logger, cmd.WebPublicDir.Path())
 	if err != nil {
@@ -1365,6 +1606,45 @@ func (cmd *RunCommand) constructWebHandler(logger lager.Logger) (http.Handler, e
 	return metric.WrapHandler(logger, metric.Metrics, "web", webHandler), nil
 }
 
+func (cmd *RunCommand) buildLogArchiver() (*logarchive.Archiver, error) {
+	if !cmd.BuildLogArchive.IsConfigured() {
+		return nil, nil
+	}
+
+	store, err := cmd.BuildLogArchive.Store()
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure build log archive: %w", err)
+	}
+
+	return logarchive.NewArchiver(store), nil
+}
+
+func (cmd *RunCommand) provenanceRecorder() (provenance.Recorder, error) {
+	if cmd.Provenance.SigningKey.Path() == "" {
+		return nil, nil
+	}
+
+	signer, err := provenance.LoadEd25519Signer(cmd.Provenance.SigningKey.Path())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load provenance signing key: %w", err)
+	}
+
+	return provenance.NewRecorder(cmd.ExternalURL.String(), signer), nil
+}
+
+func (cmd *RunCommand) remoteTaskCache(conn db.Conn, compressionLib compression.Compression) (taskcache.RemoteCache, error) {
+	if !cmd.RemoteTaskCache.IsConfigured() {
+		return nil, nil
+	}
+
+	store, err := cmd.RemoteTaskCache.Store()
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure remote task cache: %w", err)
+	}
+
+	return taskcache.NewRemoteCache(store, db.NewRemoteTaskCacheRepository(conn), compressionLib), nil
+}
+
 func (cmd *RunCommand) skyHttpClient() (*http.Client, error) {
 	httpClient := http.DefaultClient
 
@@ -1594,7 +1874,7 @@ func (cmd *RunCommand) constructDBConn(
 	connectionName string,
 	lockFactory lock.LockFactory,
 ) (db.Conn, error) {
-	dbConn, err := db.Open(logger.Session("db"), driverName, cmd.Postgres.ConnectionString(), cmd.newKey(), cmd.oldKey(), connectionName, lockFactory)
+	dbConn, err := db.Open(logger.Session("db"), driverName, cmd.Postgres.ConnectionString(), cmd.newKey(), cmd.oldKey(), cmd.keyring, connectionName, lockFactory)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %s", err)
 	}
@@ -1675,6 +1955,8 @@ func (cmd *RunCommand) constructEngine(
 	lockFactory lock.LockFactory,
 	rateLimiter engine.RateLimiter,
 	policyChecker policy.Checker,
+	provenanceRecorder provenance.Recorder,
+	remoteTaskCache taskcache.RemoteCache,
 ) engine.Engine {
 	return engine.NewEngine(
 		engine.NewStepperFactory(
@@ -1690,6 +1972,7 @@ func (cmd *RunCommand) constructEngine(
 				defaultLimits,
 				strategy,
 				cmd.GlobalResourceCheckTimeout,
+				remoteTaskCache,
 			),
 			cmd.ExternalURL.String(),
 			rateLimiter,
@@ -1700,6 +1983,7 @@ func (cmd *RunCommand) constructEngine(
 		),
 		secretManager,
 		cmd.varSourcePool,
+		provenanceRecorder,
 	)
 }
 
@@ -1871,6 +2155,9 @@ func (cmd *RunCommand) constructAPIHandler(
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	dbPolicyDecisions db.PolicyDecisionRepository,
+	dbAuditEvents db.AuditEventRepository,
+	dbEncryptionRotation db.EncryptionRotation,
 	policyChecker policy.Checker,
 ) (http.Handler, error) {
 
@@ -1881,6 +2168,16 @@ func (cmd *RunCommand) constructAPIHandler(
 
 	rejectArchivedHandlerFactory := pipelineserver.NewRejectArchivedHandlerFactory(teamFactory)
 
+	var eventHandlerFactory buildserver.EventHandlerFactory = buildserver.NewEventHandler
+	archiver, err := cmd.buildLogArchiver()
+	if err != nil {
+		return nil, err
+	}
+
+	if archiver != nil {
+		eventHandlerFactory = buildserver.NewArchivedEventHandlerFactory(archiver)
+	}
+
 	aud := auditor.NewAuditor(
 		cmd.Auditor.EnableBuildAuditLog,
 		cmd.Auditor.EnableContainerAuditLog,
@@ -1891,14 +2188,10 @@ func (cmd *RunCommand) constructAPIHandler(
 		cmd.Auditor.EnableTeamAuditLog,
 		cmd.Auditor.EnableWorkerAuditLog,
 		cmd.Auditor.EnableVolumeAuditLog,
+		dbAuditEvents,
 		logger,
 	)
 
-	customRoles, err := cmd.parseCustomRoles()
-	if err != nil {
-		return nil, err
-	}
-
 	apiWrapper := wrappa.MultiWrappa{
 		wrappa.NewConcurrentRequestLimitsWrappa(
 			logger,
@@ -1918,7 +2211,6 @@ func (cmd *RunCommand) constructAPIHandler(
 			logger,
 			accessFactory,
 			aud,
-			customRoles,
 		),
 		wrappa.NewCompressionWrappa(logger),
 	}
@@ -1943,7 +2235,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		resourceConfigFactory,
 		dbUserFactory,
 
-		buildserver.NewEventHandler,
+		eventHandlerFactory,
 
 		workerPool,
 
@@ -1960,6 +2252,9 @@ func (cmd *RunCommand) constructAPIHandler(
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
 		dbWall,
+		dbPolicyDecisions,
+		dbAuditEvents,
+		dbEncryptionRotation,
 		clock.NewClock(),
 	)
 }
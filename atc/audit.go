@@ -0,0 +1,20 @@
+package atc
+
+// AuditEvent records an API request made by a user.
+type AuditEvent struct {
+	ID   int    `json:"id"`
+	Time int64  `json:"time"`
+	User string `json:"user"`
+	Team string `json:"team,omitempty"`
+
+	// Action is the name of the route which was requested, and Target the
+	// path of the object it was requested on.
+	Action string `json:"action"`
+	Target string `json:"target"`
+
+	// Params are the request's query and form parameters, with the values
+	// of any which may hold secrets redacted.
+	Params map[string][]string `json:"params,omitempty"`
+
+	Status int `json:"status"`
+}
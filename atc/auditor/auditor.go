@@ -3,6 +3,7 @@ package auditor
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -10,6 +11,13 @@ import (
 
 //go:generate counterfeiter . Auditor
 
+//go:generate counterfeiter . EventStore
+
+// EventStore persists audit events so that they can be queried later.
+type EventStore interface {
+	SaveAuditEvent(atc.AuditEvent) error
+}
+
 func NewAuditor(
 	EnableBuildAuditLog bool,
 	EnableContainerAuditLog bool,
@@ -20,6 +28,7 @@ func NewAuditor(
 	EnableTeamAuditLog bool,
 	EnableWorkerAuditLog bool,
 	EnableVolumeAuditLog bool,
+	store EventStore,
 	logger lager.Logger,
 ) *auditor {
 	return &auditor{
@@ -32,12 +41,13 @@ func NewAuditor(
 		EnableTeamAuditLog:      EnableTeamAuditLog,
 		EnableWorkerAuditLog:    EnableWorkerAuditLog,
 		EnableVolumeAuditLog:    EnableVolumeAuditLog,
+		store:                   store,
 		logger:                  logger,
 	}
 }
 
 type Auditor interface {
-	Audit(action string, userName string, r *http.Request)
+	Audit(action string, userName string, r *http.Request, statusCode int)
 }
 
 type auditor struct {
@@ -50,6 +60,7 @@ type auditor struct {
 	EnableTeamAuditLog      bool
 	EnableWorkerAuditLog    bool
 	EnableVolumeAuditLog    bool
+	store                   EventStore
 	logger                  lager.Logger
 }
 
@@ -57,9 +68,11 @@ func (a *auditor) ValidateAction(action string) bool {
 	switch action {
 	case atc.GetBuild,
 		atc.GetBuildPlan,
+		atc.GetBuildProvenance,
 		atc.CreateBuild,
 		atc.RerunJobBuild,
 		atc.ListBuilds,
+		atc.ListBuildQueue,
 		atc.BuildEvents,
 		atc.BuildResources,
 		atc.AbortBuild,
@@ -102,7 +115,10 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenamePipeline,
 		atc.ListPipelineBuilds,
 		atc.CreatePipelineBuild,
-		atc.PipelineBadge:
+		atc.PipelineBadge,
+		atc.ListPipelineNotifications,
+		atc.ListPipelineConfigHistory,
+		atc.GetPipelineConfigRevision:
 		return a.EnablePipelineAuditLog
 	case atc.ListAllResources,
 		atc.ListResources,
@@ -132,6 +148,9 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetInfo,
 		atc.GetInfoCreds,
 		atc.ListActiveUsersSince,
+		atc.ListPolicyDecisions,
+		atc.ListAuditEvents,
+		atc.GetEncryptionProgress,
 		atc.GetUser,
 		atc.GetWall,
 		atc.SetWall,
@@ -142,6 +161,12 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
+		atc.SearchBuildLogs,
+		atc.ListServiceAccounts,
+		atc.CreateServiceAccount,
+		atc.DeleteServiceAccount,
+		atc.CreateServiceAccountToken,
+		atc.RevokeServiceAccountToken,
 		atc.GetTeam:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
@@ -154,16 +179,63 @@ func (a *auditor) ValidateAction(action string) bool {
 		return a.EnableWorkerAuditLog
 	case atc.ListVolumes,
 		atc.ListDestroyingVolumes,
-		atc.ReportWorkerVolumes:
+		atc.ReportWorkerVolumes,
+		atc.ReportVolumeSizes:
 		return a.EnableVolumeAuditLog
 	default:
 		panic(fmt.Sprintf("unhandled action: %s", action))
 	}
 }
 
-func (a *auditor) Audit(action string, userName string, r *http.Request) {
+func (a *auditor) Audit(action string, userName string, r *http.Request, statusCode int) {
 	err := r.ParseForm()
-	if err == nil && a.ValidateAction(action) {
-		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+	if err != nil || !a.ValidateAction(action) {
+		return
+	}
+
+	params := redactParams(r.Form)
+
+	a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": params, "status": statusCode})
+
+	if a.store == nil {
+		return
+	}
+
+	err = a.store.SaveAuditEvent(atc.AuditEvent{
+		User:   userName,
+		Team:   r.Form.Get(":team_name"),
+		Action: action,
+		Target: r.URL.Path,
+		Params: params,
+		Status: statusCode,
+	})
+	if err != nil {
+		a.logger.Error("failed-to-save-audit-event", err, lager.Data{"action": action})
+	}
+}
+
+var secretParamWords = []string{"token", "password", "secret", "key", "credential"}
+
+// redactParams drops the route parameters, which are already part of the
+// request path, and hides the values of any parameters which look like they
+// hold secrets.
+func redactParams(form map[string][]string) map[string][]string {
+	params := map[string][]string{}
+	for name, values := range form {
+		if strings.HasPrefix(name, ":") {
+			continue
+		}
+
+		params[name] = values
+
+		lower := strings.ToLower(name)
+		for _, word := range secretParamWords {
+			if strings.Contains(lower, word) {
+				params[name] = []string{"[redacted]"}
+				break
+			}
+		}
 	}
+
+	return params
 }
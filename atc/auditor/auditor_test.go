@@ -1,12 +1,14 @@
 package auditor_test
 
 import (
+	"errors"
 	"net/http"
 
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -18,6 +20,7 @@ var _ = Describe("Audit", func() {
 		dummyAction             string
 		userName                string
 		logger                  *lagertest.TestLogger
+		fakeStore               *auditorfakes.FakeEventStore
 		req                     *http.Request
 		EnableBuildAuditLog     bool
 		EnableContainerAuditLog bool
@@ -39,6 +42,7 @@ var _ = Describe("Audit", func() {
 	})
 
 	JustBeforeEach(func() {
+		fakeStore = new(auditorfakes.FakeEventStore)
 		logger = lagertest.NewTestLogger("access_handler")
 
 		aud = auditor.NewAuditor(
@@ -51,6 +55,7 @@ var _ = Describe("Audit", func() {
 			EnableTeamAuditLog,
 			EnableWorkerAuditLog,
 			EnableVolumeAuditLog,
+			fakeStore,
 			logger,
 		)
 	})
@@ -80,7 +85,7 @@ var _ = Describe("Audit", func() {
 		})
 		It("all routes are handled and does not panic", func() {
 			for _, route := range atc.Routes {
-				aud.Audit(route.Name, userName, req)
+				aud.Audit(route.Name, userName, req, http.StatusOK)
 			}
 			logs := logger.Logs()
 			Expect(len(logs)).ToNot(Equal(0))
@@ -96,7 +101,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -110,7 +115,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -123,7 +128,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -136,7 +141,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -152,7 +157,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -166,7 +171,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -179,7 +184,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -192,7 +197,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -208,7 +213,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -222,7 +227,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -235,7 +240,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -248,7 +253,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -264,7 +269,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -278,7 +283,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -291,7 +296,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -304,7 +309,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -320,7 +325,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -334,7 +339,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -347,7 +352,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -360,7 +365,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -376,7 +381,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -390,7 +395,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -403,7 +408,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -416,7 +421,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -432,7 +437,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -446,7 +451,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -459,7 +464,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -472,7 +477,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -488,7 +493,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -502,7 +507,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -515,7 +520,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -528,7 +533,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -544,7 +549,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -558,7 +563,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -571,7 +576,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -584,10 +589,54 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
 		})
 	})
+
+	Describe("persisting events", func() {
+		BeforeEach(func() {
+			EnableSystemAuditLog = true
+			dummyAction = atc.SaveConfig
+
+			var err error
+			req, err = http.NewRequest("PUT", "http://localhost:8080/api/v1/teams/main/pipelines/some-pipeline/config?:team_name=main&:pipeline_name=some-pipeline&check_creds=true&access_token=abc", http.NoBody)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("saves the event with secrets redacted", func() {
+			aud.Audit(dummyAction, userName, req, http.StatusForbidden)
+
+			Expect(fakeStore.SaveAuditEventCallCount()).To(Equal(1))
+			Expect(fakeStore.SaveAuditEventArgsForCall(0)).To(Equal(atc.AuditEvent{
+				User:   userName,
+				Team:   "main",
+				Action: atc.SaveConfig,
+				Target: "/api/v1/teams/main/pipelines/some-pipeline/config",
+				Params: map[string][]string{
+					"check_creds":  {"true"},
+					"access_token": {"[redacted]"},
+				},
+				Status: http.StatusForbidden,
+			}))
+		})
+
+		It("does not save events for disabled actions", func() {
+			aud.Audit(atc.ListWorkers, userName, req, http.StatusOK)
+			Expect(fakeStore.SaveAuditEventCallCount()).To(BeZero())
+		})
+
+		Context("when saving fails", func() {
+			JustBeforeEach(func() {
+				fakeStore.SaveAuditEventReturns(errors.New("nope"))
+			})
+
+			It("logs the failure", func() {
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
+				Expect(logger.LogMessages()).To(ContainElement("access_handler.failed-to-save-audit-event"))
+			})
+		})
+	})
 })
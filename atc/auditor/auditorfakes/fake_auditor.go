@@ -9,29 +9,31 @@ import (
 )
 
 type FakeAuditor struct {
-	AuditStub        func(string, string, *http.Request)
+	AuditStub        func(string, string, *http.Request, int)
 	auditMutex       sync.RWMutex
 	auditArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 *http.Request
+		arg4 int
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) {
+func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request, arg4 int) {
 	fake.auditMutex.Lock()
 	fake.auditArgsForCall = append(fake.auditArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 *http.Request
-	}{arg1, arg2, arg3})
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.AuditStub
-	fake.recordInvocation("Audit", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("Audit", []interface{}{arg1, arg2, arg3, arg4})
 	fake.auditMutex.Unlock()
 	if stub != nil {
-		fake.AuditStub(arg1, arg2, arg3)
+		fake.AuditStub(arg1, arg2, arg3, arg4)
 	}
 }
 
@@ -41,17 +43,17 @@ func (fake *FakeAuditor) AuditCallCount() int {
 	return len(fake.auditArgsForCall)
 }
 
-func (fake *FakeAuditor) AuditCalls(stub func(string, string, *http.Request)) {
+func (fake *FakeAuditor) AuditCalls(stub func(string, string, *http.Request, int)) {
 	fake.auditMutex.Lock()
 	defer fake.auditMutex.Unlock()
 	fake.AuditStub = stub
 }
 
-func (fake *FakeAuditor) AuditArgsForCall(i int) (string, string, *http.Request) {
+func (fake *FakeAuditor) AuditArgsForCall(i int) (string, string, *http.Request, int) {
 	fake.auditMutex.RLock()
 	defer fake.auditMutex.RUnlock()
 	argsForCall := fake.auditArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeAuditor) Invocations() map[string][][]interface{} {
@@ -0,0 +1,13 @@
+package blobstore_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestBlobStore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Blob Store Suite")
+}
@@ -0,0 +1,93 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Local keeps blobs in a directory on the web node, which should be shared
+// between web nodes, e.g. over NFS.
+type Local struct {
+	Dir string `long:"local-dir" description:"Directory, shared by all web nodes, in which to keep blobs."`
+}
+
+// IsConfigured identifies if a Dir has been set
+func (l Local) IsConfigured() bool {
+	return l.Dir != ""
+}
+
+// Store returns a Store which reads and writes blobs under Dir
+func (l Local) Store() (Store, error) {
+	err := os.MkdirAll(l.Dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return localStore{dir: l.Dir}, nil
+}
+
+type localStore struct {
+	dir string
+}
+
+func (store localStore) Put(ctx context.Context, key string, r io.Reader) error {
+	path := store.path(key)
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a partially written blob is
+	// never visible under the final key
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".blob-")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (store localStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(store.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (store localStore) Delete(ctx context.Context, key string) error {
+	err := os.Remove(store.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (store localStore) path(key string) string {
+	// keys are always relative to the directory
+	return filepath.Join(store.dir, filepath.FromSlash(strings.TrimLeft(filepath.Clean("/"+key), "/")))
+}
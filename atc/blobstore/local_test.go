@@ -0,0 +1,76 @@
+package blobstore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc/blobstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Local", func() {
+	var (
+		ctx   context.Context
+		dir   string
+		store blobstore.Store
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		dir, err = ioutil.TempDir("", "blobstore")
+		Expect(err).ToNot(HaveOccurred())
+
+		store, err = blobstore.Local{Dir: dir}.Store()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("reads back what was put", func() {
+		err := store.Put(ctx, "some/key", strings.NewReader("some-blob"))
+		Expect(err).ToNot(HaveOccurred())
+
+		blob, err := store.Get(ctx, "some/key")
+		Expect(err).ToNot(HaveOccurred())
+		defer blob.Close()
+
+		Expect(ioutil.ReadAll(blob)).To(Equal([]byte("some-blob")))
+	})
+
+	It("keeps keys within the directory", func() {
+		err := store.Put(ctx, "../../escaped", strings.NewReader("some-blob"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(dir + "/escaped").To(BeAnExistingFile())
+	})
+
+	It("returns ErrNotFound for an unknown key", func() {
+		_, err := store.Get(ctx, "unknown")
+		Expect(err).To(Equal(blobstore.ErrNotFound))
+	})
+
+	Describe("Delete", func() {
+		It("removes the blob", func() {
+			err := store.Put(ctx, "some/key", strings.NewReader("some-blob"))
+			Expect(err).ToNot(HaveOccurred())
+
+			err = store.Delete(ctx, "some/key")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = store.Get(ctx, "some/key")
+			Expect(err).To(Equal(blobstore.ErrNotFound))
+		})
+
+		It("succeeds if the blob does not exist", func() {
+			Expect(store.Delete(ctx, "unknown")).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// S3 keeps blobs in a bucket on S3 or any S3-compatible API.
+type S3 struct {
+	Bucket          string `long:"s3-bucket" description:"Bucket in which to keep blobs."`
+	Prefix          string `long:"s3-prefix" description:"Prefix to prepend to the key of each blob."`
+	Region          string `long:"s3-region" description:"AWS region of the bucket."`
+	Endpoint        string `long:"s3-endpoint" description:"Custom endpoint for an S3-compatible API."`
+	AccessKeyID     string `long:"s3-access-key-id" description:"Access key ID. Credentials are discovered from the environment if not set."`
+	SecretAccessKey string `long:"s3-secret-access-key" description:"Secret access key."`
+	SessionToken    string `long:"s3-session-token" description:"Session token."`
+	ForcePathStyle  bool   `long:"s3-force-path-style" description:"Use path-style addressing, as required by most S3-compatible APIs."`
+}
+
+// IsConfigured identifies if a Bucket has been set
+func (s S3) IsConfigured() bool {
+	return s.Bucket != ""
+}
+
+// Store returns a Store which reads and writes objects in Bucket
+func (s S3) Store() (Store, error) {
+	config := &aws.Config{
+		S3ForcePathStyle: aws.Bool(s.ForcePathStyle),
+	}
+
+	if s.Region != "" {
+		config.Region = aws.String(s.Region)
+	}
+
+	if s.Endpoint != "" {
+		config.Endpoint = aws.String(s.Endpoint)
+	}
+
+	if s.AccessKeyID != "" {
+		config.Credentials = credentials.NewStaticCredentials(s.AccessKeyID, s.SecretAccessKey, s.SessionToken)
+	}
+
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s3Store{
+		bucket:   s.Bucket,
+		prefix:   s.Prefix,
+		client:   s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+	}, nil
+}
+
+type s3Store struct {
+	bucket string
+	prefix string
+
+	client   *s3.S3
+	uploader *s3manager.Uploader
+}
+
+func (store s3Store) Put(ctx context.Context, key string, r io.Reader) error {
+	_, err := store.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(path.Join(store.prefix, key)),
+		Body:   r,
+	})
+	return err
+}
+
+func (store s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := store.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(path.Join(store.prefix, key)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+// Delete succeeds even if the object does not exist.
+func (store s3Store) Delete(ctx context.Context, key string) error {
+	_, err := store.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(path.Join(store.prefix, key)),
+	})
+	return err
+}
@@ -0,0 +1,42 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned by a Store when no blob exists for a key.
+var ErrNotFound = errors.New("blob not found")
+
+//go:generate counterfeiter . Store
+
+// Store is a backend shared by all web nodes in which blobs, such as task
+// caches and archived build logs, are kept by key.
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Local Local
+	S3    S3
+}
+
+// IsConfigured identifies if any backend has been configured.
+func (c Config) IsConfigured() bool {
+	return c.Local.IsConfigured() || c.S3.IsConfigured()
+}
+
+// Store returns the configured backend, or nil if none has been configured.
+func (c Config) Store() (Store, error) {
+	switch {
+	case c.Local.IsConfigured():
+		return c.Local.Store()
+	case c.S3.IsConfigured():
+		return c.S3.Store()
+	}
+
+	return nil, nil
+}
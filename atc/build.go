@@ -32,6 +32,7 @@ type Build struct {
 	RerunNumber          int           `json:"rerun_number,omitempty"`
 	RerunOf              *RerunOfBuild `json:"rerun_of,omitempty"`
 	CreatedBy            *string       `json:"created_by,omitempty"`
+	Cause                *BuildCause   `json:"cause,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -0,0 +1,116 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+)
+
+type BuildCauseType string
+
+const (
+	BuildCauseInputs   BuildCauseType = "inputs"
+	BuildCauseManual   BuildCauseType = "manual"
+	BuildCauseRerun    BuildCauseType = "rerun"
+	BuildCauseSchedule BuildCauseType = "schedule"
+)
+
+// BuildCause records why a build was created.
+type BuildCause struct {
+	Type BuildCauseType `json:"type"`
+
+	Inputs    []BuildCauseInput `json:"inputs,omitempty"`
+	CreatedBy string            `json:"created_by,omitempty"`
+	RerunOf   *RerunOfBuild     `json:"rerun_of,omitempty"`
+	Schedule  *ScheduleCause    `json:"schedule,omitempty"`
+}
+
+// BuildCauseInput is a version of one of the job's inputs which had not been
+// used by any of its builds before.
+type BuildCauseInput struct {
+	Name     string  `json:"name"`
+	Resource string  `json:"resource"`
+	Version  Version `json:"version"`
+
+	// Trigger is set when the input has trigger: true, i.e. the new version
+	// is what made the build start.
+	Trigger bool `json:"trigger,omitempty"`
+
+	// Webhook is set when the version was found by a check which was
+	// triggered through the resource's webhook.
+	Webhook bool `json:"webhook,omitempty"`
+
+	// Passed lists the upstream builds which the version passed through to
+	// satisfy the input's passed constraints.
+	Passed []BuildCauseBuild `json:"passed,omitempty"`
+}
+
+type BuildCauseBuild struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	JobName string `json:"job_name"`
+}
+
+// ScheduleCause describes the job schedule which triggered a build, along
+// with the time the build was scheduled for, before any jitter.
+type ScheduleCause struct {
+	Cron          string `json:"cron"`
+	Location      string `json:"location,omitempty"`
+	ScheduledTime int64  `json:"scheduled_time"`
+}
+
+// String summarises the cause on a single line.
+func (cause BuildCause) String() string {
+	switch cause.Type {
+	case BuildCauseInputs:
+		var inputs []string
+		for _, input := range cause.Inputs {
+			inputs = append(inputs, input.String())
+		}
+
+		return "new versions of " + strings.Join(inputs, ", ")
+
+	case BuildCauseManual:
+		if cause.CreatedBy == "" {
+			return "manually triggered"
+		}
+
+		return "manually triggered by " + cause.CreatedBy
+
+	case BuildCauseRerun:
+		if cause.RerunOf == nil {
+			return "rerun"
+		}
+
+		return "rerun of #" + cause.RerunOf.Name
+
+	case BuildCauseSchedule:
+		if cause.Schedule == nil {
+			return "scheduled"
+		}
+
+		if cause.Schedule.Location == "" {
+			return fmt.Sprintf("scheduled by '%s'", cause.Schedule.Cron)
+		}
+
+		return fmt.Sprintf("scheduled by '%s' in %s", cause.Schedule.Cron, cause.Schedule.Location)
+	}
+
+	return string(cause.Type)
+}
+
+func (input BuildCauseInput) String() string {
+	var details []string
+	if input.Webhook {
+		details = append(details, "via webhook")
+	}
+
+	for _, build := range input.Passed {
+		details = append(details, fmt.Sprintf("passed %s #%s", build.JobName, build.Name))
+	}
+
+	if len(details) == 0 {
+		return input.Name
+	}
+
+	return input.Name + " (" + strings.Join(details, ", ") + ")"
+}
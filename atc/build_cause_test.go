@@ -0,0 +1,54 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildCause", func() {
+	Describe("String", func() {
+		It("describes new versions of inputs", func() {
+			Expect(atc.BuildCause{
+				Type: atc.BuildCauseInputs,
+				Inputs: []atc.BuildCauseInput{
+					{
+						Name:    "repo",
+						Webhook: true,
+						Passed: []atc.BuildCauseBuild{
+							{ID: 1, Name: "12", JobName: "unit"},
+						},
+					},
+					{Name: "image"},
+				},
+			}.String()).To(Equal("new versions of repo (via webhook, passed unit #12), image"))
+		})
+
+		It("describes manually triggered builds", func() {
+			Expect(atc.BuildCause{Type: atc.BuildCauseManual}.String()).To(Equal("manually triggered"))
+			Expect(atc.BuildCause{
+				Type:      atc.BuildCauseManual,
+				CreatedBy: "some-user",
+			}.String()).To(Equal("manually triggered by some-user"))
+		})
+
+		It("describes reruns", func() {
+			Expect(atc.BuildCause{
+				Type:    atc.BuildCauseRerun,
+				RerunOf: &atc.RerunOfBuild{ID: 1, Name: "3"},
+			}.String()).To(Equal("rerun of #3"))
+		})
+
+		It("describes scheduled builds", func() {
+			Expect(atc.BuildCause{
+				Type:     atc.BuildCauseSchedule,
+				Schedule: &atc.ScheduleCause{Cron: "0 2 * * *"},
+			}.String()).To(Equal("scheduled by '0 2 * * *'"))
+			Expect(atc.BuildCause{
+				Type:     atc.BuildCauseSchedule,
+				Schedule: &atc.ScheduleCause{Cron: "0 2 * * *", Location: "Europe/Berlin"},
+			}.String()).To(Equal("scheduled by '0 2 * * *' in Europe/Berlin"))
+		})
+	})
+})
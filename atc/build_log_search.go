@@ -0,0 +1,110 @@
+package atc
+
+import (
+	"strings"
+)
+
+const (
+	BuildLogSearchQueryText     = "q"
+	BuildLogSearchQueryPipeline = "pipeline"
+	BuildLogSearchQueryJob      = "job"
+	BuildLogSearchQuerySince    = "since"
+	BuildLogSearchQueryUntil    = "until"
+	BuildLogSearchQueryContext  = "context"
+	BuildLogSearchQueryLimit    = "limit"
+
+	BuildLogSearchDefaultLimit = 100
+	BuildLogSearchMaxLimit     = 1000
+	BuildLogSearchMaxContext   = 10
+)
+
+// BuildLogMatch is a line of build output which matched a log search.
+type BuildLogMatch struct {
+	BuildID              int          `json:"build_id"`
+	BuildName            string       `json:"build_name"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name,omitempty"`
+	Origin               string       `json:"origin"`
+	Time                 int64        `json:"time"`
+
+	LogLineMatch
+}
+
+// LogLineMatch is a matching line along with the lines around it.
+type LogLineMatch struct {
+	Line   string   `json:"line"`
+	Before []string `json:"before,omitempty"`
+	After  []string `json:"after,omitempty"`
+}
+
+// MatchLogLines returns each line of a log payload which contains the query,
+// ignoring case, along with up to context lines either side of it.
+func MatchLogLines(payload string, query string, context int) []LogLineMatch {
+	query = strings.ToLower(query)
+	if query == "" {
+		return nil
+	}
+
+	lines := splitLogLines(payload)
+
+	var matches []LogLineMatch
+	for i, line := range lines {
+		if !strings.Contains(strings.ToLower(line), query) {
+			continue
+		}
+
+		matches = append(matches, logLineMatch(lines, i, context))
+	}
+
+	return matches
+}
+
+// LogLineContext returns the line of a log payload at the given index (from
+// zero), along with up to context lines either side of it.
+func LogLineContext(payload string, index int, context int) LogLineMatch {
+	lines := splitLogLines(payload)
+	if index < 0 || index >= len(lines) {
+		return LogLineMatch{}
+	}
+
+	return logLineMatch(lines, index, context)
+}
+
+func splitLogLines(payload string) []string {
+	return strings.Split(strings.TrimRight(payload, "\n"), "\n")
+}
+
+func logLineMatch(lines []string, i int, context int) LogLineMatch {
+	match := LogLineMatch{Line: strings.TrimRight(lines[i], "\r")}
+
+	if context > 0 {
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+
+		end := i + 1 + context
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		match.Before = trimCarriageReturns(lines[start:i])
+		match.After = trimCarriageReturns(lines[i+1 : end])
+	}
+
+	return match
+}
+
+func trimCarriageReturns(lines []string) []string {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	trimmed := make([]string, len(lines))
+	for i, line := range lines {
+		trimmed[i] = strings.TrimRight(line, "\r")
+	}
+
+	return trimmed
+}
@@ -0,0 +1,42 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MatchLogLines", func() {
+	const payload = "fetching deps\r\ncompiling\nerror: Connection refused\nretrying\nerror: connection refused\ngiving up\n"
+
+	It("returns the lines containing the query, ignoring case", func() {
+		Expect(atc.MatchLogLines(payload, "connection REFUSED", 0)).To(Equal([]atc.LogLineMatch{
+			{Line: "error: Connection refused"},
+			{Line: "error: connection refused"},
+		}))
+	})
+
+	It("includes the lines around each match", func() {
+		Expect(atc.MatchLogLines(payload, "connection refused", 2)).To(Equal([]atc.LogLineMatch{
+			{
+				Line:   "error: Connection refused",
+				Before: []string{"fetching deps", "compiling"},
+				After:  []string{"retrying", "error: connection refused"},
+			},
+			{
+				Line:   "error: connection refused",
+				Before: []string{"error: Connection refused", "retrying"},
+				After:  []string{"giving up"},
+			},
+		}))
+	})
+
+	It("matches nothing for an empty query", func() {
+		Expect(atc.MatchLogLines(payload, "", 2)).To(BeEmpty())
+	})
+
+	It("matches nothing when no line contains the query", func() {
+		Expect(atc.MatchLogLines(payload, "segfault", 2)).To(BeEmpty())
+	})
+})
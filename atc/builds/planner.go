@@ -55,6 +55,7 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		ConfigPath:        step.ConfigPath,
 		Vars:              step.Vars,
 		Tags:              step.Tags,
+		NodeSelector:      step.NodeSelector,
 		Params:            step.Params,
 		InputMapping:      step.InputMapping,
 		OutputMapping:     step.OutputMapping,
@@ -103,6 +104,8 @@ func (visitor *planVisitor) VisitGet(step *atc.GetStep) error {
 		Tags:     step.Tags,
 		Timeout:  step.Timeout,
 
+		NodeSelector: step.NodeSelector,
+
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
 
@@ -137,6 +140,8 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 		Tags:    step.Tags,
 		Timeout: step.Timeout,
 
+		NodeSelector: step.NodeSelector,
+
 		VersionedResourceTypes: visitor.resourceTypes,
 	}
 
@@ -153,6 +158,8 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 		Tags:    step.Tags,
 		Timeout: step.Timeout,
 
+		NodeSelector: step.NodeSelector,
+
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
 
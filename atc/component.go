@@ -8,6 +8,12 @@ const (
 	ComponentLidarScanner               = "scanner"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentNotifier                   = "notifier"
+	ComponentQuotaReporter              = "quota_reporter"
+	ComponentFlakinessAnalyzer          = "flakiness_analyzer"
+	ComponentScheduleTrigger            = "schedule_trigger"
+	ComponentReencrypter                = "reencrypter"
+	ComponentLogSearchIndexer           = "log_search_indexer"
 	ComponentCollectorAccessTokens      = "collector_access_tokens"
 	ComponentCollectorArtifacts         = "collector_artifacts"
 	ComponentCollectorBuilds            = "collector_builds"
@@ -20,6 +26,9 @@ const (
 	ComponentCollectorVolumes           = "collector_volumes"
 	ComponentCollectorWorkers           = "collector_workers"
 	ComponentCollectorPipelines         = "collector_pipelines"
+	ComponentCollectorTaskCaches        = "collector_task_caches"
+	ComponentCollectorAuditEvents       = "collector_audit_events"
+	ComponentCollectorPolicyDecisions   = "collector_policy_decisions"
 )
 
 type Component struct {
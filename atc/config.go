@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gobwas/glob"
 	"golang.org/x/crypto/ssh"
 	"sigs.k8s.io/yaml"
 
@@ -20,12 +21,13 @@ const DefaultTeamName = "main"
 type Tags []string
 
 type Config struct {
-	Groups        GroupConfigs     `json:"groups,omitempty"`
-	VarSources    VarSourceConfigs `json:"var_sources,omitempty"`
-	Resources     ResourceConfigs  `json:"resources,omitempty"`
-	ResourceTypes ResourceTypes    `json:"resource_types,omitempty"`
-	Jobs          JobConfigs       `json:"jobs,omitempty"`
-	Display       *DisplayConfig   `json:"display,omitempty"`
+	Groups        GroupConfigs         `json:"groups,omitempty"`
+	VarSources    VarSourceConfigs     `json:"var_sources,omitempty"`
+	Resources     ResourceConfigs      `json:"resources,omitempty"`
+	ResourceTypes ResourceTypes        `json:"resource_types,omitempty"`
+	Jobs          JobConfigs           `json:"jobs,omitempty"`
+	Display       *DisplayConfig       `json:"display,omitempty"`
+	Notifications *NotificationsConfig `json:"notifications,omitempty"`
 }
 
 func UnmarshalConfig(payload []byte, config interface{}) error {
@@ -37,6 +39,7 @@ func UnmarshalConfig(payload []byte, config interface{}) error {
 		ResourceTypes interface{} `json:"resource_types,omitempty"`
 		Jobs          interface{} `json:"jobs,omitempty"`
 		Display       interface{} `json:"display,omitempty"`
+		Notifications interface{} `json:"notifications,omitempty"`
 	}
 
 	var stripped skeletonConfig
@@ -50,10 +53,19 @@ func UnmarshalConfig(payload []byte, config interface{}) error {
 		return err
 	}
 
-	return yaml.UnmarshalStrict(
+	err = yaml.UnmarshalStrict(
 		strippedPayload,
 		&config,
 	)
+	if err != nil {
+		return err
+	}
+
+	if c, ok := config.(*Config); ok {
+		return KeepMatrixValueText(payload, c)
+	}
+
+	return nil
 }
 
 type GroupConfig struct {
@@ -188,6 +200,8 @@ type ResourceConfig struct {
 	Version              Version     `json:"version,omitempty"`
 	Icon                 string      `json:"icon,omitempty"`
 	ExposeBuildCreatedBy bool        `json:"expose_build_created_by,omitempty"`
+
+	NodeSelector NodeSelector `json:"node_selector,omitempty"`
 }
 
 type ResourceType struct {
@@ -295,6 +309,141 @@ func (jobs JobConfigs) Lookup(name string) (JobConfig, bool) {
 	return JobConfig{}, false
 }
 
+// ExpandJobMatrices returns a copy of the config in which every job declaring a
+// matrix is replaced by its expanded jobs. Groups and passed constraints that
+// refer to such a job by name refer to all of its expanded jobs instead.
+//
+// When the pipeline has groups, expanded jobs which would belong to no group
+// are put in a group named after the job they were expanded from.
+func (config Config) ExpandJobMatrices() (Config, error) {
+	var matrixJobs []string
+	expandedNames := map[string][]string{}
+	jobs := JobConfigs{}
+
+	for _, job := range config.Jobs {
+		expanded, err := job.ExpandMatrix()
+		if err != nil {
+			return Config{}, err
+		}
+
+		if len(job.Matrix) != 0 {
+			matrixJobs = append(matrixJobs, job.Name)
+			for _, expandedJob := range expanded {
+				expandedNames[job.Name] = append(expandedNames[job.Name], expandedJob.Name)
+			}
+		}
+
+		jobs = append(jobs, expanded...)
+	}
+
+	if len(expandedNames) == 0 {
+		return config, nil
+	}
+
+	// round-trip the jobs so that rewriting passed constraints doesn't modify
+	// the steps of the original config
+	payload, err := json.Marshal(jobs)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config.Jobs = nil
+	err = json.Unmarshal(payload, &config.Jobs)
+	if err != nil {
+		return Config{}, err
+	}
+
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(StepRecursor{
+			OnGet: func(step *GetStep) error {
+				step.Passed = expandJobNames(step.Passed, expandedNames)
+				return nil
+			},
+		})
+	}
+
+	groups := make(GroupConfigs, len(config.Groups))
+	for i, group := range config.Groups {
+		group.Jobs = expandJobNames(group.Jobs, expandedNames)
+		groups[i] = group
+	}
+
+	if len(groups) != 0 {
+		for _, name := range matrixJobs {
+			var ungrouped []string
+			for _, expandedName := range expandedNames[name] {
+				if !groups.includeJob(expandedName) {
+					ungrouped = append(ungrouped, expandedName)
+				}
+			}
+
+			if len(ungrouped) == 0 {
+				continue
+			}
+
+			_, index, found := groups.Lookup(name)
+			if found {
+				groups[index].Jobs = append(groups[index].Jobs, ungrouped...)
+			} else {
+				groups = append(groups, GroupConfig{Name: name, Jobs: ungrouped})
+			}
+		}
+	}
+
+	if config.Groups != nil {
+		config.Groups = groups
+	}
+
+	if config.Notifications != nil {
+		notifications := *config.Notifications
+		notifications.Rules = make(NotificationRuleConfigs, len(config.Notifications.Rules))
+		for i, rule := range config.Notifications.Rules {
+			rule.Jobs = expandJobNames(rule.Jobs, expandedNames)
+			notifications.Rules[i] = rule
+		}
+
+		config.Notifications = &notifications
+	}
+
+	return config, nil
+}
+
+// includeJob returns whether any of the groups includes the job, either by
+// name or by a glob.
+func (groups GroupConfigs) includeJob(name string) bool {
+	for _, group := range groups {
+		for _, pattern := range group.Jobs {
+			g, err := glob.Compile(pattern)
+			if err != nil {
+				continue
+			}
+
+			if g.Match(name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func expandJobNames(names []string, expandedNames map[string][]string) []string {
+	if names == nil {
+		return nil
+	}
+
+	result := []string{}
+	for _, name := range names {
+		if expanded, found := expandedNames[name]; found {
+			result = append(result, expanded...)
+		} else {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
+
 func (config Config) JobIsPublic(jobName string) (bool, error) {
 	job, found := config.Jobs.Lookup(jobName)
 	if !found {
@@ -30,6 +30,11 @@ type DisplayDiff struct {
 	After  *DisplayConfig
 }
 
+type NotificationsDiff struct {
+	Before *NotificationsConfig
+	After  *NotificationsConfig
+}
+
 func name(v interface{}) string {
 	return reflect.ValueOf(v).FieldByName("Name").String()
 }
@@ -76,6 +81,24 @@ func (diff DisplayDiff) Render(to io.Writer) {
 	}
 }
 
+func (diff NotificationsDiff) Render(to io.Writer) {
+	label := "notifications configuration"
+	if diff.Before != nil && diff.After != nil {
+		fmt.Fprintf(to, ansi.Color("%s has changed:", "yellow")+"\n", label)
+		payloadA, _ := yaml.Marshal(diff.Before)
+		payloadB, _ := yaml.Marshal(diff.After)
+		renderDiff(to, string(payloadA), string(payloadB))
+	} else if diff.Before != nil {
+		fmt.Fprintf(to, ansi.Color("%s has been removed:", "yellow")+"\n", label)
+		payloadA, _ := yaml.Marshal(diff.Before)
+		renderDiff(to, string(payloadA), "")
+	} else {
+		fmt.Fprintf(to, ansi.Color("%s has been added:", "yellow")+"\n", label)
+		payloadB, _ := yaml.Marshal(diff.After)
+		renderDiff(to, "", string(payloadB))
+	}
+}
+
 type GroupIndex GroupConfigs
 
 func (index GroupIndex) Slice() []interface{} {
@@ -242,6 +265,17 @@ func diffDisplay(oldDisplay, newDisplay *DisplayConfig) (DisplayDiff, bool) {
 	}, practicallyDifferent(oldDisplay, newDisplay)
 }
 
+func diffNotifications(oldNotifications, newNotifications *NotificationsConfig) (NotificationsDiff, bool) {
+	if oldNotifications == nil && newNotifications == nil {
+		return NotificationsDiff{}, false
+	}
+
+	return NotificationsDiff{
+		Before: oldNotifications,
+		After:  newNotifications,
+	}, practicallyDifferent(oldNotifications, newNotifications)
+}
+
 func renderDiff(to io.Writer, a, b string) {
 	diffs := difflib.Diff(strings.Split(a, "\n"), strings.Split(b, "\n"))
 	indent := gexec.NewPrefixedWriter("\b\b", to)
@@ -292,7 +326,7 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 	varSourceDiffs := diffIndices(VarSourceIndex(c.VarSources), VarSourceIndex(newConfig.VarSources))
 	if len(varSourceDiffs) > 0 {
 		diffExists = true
-		fmt.Println("variable source:")
+		fmt.Fprintln(out, "variable source:")
 
 		for _, diff := range varSourceDiffs {
 			diff.Render(indent, "variable source")
@@ -335,5 +369,52 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 		displayDiff.Render(indent)
 	}
 
+	notificationsDiff, diff := diffNotifications(c.Notifications, newConfig.Notifications)
+	if diff {
+		diffExists = true
+		notificationsDiff.Render(indent)
+	}
+
 	return diffExists
 }
+
+// Diff renders the difference between two teams' auth configs. Roles and
+// their users and groups are compared regardless of empty entries, which the
+// API may or may not include.
+func (auth TeamAuth) Diff(out io.Writer, newAuth TeamAuth) bool {
+	oldRoles := auth.withoutEmptyEntries()
+	newRoles := newAuth.withoutEmptyEntries()
+
+	if !practicallyDifferent(oldRoles, newRoles) {
+		return false
+	}
+
+	indent := gexec.NewPrefixedWriter("  ", out)
+
+	fmt.Fprintln(out, ansi.Color("auth has changed:", "yellow"))
+
+	payloadA, _ := yaml.Marshal(oldRoles)
+	payloadB, _ := yaml.Marshal(newRoles)
+
+	renderDiff(indent, string(payloadA), string(payloadB))
+
+	return true
+}
+
+func (auth TeamAuth) withoutEmptyEntries() TeamAuth {
+	roles := TeamAuth{}
+	for role, config := range auth {
+		entries := map[string][]string{}
+		for kind, values := range config {
+			if len(values) > 0 {
+				entries[kind] = values
+			}
+		}
+
+		if len(entries) > 0 {
+			roles[role] = entries
+		}
+	}
+
+	return roles
+}
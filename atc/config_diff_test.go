@@ -216,4 +216,97 @@ var _ = Describe("Config diff", func() {
 			})
 		})
 	})
+
+	Describe("notifications config", func() {
+		var notifications NotificationsConfig
+		BeforeEach(func() {
+			notifications = NotificationsConfig{
+				Sinks: NotificationSinkConfigs{
+					{Name: "some-sink", Type: NotificationSinkTypeWebhook, Source: Source{"url": "https://example.com"}},
+				},
+				Rules: NotificationRuleConfigs{
+					{Sinks: []string{"some-sink"}},
+				},
+			}
+		})
+
+		Context("when there is no notifications config", func() {
+			It("does not print anything about notifications config", func() {
+				buffer := NewBuffer()
+				diff := Config{}.Diff(buffer, Config{})
+				Expect(diff).To(BeFalse())
+				Consistently(buffer).ShouldNot(Say("notifications"))
+			})
+		})
+
+		Context("when notifications config is added", func() {
+			It("says config has been added", func() {
+				buffer := NewBuffer()
+				diff := Config{}.Diff(buffer, Config{Notifications: &notifications})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("notifications configuration has been added:"))
+				Eventually(buffer).Should(Say(`\+.*name: some-sink`))
+			})
+		})
+
+		Context("when notifications config is removed", func() {
+			It("says config has been removed", func() {
+				buffer := NewBuffer()
+				diff := Config{Notifications: &notifications}.Diff(buffer, Config{})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("notifications configuration has been removed:"))
+				Eventually(buffer).Should(Say(`-.*name: some-sink`))
+			})
+		})
+
+		Context("when a rule changes", func() {
+			It("says config has changed", func() {
+				newNotifications := notifications
+				newNotifications.Rules = NotificationRuleConfigs{
+					{To: []BuildStatus{StatusFailed}, Sinks: []string{"some-sink"}},
+				}
+
+				buffer := NewBuffer()
+				diff := Config{Notifications: &notifications}.Diff(buffer, Config{Notifications: &newNotifications})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("notifications configuration has changed:"))
+				Eventually(buffer).Should(Say(`\+.*- failed`))
+			})
+		})
+	})
+
+	Describe("team auth", func() {
+		var auth TeamAuth
+		BeforeEach(func() {
+			auth = TeamAuth{
+				"owner": {"users": {"local:admin"}, "groups": {}},
+			}
+		})
+
+		Context("when only empty entries differ", func() {
+			It("says there are no changes", func() {
+				buffer := NewBuffer()
+				diff := auth.Diff(buffer, TeamAuth{
+					"owner":  {"users": {"local:admin"}},
+					"viewer": {"users": {}},
+				})
+				Expect(diff).To(BeFalse())
+				Consistently(buffer).ShouldNot(Say("auth"))
+			})
+		})
+
+		Context("when a role is added", func() {
+			It("says auth has changed", func() {
+				buffer := NewBuffer()
+				diff := auth.Diff(buffer, TeamAuth{
+					"owner":  {"users": {"local:admin"}},
+					"viewer": {"groups": {"github:org"}},
+				})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("auth has changed:"))
+				Eventually(buffer).Should(Say(`\+.*viewer:`))
+				Eventually(buffer).Should(Say(`\+.*- github:org`))
+			})
+		})
+	})
 })
@@ -223,4 +223,145 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("ExpandJobMatrices", func() {
+		var config Config
+
+		BeforeEach(func() {
+			config = Config{
+				Groups: GroupConfigs{
+					{Name: "tests", Jobs: []string{"test"}},
+					{Name: "all", Jobs: []string{"test", "ship"}},
+				},
+				Jobs: JobConfigs{
+					{
+						Name: "test",
+						Matrix: []MatrixVarConfig{
+							{Var: "os", Values: []interface{}{"linux", "windows"}},
+						},
+						PlanSequence: []Step{
+							{Config: &GetStep{Name: "repo"}},
+						},
+					},
+					{
+						Name: "ship",
+						PlanSequence: []Step{
+							{Config: &GetStep{Name: "repo", Passed: []string{"test"}}},
+						},
+					},
+				},
+			}
+		})
+
+		It("replaces the job with its expanded jobs", func() {
+			expanded, err := config.ExpandJobMatrices()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(expanded.Jobs).To(HaveLen(3))
+			Expect(expanded.Jobs[0].Name).To(Equal("test-linux"))
+			Expect(expanded.Jobs[1].Name).To(Equal("test-windows"))
+			Expect(expanded.Jobs[2].Name).To(Equal("ship"))
+		})
+
+		It("groups the expanded jobs together", func() {
+			expanded, err := config.ExpandJobMatrices()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(expanded.Groups).To(Equal(GroupConfigs{
+				{Name: "tests", Jobs: []string{"test-linux", "test-windows"}},
+				{Name: "all", Jobs: []string{"test-linux", "test-windows", "ship"}},
+			}))
+		})
+
+		Context("when the job is in no group", func() {
+			BeforeEach(func() {
+				config.Groups = GroupConfigs{
+					{Name: "all", Jobs: []string{"ship"}},
+				}
+			})
+
+			It("groups the expanded jobs under the job's name", func() {
+				expanded, err := config.ExpandJobMatrices()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(expanded.Groups).To(Equal(GroupConfigs{
+					{Name: "all", Jobs: []string{"ship"}},
+					{Name: "test", Jobs: []string{"test-linux", "test-windows"}},
+				}))
+			})
+		})
+
+		Context("when the job is grouped by a glob matching some of the expanded jobs", func() {
+			BeforeEach(func() {
+				config.Groups = GroupConfigs{
+					{Name: "all", Jobs: []string{"ship", "*-linux"}},
+				}
+			})
+
+			It("groups the rest of the expanded jobs under the job's name", func() {
+				expanded, err := config.ExpandJobMatrices()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(expanded.Groups).To(Equal(GroupConfigs{
+					{Name: "all", Jobs: []string{"ship", "*-linux"}},
+					{Name: "test", Jobs: []string{"test-windows"}},
+				}))
+			})
+		})
+
+		Context("when the pipeline has no groups", func() {
+			BeforeEach(func() {
+				config.Groups = nil
+			})
+
+			It("does not add any", func() {
+				expanded, err := config.ExpandJobMatrices()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expanded.Groups).To(BeNil())
+			})
+		})
+
+		It("requires passed constraints to pass all of the expanded jobs", func() {
+			expanded, err := config.ExpandJobMatrices()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(expanded.Jobs[2].PlanSequence).To(Equal([]Step{
+				{Config: &GetStep{Name: "repo", Passed: []string{"test-linux", "test-windows"}}},
+			}))
+		})
+
+		It("rewrites notification rules to match the expanded jobs", func() {
+			config.Notifications = &NotificationsConfig{
+				Rules: NotificationRuleConfigs{
+					{Jobs: []string{"test", "deploy-*"}, Sinks: []string{"some-sink"}},
+				},
+			}
+
+			expanded, err := config.ExpandJobMatrices()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(expanded.Notifications.Rules[0].Jobs).To(Equal([]string{"test-linux", "test-windows", "deploy-*"}))
+			Expect(config.Notifications.Rules[0].Jobs).To(Equal([]string{"test", "deploy-*"}))
+		})
+
+		It("does not modify the original config", func() {
+			_, err := config.ExpandJobMatrices()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.Groups[0].Jobs).To(Equal([]string{"test"}))
+			Expect(config.Jobs[1].PlanSequence[0].Config.(*GetStep).Passed).To(Equal([]string{"test"}))
+		})
+
+		Context("when no job has a matrix", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Matrix = nil
+			})
+
+			It("returns the config as-is", func() {
+				expanded, err := config.ExpandJobMatrices()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expanded).To(Equal(config))
+			})
+		})
+	})
 })
@@ -26,6 +26,19 @@ func Validate(c atc.Config) ([]atc.ConfigWarning, []string) {
 	warnings := []atc.ConfigWarning{}
 	errorMessages := []string{}
 
+	matrixErr := validateJobMatrices(c)
+	if matrixErr != nil {
+		errorMessages = append(errorMessages, formatErr("jobs", matrixErr))
+		return warnings, errorMessages
+	}
+
+	// validate each expansion of a job's matrix as a job of its own
+	c, err := c.ExpandJobMatrices()
+	if err != nil {
+		errorMessages = append(errorMessages, formatErr("jobs", err))
+		return warnings, errorMessages
+	}
+
 	groupsWarnings, groupsErr := validateGroups(c)
 	if groupsErr != nil {
 		errorMessages = append(errorMessages, formatErr("groups", groupsErr))
@@ -62,6 +75,12 @@ func Validate(c atc.Config) ([]atc.ConfigWarning, []string) {
 	}
 	warnings = append(warnings, displayWarnings...)
 
+	notificationsWarnings, notificationsErr := validateNotifications(c)
+	if notificationsErr != nil {
+		errorMessages = append(errorMessages, formatErr("notifications", notificationsErr))
+	}
+	warnings = append(warnings, notificationsWarnings...)
+
 	return warnings, errorMessages
 }
 
@@ -184,6 +203,13 @@ func validateResources(c atc.Config) ([]atc.ConfigWarning, error) {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		for j, req := range resource.NodeSelector {
+			err := req.Validate()
+			if err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s.node_selector[%d]: %s", identifier, j, err))
+			}
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -307,6 +333,13 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 			errorMessages = append(errorMessages, identifier+" has no name")
 		}
 
+		if !job.Priority.Valid() {
+			errorMessages = append(
+				errorMessages,
+				identifier+fmt.Sprintf(" has unknown priority '%s' (must be one of: %s)", job.Priority, strings.Join(atc.JobPriorityNames(), ", ")),
+			)
+		}
+
 		if job.BuildLogRetention != nil && job.BuildLogsToRetain != 0 {
 			errorMessages = append(
 				errorMessages,
@@ -346,6 +379,13 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 			}
 		}
 
+		if job.Schedule != nil {
+			err := job.Schedule.Validate()
+			if err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s.schedule: %s", identifier, err))
+			}
+		}
+
 		step := job.Step()
 
 		validator := atc.NewStepValidator(c, []string{identifier, ".plan"})
@@ -360,6 +400,80 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 	return warnings, compositeErr(errorMessages)
 }
 
+func validateJobMatrices(c atc.Config) error {
+	var errorMessages []string
+
+	for i, job := range c.Jobs {
+		if len(job.Matrix) == 0 {
+			continue
+		}
+
+		var identifier string
+		if job.Name == "" {
+			identifier = fmt.Sprintf("jobs[%d].matrix", i)
+		} else {
+			identifier = fmt.Sprintf("jobs.%s.matrix", job.Name)
+		}
+
+		vars := map[string]int{}
+		for j, matrixVar := range job.Matrix {
+			if matrixVar.Var == "" {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s[%d] has no var", identifier, j))
+			} else if other, exists := vars[matrixVar.Var]; exists {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf(
+						"%s[%d] and %s[%d] have the same var ('%s')",
+						identifier, other, identifier, j, matrixVar.Var))
+			} else {
+				vars[matrixVar.Var] = j
+			}
+
+			if len(matrixVar.Values) == 0 {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s[%d] has no values", identifier, j))
+			}
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return compositeErr(errorMessages)
+	}
+
+	// the expanded jobs are named after the values, so make sure none of them
+	// takes the name of another job
+	names := map[string]string{}
+	for _, job := range c.Jobs {
+		if len(job.Matrix) == 0 && job.Name != "" {
+			names[job.Name] = fmt.Sprintf("jobs.%s", job.Name)
+		}
+	}
+
+	for _, job := range c.Jobs {
+		if len(job.Matrix) == 0 {
+			continue
+		}
+
+		expanded, err := job.ExpandMatrix()
+		if err != nil {
+			// reported when expanding the whole config
+			continue
+		}
+
+		identifier := fmt.Sprintf("jobs.%s.matrix", job.Name)
+		for _, expandedJob := range expanded {
+			if other, exists := names[expandedJob.Name]; exists {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf(
+						"%s expands to a job named '%s', which collides with %s",
+						identifier, expandedJob.Name, other))
+			} else {
+				names[expandedJob.Name] = identifier
+			}
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
 func compositeErr(errorMessages []string) error {
 	if len(errorMessages) == 0 {
 		return nil
@@ -448,3 +562,106 @@ func validateDisplay(c atc.Config) ([]atc.ConfigWarning, error) {
 
 	return warnings, nil
 }
+
+var notificationSinkRequiredFields = map[string][]string{
+	atc.NotificationSinkTypeWebhook: {"url"},
+	atc.NotificationSinkTypeSlack:   {"url"},
+	atc.NotificationSinkTypeSMTP:    {"host", "from", "to"},
+}
+
+func validateNotifications(c atc.Config) ([]atc.ConfigWarning, error) {
+	var warnings []atc.ConfigWarning
+	var errorMessages []string
+
+	if c.Notifications == nil {
+		return warnings, nil
+	}
+
+	names := map[string]int{}
+
+	for i, sink := range c.Notifications.Sinks {
+		var identifier string
+		if sink.Name == "" {
+			identifier = fmt.Sprintf("notifications.sinks[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("notifications.sinks.%s", sink.Name)
+		}
+
+		warning, err := atc.ValidateIdentifier(sink.Name, identifier)
+		if err != nil {
+			errorMessages = append(errorMessages, err.Error())
+		}
+		if warning != nil {
+			warnings = append(warnings, *warning)
+		}
+
+		if other, exists := names[sink.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf("%s and notifications.sinks[%d] have the same name ('%s')", identifier, other, sink.Name))
+		} else if sink.Name != "" {
+			names[sink.Name] = i
+		}
+
+		requiredFields, known := notificationSinkRequiredFields[sink.Type]
+		if !known {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf("%s has unknown type '%s' (must be one of: %s)", identifier, sink.Type, strings.Join(atc.NotificationSinkTypes, ", ")))
+			continue
+		}
+
+		for _, field := range requiredFields {
+			if _, found := sink.Source[field]; !found {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf("%s is missing '%s' in its source", identifier, field))
+			}
+		}
+	}
+
+	for i, rule := range c.Notifications.Rules {
+		identifier := fmt.Sprintf("notifications.rules[%d]", i)
+
+		if len(rule.Sinks) == 0 {
+			errorMessages = append(errorMessages, identifier+" has no sinks")
+		}
+
+		for _, sink := range rule.Sinks {
+			if _, found := c.Notifications.Sinks.Lookup(sink); !found {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf("%s has unknown sink '%s'", identifier, sink))
+			}
+		}
+
+		for _, jobGlob := range rule.Jobs {
+			g, err := glob.Compile(jobGlob)
+			if err != nil {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf("invalid glob expression '%s' for %s", jobGlob, identifier))
+				continue
+			}
+
+			matchingJob := false
+			for _, job := range c.Jobs {
+				if g.Match(job.Name) {
+					matchingJob = true
+					break
+				}
+			}
+
+			if !matchingJob {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf("no jobs match '%s' for %s", jobGlob, identifier))
+			}
+		}
+
+		for _, status := range append(append([]atc.BuildStatus{}, rule.From...), rule.To...) {
+			switch status {
+			case atc.StatusSucceeded, atc.StatusFailed, atc.StatusErrored, atc.StatusAborted:
+			default:
+				errorMessages = append(errorMessages,
+					fmt.Sprintf("%s has invalid build status '%s'", identifier, status))
+			}
+		}
+	}
+
+	return warnings, compositeErr(errorMessages)
+}
@@ -840,6 +840,102 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has a matrix", func() {
+			BeforeEach(func() {
+				job.Matrix = []atc.MatrixVarConfig{
+					{Var: "os", Values: []interface{}{"linux", "windows"}},
+				}
+			})
+
+			Context("when the matrix is valid", func() {
+				BeforeEach(func() {
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns no error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a matrix var has no values", func() {
+				BeforeEach(func() {
+					job.Matrix = append(job.Matrix, atc.MatrixVarConfig{Var: "arch"})
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.matrix[1] has no values"))
+				})
+			})
+
+			Context("when two matrix vars have the same name", func() {
+				BeforeEach(func() {
+					job.Matrix = append(job.Matrix, atc.MatrixVarConfig{Var: "os", Values: []interface{}{"darwin"}})
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.matrix[0] and jobs.some-other-job.matrix[1] have the same var ('os')"))
+				})
+			})
+
+			Context("when an expanded job takes the name of another job", func() {
+				BeforeEach(func() {
+					otherJob := job
+					otherJob.Name = "some-other-job-linux"
+					otherJob.Matrix = nil
+
+					config.Jobs = append(config.Jobs, otherJob, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.matrix expands to a job named 'some-other-job-linux', which collides with jobs.some-other-job-linux"))
+				})
+			})
+
+			Context("when an expanded job is invalid", func() {
+				BeforeEach(func() {
+					job.BuildLogsToRetain = -1
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error for each expanded job", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job-linux has negative build_logs_to_retain: -1"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job-windows has negative build_logs_to_retain: -1"))
+				})
+			})
+		})
+
+		Context("when a job has an unknown priority", func() {
+			BeforeEach(func() {
+				job.Priority = "urgent"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has unknown priority 'urgent' (must be one of: high, normal, low)"))
+			})
+		})
+
+		Context("when a job has a known priority", func() {
+			BeforeEach(func() {
+				job.Priority = atc.JobPriorityHigh
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
 		Context("when a job has a negative build_logs_to_retain", func() {
 			BeforeEach(func() {
 				job.BuildLogsToRetain = -1
@@ -1621,6 +1717,26 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a step has an invalid node selector", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name: "some-resource",
+							NodeSelector: atc.NodeSelector{
+								{Key: "arch", Operator: atc.NodeSelectorOpIn},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).node_selector[0]: node selector for 'arch' must specify values for operator 'In'"))
+				})
+			})
+
 			Context("when a retry plan has a negative attempts number", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -2055,6 +2171,34 @@ var _ = Describe("ValidateConfig", func() {
 				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative build_log_retention.days: -1"))
 			})
 		})
+
+		Context("when a job has a valid schedule", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &atc.JobSchedule{
+					Cron:     "0 9 * * 1-5",
+					Location: "Europe/London",
+					Jitter:   "5m",
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has an invalid schedule", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &atc.JobSchedule{
+					Cron:     "0 9 * *",
+					Location: "Nowhere/Special",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.schedule: invalid cron expression '0 9 * *'"))
+			})
+		})
 	})
 
 	Describe("validating display config", func() {
@@ -2111,6 +2255,121 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("validating notifications", func() {
+		BeforeEach(func() {
+			config.Notifications = &atc.NotificationsConfig{
+				Sinks: atc.NotificationSinkConfigs{
+					{
+						Name:   "some-webhook",
+						Type:   "webhook",
+						Source: atc.Source{"url": "https://example.com/hook"},
+					},
+					{
+						Name: "some-smtp",
+						Type: "smtp",
+						Source: atc.Source{
+							"host": "smtp.example.com",
+							"from": "ci@example.com",
+							"to":   []string{"team@example.com"},
+						},
+					},
+				},
+				Rules: atc.NotificationRuleConfigs{
+					{
+						Jobs:  []string{"some-*"},
+						From:  []atc.BuildStatus{atc.StatusSucceeded},
+						To:    []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+						Sinks: []string{"some-webhook", "some-smtp"},
+					},
+				},
+			}
+		})
+
+		Context("when the notifications are valid", func() {
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a sink has an unknown type", func() {
+			BeforeEach(func() {
+				config.Notifications.Sinks[0].Type = "pigeon"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid notifications:"))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.sinks.some-webhook has unknown type 'pigeon'"))
+			})
+		})
+
+		Context("when a sink is missing a required field", func() {
+			BeforeEach(func() {
+				delete(config.Notifications.Sinks[1].Source, "to")
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.sinks.some-smtp is missing 'to' in its source"))
+			})
+		})
+
+		Context("when two sinks have the same name", func() {
+			BeforeEach(func() {
+				config.Notifications.Sinks[1].Name = "some-webhook"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.sinks.some-webhook and notifications.sinks[0] have the same name ('some-webhook')"))
+			})
+		})
+
+		Context("when a rule refers to an unknown sink", func() {
+			BeforeEach(func() {
+				config.Notifications.Rules[0].Sinks = []string{"bogus-sink"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.rules[0] has unknown sink 'bogus-sink'"))
+			})
+		})
+
+		Context("when a rule has no sinks", func() {
+			BeforeEach(func() {
+				config.Notifications.Rules[0].Sinks = nil
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.rules[0] has no sinks"))
+			})
+		})
+
+		Context("when a rule matches no jobs", func() {
+			BeforeEach(func() {
+				config.Notifications.Rules[0].Jobs = []string{"nonexistent-*"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("no jobs match 'nonexistent-*' for notifications.rules[0]"))
+			})
+		})
+
+		Context("when a rule has an invalid status", func() {
+			BeforeEach(func() {
+				config.Notifications.Rules[0].To = []atc.BuildStatus{atc.StatusPending}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.rules[0] has invalid build status 'pending'"))
+			})
+		})
+	})
+
 	Describe("invalid pipeline", func() {
 		Context("contains zero jobs", func() {
 			BeforeEach(func() {
@@ -46,6 +46,12 @@ func (m *MemoryLimit) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (m *MemoryLimit) UnmarshalFlag(value string) error {
+	var err error
+	*m, err = ParseMemoryLimit(value)
+	return err
+}
+
 func ParseMemoryLimit(limit string) (MemoryLimit, error) {
 	limit = strings.ToUpper(limit)
 	matches := memoryRegex.FindStringSubmatch(limit)
@@ -66,7 +66,11 @@ func (cs *CachedSecrets) Get(secretPath string) (interface{}, *time.Time, bool,
 				duration = itemDuration
 			}
 		}
-		cs.cache.Set(secretPath, entry, duration)
+		// an already expired lease must not be cached at all, as the cache
+		// would otherwise treat the non-positive duration as "never expire"
+		if duration > 0 {
+			cs.cache.Set(secretPath, entry, duration)
+		}
 	} else {
 		cs.cache.Set(secretPath, entry, cs.cacheConfig.DurationNotFound)
 	}
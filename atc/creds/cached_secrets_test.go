@@ -162,4 +162,15 @@ var _ = Describe("Caching of secrets", func() {
 		Expect(underlyingMisses).To(BeIdenticalTo(4))
 	})
 
+	It("should not cache entries whose lease has already expired", func() {
+		expiration := time.Now().Add(-time.Second)
+		secretManager.GetStub = makeGetStub("foo", "value", &expiration, true, nil, &underlyingReads, &underlyingMisses)
+
+		_, _, _, _ = cachedSecretManager.Get("foo")
+		Expect(underlyingReads).To(BeIdenticalTo(1))
+
+		_, _, _, _ = cachedSecretManager.Get("foo")
+		Expect(underlyingReads).To(BeIdenticalTo(2))
+	})
+
 })
@@ -82,6 +82,12 @@ func (v Vault) findSecret(path string) (*vaultapi.Secret, *time.Time, bool, erro
 	}
 
 	if secret != nil {
+		// Secrets without a lease, e.g. those stored in a KV v2 engine, don't
+		// expire on their own.
+		if secret.LeaseDuration <= 0 {
+			return secret, nil, true, nil
+		}
+
 		// The lease duration is TTL: the time in seconds for which the lease is valid
 		// A consumer of this secret must renew the lease within that time.
 		duration := time.Duration(secret.LeaseDuration) * time.Second / 2
@@ -124,6 +124,48 @@ var _ = Describe("Vault", func() {
 				Expect(err).To(BeNil())
 			})
 
+			Context("when the secret has a lease", func() {
+				BeforeEach(func() {
+					v.SecretReader = &MockSecretReader{&[]MockSecret{
+						{
+							path: "/concourse/team/pipeline/foo",
+							secret: &vaultapi.Secret{
+								LeaseDuration: 60,
+								Data:          map[string]interface{}{"value": "bar"},
+							},
+						}},
+					}
+				})
+
+				It("should expire halfway through the lease", func() {
+					_, expiration, found, err := v.Get("/concourse/team/pipeline/foo")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(expiration).ToNot(BeNil())
+					Expect(*expiration).To(BeTemporally("~", time.Now().Add(30*time.Second), time.Second))
+				})
+			})
+
+			Context("when the secret has no lease", func() {
+				BeforeEach(func() {
+					v.SecretReader = &MockSecretReader{&[]MockSecret{
+						{
+							path: "/concourse/team/pipeline/foo",
+							secret: &vaultapi.Secret{
+								Data: map[string]interface{}{"value": "bar"},
+							},
+						}},
+					}
+				})
+
+				It("should not expire", func() {
+					_, expiration, found, err := v.Get("/concourse/team/pipeline/foo")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(expiration).To(BeNil())
+				})
+			})
+
 			It("should get secret from team", func() {
 				v.SecretReader = &MockSecretReader{&[]MockSecret{
 					{
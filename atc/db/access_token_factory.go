@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -39,6 +40,10 @@ func (a *accessTokenFactory) CreateAccessToken(token string, claims Claims) erro
 }
 
 func (a *accessTokenFactory) GetAccessToken(token string) (AccessToken, bool, error) {
+	if strings.HasPrefix(token, ServiceAccountTokenPrefix) {
+		return getServiceAccountToken(a.conn, token)
+	}
+
 	row := psql.Select("token", "claims").
 		From("access_tokens").
 		Where(sq.Eq{"token": token}).
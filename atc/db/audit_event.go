@@ -0,0 +1,236 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+// AuditEventFilter narrows down the events returned; empty fields match any
+// event.
+type AuditEventFilter struct {
+	Team   string
+	User   string
+	Action string
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+}
+
+//go:generate counterfeiter . AuditEventRepository
+
+type AuditEventRepository interface {
+	SaveAuditEvent(atc.AuditEvent) error
+
+	// AuditEvents returns the matching events, most recent first.
+	AuditEvents(AuditEventFilter) ([]atc.AuditEvent, error)
+
+	// AuditEventsAfter returns up to limit events with an ID greater than
+	// the given one, oldest first. Events are committed in the order of their
+	// IDs, so the last ID returned can be used as a cursor.
+	AuditEventsAfter(id int, limit int) ([]atc.AuditEvent, error)
+
+	// DeleteAuditEventsBefore removes every event recorded before the given
+	// time and returns how many were removed.
+	DeleteAuditEventsBefore(time.Time) (int64, error)
+
+	DrainCursors() (map[string]int, error)
+	SetDrainCursor(sink string, cursor int) error
+}
+
+type auditEventRepository struct {
+	conn Conn
+}
+
+func NewAuditEventRepository(conn Conn) AuditEventRepository {
+	return &auditEventRepository{conn: conn}
+}
+
+var auditEventColumns = []string{"id", "created_at", "user_name", "team", "action", "target", "params", "status"}
+
+func (repository *auditEventRepository) SaveAuditEvent(event atc.AuditEvent) error {
+	var params interface{}
+	if len(event.Params) > 0 {
+		payload, err := json.Marshal(event.Params)
+		if err != nil {
+			return err
+		}
+
+		params = payload
+	}
+
+	tx, err := repository.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	// events are saved one at a time, so that an event is never committed
+	// after one with a greater ID which a drain cursor may already be past
+	_, err = tx.Exec(`SELECT pg_advisory_xact_lock($1)`, lock.LockTypeAuditEventSaving)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("audit_events").
+		Columns("user_name", "team", "action", "target", "params", "status").
+		Values(
+			event.User,
+			sql.NullString{String: event.Team, Valid: event.Team != ""},
+			event.Action,
+			event.Target,
+			params,
+			event.Status,
+		).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (repository *auditEventRepository) AuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error) {
+	query := psql.Select(auditEventColumns...).
+		From("audit_events").
+		OrderBy("id DESC")
+
+	if filter.Team != "" {
+		query = query.Where(sq.Eq{"team": filter.Team})
+	}
+
+	if filter.User != "" {
+		query = query.Where(sq.Eq{"user_name": filter.User})
+	}
+
+	if filter.Action != "" {
+		query = query.Where(sq.Eq{"action": filter.Action})
+	}
+
+	if !filter.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"created_at": filter.Since})
+	}
+
+	if !filter.Until.IsZero() {
+		query = query.Where(sq.Lt{"created_at": filter.Until})
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+
+	return repository.queryEvents(query)
+}
+
+func (repository *auditEventRepository) AuditEventsAfter(id int, limit int) ([]atc.AuditEvent, error) {
+	return repository.queryEvents(
+		psql.Select(auditEventColumns...).
+			From("audit_events").
+			Where(sq.Gt{"id": id}).
+			OrderBy("id ASC").
+			Limit(uint64(limit)),
+	)
+}
+
+func (repository *auditEventRepository) DeleteAuditEventsBefore(before time.Time) (int64, error) {
+	result, err := psql.Delete("audit_events").
+		Where(sq.Lt{"created_at": before}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (repository *auditEventRepository) DrainCursors() (map[string]int, error) {
+	rows, err := psql.Select("sink", "cursor").
+		From("audit_drain_cursors").
+		RunWith(repository.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	cursors := map[string]int{}
+	for rows.Next() {
+		var sink string
+		var cursor int
+
+		err = rows.Scan(&sink, &cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		cursors[sink] = cursor
+	}
+
+	return cursors, rows.Err()
+}
+
+func (repository *auditEventRepository) SetDrainCursor(sink string, cursor int) error {
+	_, err := psql.Insert("audit_drain_cursors").
+		Columns("sink", "cursor").
+		Values(sink, cursor).
+		Suffix("ON CONFLICT (sink) DO UPDATE SET cursor = EXCLUDED.cursor").
+		RunWith(repository.conn).
+		Exec()
+
+	return err
+}
+
+func (repository *auditEventRepository) queryEvents(query sq.SelectBuilder) ([]atc.AuditEvent, error) {
+	rows, err := query.RunWith(repository.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	events := []atc.AuditEvent{}
+	for rows.Next() {
+		var (
+			event     atc.AuditEvent
+			team      sql.NullString
+			params    []byte
+			createdAt time.Time
+		)
+
+		err = rows.Scan(
+			&event.ID,
+			&createdAt,
+			&event.User,
+			&team,
+			&event.Action,
+			&event.Target,
+			&params,
+			&event.Status,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if params != nil {
+			err = json.Unmarshal(params, &event.Params)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		event.Team = team.String
+		event.Time = createdAt.Unix()
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,156 @@
+package db_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventRepository", func() {
+	var repository db.AuditEventRepository
+
+	BeforeEach(func() {
+		repository = db.NewAuditEventRepository(dbConn)
+
+		Expect(repository.SaveAuditEvent(atc.AuditEvent{
+			User:   "some-user",
+			Team:   "some-team",
+			Action: atc.SaveConfig,
+			Target: "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+			Params: map[string][]string{"check_creds": {"true"}},
+			Status: 200,
+		})).To(Succeed())
+
+		Expect(repository.SaveAuditEvent(atc.AuditEvent{
+			User:   "other-user",
+			Action: atc.ListWorkers,
+			Target: "/api/v1/workers",
+			Status: 403,
+		})).To(Succeed())
+	})
+
+	It("returns the most recent events first", func() {
+		events, err := repository.AuditEvents(db.AuditEventFilter{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(2))
+
+		Expect(events[0].User).To(Equal("other-user"))
+		Expect(events[0].Team).To(BeEmpty())
+		Expect(events[0].Params).To(BeEmpty())
+		Expect(events[0].Status).To(Equal(403))
+
+		Expect(events[1].Team).To(Equal("some-team"))
+		Expect(events[1].Action).To(Equal(atc.SaveConfig))
+		Expect(events[1].Target).To(Equal("/api/v1/teams/some-team/pipelines/some-pipeline/config"))
+		Expect(events[1].Params).To(Equal(map[string][]string{"check_creds": {"true"}}))
+		Expect(events[1].Time).ToNot(BeZero())
+	})
+
+	It("filters the events", func() {
+		events, err := repository.AuditEvents(db.AuditEventFilter{Team: "some-team"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].User).To(Equal("some-user"))
+
+		events, err = repository.AuditEvents(db.AuditEventFilter{User: "other-user", Action: atc.ListWorkers})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+
+		events, err = repository.AuditEvents(db.AuditEventFilter{Until: time.Now().Add(-time.Hour)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(BeEmpty())
+
+		events, err = repository.AuditEvents(db.AuditEventFilter{Limit: 1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+	})
+
+	It("returns events after an id in order", func() {
+		all, err := repository.AuditEvents(db.AuditEventFilter{})
+		Expect(err).ToNot(HaveOccurred())
+
+		events, err := repository.AuditEventsAfter(all[1].ID, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].ID).To(Equal(all[0].ID))
+	})
+
+	It("never commits an event behind one which is already visible", func() {
+		done := make(chan struct{})
+		wg := new(sync.WaitGroup)
+
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				for {
+					select {
+					case <-done:
+						return
+					default:
+					}
+
+					Expect(repository.SaveAuditEvent(atc.AuditEvent{
+						User:   "some-user",
+						Action: atc.ListWorkers,
+						Target: "/api/v1/workers",
+						Status: 200,
+					})).To(Succeed())
+				}
+			}()
+		}
+
+		cursor := 0
+		seen := map[int]bool{}
+		drain := func() {
+			events, err := repository.AuditEventsAfter(cursor, 1000)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, event := range events {
+				seen[event.ID] = true
+				cursor = event.ID
+			}
+		}
+
+		for len(seen) < 200 {
+			drain()
+		}
+
+		close(done)
+		wg.Wait()
+		drain()
+
+		events, err := repository.AuditEventsAfter(0, 10000)
+		Expect(err).ToNot(HaveOccurred())
+		for _, event := range events {
+			Expect(seen).To(HaveKey(event.ID))
+		}
+	})
+
+	It("deletes events before a time", func() {
+		deleted, err := repository.DeleteAuditEventsBefore(time.Now().Add(-time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeZero())
+
+		deleted, err = repository.DeleteAuditEventsBefore(time.Now().Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(Equal(int64(2)))
+	})
+
+	It("tracks drain cursors per sink", func() {
+		Expect(repository.SetDrainCursor("some-sink", 1)).To(Succeed())
+		Expect(repository.SetDrainCursor("some-sink", 2)).To(Succeed())
+		Expect(repository.SetDrainCursor("other-sink", 1)).To(Succeed())
+
+		cursors, err := repository.DrainCursors()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cursors).To(Equal(map[string]int{"some-sink": 2, "other-sink": 1}))
+	})
+})
@@ -36,6 +36,10 @@ type BuildInput struct {
 	FirstOccurrence bool
 	ResolveError    string
 
+	// Webhook is set when the version was found by a check which was
+	// triggered through the resource's webhook.
+	Webhook bool
+
 	Context SpanContext
 }
 
@@ -96,7 +100,11 @@ var buildsQuery = psql.Select(`
 		b.rerun_of,
 		rb.name,
 		b.rerun_number,
-		b.span_context
+		b.span_context,
+		b.log_archive,
+		b.queue_reason,
+		COALESCE(j.priority, 0),
+		b.cause
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -138,6 +146,7 @@ type Build interface {
 	PublicPlan() *json.RawMessage
 	HasPlan() bool
 	Status() BuildStatus
+	CreateTime() time.Time
 	StartTime() time.Time
 	IsNewerThanLastCheckOf(input Resource) bool
 	EndTime() time.Time
@@ -185,6 +194,10 @@ type Build interface {
 
 	Resources() ([]BuildInput, []BuildOutput, error)
 	SaveImageResourceVersion(UsedResourceCache) error
+	ImageResourceVersions() ([]BuildImageResourceVersion, error)
+
+	Provenance() (atc.ProvenanceEnvelope, bool, error)
+	SaveProvenance(atc.ProvenanceEnvelope) error
 
 	Delete() (bool, error)
 	MarkAsAborted() error
@@ -194,6 +207,18 @@ type Build interface {
 	IsDrained() bool
 	SetDrained(bool) error
 
+	DrainCursors() (map[string]uint, error)
+	SetDrainCursor(string, uint) error
+
+	LogArchive() string
+	SetLogArchive(string) error
+
+	Priority() atc.JobPriority
+	QueueReason() atc.QueueReason
+	SetQueueReason(atc.QueueReason) error
+
+	Cause() *atc.BuildCause
+
 	SpanContext() propagation.HTTPSupplier
 
 	SavePipeline(
@@ -230,6 +255,13 @@ type build struct {
 
 	createdBy *string
 
+	logArchive string
+
+	priority    atc.JobPriority
+	queueReason atc.QueueReason
+
+	cause *atc.BuildCause
+
 	rerunOf     int
 	rerunOfName string
 	rerunNumber int
@@ -380,6 +412,12 @@ func (b *build) RerunOf() int         { return b.rerunOf }
 func (b *build) RerunOfName() string  { return b.rerunOfName }
 func (b *build) RerunNumber() int     { return b.rerunNumber }
 func (b *build) CreatedBy() *string   { return b.createdBy }
+func (b *build) LogArchive() string   { return b.logArchive }
+
+func (b *build) CreateTime() time.Time        { return b.createTime }
+func (b *build) Priority() atc.JobPriority    { return b.priority }
+func (b *build) QueueReason() atc.QueueReason { return b.queueReason }
+func (b *build) Cause() *atc.BuildCause       { return b.cause }
 
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
@@ -516,6 +554,7 @@ func (b *build) start(tx Tx, plan atc.Plan) (bool, error) {
 		Set("private_plan", encryptedPlan).
 		Set("public_plan", plan.Public()).
 		Set("nonce", nonce).
+		Set("queue_reason", nil).
 		Where(sq.Eq{
 			"id":      b.id,
 			"status":  "pending",
@@ -552,6 +591,7 @@ func (b *build) Finish(status BuildStatus) error {
 	defer Rollback(tx)
 
 	var endTime time.Time
+	var notificationQueued bool
 
 	err = psql.Update("builds").
 		Set("status", status).
@@ -559,6 +599,7 @@ func (b *build) Finish(status BuildStatus) error {
 		Set("completed", true).
 		Set("private_plan", nil).
 		Set("nonce", nil).
+		Set("queue_reason", nil).
 		Where(sq.Eq{"id": b.id}).
 		Suffix("RETURNING end_time").
 		RunWith(tx).
@@ -723,6 +764,11 @@ WITH RECURSIVE pipelines_to_archive AS (
 			return err
 		}
 
+		notificationQueued, err = queueBuildNotification(tx, b.id, b.jobID)
+		if err != nil {
+			return err
+		}
+
 		err = updateTransitionBuildForJob(tx, b.jobID, b.id, status, b.rerunOf)
 		if err != nil {
 			return err
@@ -754,6 +800,13 @@ WITH RECURSIVE pipelines_to_archive AS (
 		return err
 	}
 
+	if notificationQueued {
+		err = b.conn.Bus().Notify(atc.ComponentNotifier)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -777,14 +830,114 @@ func (b *build) Variables(logger lager.Logger, globalSecrets creds.Secrets, varS
 }
 
 func (b *build) SetDrained(drained bool) error {
-	_, err := psql.Update("builds").
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("builds").
 		Set("drained", drained).
 		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	// the cursors are only needed while the build is still being drained
+	_, err = psql.Delete("build_drain_cursors").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	b.drained = drained
+
+	return nil
+}
+
+// DrainCursors returns the number of events that have already been drained
+// to each sink, keyed by sink name.
+func (b *build) DrainCursors() (map[string]uint, error) {
+	rows, err := psql.Select("sink", "cursor").
+		From("build_drain_cursors").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	cursors := map[string]uint{}
+	for rows.Next() {
+		var sink string
+		var cursor uint
+
+		err = rows.Scan(&sink, &cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		cursors[sink] = cursor
+	}
+
+	return cursors, rows.Err()
+}
+
+func (b *build) SetDrainCursor(sink string, cursor uint) error {
+	_, err := psql.Insert("build_drain_cursors").
+		Columns("build_id", "sink", "cursor").
+		Values(b.id, sink, cursor).
+		Suffix("ON CONFLICT (build_id, sink) DO UPDATE SET cursor = EXCLUDED.cursor").
+		RunWith(b.conn).
+		Exec()
+
+	return err
+}
+
+func (b *build) SetLogArchive(location string) error {
+	_, err := psql.Update("builds").
+		Set("log_archive", location).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+
+	if err == nil {
+		b.logArchive = location
+	}
+	return err
+}
+
+// SetQueueReason records why the build is not running yet. An empty reason
+// clears it.
+func (b *build) SetQueueReason(reason atc.QueueReason) error {
+	var value interface{}
+	if reason != "" {
+		value = string(reason)
+	}
+
+	// pending builds are given their queue reason on every scheduling tick, so
+	// leave the row alone unless the reason actually changes
+	_, err := psql.Update("builds").
+		Set("queue_reason", value).
+		Where(sq.Eq{"id": b.id}).
+		Where(sq.Expr("queue_reason IS DISTINCT FROM ?", value)).
 		RunWith(b.conn).
 		Exec()
 
 	if err == nil {
-		b.drained = drained
+		b.queueReason = reason
 	}
 	return err
 }
@@ -1210,7 +1363,7 @@ func (b *build) SaveOutput(
 		return err
 	}
 
-	newVersion, err := saveResourceVersion(tx, resourceConfigScope.ID(), version, metadata, nil)
+	newVersion, err := saveResourceVersion(tx, resourceConfigScope.ID(), version, metadata, nil, false)
 	if err != nil {
 		return err
 	}
@@ -1719,7 +1872,9 @@ func (b *build) SavePipeline(
 
 	jobID := newNullInt64(b.jobID)
 	buildID := newNullInt64(b.id)
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID)
+	change := ConfigChange{Author: b.configAuthor()}
+
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID, change)
 	if err != nil {
 		return nil, false, err
 	}
@@ -1744,6 +1899,16 @@ func (b *build) SavePipeline(
 	return pipeline, isNewPipeline, nil
 }
 
+// configAuthor identifies the build as the author of the configs set by its
+// set_pipeline steps.
+func (b *build) configAuthor() string {
+	if b.jobID != 0 {
+		return fmt.Sprintf("%s/%s #%s", b.pipelineName, b.jobName, b.name)
+	}
+
+	return fmt.Sprintf("build #%d", b.id)
+}
+
 func newNullInt64(i int) sql.NullInt64 {
 	return sql.NullInt64{
 		Valid: true,
@@ -1767,10 +1932,12 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		jobID, resourceID, resourceTypeID, pipelineID, rerunOf, rerunNumber                                 sql.NullInt64
 		schema, privatePlan, jobName, resourceName, resourceTypeName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                                                            pq.NullTime
-		nonce, spanContext, createdBy                                                                       sql.NullString
+		nonce, spanContext, createdBy, logArchive, queueReason                                              sql.NullString
+		priority                                                                                            int
 		drained, aborted, completed                                                                         bool
 		status                                                                                              string
 		pipelineInstanceVars                                                                                sql.NullString
+		cause                                                                                               sql.NullString
 	)
 
 	err := row.Scan(
@@ -1807,6 +1974,10 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunOfName,
 		&rerunNumber,
 		&spanContext,
+		&logArchive,
+		&queueReason,
+		&priority,
+		&cause,
 	)
 	if err != nil {
 		return err
@@ -1832,6 +2003,17 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.logArchive = logArchive.String
+	b.queueReason = atc.QueueReason(queueReason.String)
+	b.priority = atc.JobPriorityForRank(priority)
+
+	b.cause = nil
+	if cause.Valid {
+		err = json.Unmarshal([]byte(cause.String), &b.cause)
+		if err != nil {
+			return err
+		}
+	}
 
 	var (
 		noncense      *string
@@ -2073,6 +2255,32 @@ func updateTransitionBuildForJob(tx Tx, jobID int, buildID int, buildStatus Buil
 	return nil
 }
 
+// queueBuildNotification queues the finished build to be matched against its
+// pipeline's notification rules, along with the status of the job's previous
+// build. Nothing is queued for pipelines without notifications configured.
+func queueBuildNotification(tx Tx, buildID int, jobID int) (bool, error) {
+	result, err := tx.Exec(`
+		INSERT INTO build_notifications (build_id, previous_status)
+		SELECT $1, lb.status
+		FROM jobs j
+		JOIN pipelines p ON p.id = j.pipeline_id
+		LEFT JOIN builds lb ON lb.id = j.latest_completed_build_id
+		WHERE j.id = $2
+		AND p.notifications IS NOT NULL
+		ON CONFLICT (build_id) DO NOTHING
+	`, buildID, jobID)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
 func isNotRerunBuild(rerunID int) bool {
 	return rerunID == 0
 }
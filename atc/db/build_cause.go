@@ -0,0 +1,71 @@
+package db
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+func buildCauseValue(cause atc.BuildCause) (string, error) {
+	payload, err := json.Marshal(cause)
+	if err != nil {
+		return "", err
+	}
+
+	return string(payload), nil
+}
+
+// resolveBuildCauseBuilds fills in the names of the upstream builds which the
+// cause's inputs passed through, of which only the IDs are known when
+// determining the inputs.
+func resolveBuildCauseBuilds(tx Tx, cause *atc.BuildCause) error {
+	var ids []int
+	for _, input := range cause.Inputs {
+		for _, build := range input.Passed {
+			ids = append(ids, build.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	rows, err := tx.Query(`
+		SELECT b.id, b.name, j.name
+		FROM builds b
+		JOIN jobs j ON j.id = b.job_id
+		WHERE b.id = ANY($1)
+	`, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	builds := map[int]atc.BuildCauseBuild{}
+	for rows.Next() {
+		var build atc.BuildCauseBuild
+		err = rows.Scan(&build.ID, &build.Name, &build.JobName)
+		if err != nil {
+			return err
+		}
+
+		builds[build.ID] = build
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	for i, input := range cause.Inputs {
+		for j, build := range input.Passed {
+			if resolved, found := builds[build.ID]; found {
+				cause.Inputs[i].Passed[j] = resolved
+			}
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
 )
 
@@ -18,6 +19,8 @@ type BuildFactory interface {
 	AllBuilds(Page) ([]Build, Pagination, error)
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
+	VisibleQueuedBuilds([]string) ([]Build, error)
+	AllQueuedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
@@ -133,6 +136,41 @@ func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	return getBuilds(query, f.conn, f.lockFactory)
 }
 
+// queuedBuildsQuery selects the builds that are waiting to run, either
+// because they have not been started yet or because they are waiting for a
+// worker or for their team's quota, in the order they should be run in. Check
+// builds are left out.
+var queuedBuildsQuery = buildsQuery.
+	Where(sq.Or{
+		sq.Eq{"b.status": BuildStatusPending},
+		sq.Eq{
+			"b.status": BuildStatusStarted,
+			"b.queue_reason": []string{
+				string(atc.QueueReasonNoWorker),
+				string(atc.QueueReasonQuota),
+			},
+		},
+	}).
+	Where(sq.Eq{
+		"b.resource_id":      nil,
+		"b.resource_type_id": nil,
+	}).
+	OrderBy("COALESCE(j.priority, 0) DESC", "b.id ASC")
+
+func (f *buildFactory) VisibleQueuedBuilds(teamNames []string) ([]Build, error) {
+	query := queuedBuildsQuery.
+		Where(sq.Or{
+			sq.Eq{"p.public": true},
+			sq.Eq{"t.name": teamNames},
+		})
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
+func (f *buildFactory) AllQueuedBuilds() ([]Build, error) {
+	return getBuilds(queuedBuildsQuery, f.conn, f.lockFactory)
+}
+
 func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFactory) ([]Build, error) {
 	rows, err := buildsQuery.RunWith(conn).Query()
 	if err != nil {
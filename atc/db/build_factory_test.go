@@ -350,6 +350,98 @@ var _ = Describe("BuildFactory", func() {
 		})
 	})
 
+	Describe("AllQueuedBuilds", func() {
+		var err error
+		var oneOffBuild db.Build
+		var normalBuild db.Build
+		var highBuild db.Build
+		var waitingBuild db.Build
+		var otherTeamBuild db.Build
+
+		BeforeEach(func() {
+			oneOffBuild, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			config := atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "normal-job"},
+					{Name: "high-job", Priority: atc.JobPriorityHigh},
+				},
+			}
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			Expect(err).NotTo(HaveOccurred())
+
+			normalJob, found, err := pipeline.Job("normal-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			highJob, found, err := pipeline.Job("high-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			normalBuild, err = normalJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = normalBuild.SetQueueReason(atc.QueueReasonMaxInFlight)
+			Expect(err).NotTo(HaveOccurred())
+
+			highBuild, err = highJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			waitingBuild, err = normalJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := waitingBuild.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			err = waitingBuild.SetQueueReason(atc.QueueReasonNoWorker)
+			Expect(err).NotTo(HaveOccurred())
+
+			runningBuild, err := highJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err = runningBuild.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).NotTo(HaveOccurred())
+
+			otherTeamBuild, err = otherTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the waiting builds of all teams, highest priority first", func() {
+			builds, err := buildFactory.AllQueuedBuilds()
+			Expect(err).NotTo(HaveOccurred())
+
+			buildIDs := []int{}
+			for _, build := range builds {
+				buildIDs = append(buildIDs, build.ID())
+			}
+			Expect(buildIDs).To(Equal([]int{
+				highBuild.ID(),
+				oneOffBuild.ID(),
+				normalBuild.ID(),
+				waitingBuild.ID(),
+				otherTeamBuild.ID(),
+			}))
+
+			Expect(builds[0].Priority()).To(Equal(atc.JobPriorityHigh))
+			Expect(builds[2].QueueReason()).To(Equal(atc.QueueReasonMaxInFlight))
+			Expect(builds[3].QueueReason()).To(Equal(atc.QueueReasonNoWorker))
+		})
+
+		It("only returns the builds of the given teams when asked for visible builds", func() {
+			builds, err := buildFactory.VisibleQueuedBuilds([]string{"some-other-team"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(builds).To(HaveLen(1))
+			Expect(builds[0].ID()).To(Equal(otherTeamBuild.ID()))
+		})
+	})
+
 	Describe("PublicBuilds", func() {
 		var publicBuild db.Build
 
@@ -0,0 +1,190 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// BuildLogSearch narrows down a search through a team's build logs.
+type BuildLogSearch struct {
+	Query string
+
+	Pipeline *atc.PipelineRef
+	Job      string
+	Since    time.Time
+	Until    time.Time
+
+	Context int
+	Limit   int
+}
+
+// SearchBuildLogs finds the lines of the team's build logs which contain the
+// query, most recent builds first. Candidate log events are found through
+// the full-text index on each build events partition, and then split into
+// lines and narrowed down to those which contain the query as-is, so that the
+// limit applies to the lines rather than to the events they came from.
+func (t *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	tables, err := t.buildEventsTables(search)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 {
+		return []atc.BuildLogMatch{}, nil
+	}
+
+	var args []interface{}
+
+	var unions []string
+	for _, table := range tables {
+		unions = append(unions, `
+			SELECT COALESCE(build_id, build_id_old) AS build_id, event_id, payload
+			FROM `+table+`
+			WHERE type = 'log'
+			AND to_tsvector('simple', payload::json->>'payload') @@ plainto_tsquery('simple', ?)`)
+		args = append(args, search.Query)
+	}
+
+	conditions := []string{"b.team_id = ?"}
+	args = append(args, t.id)
+
+	if search.Job != "" {
+		conditions = append(conditions, "j.name = ?")
+		args = append(args, search.Job)
+	}
+
+	if !search.Since.IsZero() {
+		conditions = append(conditions, "b.start_time >= ?")
+		args = append(args, search.Since)
+	}
+
+	if !search.Until.IsZero() {
+		conditions = append(conditions, "b.start_time <= ?")
+		args = append(args, search.Until)
+	}
+
+	conditions = append(conditions, "strpos(lower(l.line), lower(?)) > 0")
+	args = append(args, search.Query)
+
+	args = append(args, search.Limit)
+
+	query, err := sq.Dollar.ReplacePlaceholders(`
+		SELECT e.payload, l.n - 1, b.id, b.name, p.name, p.instance_vars, j.name
+		FROM (` + strings.Join(unions, " UNION ALL ") + `) e
+		CROSS JOIN LATERAL regexp_split_to_table(e.payload::json->>'payload', E'\n') WITH ORDINALITY AS l(line, n)
+		JOIN builds b ON b.id = e.build_id
+		LEFT JOIN pipelines p ON p.id = b.pipeline_id
+		LEFT JOIN jobs j ON j.id = b.job_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY b.id DESC, e.event_id ASC, l.n ASC
+		LIMIT ?
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := t.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	matches := []atc.BuildLogMatch{}
+	for rows.Next() {
+		var (
+			payload                             string
+			lineIndex                           int
+			buildID                             int
+			buildName                           string
+			pipelineName, instanceVars, jobName sql.NullString
+		)
+
+		err = rows.Scan(&payload, &lineIndex, &buildID, &buildName, &pipelineName, &instanceVars, &jobName)
+		if err != nil {
+			return nil, err
+		}
+
+		var log event.Log
+		err = json.Unmarshal([]byte(payload), &log)
+		if err != nil {
+			return nil, err
+		}
+
+		match := atc.BuildLogMatch{
+			BuildID:      buildID,
+			BuildName:    buildName,
+			PipelineName: pipelineName.String,
+			JobName:      jobName.String,
+			Origin:       string(log.Origin.ID),
+			Time:         log.Time,
+			LogLineMatch: atc.LogLineContext(log.Payload, lineIndex, search.Context),
+		}
+
+		if instanceVars.Valid {
+			err = json.Unmarshal([]byte(instanceVars.String), &match.PipelineInstanceVars)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, rows.Err()
+}
+
+// buildEventsTables returns the build events partitions which may contain
+// logs matching the search.
+func (t *team) buildEventsTables(search BuildLogSearch) ([]string, error) {
+	if search.Pipeline != nil {
+		pipeline, found, err := t.Pipeline(*search.Pipeline)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, nil
+		}
+
+		return []string{fmt.Sprintf("pipeline_build_events_%d", pipeline.ID())}, nil
+	}
+
+	var tables []string
+
+	// one-off builds never belong to a job
+	if search.Job == "" {
+		tables = append(tables, fmt.Sprintf("team_build_events_%d", t.id))
+	}
+
+	rows, err := psql.Select("id").
+		From("pipelines").
+		Where(sq.Eq{"team_id": t.id}).
+		OrderBy("id").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, fmt.Sprintf("pipeline_build_events_%d", id))
+	}
+
+	return tables, rows.Err()
+}
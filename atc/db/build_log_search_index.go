@@ -0,0 +1,78 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . BuildLogSearchIndexer
+
+// BuildLogSearchIndexer builds the full-text indexes that log search relies
+// on for the build events partitions which existed before it did.
+type BuildLogSearchIndexer interface {
+	// IndexNext builds the index of one partition which lacks a valid one,
+	// returning the partition's name, or an empty string once every
+	// partition is indexed. The index is built concurrently, so it may take
+	// a while but does not block writes to the partition.
+	IndexNext() (string, error)
+}
+
+type buildLogSearchIndexer struct {
+	conn Conn
+}
+
+func NewBuildLogSearchIndexer(conn Conn) BuildLogSearchIndexer {
+	return &buildLogSearchIndexer{
+		conn: conn,
+	}
+}
+
+func (indexer *buildLogSearchIndexer) IndexNext() (string, error) {
+	var table string
+	err := indexer.conn.QueryRow(`
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		JOIN pg_class p ON p.oid = i.inhparent
+		WHERE p.relname = 'build_events'
+		AND NOT EXISTS (
+			SELECT 1
+			FROM pg_index x
+			JOIN pg_class ic ON ic.oid = x.indexrelid
+			WHERE x.indrelid = c.oid
+			AND ic.relname = c.relname || '_log_search'
+			AND x.indisvalid
+		)
+		ORDER BY c.relname
+		LIMIT 1
+	`).Scan(&table)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	index := pq.QuoteIdentifier(table + "_log_search")
+
+	// a concurrent build which was interrupted leaves an invalid index behind,
+	// which has to be dropped before it can be built again
+	_, err = indexer.conn.Exec(`DROP INDEX CONCURRENTLY IF EXISTS ` + index)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = indexer.conn.Exec(`
+		CREATE INDEX CONCURRENTLY ` + index + `
+		ON ` + pq.QuoteIdentifier(table) + `
+		USING gin (to_tsvector('simple', payload::json->>'payload'))
+		WHERE type = 'log'
+	`)
+	if err != nil {
+		return "", err
+	}
+
+	return table, nil
+}
@@ -0,0 +1,53 @@
+package db_test
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildLogSearchIndexer", func() {
+	var indexer db.BuildLogSearchIndexer
+
+	BeforeEach(func() {
+		indexer = db.NewBuildLogSearchIndexer(dbConn)
+	})
+
+	indexExists := func(name string) bool {
+		var exists bool
+		err := dbConn.QueryRow(`SELECT EXISTS (SELECT 1 FROM pg_class WHERE relname = $1)`, name).Scan(&exists)
+		Expect(err).ToNot(HaveOccurred())
+		return exists
+	}
+
+	It("has nothing to index once partitions are created with their index", func() {
+		table, err := indexer.IndexNext()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(table).To(BeEmpty())
+	})
+
+	Context("when a partition is missing its index", func() {
+		var table string
+
+		BeforeEach(func() {
+			table = fmt.Sprintf("pipeline_build_events_%d", defaultPipeline.ID())
+
+			_, err := dbConn.Exec(`DROP INDEX ` + table + `_log_search`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("builds it, and then has nothing left to index", func() {
+			indexed, err := indexer.IndexNext()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(indexed).To(Equal(table))
+			Expect(indexExists(table + "_log_search")).To(BeTrue())
+
+			indexed, err = indexer.IndexNext()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(indexed).To(BeEmpty())
+		})
+	})
+})
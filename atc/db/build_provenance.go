@@ -0,0 +1,99 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// BuildImageResourceVersion is the version of an image fetched for one of
+// the build's steps, along with the type of the resource it was fetched
+// with.
+type BuildImageResourceVersion struct {
+	Type    string
+	Version atc.Version
+}
+
+// ImageResourceVersions returns the image versions recorded for the build
+// via SaveImageResourceVersion. Note that these are only kept around for the
+// latest successful build of a job.
+func (b *build) ImageResourceVersions() ([]BuildImageResourceVersion, error) {
+	rows, err := psql.Select("COALESCE(brt.name, '')", "rc.version").
+		From("build_image_resource_caches birc").
+		Join("resource_caches rc ON rc.id = birc.resource_cache_id").
+		Join("resource_configs rcfg ON rcfg.id = rc.resource_config_id").
+		LeftJoin("base_resource_types brt ON brt.id = rcfg.base_resource_type_id").
+		Where(sq.Eq{"birc.build_id": b.id}).
+		OrderBy("birc.resource_cache_id").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var versions []BuildImageResourceVersion
+	for rows.Next() {
+		var (
+			imageVersion BuildImageResourceVersion
+			versionBlob  string
+		)
+
+		err = rows.Scan(&imageVersion.Type, &versionBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(versionBlob), &imageVersion.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, imageVersion)
+	}
+
+	return versions, rows.Err()
+}
+
+func (b *build) Provenance() (atc.ProvenanceEnvelope, bool, error) {
+	var envelopeBlob []byte
+	err := psql.Select("envelope").
+		From("build_provenance").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&envelopeBlob)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.ProvenanceEnvelope{}, false, nil
+		}
+
+		return atc.ProvenanceEnvelope{}, false, err
+	}
+
+	var envelope atc.ProvenanceEnvelope
+	err = json.Unmarshal(envelopeBlob, &envelope)
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, false, err
+	}
+
+	return envelope, true, nil
+}
+
+func (b *build) SaveProvenance(envelope atc.ProvenanceEnvelope) error {
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("build_provenance").
+		Columns("build_id", "envelope").
+		Values(b.id, string(payload)).
+		Suffix("ON CONFLICT (build_id) DO UPDATE SET envelope = EXCLUDED.envelope, created_at = now()").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
@@ -323,6 +323,60 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("SetQueueReason", func() {
+		It("persists the reason until the build starts", func() {
+			err := build.SetQueueReason(atc.QueueReasonSerialGroup)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.QueueReason()).To(Equal(atc.QueueReasonSerialGroup))
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.QueueReason()).To(Equal(atc.QueueReasonSerialGroup))
+
+			started, err := build.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			found, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.QueueReason()).To(BeEmpty())
+		})
+
+		It("clears the reason when given an empty one", func() {
+			err := build.SetQueueReason(atc.QueueReasonInputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetQueueReason("")
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.QueueReason()).To(BeEmpty())
+		})
+
+		It("does not rewrite the row when the reason is unchanged", func() {
+			err := build.SetQueueReason(atc.QueueReasonInputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			rowVersion := func() string {
+				var xmin string
+				err := dbConn.QueryRow(`SELECT xmin::text FROM builds WHERE id = $1`, build.ID()).Scan(&xmin)
+				Expect(err).NotTo(HaveOccurred())
+				return xmin
+			}
+
+			before := rowVersion()
+
+			err = build.SetQueueReason(atc.QueueReasonInputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rowVersion()).To(Equal(before))
+		})
+	})
+
 	Describe("Drain", func() {
 		It("defaults drain to false in the beginning", func() {
 			Expect(build.IsDrained()).To(BeFalse())
@@ -342,6 +396,109 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("DrainCursors", func() {
+		It("has no cursors in the beginning", func() {
+			cursors, err := build.DrainCursors()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cursors).To(BeEmpty())
+		})
+
+		It("tracks a cursor per sink", func() {
+			err := build.SetDrainCursor("syslog", 3)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetDrainCursor("http", 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetDrainCursor("syslog", 5)
+			Expect(err).NotTo(HaveOccurred())
+
+			cursors, err := build.DrainCursors()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cursors).To(Equal(map[string]uint{"syslog": 5, "http": 1}))
+		})
+
+		It("clears the cursors once the build is drained", func() {
+			err := build.SetDrainCursor("syslog", 3)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetDrained(true)
+			Expect(err).NotTo(HaveOccurred())
+
+			cursors, err := build.DrainCursors()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cursors).To(BeEmpty())
+		})
+	})
+
+	Describe("LogArchive", func() {
+		It("defaults to no archive", func() {
+			Expect(build.LogArchive()).To(BeEmpty())
+		})
+
+		It("has the archive location set after a reload", func() {
+			err := build.SetLogArchive("builds/1/events.json.gz")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.LogArchive()).To(Equal("builds/1/events.json.gz"))
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.LogArchive()).To(Equal("builds/1/events.json.gz"))
+		})
+	})
+
+	Describe("ImageResourceVersions", func() {
+		It("returns the image versions saved for the build", func() {
+			resourceCache, err := resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForBuild(build.ID()),
+				"some-base-resource-type",
+				atc.Version{"digest": "sha256:some-digest"},
+				atc.Source{"repository": "some-image"},
+				atc.Params{},
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveImageResourceVersion(resourceCache)
+			Expect(err).NotTo(HaveOccurred())
+
+			versions, err := build.ImageResourceVersions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(Equal([]db.BuildImageResourceVersion{
+				{Type: "some-base-resource-type", Version: atc.Version{"digest": "sha256:some-digest"}},
+			}))
+		})
+	})
+
+	Describe("Provenance", func() {
+		It("has no provenance in the beginning", func() {
+			_, found, err := build.Provenance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns the latest saved provenance", func() {
+			envelope := atc.ProvenanceEnvelope{
+				PayloadType: atc.ProvenancePayloadType,
+				Payload:     "c29tZS1wYXlsb2Fk",
+				Signatures: []atc.ProvenanceSignature{
+					{KeyID: "some-key", Sig: "c29tZS1zaWc="},
+				},
+			}
+
+			err := build.SaveProvenance(atc.ProvenanceEnvelope{PayloadType: atc.ProvenancePayloadType})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveProvenance(envelope)
+			Expect(err).NotTo(HaveOccurred())
+
+			saved, found, err := build.Provenance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(saved).To(Equal(envelope))
+		})
+	})
+
 	Describe("Start", func() {
 		var err error
 		var started bool
@@ -26,6 +26,7 @@ var _ = Describe("CheckFactory", func() {
 			fakeResourceTypes db.ResourceTypes
 			fromVersion       atc.Version
 			manuallyTriggered bool
+			webhook           bool
 
 			checkPlan atc.CheckPlan
 			fakeBuild *dbfakes.FakeBuild
@@ -65,10 +66,11 @@ var _ = Describe("CheckFactory", func() {
 
 			fakeResourceTypes = db.ResourceTypes{fakeResourceType}
 			manuallyTriggered = false
+			webhook = false
 		})
 
 		JustBeforeEach(func() {
-			build, created, err = checkFactory.TryCreateCheck(context.TODO(), fakeResource, fakeResourceTypes, fromVersion, manuallyTriggered)
+			build, created, err = checkFactory.TryCreateCheck(context.TODO(), fakeResource, fakeResourceTypes, fromVersion, manuallyTriggered, webhook)
 		})
 
 		Context("when the resource parent type is not a custom type", func() {
@@ -90,6 +92,17 @@ var _ = Describe("CheckFactory", func() {
 				Expect(plan.Check).To(Equal(&checkPlan))
 			})
 
+			Context("when the check is triggered through the webhook", func() {
+				BeforeEach(func() {
+					webhook = true
+				})
+
+				It("marks the check plan", func() {
+					_, _, plan := fakeResource.CreateBuildArgsForCall(0)
+					Expect(plan.Check.Webhook).To(BeTrue())
+				})
+			})
+
 			Context("when the interval has not elapsed", func() {
 				BeforeEach(func() {
 					fakeResource.LastCheckEndTimeReturns(time.Now().Add(defaultCheckInterval))
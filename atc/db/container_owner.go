@@ -16,6 +16,11 @@ import (
 type ContainerOwner interface {
 	Find(conn Conn) (sq.Eq, bool, error)
 	Create(tx Tx, workerName string) (map[string]interface{}, error)
+
+	// ReservationKey identifies the owner among the resources reserved on a
+	// worker, so that whichever ATC is running the owner's step can release
+	// them.
+	ReservationKey() string
 }
 
 // NewImageCheckContainerOwner references a container whose image resource this
@@ -44,6 +49,10 @@ func (c imageCheckContainerOwner) Create(Tx, string) (map[string]interface{}, er
 	return c.sqlMap(), nil
 }
 
+func (c imageCheckContainerOwner) ReservationKey() string {
+	return fmt.Sprintf("image-check:%d", c.Container.ID())
+}
+
 func (c imageCheckContainerOwner) sqlMap() map[string]interface{} {
 	return map[string]interface{}{
 		"image_check_container_id": c.Container.ID(),
@@ -77,6 +86,10 @@ func (c imageGetContainerOwner) Create(Tx, string) (map[string]interface{}, erro
 	return c.sqlMap(), nil
 }
 
+func (c imageGetContainerOwner) ReservationKey() string {
+	return fmt.Sprintf("image-get:%d", c.Container.ID())
+}
+
 func (c imageGetContainerOwner) sqlMap() map[string]interface{} {
 	return map[string]interface{}{
 		"image_get_container_id": c.Container.ID(),
@@ -112,6 +125,10 @@ func (c buildStepContainerOwner) Create(Tx, string) (map[string]interface{}, err
 	return c.sqlMap(), nil
 }
 
+func (c buildStepContainerOwner) ReservationKey() string {
+	return fmt.Sprintf("build-step:%d:%s", c.BuildID, c.PlanID)
+}
+
 func (c buildStepContainerOwner) sqlMap() map[string]interface{} {
 	return map[string]interface{}{
 		"build_id": c.BuildID,
@@ -180,6 +197,10 @@ func (c resourceConfigCheckSessionContainerOwner) Find(conn Conn) (sq.Eq, bool,
 	}, true, nil
 }
 
+func (c resourceConfigCheckSessionContainerOwner) ReservationKey() string {
+	return fmt.Sprintf("check-session:%d:%d", c.resourceConfigID, c.baseResourceTypeID)
+}
+
 func (c resourceConfigCheckSessionContainerOwner) Create(tx Tx, workerName string) (map[string]interface{}, error) {
 	var wbrtID int
 	err := psql.Select("id").
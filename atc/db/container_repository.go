@@ -19,6 +19,7 @@ type ContainerRepository interface {
 	UpdateContainersMissingSince(workerName string, handles []string) error
 	RemoveMissingContainers(time.Duration) (int, error)
 	DestroyUnknownContainers(workerName string, reportedHandles []string) (int, error)
+	RemoveUnclaimedReservations(time.Duration) (int, error)
 }
 
 type containerRepository struct {
@@ -192,6 +193,28 @@ func (repository *containerRepository) RemoveMissingContainers(gracePeriod time.
 	return int(affected), nil
 }
 
+// RemoveUnclaimedReservations removes the worker reservations which no
+// container was created for within the grace period, e.g. because the ATC
+// which picked the worker went away before creating it. Reservations which
+// were claimed by a container go away along with the container.
+func (repository *containerRepository) RemoveUnclaimedReservations(gracePeriod time.Duration) (int, error) {
+	result, err := psql.Delete("worker_reservations").
+		Where(sq.Eq{"container_id": nil}).
+		Where(sq.Expr(fmt.Sprintf("NOW() - reserved_at > '%s'", fmt.Sprintf("%.0f seconds", gracePeriod.Seconds())))).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
 func (repository *containerRepository) RemoveDestroyingContainers(workerName string, handlesToIgnore []string) (int, error) {
 	rows, err := psql.Delete("containers").
 		Where(
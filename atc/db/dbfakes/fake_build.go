@@ -99,6 +99,26 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	CauseStub        func() *atc.BuildCause
+	causeMutex       sync.RWMutex
+	causeArgsForCall []struct {
+	}
+	causeReturns struct {
+		result1 *atc.BuildCause
+	}
+	causeReturnsOnCall map[int]struct {
+		result1 *atc.BuildCause
+	}
+	CreateTimeStub        func() time.Time
+	createTimeMutex       sync.RWMutex
+	createTimeArgsForCall []struct {
+	}
+	createTimeReturns struct {
+		result1 time.Time
+	}
+	createTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	CreatedByStub        func() *string
 	createdByMutex       sync.RWMutex
 	createdByArgsForCall []struct {
@@ -121,6 +141,18 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	DrainCursorsStub        func() (map[string]uint, error)
+	drainCursorsMutex       sync.RWMutex
+	drainCursorsArgsForCall []struct {
+	}
+	drainCursorsReturns struct {
+		result1 map[string]uint
+		result2 error
+	}
+	drainCursorsReturnsOnCall map[int]struct {
+		result1 map[string]uint
+		result2 error
+	}
 	EndTimeStub        func() time.Time
 	endTimeMutex       sync.RWMutex
 	endTimeArgsForCall []struct {
@@ -175,6 +207,18 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ImageResourceVersionsStub        func() ([]db.BuildImageResourceVersion, error)
+	imageResourceVersionsMutex       sync.RWMutex
+	imageResourceVersionsArgsForCall []struct {
+	}
+	imageResourceVersionsReturns struct {
+		result1 []db.BuildImageResourceVersion
+		result2 error
+	}
+	imageResourceVersionsReturnsOnCall map[int]struct {
+		result1 []db.BuildImageResourceVersion
+		result2 error
+	}
 	InputsReadyStub        func() bool
 	inputsReadyMutex       sync.RWMutex
 	inputsReadyArgsForCall []struct {
@@ -298,6 +342,16 @@ type FakeBuild struct {
 	lagerDataReturnsOnCall map[int]struct {
 		result1 lager.Data
 	}
+	LogArchiveStub        func() string
+	logArchiveMutex       sync.RWMutex
+	logArchiveArgsForCall []struct {
+	}
+	logArchiveReturns struct {
+		result1 string
+	}
+	logArchiveReturnsOnCall map[int]struct {
+		result1 string
+	}
 	MarkAsAbortedStub        func() error
 	markAsAbortedMutex       sync.RWMutex
 	markAsAbortedArgsForCall []struct {
@@ -386,6 +440,16 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	PriorityStub        func() atc.JobPriority
+	priorityMutex       sync.RWMutex
+	priorityArgsForCall []struct {
+	}
+	priorityReturns struct {
+		result1 atc.JobPriority
+	}
+	priorityReturnsOnCall map[int]struct {
+		result1 atc.JobPriority
+	}
 	PrivatePlanStub        func() atc.Plan
 	privatePlanMutex       sync.RWMutex
 	privatePlanArgsForCall []struct {
@@ -396,6 +460,20 @@ type FakeBuild struct {
 	privatePlanReturnsOnCall map[int]struct {
 		result1 atc.Plan
 	}
+	ProvenanceStub        func() (atc.ProvenanceEnvelope, bool, error)
+	provenanceMutex       sync.RWMutex
+	provenanceArgsForCall []struct {
+	}
+	provenanceReturns struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
+	provenanceReturnsOnCall map[int]struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
 	PublicPlanStub        func() *json.RawMessage
 	publicPlanMutex       sync.RWMutex
 	publicPlanArgsForCall []struct {
@@ -406,6 +484,16 @@ type FakeBuild struct {
 	publicPlanReturnsOnCall map[int]struct {
 		result1 *json.RawMessage
 	}
+	QueueReasonStub        func() atc.QueueReason
+	queueReasonMutex       sync.RWMutex
+	queueReasonArgsForCall []struct {
+	}
+	queueReasonReturns struct {
+		result1 atc.QueueReason
+	}
+	queueReasonReturnsOnCall map[int]struct {
+		result1 atc.QueueReason
+	}
 	ReapTimeStub        func() time.Time
 	reapTimeMutex       sync.RWMutex
 	reapTimeArgsForCall []struct {
@@ -582,6 +670,17 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	SaveProvenanceStub        func(atc.ProvenanceEnvelope) error
+	saveProvenanceMutex       sync.RWMutex
+	saveProvenanceArgsForCall []struct {
+		arg1 atc.ProvenanceEnvelope
+	}
+	saveProvenanceReturns struct {
+		result1 error
+	}
+	saveProvenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -592,6 +691,18 @@ type FakeBuild struct {
 	schemaReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SetDrainCursorStub        func(string, uint) error
+	setDrainCursorMutex       sync.RWMutex
+	setDrainCursorArgsForCall []struct {
+		arg1 string
+		arg2 uint
+	}
+	setDrainCursorReturns struct {
+		result1 error
+	}
+	setDrainCursorReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetDrainedStub        func(bool) error
 	setDrainedMutex       sync.RWMutex
 	setDrainedArgsForCall []struct {
@@ -614,6 +725,28 @@ type FakeBuild struct {
 	setInterceptibleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetLogArchiveStub        func(string) error
+	setLogArchiveMutex       sync.RWMutex
+	setLogArchiveArgsForCall []struct {
+		arg1 string
+	}
+	setLogArchiveReturns struct {
+		result1 error
+	}
+	setLogArchiveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetQueueReasonStub        func(atc.QueueReason) error
+	setQueueReasonMutex       sync.RWMutex
+	setQueueReasonArgsForCall []struct {
+		arg1 atc.QueueReason
+	}
+	setQueueReasonReturns struct {
+		result1 error
+	}
+	setQueueReasonReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SpanContextStub        func() propagation.HTTPSupplier
 	spanContextMutex       sync.RWMutex
 	spanContextArgsForCall []struct {
@@ -1079,6 +1212,112 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) Cause() *atc.BuildCause {
+	fake.causeMutex.Lock()
+	ret, specificReturn := fake.causeReturnsOnCall[len(fake.causeArgsForCall)]
+	fake.causeArgsForCall = append(fake.causeArgsForCall, struct {
+	}{})
+	stub := fake.CauseStub
+	fakeReturns := fake.causeReturns
+	fake.recordInvocation("Cause", []interface{}{})
+	fake.causeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CauseCallCount() int {
+	fake.causeMutex.RLock()
+	defer fake.causeMutex.RUnlock()
+	return len(fake.causeArgsForCall)
+}
+
+func (fake *FakeBuild) CauseCalls(stub func() *atc.BuildCause) {
+	fake.causeMutex.Lock()
+	defer fake.causeMutex.Unlock()
+	fake.CauseStub = stub
+}
+
+func (fake *FakeBuild) CauseReturns(result1 *atc.BuildCause) {
+	fake.causeMutex.Lock()
+	defer fake.causeMutex.Unlock()
+	fake.CauseStub = nil
+	fake.causeReturns = struct {
+		result1 *atc.BuildCause
+	}{result1}
+}
+
+func (fake *FakeBuild) CauseReturnsOnCall(i int, result1 *atc.BuildCause) {
+	fake.causeMutex.Lock()
+	defer fake.causeMutex.Unlock()
+	fake.CauseStub = nil
+	if fake.causeReturnsOnCall == nil {
+		fake.causeReturnsOnCall = make(map[int]struct {
+			result1 *atc.BuildCause
+		})
+	}
+	fake.causeReturnsOnCall[i] = struct {
+		result1 *atc.BuildCause
+	}{result1}
+}
+
+func (fake *FakeBuild) CreateTime() time.Time {
+	fake.createTimeMutex.Lock()
+	ret, specificReturn := fake.createTimeReturnsOnCall[len(fake.createTimeArgsForCall)]
+	fake.createTimeArgsForCall = append(fake.createTimeArgsForCall, struct {
+	}{})
+	stub := fake.CreateTimeStub
+	fakeReturns := fake.createTimeReturns
+	fake.recordInvocation("CreateTime", []interface{}{})
+	fake.createTimeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CreateTimeCallCount() int {
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
+	return len(fake.createTimeArgsForCall)
+}
+
+func (fake *FakeBuild) CreateTimeCalls(stub func() time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = stub
+}
+
+func (fake *FakeBuild) CreateTimeReturns(result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	fake.createTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeBuild) CreateTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	if fake.createTimeReturnsOnCall == nil {
+		fake.createTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.createTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeBuild) CreatedBy() *string {
 	fake.createdByMutex.Lock()
 	ret, specificReturn := fake.createdByReturnsOnCall[len(fake.createdByArgsForCall)]
@@ -1188,6 +1427,62 @@ func (fake *FakeBuild) DeleteReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) DrainCursors() (map[string]uint, error) {
+	fake.drainCursorsMutex.Lock()
+	ret, specificReturn := fake.drainCursorsReturnsOnCall[len(fake.drainCursorsArgsForCall)]
+	fake.drainCursorsArgsForCall = append(fake.drainCursorsArgsForCall, struct {
+	}{})
+	stub := fake.DrainCursorsStub
+	fakeReturns := fake.drainCursorsReturns
+	fake.recordInvocation("DrainCursors", []interface{}{})
+	fake.drainCursorsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) DrainCursorsCallCount() int {
+	fake.drainCursorsMutex.RLock()
+	defer fake.drainCursorsMutex.RUnlock()
+	return len(fake.drainCursorsArgsForCall)
+}
+
+func (fake *FakeBuild) DrainCursorsCalls(stub func() (map[string]uint, error)) {
+	fake.drainCursorsMutex.Lock()
+	defer fake.drainCursorsMutex.Unlock()
+	fake.DrainCursorsStub = stub
+}
+
+func (fake *FakeBuild) DrainCursorsReturns(result1 map[string]uint, result2 error) {
+	fake.drainCursorsMutex.Lock()
+	defer fake.drainCursorsMutex.Unlock()
+	fake.DrainCursorsStub = nil
+	fake.drainCursorsReturns = struct {
+		result1 map[string]uint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) DrainCursorsReturnsOnCall(i int, result1 map[string]uint, result2 error) {
+	fake.drainCursorsMutex.Lock()
+	defer fake.drainCursorsMutex.Unlock()
+	fake.DrainCursorsStub = nil
+	if fake.drainCursorsReturnsOnCall == nil {
+		fake.drainCursorsReturnsOnCall = make(map[int]struct {
+			result1 map[string]uint
+			result2 error
+		})
+	}
+	fake.drainCursorsReturnsOnCall[i] = struct {
+		result1 map[string]uint
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) EndTime() time.Time {
 	fake.endTimeMutex.Lock()
 	ret, specificReturn := fake.endTimeReturnsOnCall[len(fake.endTimeArgsForCall)]
@@ -1472,6 +1767,62 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) ImageResourceVersions() ([]db.BuildImageResourceVersion, error) {
+	fake.imageResourceVersionsMutex.Lock()
+	ret, specificReturn := fake.imageResourceVersionsReturnsOnCall[len(fake.imageResourceVersionsArgsForCall)]
+	fake.imageResourceVersionsArgsForCall = append(fake.imageResourceVersionsArgsForCall, struct {
+	}{})
+	stub := fake.ImageResourceVersionsStub
+	fakeReturns := fake.imageResourceVersionsReturns
+	fake.recordInvocation("ImageResourceVersions", []interface{}{})
+	fake.imageResourceVersionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ImageResourceVersionsCallCount() int {
+	fake.imageResourceVersionsMutex.RLock()
+	defer fake.imageResourceVersionsMutex.RUnlock()
+	return len(fake.imageResourceVersionsArgsForCall)
+}
+
+func (fake *FakeBuild) ImageResourceVersionsCalls(stub func() ([]db.BuildImageResourceVersion, error)) {
+	fake.imageResourceVersionsMutex.Lock()
+	defer fake.imageResourceVersionsMutex.Unlock()
+	fake.ImageResourceVersionsStub = stub
+}
+
+func (fake *FakeBuild) ImageResourceVersionsReturns(result1 []db.BuildImageResourceVersion, result2 error) {
+	fake.imageResourceVersionsMutex.Lock()
+	defer fake.imageResourceVersionsMutex.Unlock()
+	fake.ImageResourceVersionsStub = nil
+	fake.imageResourceVersionsReturns = struct {
+		result1 []db.BuildImageResourceVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ImageResourceVersionsReturnsOnCall(i int, result1 []db.BuildImageResourceVersion, result2 error) {
+	fake.imageResourceVersionsMutex.Lock()
+	defer fake.imageResourceVersionsMutex.Unlock()
+	fake.ImageResourceVersionsStub = nil
+	if fake.imageResourceVersionsReturnsOnCall == nil {
+		fake.imageResourceVersionsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildImageResourceVersion
+			result2 error
+		})
+	}
+	fake.imageResourceVersionsReturnsOnCall[i] = struct {
+		result1 []db.BuildImageResourceVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) InputsReady() bool {
 	fake.inputsReadyMutex.Lock()
 	ret, specificReturn := fake.inputsReadyReturnsOnCall[len(fake.inputsReadyArgsForCall)]
@@ -2119,6 +2470,59 @@ func (fake *FakeBuild) LagerDataReturnsOnCall(i int, result1 lager.Data) {
 	}{result1}
 }
 
+func (fake *FakeBuild) LogArchive() string {
+	fake.logArchiveMutex.Lock()
+	ret, specificReturn := fake.logArchiveReturnsOnCall[len(fake.logArchiveArgsForCall)]
+	fake.logArchiveArgsForCall = append(fake.logArchiveArgsForCall, struct {
+	}{})
+	stub := fake.LogArchiveStub
+	fakeReturns := fake.logArchiveReturns
+	fake.recordInvocation("LogArchive", []interface{}{})
+	fake.logArchiveMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) LogArchiveCallCount() int {
+	fake.logArchiveMutex.RLock()
+	defer fake.logArchiveMutex.RUnlock()
+	return len(fake.logArchiveArgsForCall)
+}
+
+func (fake *FakeBuild) LogArchiveCalls(stub func() string) {
+	fake.logArchiveMutex.Lock()
+	defer fake.logArchiveMutex.Unlock()
+	fake.LogArchiveStub = stub
+}
+
+func (fake *FakeBuild) LogArchiveReturns(result1 string) {
+	fake.logArchiveMutex.Lock()
+	defer fake.logArchiveMutex.Unlock()
+	fake.LogArchiveStub = nil
+	fake.logArchiveReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) LogArchiveReturnsOnCall(i int, result1 string) {
+	fake.logArchiveMutex.Lock()
+	defer fake.logArchiveMutex.Unlock()
+	fake.LogArchiveStub = nil
+	if fake.logArchiveReturnsOnCall == nil {
+		fake.logArchiveReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.logArchiveReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) MarkAsAborted() error {
 	fake.markAsAbortedMutex.Lock()
 	ret, specificReturn := fake.markAsAbortedReturnsOnCall[len(fake.markAsAbortedArgsForCall)]
@@ -2555,6 +2959,59 @@ func (fake *FakeBuild) PreparationReturnsOnCall(i int, result1 db.BuildPreparati
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Priority() atc.JobPriority {
+	fake.priorityMutex.Lock()
+	ret, specificReturn := fake.priorityReturnsOnCall[len(fake.priorityArgsForCall)]
+	fake.priorityArgsForCall = append(fake.priorityArgsForCall, struct {
+	}{})
+	stub := fake.PriorityStub
+	fakeReturns := fake.priorityReturns
+	fake.recordInvocation("Priority", []interface{}{})
+	fake.priorityMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PriorityCallCount() int {
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
+	return len(fake.priorityArgsForCall)
+}
+
+func (fake *FakeBuild) PriorityCalls(stub func() atc.JobPriority) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = stub
+}
+
+func (fake *FakeBuild) PriorityReturns(result1 atc.JobPriority) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	fake.priorityReturns = struct {
+		result1 atc.JobPriority
+	}{result1}
+}
+
+func (fake *FakeBuild) PriorityReturnsOnCall(i int, result1 atc.JobPriority) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	if fake.priorityReturnsOnCall == nil {
+		fake.priorityReturnsOnCall = make(map[int]struct {
+			result1 atc.JobPriority
+		})
+	}
+	fake.priorityReturnsOnCall[i] = struct {
+		result1 atc.JobPriority
+	}{result1}
+}
+
 func (fake *FakeBuild) PrivatePlan() atc.Plan {
 	fake.privatePlanMutex.Lock()
 	ret, specificReturn := fake.privatePlanReturnsOnCall[len(fake.privatePlanArgsForCall)]
@@ -2608,6 +3065,65 @@ func (fake *FakeBuild) PrivatePlanReturnsOnCall(i int, result1 atc.Plan) {
 	}{result1}
 }
 
+func (fake *FakeBuild) Provenance() (atc.ProvenanceEnvelope, bool, error) {
+	fake.provenanceMutex.Lock()
+	ret, specificReturn := fake.provenanceReturnsOnCall[len(fake.provenanceArgsForCall)]
+	fake.provenanceArgsForCall = append(fake.provenanceArgsForCall, struct {
+	}{})
+	stub := fake.ProvenanceStub
+	fakeReturns := fake.provenanceReturns
+	fake.recordInvocation("Provenance", []interface{}{})
+	fake.provenanceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ProvenanceCallCount() int {
+	fake.provenanceMutex.RLock()
+	defer fake.provenanceMutex.RUnlock()
+	return len(fake.provenanceArgsForCall)
+}
+
+func (fake *FakeBuild) ProvenanceCalls(stub func() (atc.ProvenanceEnvelope, bool, error)) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = stub
+}
+
+func (fake *FakeBuild) ProvenanceReturns(result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = nil
+	fake.provenanceReturns = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ProvenanceReturnsOnCall(i int, result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = nil
+	if fake.provenanceReturnsOnCall == nil {
+		fake.provenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.ProvenanceEnvelope
+			result2 bool
+			result3 error
+		})
+	}
+	fake.provenanceReturnsOnCall[i] = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) PublicPlan() *json.RawMessage {
 	fake.publicPlanMutex.Lock()
 	ret, specificReturn := fake.publicPlanReturnsOnCall[len(fake.publicPlanArgsForCall)]
@@ -2661,6 +3177,59 @@ func (fake *FakeBuild) PublicPlanReturnsOnCall(i int, result1 *json.RawMessage)
 	}{result1}
 }
 
+func (fake *FakeBuild) QueueReason() atc.QueueReason {
+	fake.queueReasonMutex.Lock()
+	ret, specificReturn := fake.queueReasonReturnsOnCall[len(fake.queueReasonArgsForCall)]
+	fake.queueReasonArgsForCall = append(fake.queueReasonArgsForCall, struct {
+	}{})
+	stub := fake.QueueReasonStub
+	fakeReturns := fake.queueReasonReturns
+	fake.recordInvocation("QueueReason", []interface{}{})
+	fake.queueReasonMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) QueueReasonCallCount() int {
+	fake.queueReasonMutex.RLock()
+	defer fake.queueReasonMutex.RUnlock()
+	return len(fake.queueReasonArgsForCall)
+}
+
+func (fake *FakeBuild) QueueReasonCalls(stub func() atc.QueueReason) {
+	fake.queueReasonMutex.Lock()
+	defer fake.queueReasonMutex.Unlock()
+	fake.QueueReasonStub = stub
+}
+
+func (fake *FakeBuild) QueueReasonReturns(result1 atc.QueueReason) {
+	fake.queueReasonMutex.Lock()
+	defer fake.queueReasonMutex.Unlock()
+	fake.QueueReasonStub = nil
+	fake.queueReasonReturns = struct {
+		result1 atc.QueueReason
+	}{result1}
+}
+
+func (fake *FakeBuild) QueueReasonReturnsOnCall(i int, result1 atc.QueueReason) {
+	fake.queueReasonMutex.Lock()
+	defer fake.queueReasonMutex.Unlock()
+	fake.QueueReasonStub = nil
+	if fake.queueReasonReturnsOnCall == nil {
+		fake.queueReasonReturnsOnCall = make(map[int]struct {
+			result1 atc.QueueReason
+		})
+	}
+	fake.queueReasonReturnsOnCall[i] = struct {
+		result1 atc.QueueReason
+	}{result1}
+}
+
 func (fake *FakeBuild) ReapTime() time.Time {
 	fake.reapTimeMutex.Lock()
 	ret, specificReturn := fake.reapTimeReturnsOnCall[len(fake.reapTimeArgsForCall)]
@@ -3516,6 +4085,67 @@ func (fake *FakeBuild) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) SaveProvenance(arg1 atc.ProvenanceEnvelope) error {
+	fake.saveProvenanceMutex.Lock()
+	ret, specificReturn := fake.saveProvenanceReturnsOnCall[len(fake.saveProvenanceArgsForCall)]
+	fake.saveProvenanceArgsForCall = append(fake.saveProvenanceArgsForCall, struct {
+		arg1 atc.ProvenanceEnvelope
+	}{arg1})
+	stub := fake.SaveProvenanceStub
+	fakeReturns := fake.saveProvenanceReturns
+	fake.recordInvocation("SaveProvenance", []interface{}{arg1})
+	fake.saveProvenanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveProvenanceCallCount() int {
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
+	return len(fake.saveProvenanceArgsForCall)
+}
+
+func (fake *FakeBuild) SaveProvenanceCalls(stub func(atc.ProvenanceEnvelope) error) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = stub
+}
+
+func (fake *FakeBuild) SaveProvenanceArgsForCall(i int) atc.ProvenanceEnvelope {
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
+	argsForCall := fake.saveProvenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveProvenanceReturns(result1 error) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = nil
+	fake.saveProvenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveProvenanceReturnsOnCall(i int, result1 error) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = nil
+	if fake.saveProvenanceReturnsOnCall == nil {
+		fake.saveProvenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveProvenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -3569,6 +4199,68 @@ func (fake *FakeBuild) SchemaReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetDrainCursor(arg1 string, arg2 uint) error {
+	fake.setDrainCursorMutex.Lock()
+	ret, specificReturn := fake.setDrainCursorReturnsOnCall[len(fake.setDrainCursorArgsForCall)]
+	fake.setDrainCursorArgsForCall = append(fake.setDrainCursorArgsForCall, struct {
+		arg1 string
+		arg2 uint
+	}{arg1, arg2})
+	stub := fake.SetDrainCursorStub
+	fakeReturns := fake.setDrainCursorReturns
+	fake.recordInvocation("SetDrainCursor", []interface{}{arg1, arg2})
+	fake.setDrainCursorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetDrainCursorCallCount() int {
+	fake.setDrainCursorMutex.RLock()
+	defer fake.setDrainCursorMutex.RUnlock()
+	return len(fake.setDrainCursorArgsForCall)
+}
+
+func (fake *FakeBuild) SetDrainCursorCalls(stub func(string, uint) error) {
+	fake.setDrainCursorMutex.Lock()
+	defer fake.setDrainCursorMutex.Unlock()
+	fake.SetDrainCursorStub = stub
+}
+
+func (fake *FakeBuild) SetDrainCursorArgsForCall(i int) (string, uint) {
+	fake.setDrainCursorMutex.RLock()
+	defer fake.setDrainCursorMutex.RUnlock()
+	argsForCall := fake.setDrainCursorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SetDrainCursorReturns(result1 error) {
+	fake.setDrainCursorMutex.Lock()
+	defer fake.setDrainCursorMutex.Unlock()
+	fake.SetDrainCursorStub = nil
+	fake.setDrainCursorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetDrainCursorReturnsOnCall(i int, result1 error) {
+	fake.setDrainCursorMutex.Lock()
+	defer fake.setDrainCursorMutex.Unlock()
+	fake.SetDrainCursorStub = nil
+	if fake.setDrainCursorReturnsOnCall == nil {
+		fake.setDrainCursorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setDrainCursorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SetDrained(arg1 bool) error {
 	fake.setDrainedMutex.Lock()
 	ret, specificReturn := fake.setDrainedReturnsOnCall[len(fake.setDrainedArgsForCall)]
@@ -3691,6 +4383,128 @@ func (fake *FakeBuild) SetInterceptibleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetLogArchive(arg1 string) error {
+	fake.setLogArchiveMutex.Lock()
+	ret, specificReturn := fake.setLogArchiveReturnsOnCall[len(fake.setLogArchiveArgsForCall)]
+	fake.setLogArchiveArgsForCall = append(fake.setLogArchiveArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetLogArchiveStub
+	fakeReturns := fake.setLogArchiveReturns
+	fake.recordInvocation("SetLogArchive", []interface{}{arg1})
+	fake.setLogArchiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetLogArchiveCallCount() int {
+	fake.setLogArchiveMutex.RLock()
+	defer fake.setLogArchiveMutex.RUnlock()
+	return len(fake.setLogArchiveArgsForCall)
+}
+
+func (fake *FakeBuild) SetLogArchiveCalls(stub func(string) error) {
+	fake.setLogArchiveMutex.Lock()
+	defer fake.setLogArchiveMutex.Unlock()
+	fake.SetLogArchiveStub = stub
+}
+
+func (fake *FakeBuild) SetLogArchiveArgsForCall(i int) string {
+	fake.setLogArchiveMutex.RLock()
+	defer fake.setLogArchiveMutex.RUnlock()
+	argsForCall := fake.setLogArchiveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetLogArchiveReturns(result1 error) {
+	fake.setLogArchiveMutex.Lock()
+	defer fake.setLogArchiveMutex.Unlock()
+	fake.SetLogArchiveStub = nil
+	fake.setLogArchiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetLogArchiveReturnsOnCall(i int, result1 error) {
+	fake.setLogArchiveMutex.Lock()
+	defer fake.setLogArchiveMutex.Unlock()
+	fake.SetLogArchiveStub = nil
+	if fake.setLogArchiveReturnsOnCall == nil {
+		fake.setLogArchiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setLogArchiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetQueueReason(arg1 atc.QueueReason) error {
+	fake.setQueueReasonMutex.Lock()
+	ret, specificReturn := fake.setQueueReasonReturnsOnCall[len(fake.setQueueReasonArgsForCall)]
+	fake.setQueueReasonArgsForCall = append(fake.setQueueReasonArgsForCall, struct {
+		arg1 atc.QueueReason
+	}{arg1})
+	stub := fake.SetQueueReasonStub
+	fakeReturns := fake.setQueueReasonReturns
+	fake.recordInvocation("SetQueueReason", []interface{}{arg1})
+	fake.setQueueReasonMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetQueueReasonCallCount() int {
+	fake.setQueueReasonMutex.RLock()
+	defer fake.setQueueReasonMutex.RUnlock()
+	return len(fake.setQueueReasonArgsForCall)
+}
+
+func (fake *FakeBuild) SetQueueReasonCalls(stub func(atc.QueueReason) error) {
+	fake.setQueueReasonMutex.Lock()
+	defer fake.setQueueReasonMutex.Unlock()
+	fake.SetQueueReasonStub = stub
+}
+
+func (fake *FakeBuild) SetQueueReasonArgsForCall(i int) atc.QueueReason {
+	fake.setQueueReasonMutex.RLock()
+	defer fake.setQueueReasonMutex.RUnlock()
+	argsForCall := fake.setQueueReasonArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetQueueReasonReturns(result1 error) {
+	fake.setQueueReasonMutex.Lock()
+	defer fake.setQueueReasonMutex.Unlock()
+	fake.SetQueueReasonStub = nil
+	fake.setQueueReasonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetQueueReasonReturnsOnCall(i int, result1 error) {
+	fake.setQueueReasonMutex.Lock()
+	defer fake.setQueueReasonMutex.Unlock()
+	fake.SetQueueReasonStub = nil
+	if fake.setQueueReasonReturnsOnCall == nil {
+		fake.setQueueReasonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setQueueReasonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SpanContext() propagation.HTTPSupplier {
 	fake.spanContextMutex.Lock()
 	ret, specificReturn := fake.spanContextReturnsOnCall[len(fake.spanContextArgsForCall)]
@@ -4215,10 +5029,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.causeMutex.RLock()
+	defer fake.causeMutex.RUnlock()
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
 	fake.createdByMutex.RLock()
 	defer fake.createdByMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.drainCursorsMutex.RLock()
+	defer fake.drainCursorsMutex.RUnlock()
 	fake.endTimeMutex.RLock()
 	defer fake.endTimeMutex.RUnlock()
 	fake.eventsMutex.RLock()
@@ -4229,6 +5049,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.hasPlanMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.imageResourceVersionsMutex.RLock()
+	defer fake.imageResourceVersionsMutex.RUnlock()
 	fake.inputsReadyMutex.RLock()
 	defer fake.inputsReadyMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
@@ -4253,6 +5075,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobNameMutex.RUnlock()
 	fake.lagerDataMutex.RLock()
 	defer fake.lagerDataMutex.RUnlock()
+	fake.logArchiveMutex.RLock()
+	defer fake.logArchiveMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -4269,10 +5093,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.pipelineRefMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
 	fake.privatePlanMutex.RLock()
 	defer fake.privatePlanMutex.RUnlock()
+	fake.provenanceMutex.RLock()
+	defer fake.provenanceMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
 	defer fake.publicPlanMutex.RUnlock()
+	fake.queueReasonMutex.RLock()
+	defer fake.queueReasonMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
@@ -4303,12 +5133,20 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveOutputMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
+	fake.setDrainCursorMutex.RLock()
+	defer fake.setDrainCursorMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
 	defer fake.setInterceptibleMutex.RUnlock()
+	fake.setLogArchiveMutex.RLock()
+	defer fake.setLogArchiveMutex.RUnlock()
+	fake.setQueueReasonMutex.RLock()
+	defer fake.setQueueReasonMutex.RUnlock()
 	fake.spanContextMutex.RLock()
 	defer fake.spanContextMutex.RUnlock()
 	fake.startMutex.RLock()
@@ -23,6 +23,18 @@ type FakeBuildFactory struct {
 		result2 db.Pagination
 		result3 error
 	}
+	AllQueuedBuildsStub        func() ([]db.Build, error)
+	allQueuedBuildsMutex       sync.RWMutex
+	allQueuedBuildsArgsForCall []struct {
+	}
+	allQueuedBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	allQueuedBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	BuildStub        func(int) (db.Build, bool, error)
 	buildMutex       sync.RWMutex
 	buildArgsForCall []struct {
@@ -103,6 +115,19 @@ type FakeBuildFactory struct {
 		result2 db.Pagination
 		result3 error
 	}
+	VisibleQueuedBuildsStub        func([]string) ([]db.Build, error)
+	visibleQueuedBuildsMutex       sync.RWMutex
+	visibleQueuedBuildsArgsForCall []struct {
+		arg1 []string
+	}
+	visibleQueuedBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	visibleQueuedBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -174,6 +199,62 @@ func (fake *FakeBuildFactory) AllBuildsReturnsOnCall(i int, result1 []db.Build,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildFactory) AllQueuedBuilds() ([]db.Build, error) {
+	fake.allQueuedBuildsMutex.Lock()
+	ret, specificReturn := fake.allQueuedBuildsReturnsOnCall[len(fake.allQueuedBuildsArgsForCall)]
+	fake.allQueuedBuildsArgsForCall = append(fake.allQueuedBuildsArgsForCall, struct {
+	}{})
+	stub := fake.AllQueuedBuildsStub
+	fakeReturns := fake.allQueuedBuildsReturns
+	fake.recordInvocation("AllQueuedBuilds", []interface{}{})
+	fake.allQueuedBuildsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) AllQueuedBuildsCallCount() int {
+	fake.allQueuedBuildsMutex.RLock()
+	defer fake.allQueuedBuildsMutex.RUnlock()
+	return len(fake.allQueuedBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) AllQueuedBuildsCalls(stub func() ([]db.Build, error)) {
+	fake.allQueuedBuildsMutex.Lock()
+	defer fake.allQueuedBuildsMutex.Unlock()
+	fake.AllQueuedBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) AllQueuedBuildsReturns(result1 []db.Build, result2 error) {
+	fake.allQueuedBuildsMutex.Lock()
+	defer fake.allQueuedBuildsMutex.Unlock()
+	fake.AllQueuedBuildsStub = nil
+	fake.allQueuedBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) AllQueuedBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.allQueuedBuildsMutex.Lock()
+	defer fake.allQueuedBuildsMutex.Unlock()
+	fake.AllQueuedBuildsStub = nil
+	if fake.allQueuedBuildsReturnsOnCall == nil {
+		fake.allQueuedBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.allQueuedBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) Build(arg1 int) (db.Build, bool, error) {
 	fake.buildMutex.Lock()
 	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
@@ -546,11 +627,82 @@ func (fake *FakeBuildFactory) VisibleBuildsReturnsOnCall(i int, result1 []db.Bui
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildFactory) VisibleQueuedBuilds(arg1 []string) ([]db.Build, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.visibleQueuedBuildsMutex.Lock()
+	ret, specificReturn := fake.visibleQueuedBuildsReturnsOnCall[len(fake.visibleQueuedBuildsArgsForCall)]
+	fake.visibleQueuedBuildsArgsForCall = append(fake.visibleQueuedBuildsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.VisibleQueuedBuildsStub
+	fakeReturns := fake.visibleQueuedBuildsReturns
+	fake.recordInvocation("VisibleQueuedBuilds", []interface{}{arg1Copy})
+	fake.visibleQueuedBuildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) VisibleQueuedBuildsCallCount() int {
+	fake.visibleQueuedBuildsMutex.RLock()
+	defer fake.visibleQueuedBuildsMutex.RUnlock()
+	return len(fake.visibleQueuedBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) VisibleQueuedBuildsCalls(stub func([]string) ([]db.Build, error)) {
+	fake.visibleQueuedBuildsMutex.Lock()
+	defer fake.visibleQueuedBuildsMutex.Unlock()
+	fake.VisibleQueuedBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) VisibleQueuedBuildsArgsForCall(i int) []string {
+	fake.visibleQueuedBuildsMutex.RLock()
+	defer fake.visibleQueuedBuildsMutex.RUnlock()
+	argsForCall := fake.visibleQueuedBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) VisibleQueuedBuildsReturns(result1 []db.Build, result2 error) {
+	fake.visibleQueuedBuildsMutex.Lock()
+	defer fake.visibleQueuedBuildsMutex.Unlock()
+	fake.VisibleQueuedBuildsStub = nil
+	fake.visibleQueuedBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) VisibleQueuedBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.visibleQueuedBuildsMutex.Lock()
+	defer fake.visibleQueuedBuildsMutex.Unlock()
+	fake.VisibleQueuedBuildsStub = nil
+	if fake.visibleQueuedBuildsReturnsOnCall == nil {
+		fake.visibleQueuedBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.visibleQueuedBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.allBuildsMutex.RLock()
 	defer fake.allBuildsMutex.RUnlock()
+	fake.allQueuedBuildsMutex.RLock()
+	defer fake.allQueuedBuildsMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
@@ -563,6 +715,8 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.publicBuildsMutex.RUnlock()
 	fake.visibleBuildsMutex.RLock()
 	defer fake.visibleBuildsMutex.RUnlock()
+	fake.visibleQueuedBuildsMutex.RLock()
+	defer fake.visibleQueuedBuildsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
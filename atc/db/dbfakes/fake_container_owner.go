@@ -38,6 +38,16 @@ type FakeContainerOwner struct {
 		result2 bool
 		result3 error
 	}
+	ReservationKeyStub        func() string
+	reservationKeyMutex       sync.RWMutex
+	reservationKeyArgsForCall []struct {
+	}
+	reservationKeyReturns struct {
+		result1 string
+	}
+	reservationKeyReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -174,6 +184,59 @@ func (fake *FakeContainerOwner) FindReturnsOnCall(i int, result1 squirrel.Eq, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeContainerOwner) ReservationKey() string {
+	fake.reservationKeyMutex.Lock()
+	ret, specificReturn := fake.reservationKeyReturnsOnCall[len(fake.reservationKeyArgsForCall)]
+	fake.reservationKeyArgsForCall = append(fake.reservationKeyArgsForCall, struct {
+	}{})
+	stub := fake.ReservationKeyStub
+	fakeReturns := fake.reservationKeyReturns
+	fake.recordInvocation("ReservationKey", []interface{}{})
+	fake.reservationKeyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerOwner) ReservationKeyCallCount() int {
+	fake.reservationKeyMutex.RLock()
+	defer fake.reservationKeyMutex.RUnlock()
+	return len(fake.reservationKeyArgsForCall)
+}
+
+func (fake *FakeContainerOwner) ReservationKeyCalls(stub func() string) {
+	fake.reservationKeyMutex.Lock()
+	defer fake.reservationKeyMutex.Unlock()
+	fake.ReservationKeyStub = stub
+}
+
+func (fake *FakeContainerOwner) ReservationKeyReturns(result1 string) {
+	fake.reservationKeyMutex.Lock()
+	defer fake.reservationKeyMutex.Unlock()
+	fake.ReservationKeyStub = nil
+	fake.reservationKeyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeContainerOwner) ReservationKeyReturnsOnCall(i int, result1 string) {
+	fake.reservationKeyMutex.Lock()
+	defer fake.reservationKeyMutex.Unlock()
+	fake.ReservationKeyStub = nil
+	if fake.reservationKeyReturnsOnCall == nil {
+		fake.reservationKeyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.reservationKeyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeContainerOwner) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
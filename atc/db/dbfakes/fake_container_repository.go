@@ -91,6 +91,19 @@ type FakeContainerRepository struct {
 		result1 int
 		result2 error
 	}
+	RemoveUnclaimedReservationsStub        func(time.Duration) (int, error)
+	removeUnclaimedReservationsMutex       sync.RWMutex
+	removeUnclaimedReservationsArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeUnclaimedReservationsReturns struct {
+		result1 int
+		result2 error
+	}
+	removeUnclaimedReservationsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	UpdateContainersMissingSinceStub        func(string, []string) error
 	updateContainersMissingSinceMutex       sync.RWMutex
 	updateContainersMissingSinceArgsForCall []struct {
@@ -493,6 +506,70 @@ func (fake *FakeContainerRepository) RemoveMissingContainersReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeContainerRepository) RemoveUnclaimedReservations(arg1 time.Duration) (int, error) {
+	fake.removeUnclaimedReservationsMutex.Lock()
+	ret, specificReturn := fake.removeUnclaimedReservationsReturnsOnCall[len(fake.removeUnclaimedReservationsArgsForCall)]
+	fake.removeUnclaimedReservationsArgsForCall = append(fake.removeUnclaimedReservationsArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	stub := fake.RemoveUnclaimedReservationsStub
+	fakeReturns := fake.removeUnclaimedReservationsReturns
+	fake.recordInvocation("RemoveUnclaimedReservations", []interface{}{arg1})
+	fake.removeUnclaimedReservationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerRepository) RemoveUnclaimedReservationsCallCount() int {
+	fake.removeUnclaimedReservationsMutex.RLock()
+	defer fake.removeUnclaimedReservationsMutex.RUnlock()
+	return len(fake.removeUnclaimedReservationsArgsForCall)
+}
+
+func (fake *FakeContainerRepository) RemoveUnclaimedReservationsCalls(stub func(time.Duration) (int, error)) {
+	fake.removeUnclaimedReservationsMutex.Lock()
+	defer fake.removeUnclaimedReservationsMutex.Unlock()
+	fake.RemoveUnclaimedReservationsStub = stub
+}
+
+func (fake *FakeContainerRepository) RemoveUnclaimedReservationsArgsForCall(i int) time.Duration {
+	fake.removeUnclaimedReservationsMutex.RLock()
+	defer fake.removeUnclaimedReservationsMutex.RUnlock()
+	argsForCall := fake.removeUnclaimedReservationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeContainerRepository) RemoveUnclaimedReservationsReturns(result1 int, result2 error) {
+	fake.removeUnclaimedReservationsMutex.Lock()
+	defer fake.removeUnclaimedReservationsMutex.Unlock()
+	fake.RemoveUnclaimedReservationsStub = nil
+	fake.removeUnclaimedReservationsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRepository) RemoveUnclaimedReservationsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeUnclaimedReservationsMutex.Lock()
+	defer fake.removeUnclaimedReservationsMutex.Unlock()
+	fake.RemoveUnclaimedReservationsStub = nil
+	if fake.removeUnclaimedReservationsReturnsOnCall == nil {
+		fake.removeUnclaimedReservationsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeUnclaimedReservationsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeContainerRepository) UpdateContainersMissingSince(arg1 string, arg2 []string) error {
 	var arg2Copy []string
 	if arg2 != nil {
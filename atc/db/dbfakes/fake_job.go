@@ -124,6 +124,23 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateScheduledBuildStub        func(atc.BuildCause, time.Time, time.Time) (db.Build, bool, error)
+	createScheduledBuildMutex       sync.RWMutex
+	createScheduledBuildArgsForCall []struct {
+		arg1 atc.BuildCause
+		arg2 time.Time
+		arg3 time.Time
+	}
+	createScheduledBuildReturns struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
+	createScheduledBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
 	DisableManualTriggerStub        func() bool
 	disableManualTriggerMutex       sync.RWMutex
 	disableManualTriggerArgsForCall []struct {
@@ -134,10 +151,11 @@ type FakeJob struct {
 	disableManualTriggerReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	EnsurePendingBuildExistsStub        func(context.Context) error
+	EnsurePendingBuildExistsStub        func(context.Context, atc.BuildCause) error
 	ensurePendingBuildExistsMutex       sync.RWMutex
 	ensurePendingBuildExistsArgsForCall []struct {
 		arg1 context.Context
+		arg2 atc.BuildCause
 	}
 	ensurePendingBuildExistsReturns struct {
 		result1 error
@@ -169,6 +187,29 @@ type FakeJob struct {
 	firstLoggedBuildIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	FlakinessStub        func() *atc.JobFlakiness
+	flakinessMutex       sync.RWMutex
+	flakinessArgsForCall []struct {
+	}
+	flakinessReturns struct {
+		result1 *atc.JobFlakiness
+	}
+	flakinessReturnsOnCall map[int]struct {
+		result1 *atc.JobFlakiness
+	}
+	FlakinessBuildsStub        func(int) ([]db.FlakinessBuild, error)
+	flakinessBuildsMutex       sync.RWMutex
+	flakinessBuildsArgsForCall []struct {
+		arg1 int
+	}
+	flakinessBuildsReturns struct {
+		result1 []db.FlakinessBuild
+		result2 error
+	}
+	flakinessBuildsReturnsOnCall map[int]struct {
+		result1 []db.FlakinessBuild
+		result2 error
+	}
 	GetFullNextBuildInputsStub        func() ([]db.BuildInput, bool, error)
 	getFullNextBuildInputsMutex       sync.RWMutex
 	getFullNextBuildInputsArgsForCall []struct {
@@ -259,6 +300,26 @@ type FakeJob struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NextFireTimeStub        func() time.Time
+	nextFireTimeMutex       sync.RWMutex
+	nextFireTimeArgsForCall []struct {
+	}
+	nextFireTimeReturns struct {
+		result1 time.Time
+	}
+	nextFireTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	NextScheduledTimeStub        func() time.Time
+	nextScheduledTimeMutex       sync.RWMutex
+	nextScheduledTimeArgsForCall []struct {
+	}
+	nextScheduledTimeReturns struct {
+		result1 time.Time
+	}
+	nextScheduledTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	OutputsStub        func() ([]atc.JobOutput, error)
 	outputsMutex       sync.RWMutex
 	outputsArgsForCall []struct {
@@ -391,6 +452,17 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	SaveFlakinessStub        func(atc.JobFlakiness) error
+	saveFlakinessMutex       sync.RWMutex
+	saveFlakinessArgsForCall []struct {
+		arg1 atc.JobFlakiness
+	}
+	saveFlakinessReturns struct {
+		result1 error
+	}
+	saveFlakinessReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveNextInputMappingStub        func(db.InputMapping, bool) error
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -403,6 +475,18 @@ type FakeJob struct {
 	saveNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveNextScheduleStub        func(time.Time, time.Time) error
+	saveNextScheduleMutex       sync.RWMutex
+	saveNextScheduleArgsForCall []struct {
+		arg1 time.Time
+		arg2 time.Time
+	}
+	saveNextScheduleReturns struct {
+		result1 error
+	}
+	saveNextScheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ScheduleBuildStub        func(db.Build) (bool, error)
 	scheduleBuildMutex       sync.RWMutex
 	scheduleBuildArgsForCall []struct {
@@ -1012,6 +1096,75 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CreateScheduledBuild(arg1 atc.BuildCause, arg2 time.Time, arg3 time.Time) (db.Build, bool, error) {
+	fake.createScheduledBuildMutex.Lock()
+	ret, specificReturn := fake.createScheduledBuildReturnsOnCall[len(fake.createScheduledBuildArgsForCall)]
+	fake.createScheduledBuildArgsForCall = append(fake.createScheduledBuildArgsForCall, struct {
+		arg1 atc.BuildCause
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.CreateScheduledBuildStub
+	fakeReturns := fake.createScheduledBuildReturns
+	fake.recordInvocation("CreateScheduledBuild", []interface{}{arg1, arg2, arg3})
+	fake.createScheduledBuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJob) CreateScheduledBuildCallCount() int {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	return len(fake.createScheduledBuildArgsForCall)
+}
+
+func (fake *FakeJob) CreateScheduledBuildCalls(stub func(atc.BuildCause, time.Time, time.Time) (db.Build, bool, error)) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = stub
+}
+
+func (fake *FakeJob) CreateScheduledBuildArgsForCall(i int) (atc.BuildCause, time.Time, time.Time) {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	argsForCall := fake.createScheduledBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturns(result1 db.Build, result2 bool, result3 error) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = nil
+	fake.createScheduledBuildReturns = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturnsOnCall(i int, result1 db.Build, result2 bool, result3 error) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = nil
+	if fake.createScheduledBuildReturnsOnCall == nil {
+		fake.createScheduledBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createScheduledBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJob) DisableManualTrigger() bool {
 	fake.disableManualTriggerMutex.Lock()
 	ret, specificReturn := fake.disableManualTriggerReturnsOnCall[len(fake.disableManualTriggerArgsForCall)]
@@ -1065,18 +1218,19 @@ func (fake *FakeJob) DisableManualTriggerReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *FakeJob) EnsurePendingBuildExists(arg1 context.Context) error {
+func (fake *FakeJob) EnsurePendingBuildExists(arg1 context.Context, arg2 atc.BuildCause) error {
 	fake.ensurePendingBuildExistsMutex.Lock()
 	ret, specificReturn := fake.ensurePendingBuildExistsReturnsOnCall[len(fake.ensurePendingBuildExistsArgsForCall)]
 	fake.ensurePendingBuildExistsArgsForCall = append(fake.ensurePendingBuildExistsArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
+		arg2 atc.BuildCause
+	}{arg1, arg2})
 	stub := fake.EnsurePendingBuildExistsStub
 	fakeReturns := fake.ensurePendingBuildExistsReturns
-	fake.recordInvocation("EnsurePendingBuildExists", []interface{}{arg1})
+	fake.recordInvocation("EnsurePendingBuildExists", []interface{}{arg1, arg2})
 	fake.ensurePendingBuildExistsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1090,17 +1244,17 @@ func (fake *FakeJob) EnsurePendingBuildExistsCallCount() int {
 	return len(fake.ensurePendingBuildExistsArgsForCall)
 }
 
-func (fake *FakeJob) EnsurePendingBuildExistsCalls(stub func(context.Context) error) {
+func (fake *FakeJob) EnsurePendingBuildExistsCalls(stub func(context.Context, atc.BuildCause) error) {
 	fake.ensurePendingBuildExistsMutex.Lock()
 	defer fake.ensurePendingBuildExistsMutex.Unlock()
 	fake.EnsurePendingBuildExistsStub = stub
 }
 
-func (fake *FakeJob) EnsurePendingBuildExistsArgsForCall(i int) context.Context {
+func (fake *FakeJob) EnsurePendingBuildExistsArgsForCall(i int) (context.Context, atc.BuildCause) {
 	fake.ensurePendingBuildExistsMutex.RLock()
 	defer fake.ensurePendingBuildExistsMutex.RUnlock()
 	argsForCall := fake.ensurePendingBuildExistsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeJob) EnsurePendingBuildExistsReturns(result1 error) {
@@ -1238,6 +1392,123 @@ func (fake *FakeJob) FirstLoggedBuildIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) Flakiness() *atc.JobFlakiness {
+	fake.flakinessMutex.Lock()
+	ret, specificReturn := fake.flakinessReturnsOnCall[len(fake.flakinessArgsForCall)]
+	fake.flakinessArgsForCall = append(fake.flakinessArgsForCall, struct {
+	}{})
+	stub := fake.FlakinessStub
+	fakeReturns := fake.flakinessReturns
+	fake.recordInvocation("Flakiness", []interface{}{})
+	fake.flakinessMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) FlakinessCallCount() int {
+	fake.flakinessMutex.RLock()
+	defer fake.flakinessMutex.RUnlock()
+	return len(fake.flakinessArgsForCall)
+}
+
+func (fake *FakeJob) FlakinessCalls(stub func() *atc.JobFlakiness) {
+	fake.flakinessMutex.Lock()
+	defer fake.flakinessMutex.Unlock()
+	fake.FlakinessStub = stub
+}
+
+func (fake *FakeJob) FlakinessReturns(result1 *atc.JobFlakiness) {
+	fake.flakinessMutex.Lock()
+	defer fake.flakinessMutex.Unlock()
+	fake.FlakinessStub = nil
+	fake.flakinessReturns = struct {
+		result1 *atc.JobFlakiness
+	}{result1}
+}
+
+func (fake *FakeJob) FlakinessReturnsOnCall(i int, result1 *atc.JobFlakiness) {
+	fake.flakinessMutex.Lock()
+	defer fake.flakinessMutex.Unlock()
+	fake.FlakinessStub = nil
+	if fake.flakinessReturnsOnCall == nil {
+		fake.flakinessReturnsOnCall = make(map[int]struct {
+			result1 *atc.JobFlakiness
+		})
+	}
+	fake.flakinessReturnsOnCall[i] = struct {
+		result1 *atc.JobFlakiness
+	}{result1}
+}
+
+func (fake *FakeJob) FlakinessBuilds(arg1 int) ([]db.FlakinessBuild, error) {
+	fake.flakinessBuildsMutex.Lock()
+	ret, specificReturn := fake.flakinessBuildsReturnsOnCall[len(fake.flakinessBuildsArgsForCall)]
+	fake.flakinessBuildsArgsForCall = append(fake.flakinessBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.FlakinessBuildsStub
+	fakeReturns := fake.flakinessBuildsReturns
+	fake.recordInvocation("FlakinessBuilds", []interface{}{arg1})
+	fake.flakinessBuildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) FlakinessBuildsCallCount() int {
+	fake.flakinessBuildsMutex.RLock()
+	defer fake.flakinessBuildsMutex.RUnlock()
+	return len(fake.flakinessBuildsArgsForCall)
+}
+
+func (fake *FakeJob) FlakinessBuildsCalls(stub func(int) ([]db.FlakinessBuild, error)) {
+	fake.flakinessBuildsMutex.Lock()
+	defer fake.flakinessBuildsMutex.Unlock()
+	fake.FlakinessBuildsStub = stub
+}
+
+func (fake *FakeJob) FlakinessBuildsArgsForCall(i int) int {
+	fake.flakinessBuildsMutex.RLock()
+	defer fake.flakinessBuildsMutex.RUnlock()
+	argsForCall := fake.flakinessBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) FlakinessBuildsReturns(result1 []db.FlakinessBuild, result2 error) {
+	fake.flakinessBuildsMutex.Lock()
+	defer fake.flakinessBuildsMutex.Unlock()
+	fake.FlakinessBuildsStub = nil
+	fake.flakinessBuildsReturns = struct {
+		result1 []db.FlakinessBuild
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) FlakinessBuildsReturnsOnCall(i int, result1 []db.FlakinessBuild, result2 error) {
+	fake.flakinessBuildsMutex.Lock()
+	defer fake.flakinessBuildsMutex.Unlock()
+	fake.FlakinessBuildsStub = nil
+	if fake.flakinessBuildsReturnsOnCall == nil {
+		fake.flakinessBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.FlakinessBuild
+			result2 error
+		})
+	}
+	fake.flakinessBuildsReturnsOnCall[i] = struct {
+		result1 []db.FlakinessBuild
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) GetFullNextBuildInputs() ([]db.BuildInput, bool, error) {
 	fake.getFullNextBuildInputsMutex.Lock()
 	ret, specificReturn := fake.getFullNextBuildInputsReturnsOnCall[len(fake.getFullNextBuildInputsArgsForCall)]
@@ -1677,6 +1948,112 @@ func (fake *FakeJob) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) NextFireTime() time.Time {
+	fake.nextFireTimeMutex.Lock()
+	ret, specificReturn := fake.nextFireTimeReturnsOnCall[len(fake.nextFireTimeArgsForCall)]
+	fake.nextFireTimeArgsForCall = append(fake.nextFireTimeArgsForCall, struct {
+	}{})
+	stub := fake.NextFireTimeStub
+	fakeReturns := fake.nextFireTimeReturns
+	fake.recordInvocation("NextFireTime", []interface{}{})
+	fake.nextFireTimeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) NextFireTimeCallCount() int {
+	fake.nextFireTimeMutex.RLock()
+	defer fake.nextFireTimeMutex.RUnlock()
+	return len(fake.nextFireTimeArgsForCall)
+}
+
+func (fake *FakeJob) NextFireTimeCalls(stub func() time.Time) {
+	fake.nextFireTimeMutex.Lock()
+	defer fake.nextFireTimeMutex.Unlock()
+	fake.NextFireTimeStub = stub
+}
+
+func (fake *FakeJob) NextFireTimeReturns(result1 time.Time) {
+	fake.nextFireTimeMutex.Lock()
+	defer fake.nextFireTimeMutex.Unlock()
+	fake.NextFireTimeStub = nil
+	fake.nextFireTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) NextFireTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.nextFireTimeMutex.Lock()
+	defer fake.nextFireTimeMutex.Unlock()
+	fake.NextFireTimeStub = nil
+	if fake.nextFireTimeReturnsOnCall == nil {
+		fake.nextFireTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.nextFireTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) NextScheduledTime() time.Time {
+	fake.nextScheduledTimeMutex.Lock()
+	ret, specificReturn := fake.nextScheduledTimeReturnsOnCall[len(fake.nextScheduledTimeArgsForCall)]
+	fake.nextScheduledTimeArgsForCall = append(fake.nextScheduledTimeArgsForCall, struct {
+	}{})
+	stub := fake.NextScheduledTimeStub
+	fakeReturns := fake.nextScheduledTimeReturns
+	fake.recordInvocation("NextScheduledTime", []interface{}{})
+	fake.nextScheduledTimeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) NextScheduledTimeCallCount() int {
+	fake.nextScheduledTimeMutex.RLock()
+	defer fake.nextScheduledTimeMutex.RUnlock()
+	return len(fake.nextScheduledTimeArgsForCall)
+}
+
+func (fake *FakeJob) NextScheduledTimeCalls(stub func() time.Time) {
+	fake.nextScheduledTimeMutex.Lock()
+	defer fake.nextScheduledTimeMutex.Unlock()
+	fake.NextScheduledTimeStub = stub
+}
+
+func (fake *FakeJob) NextScheduledTimeReturns(result1 time.Time) {
+	fake.nextScheduledTimeMutex.Lock()
+	defer fake.nextScheduledTimeMutex.Unlock()
+	fake.NextScheduledTimeStub = nil
+	fake.nextScheduledTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) NextScheduledTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.nextScheduledTimeMutex.Lock()
+	defer fake.nextScheduledTimeMutex.Unlock()
+	fake.NextScheduledTimeStub = nil
+	if fake.nextScheduledTimeReturnsOnCall == nil {
+		fake.nextScheduledTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.nextScheduledTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) Outputs() ([]atc.JobOutput, error) {
 	fake.outputsMutex.Lock()
 	ret, specificReturn := fake.outputsReturnsOnCall[len(fake.outputsArgsForCall)]
@@ -2337,6 +2714,67 @@ func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeJob) SaveFlakiness(arg1 atc.JobFlakiness) error {
+	fake.saveFlakinessMutex.Lock()
+	ret, specificReturn := fake.saveFlakinessReturnsOnCall[len(fake.saveFlakinessArgsForCall)]
+	fake.saveFlakinessArgsForCall = append(fake.saveFlakinessArgsForCall, struct {
+		arg1 atc.JobFlakiness
+	}{arg1})
+	stub := fake.SaveFlakinessStub
+	fakeReturns := fake.saveFlakinessReturns
+	fake.recordInvocation("SaveFlakiness", []interface{}{arg1})
+	fake.saveFlakinessMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) SaveFlakinessCallCount() int {
+	fake.saveFlakinessMutex.RLock()
+	defer fake.saveFlakinessMutex.RUnlock()
+	return len(fake.saveFlakinessArgsForCall)
+}
+
+func (fake *FakeJob) SaveFlakinessCalls(stub func(atc.JobFlakiness) error) {
+	fake.saveFlakinessMutex.Lock()
+	defer fake.saveFlakinessMutex.Unlock()
+	fake.SaveFlakinessStub = stub
+}
+
+func (fake *FakeJob) SaveFlakinessArgsForCall(i int) atc.JobFlakiness {
+	fake.saveFlakinessMutex.RLock()
+	defer fake.saveFlakinessMutex.RUnlock()
+	argsForCall := fake.saveFlakinessArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) SaveFlakinessReturns(result1 error) {
+	fake.saveFlakinessMutex.Lock()
+	defer fake.saveFlakinessMutex.Unlock()
+	fake.SaveFlakinessStub = nil
+	fake.saveFlakinessReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) SaveFlakinessReturnsOnCall(i int, result1 error) {
+	fake.saveFlakinessMutex.Lock()
+	defer fake.saveFlakinessMutex.Unlock()
+	fake.SaveFlakinessStub = nil
+	if fake.saveFlakinessReturnsOnCall == nil {
+		fake.saveFlakinessReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveFlakinessReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) SaveNextInputMapping(arg1 db.InputMapping, arg2 bool) error {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -2399,6 +2837,68 @@ func (fake *FakeJob) SaveNextInputMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) SaveNextSchedule(arg1 time.Time, arg2 time.Time) error {
+	fake.saveNextScheduleMutex.Lock()
+	ret, specificReturn := fake.saveNextScheduleReturnsOnCall[len(fake.saveNextScheduleArgsForCall)]
+	fake.saveNextScheduleArgsForCall = append(fake.saveNextScheduleArgsForCall, struct {
+		arg1 time.Time
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.SaveNextScheduleStub
+	fakeReturns := fake.saveNextScheduleReturns
+	fake.recordInvocation("SaveNextSchedule", []interface{}{arg1, arg2})
+	fake.saveNextScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) SaveNextScheduleCallCount() int {
+	fake.saveNextScheduleMutex.RLock()
+	defer fake.saveNextScheduleMutex.RUnlock()
+	return len(fake.saveNextScheduleArgsForCall)
+}
+
+func (fake *FakeJob) SaveNextScheduleCalls(stub func(time.Time, time.Time) error) {
+	fake.saveNextScheduleMutex.Lock()
+	defer fake.saveNextScheduleMutex.Unlock()
+	fake.SaveNextScheduleStub = stub
+}
+
+func (fake *FakeJob) SaveNextScheduleArgsForCall(i int) (time.Time, time.Time) {
+	fake.saveNextScheduleMutex.RLock()
+	defer fake.saveNextScheduleMutex.RUnlock()
+	argsForCall := fake.saveNextScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) SaveNextScheduleReturns(result1 error) {
+	fake.saveNextScheduleMutex.Lock()
+	defer fake.saveNextScheduleMutex.Unlock()
+	fake.SaveNextScheduleStub = nil
+	fake.saveNextScheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) SaveNextScheduleReturnsOnCall(i int, result1 error) {
+	fake.saveNextScheduleMutex.Lock()
+	defer fake.saveNextScheduleMutex.Unlock()
+	fake.SaveNextScheduleStub = nil
+	if fake.saveNextScheduleReturnsOnCall == nil {
+		fake.saveNextScheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveNextScheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleBuild(arg1 db.Build) (bool, error) {
 	fake.scheduleBuildMutex.Lock()
 	ret, specificReturn := fake.scheduleBuildReturnsOnCall[len(fake.scheduleBuildArgsForCall)]
@@ -2930,6 +3430,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
 	fake.disableManualTriggerMutex.RLock()
 	defer fake.disableManualTriggerMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
@@ -2938,6 +3440,10 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.finishedAndNextBuildMutex.RUnlock()
 	fake.firstLoggedBuildIDMutex.RLock()
 	defer fake.firstLoggedBuildIDMutex.RUnlock()
+	fake.flakinessMutex.RLock()
+	defer fake.flakinessMutex.RUnlock()
+	fake.flakinessBuildsMutex.RLock()
+	defer fake.flakinessBuildsMutex.RUnlock()
 	fake.getFullNextBuildInputsMutex.RLock()
 	defer fake.getFullNextBuildInputsMutex.RUnlock()
 	fake.getNextBuildInputsMutex.RLock()
@@ -2954,6 +3460,10 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.maxInFlightMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.nextFireTimeMutex.RLock()
+	defer fake.nextFireTimeMutex.RUnlock()
+	fake.nextScheduledTimeMutex.RLock()
+	defer fake.nextScheduledTimeMutex.RUnlock()
 	fake.outputsMutex.RLock()
 	defer fake.outputsMutex.RUnlock()
 	fake.pauseMutex.RLock()
@@ -2978,8 +3488,12 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.requestScheduleMutex.RUnlock()
 	fake.rerunBuildMutex.RLock()
 	defer fake.rerunBuildMutex.RUnlock()
+	fake.saveFlakinessMutex.RLock()
+	defer fake.saveFlakinessMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
+	fake.saveNextScheduleMutex.RLock()
+	defer fake.saveNextScheduleMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
 	defer fake.scheduleBuildMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
@@ -21,6 +21,18 @@ type FakeJobFactory struct {
 		result1 []atc.JobSummary
 		result2 error
 	}
+	JobsToAnalyzeFlakinessStub        func() (db.Jobs, error)
+	jobsToAnalyzeFlakinessMutex       sync.RWMutex
+	jobsToAnalyzeFlakinessArgsForCall []struct {
+	}
+	jobsToAnalyzeFlakinessReturns struct {
+		result1 db.Jobs
+		result2 error
+	}
+	jobsToAnalyzeFlakinessReturnsOnCall map[int]struct {
+		result1 db.Jobs
+		result2 error
+	}
 	JobsToScheduleStub        func() (db.SchedulerJobs, error)
 	jobsToScheduleMutex       sync.RWMutex
 	jobsToScheduleArgsForCall []struct {
@@ -33,6 +45,18 @@ type FakeJobFactory struct {
 		result1 db.SchedulerJobs
 		result2 error
 	}
+	ScheduledJobsStub        func() (db.Jobs, error)
+	scheduledJobsMutex       sync.RWMutex
+	scheduledJobsArgsForCall []struct {
+	}
+	scheduledJobsReturns struct {
+		result1 db.Jobs
+		result2 error
+	}
+	scheduledJobsReturnsOnCall map[int]struct {
+		result1 db.Jobs
+		result2 error
+	}
 	VisibleJobsStub        func([]string) ([]atc.JobSummary, error)
 	visibleJobsMutex       sync.RWMutex
 	visibleJobsArgsForCall []struct {
@@ -106,6 +130,62 @@ func (fake *FakeJobFactory) AllActiveJobsReturnsOnCall(i int, result1 []atc.JobS
 	}{result1, result2}
 }
 
+func (fake *FakeJobFactory) JobsToAnalyzeFlakiness() (db.Jobs, error) {
+	fake.jobsToAnalyzeFlakinessMutex.Lock()
+	ret, specificReturn := fake.jobsToAnalyzeFlakinessReturnsOnCall[len(fake.jobsToAnalyzeFlakinessArgsForCall)]
+	fake.jobsToAnalyzeFlakinessArgsForCall = append(fake.jobsToAnalyzeFlakinessArgsForCall, struct {
+	}{})
+	stub := fake.JobsToAnalyzeFlakinessStub
+	fakeReturns := fake.jobsToAnalyzeFlakinessReturns
+	fake.recordInvocation("JobsToAnalyzeFlakiness", []interface{}{})
+	fake.jobsToAnalyzeFlakinessMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobFactory) JobsToAnalyzeFlakinessCallCount() int {
+	fake.jobsToAnalyzeFlakinessMutex.RLock()
+	defer fake.jobsToAnalyzeFlakinessMutex.RUnlock()
+	return len(fake.jobsToAnalyzeFlakinessArgsForCall)
+}
+
+func (fake *FakeJobFactory) JobsToAnalyzeFlakinessCalls(stub func() (db.Jobs, error)) {
+	fake.jobsToAnalyzeFlakinessMutex.Lock()
+	defer fake.jobsToAnalyzeFlakinessMutex.Unlock()
+	fake.JobsToAnalyzeFlakinessStub = stub
+}
+
+func (fake *FakeJobFactory) JobsToAnalyzeFlakinessReturns(result1 db.Jobs, result2 error) {
+	fake.jobsToAnalyzeFlakinessMutex.Lock()
+	defer fake.jobsToAnalyzeFlakinessMutex.Unlock()
+	fake.JobsToAnalyzeFlakinessStub = nil
+	fake.jobsToAnalyzeFlakinessReturns = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobFactory) JobsToAnalyzeFlakinessReturnsOnCall(i int, result1 db.Jobs, result2 error) {
+	fake.jobsToAnalyzeFlakinessMutex.Lock()
+	defer fake.jobsToAnalyzeFlakinessMutex.Unlock()
+	fake.JobsToAnalyzeFlakinessStub = nil
+	if fake.jobsToAnalyzeFlakinessReturnsOnCall == nil {
+		fake.jobsToAnalyzeFlakinessReturnsOnCall = make(map[int]struct {
+			result1 db.Jobs
+			result2 error
+		})
+	}
+	fake.jobsToAnalyzeFlakinessReturnsOnCall[i] = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJobFactory) JobsToSchedule() (db.SchedulerJobs, error) {
 	fake.jobsToScheduleMutex.Lock()
 	ret, specificReturn := fake.jobsToScheduleReturnsOnCall[len(fake.jobsToScheduleArgsForCall)]
@@ -162,6 +242,62 @@ func (fake *FakeJobFactory) JobsToScheduleReturnsOnCall(i int, result1 db.Schedu
 	}{result1, result2}
 }
 
+func (fake *FakeJobFactory) ScheduledJobs() (db.Jobs, error) {
+	fake.scheduledJobsMutex.Lock()
+	ret, specificReturn := fake.scheduledJobsReturnsOnCall[len(fake.scheduledJobsArgsForCall)]
+	fake.scheduledJobsArgsForCall = append(fake.scheduledJobsArgsForCall, struct {
+	}{})
+	stub := fake.ScheduledJobsStub
+	fakeReturns := fake.scheduledJobsReturns
+	fake.recordInvocation("ScheduledJobs", []interface{}{})
+	fake.scheduledJobsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobFactory) ScheduledJobsCallCount() int {
+	fake.scheduledJobsMutex.RLock()
+	defer fake.scheduledJobsMutex.RUnlock()
+	return len(fake.scheduledJobsArgsForCall)
+}
+
+func (fake *FakeJobFactory) ScheduledJobsCalls(stub func() (db.Jobs, error)) {
+	fake.scheduledJobsMutex.Lock()
+	defer fake.scheduledJobsMutex.Unlock()
+	fake.ScheduledJobsStub = stub
+}
+
+func (fake *FakeJobFactory) ScheduledJobsReturns(result1 db.Jobs, result2 error) {
+	fake.scheduledJobsMutex.Lock()
+	defer fake.scheduledJobsMutex.Unlock()
+	fake.ScheduledJobsStub = nil
+	fake.scheduledJobsReturns = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobFactory) ScheduledJobsReturnsOnCall(i int, result1 db.Jobs, result2 error) {
+	fake.scheduledJobsMutex.Lock()
+	defer fake.scheduledJobsMutex.Unlock()
+	fake.ScheduledJobsStub = nil
+	if fake.scheduledJobsReturnsOnCall == nil {
+		fake.scheduledJobsReturnsOnCall = make(map[int]struct {
+			result1 db.Jobs
+			result2 error
+		})
+	}
+	fake.scheduledJobsReturnsOnCall[i] = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJobFactory) VisibleJobs(arg1 []string) ([]atc.JobSummary, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -236,8 +372,12 @@ func (fake *FakeJobFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.allActiveJobsMutex.RLock()
 	defer fake.allActiveJobsMutex.RUnlock()
+	fake.jobsToAnalyzeFlakinessMutex.RLock()
+	defer fake.jobsToAnalyzeFlakinessMutex.RUnlock()
 	fake.jobsToScheduleMutex.RLock()
 	defer fake.jobsToScheduleMutex.RUnlock()
+	fake.scheduledJobsMutex.RLock()
+	defer fake.scheduledJobsMutex.RUnlock()
 	fake.visibleJobsMutex.RLock()
 	defer fake.visibleJobsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
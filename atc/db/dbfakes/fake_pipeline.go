@@ -100,6 +100,33 @@ type FakePipeline struct {
 		result1 atc.Config
 		result2 error
 	}
+	ConfigHistoryStub        func() ([]atc.PipelineConfigRevision, error)
+	configHistoryMutex       sync.RWMutex
+	configHistoryArgsForCall []struct {
+	}
+	configHistoryReturns struct {
+		result1 []atc.PipelineConfigRevision
+		result2 error
+	}
+	configHistoryReturnsOnCall map[int]struct {
+		result1 []atc.PipelineConfigRevision
+		result2 error
+	}
+	ConfigRevisionStub        func(int) (atc.PipelineConfigRevision, bool, error)
+	configRevisionMutex       sync.RWMutex
+	configRevisionArgsForCall []struct {
+		arg1 int
+	}
+	configRevisionReturns struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	configRevisionReturnsOnCall map[int]struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
 	ConfigVersionStub        func() db.ConfigVersion
 	configVersionMutex       sync.RWMutex
 	configVersionArgsForCall []struct {
@@ -315,6 +342,29 @@ type FakePipeline struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(int) ([]db.NotificationDelivery, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}
+	NotificationsStub        func() *atc.NotificationsConfig
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct {
+	}
+	notificationsReturns struct {
+		result1 *atc.NotificationsConfig
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 *atc.NotificationsConfig
+	}
 	ParentBuildIDStub        func() int
 	parentBuildIDMutex       sync.RWMutex
 	parentBuildIDArgsForCall []struct {
@@ -963,6 +1013,129 @@ func (fake *FakePipeline) ConfigReturnsOnCall(i int, result1 atc.Config, result2
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) ConfigHistory() ([]atc.PipelineConfigRevision, error) {
+	fake.configHistoryMutex.Lock()
+	ret, specificReturn := fake.configHistoryReturnsOnCall[len(fake.configHistoryArgsForCall)]
+	fake.configHistoryArgsForCall = append(fake.configHistoryArgsForCall, struct {
+	}{})
+	stub := fake.ConfigHistoryStub
+	fakeReturns := fake.configHistoryReturns
+	fake.recordInvocation("ConfigHistory", []interface{}{})
+	fake.configHistoryMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) ConfigHistoryCallCount() int {
+	fake.configHistoryMutex.RLock()
+	defer fake.configHistoryMutex.RUnlock()
+	return len(fake.configHistoryArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigHistoryCalls(stub func() ([]atc.PipelineConfigRevision, error)) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = stub
+}
+
+func (fake *FakePipeline) ConfigHistoryReturns(result1 []atc.PipelineConfigRevision, result2 error) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = nil
+	fake.configHistoryReturns = struct {
+		result1 []atc.PipelineConfigRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigHistoryReturnsOnCall(i int, result1 []atc.PipelineConfigRevision, result2 error) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = nil
+	if fake.configHistoryReturnsOnCall == nil {
+		fake.configHistoryReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineConfigRevision
+			result2 error
+		})
+	}
+	fake.configHistoryReturnsOnCall[i] = struct {
+		result1 []atc.PipelineConfigRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigRevision(arg1 int) (atc.PipelineConfigRevision, bool, error) {
+	fake.configRevisionMutex.Lock()
+	ret, specificReturn := fake.configRevisionReturnsOnCall[len(fake.configRevisionArgsForCall)]
+	fake.configRevisionArgsForCall = append(fake.configRevisionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.ConfigRevisionStub
+	fakeReturns := fake.configRevisionReturns
+	fake.recordInvocation("ConfigRevision", []interface{}{arg1})
+	fake.configRevisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) ConfigRevisionCallCount() int {
+	fake.configRevisionMutex.RLock()
+	defer fake.configRevisionMutex.RUnlock()
+	return len(fake.configRevisionArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigRevisionCalls(stub func(int) (atc.PipelineConfigRevision, bool, error)) {
+	fake.configRevisionMutex.Lock()
+	defer fake.configRevisionMutex.Unlock()
+	fake.ConfigRevisionStub = stub
+}
+
+func (fake *FakePipeline) ConfigRevisionArgsForCall(i int) int {
+	fake.configRevisionMutex.RLock()
+	defer fake.configRevisionMutex.RUnlock()
+	argsForCall := fake.configRevisionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) ConfigRevisionReturns(result1 atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.configRevisionMutex.Lock()
+	defer fake.configRevisionMutex.Unlock()
+	fake.ConfigRevisionStub = nil
+	fake.configRevisionReturns = struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigRevisionReturnsOnCall(i int, result1 atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.configRevisionMutex.Lock()
+	defer fake.configRevisionMutex.Unlock()
+	fake.ConfigRevisionStub = nil
+	if fake.configRevisionReturnsOnCall == nil {
+		fake.configRevisionReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineConfigRevision
+			result2 bool
+			result3 error
+		})
+	}
+	fake.configRevisionReturnsOnCall[i] = struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePipeline) ConfigVersion() db.ConfigVersion {
 	fake.configVersionMutex.Lock()
 	ret, specificReturn := fake.configVersionReturnsOnCall[len(fake.configVersionArgsForCall)]
@@ -2044,6 +2217,123 @@ func (fake *FakePipeline) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakePipeline) NotificationDeliveries(arg1 int) ([]db.NotificationDelivery, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.NotificationDeliveriesStub
+	fakeReturns := fake.notificationDeliveriesReturns
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1})
+	fake.notificationDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationDeliveriesCalls(stub func(int) ([]db.NotificationDelivery, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakePipeline) NotificationDeliveriesArgsForCall(i int) int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) NotificationDeliveriesReturns(result1 []db.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) NotificationDeliveriesReturnsOnCall(i int, result1 []db.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []db.NotificationDelivery
+			result2 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) Notifications() *atc.NotificationsConfig {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct {
+	}{})
+	stub := fake.NotificationsStub
+	fakeReturns := fake.notificationsReturns
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationsCalls(stub func() *atc.NotificationsConfig) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = stub
+}
+
+func (fake *FakePipeline) NotificationsReturns(result1 *atc.NotificationsConfig) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 *atc.NotificationsConfig
+	}{result1}
+}
+
+func (fake *FakePipeline) NotificationsReturnsOnCall(i int, result1 *atc.NotificationsConfig) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 *atc.NotificationsConfig
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 *atc.NotificationsConfig
+	}{result1}
+}
+
 func (fake *FakePipeline) ParentBuildID() int {
 	fake.parentBuildIDMutex.Lock()
 	ret, specificReturn := fake.parentBuildIDReturnsOnCall[len(fake.parentBuildIDArgsForCall)]
@@ -3169,6 +3459,10 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.checkPausedMutex.RUnlock()
 	fake.configMutex.RLock()
 	defer fake.configMutex.RUnlock()
+	fake.configHistoryMutex.RLock()
+	defer fake.configHistoryMutex.RUnlock()
+	fake.configRevisionMutex.RLock()
+	defer fake.configRevisionMutex.RUnlock()
 	fake.configVersionMutex.RLock()
 	defer fake.configVersionMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
@@ -3207,6 +3501,10 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.loadDebugVersionsDBMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
 	fake.parentBuildIDMutex.RLock()
 	defer fake.parentBuildIDMutex.RUnlock()
 	fake.parentJobIDMutex.RLock()
@@ -86,6 +86,33 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateServiceAccountStub        func(string) (bool, error)
+	createServiceAccountMutex       sync.RWMutex
+	createServiceAccountArgsForCall []struct {
+		arg1 string
+	}
+	createServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	createServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateServiceAccountTokenStub        func(string, atc.ServiceAccountToken) (atc.ServiceAccountToken, error)
+	createServiceAccountTokenMutex       sync.RWMutex
+	createServiceAccountTokenArgsForCall []struct {
+		arg1 string
+		arg2 atc.ServiceAccountToken
+	}
+	createServiceAccountTokenReturns struct {
+		result1 atc.ServiceAccountToken
+		result2 error
+	}
+	createServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 atc.ServiceAccountToken
+		result2 error
+	}
 	CreateStartedBuildStub        func(atc.Plan) (db.Build, error)
 	createStartedBuildMutex       sync.RWMutex
 	createStartedBuildArgsForCall []struct {
@@ -99,6 +126,16 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
+	CustomRolesStub        func() atc.CustomRoles
+	customRolesMutex       sync.RWMutex
+	customRolesArgsForCall []struct {
+	}
+	customRolesReturns struct {
+		result1 atc.CustomRoles
+	}
+	customRolesReturnsOnCall map[int]struct {
+		result1 atc.CustomRoles
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -109,6 +146,19 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteServiceAccountStub        func(string) (bool, error)
+	deleteServiceAccountMutex       sync.RWMutex
+	deleteServiceAccountArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
@@ -328,6 +378,16 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	QuotaStub        func() *atc.TeamQuota
+	quotaMutex       sync.RWMutex
+	quotaArgsForCall []struct {
+	}
+	quotaReturns struct {
+		result1 *atc.TeamQuota
+	}
+	quotaReturnsOnCall map[int]struct {
+		result1 *atc.TeamQuota
+	}
 	RenameStub        func(string) error
 	renameMutex       sync.RWMutex
 	renameArgsForCall []struct {
@@ -353,6 +413,20 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	RevokeServiceAccountTokenStub        func(string, string) (bool, error)
+	revokeServiceAccountTokenMutex       sync.RWMutex
+	revokeServiceAccountTokenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	revokeServiceAccountTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
@@ -371,6 +445,25 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	SavePipelineWithChangeStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, db.ConfigChange) (db.Pipeline, bool, error)
+	savePipelineWithChangeMutex       sync.RWMutex
+	savePipelineWithChangeArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 bool
+		arg5 db.ConfigChange
+	}
+	savePipelineWithChangeReturns struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	savePipelineWithChangeReturnsOnCall map[int]struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
 	SaveWorkerStub        func(atc.Worker, time.Duration) (db.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -385,6 +478,42 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SearchBuildLogsStub        func(db.BuildLogSearch) ([]atc.BuildLogMatch, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 db.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	ServiceAccountsStub        func() ([]atc.ServiceAccount, error)
+	serviceAccountsMutex       sync.RWMutex
+	serviceAccountsArgsForCall []struct {
+	}
+	serviceAccountsReturns struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}
+	serviceAccountsReturnsOnCall map[int]struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}
+	UpdateCustomRolesStub        func(atc.CustomRoles) error
+	updateCustomRolesMutex       sync.RWMutex
+	updateCustomRolesArgsForCall []struct {
+		arg1 atc.CustomRoles
+	}
+	updateCustomRolesReturns struct {
+		result1 error
+	}
+	updateCustomRolesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -396,6 +525,29 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateQuotaStub        func(atc.TeamQuota) error
+	updateQuotaMutex       sync.RWMutex
+	updateQuotaArgsForCall []struct {
+		arg1 atc.TeamQuota
+	}
+	updateQuotaReturns struct {
+		result1 error
+	}
+	updateQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UsageStub        func() (atc.TeamUsage, error)
+	usageMutex       sync.RWMutex
+	usageArgsForCall []struct {
+	}
+	usageReturns struct {
+		result1 atc.TeamUsage
+		result2 error
+	}
+	usageReturnsOnCall map[int]struct {
+		result1 atc.TeamUsage
+		result2 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -764,6 +916,135 @@ func (fake *FakeTeam) CreateOneOffBuildReturnsOnCall(i int, result1 db.Build, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateServiceAccount(arg1 string) (bool, error) {
+	fake.createServiceAccountMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountReturnsOnCall[len(fake.createServiceAccountArgsForCall)]
+	fake.createServiceAccountArgsForCall = append(fake.createServiceAccountArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CreateServiceAccountStub
+	fakeReturns := fake.createServiceAccountReturns
+	fake.recordInvocation("CreateServiceAccount", []interface{}{arg1})
+	fake.createServiceAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateServiceAccountCallCount() int {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	return len(fake.createServiceAccountArgsForCall)
+}
+
+func (fake *FakeTeam) CreateServiceAccountCalls(stub func(string) (bool, error)) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = stub
+}
+
+func (fake *FakeTeam) CreateServiceAccountArgsForCall(i int) string {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	argsForCall := fake.createServiceAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateServiceAccountReturns(result1 bool, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	fake.createServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	if fake.createServiceAccountReturnsOnCall == nil {
+		fake.createServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.createServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountToken(arg1 string, arg2 atc.ServiceAccountToken) (atc.ServiceAccountToken, error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountTokenReturnsOnCall[len(fake.createServiceAccountTokenArgsForCall)]
+	fake.createServiceAccountTokenArgsForCall = append(fake.createServiceAccountTokenArgsForCall, struct {
+		arg1 string
+		arg2 atc.ServiceAccountToken
+	}{arg1, arg2})
+	stub := fake.CreateServiceAccountTokenStub
+	fakeReturns := fake.createServiceAccountTokenReturns
+	fake.recordInvocation("CreateServiceAccountToken", []interface{}{arg1, arg2})
+	fake.createServiceAccountTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenCallCount() int {
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
+	return len(fake.createServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenCalls(stub func(string, atc.ServiceAccountToken) (atc.ServiceAccountToken, error)) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = stub
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenArgsForCall(i int) (string, atc.ServiceAccountToken) {
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.createServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenReturns(result1 atc.ServiceAccountToken, result2 error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = nil
+	fake.createServiceAccountTokenReturns = struct {
+		result1 atc.ServiceAccountToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenReturnsOnCall(i int, result1 atc.ServiceAccountToken, result2 error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = nil
+	if fake.createServiceAccountTokenReturnsOnCall == nil {
+		fake.createServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 atc.ServiceAccountToken
+			result2 error
+		})
+	}
+	fake.createServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 atc.ServiceAccountToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateStartedBuild(arg1 atc.Plan) (db.Build, error) {
 	fake.createStartedBuildMutex.Lock()
 	ret, specificReturn := fake.createStartedBuildReturnsOnCall[len(fake.createStartedBuildArgsForCall)]
@@ -828,6 +1109,59 @@ func (fake *FakeTeam) CreateStartedBuildReturnsOnCall(i int, result1 db.Build, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CustomRoles() atc.CustomRoles {
+	fake.customRolesMutex.Lock()
+	ret, specificReturn := fake.customRolesReturnsOnCall[len(fake.customRolesArgsForCall)]
+	fake.customRolesArgsForCall = append(fake.customRolesArgsForCall, struct {
+	}{})
+	stub := fake.CustomRolesStub
+	fakeReturns := fake.customRolesReturns
+	fake.recordInvocation("CustomRoles", []interface{}{})
+	fake.customRolesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) CustomRolesCallCount() int {
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
+	return len(fake.customRolesArgsForCall)
+}
+
+func (fake *FakeTeam) CustomRolesCalls(stub func() atc.CustomRoles) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = stub
+}
+
+func (fake *FakeTeam) CustomRolesReturns(result1 atc.CustomRoles) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	fake.customRolesReturns = struct {
+		result1 atc.CustomRoles
+	}{result1}
+}
+
+func (fake *FakeTeam) CustomRolesReturnsOnCall(i int, result1 atc.CustomRoles) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	if fake.customRolesReturnsOnCall == nil {
+		fake.customRolesReturnsOnCall = make(map[int]struct {
+			result1 atc.CustomRoles
+		})
+	}
+	fake.customRolesReturnsOnCall[i] = struct {
+		result1 atc.CustomRoles
+	}{result1}
+}
+
 func (fake *FakeTeam) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -881,6 +1215,70 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DeleteServiceAccount(arg1 string) (bool, error) {
+	fake.deleteServiceAccountMutex.Lock()
+	ret, specificReturn := fake.deleteServiceAccountReturnsOnCall[len(fake.deleteServiceAccountArgsForCall)]
+	fake.deleteServiceAccountArgsForCall = append(fake.deleteServiceAccountArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteServiceAccountStub
+	fakeReturns := fake.deleteServiceAccountReturns
+	fake.recordInvocation("DeleteServiceAccount", []interface{}{arg1})
+	fake.deleteServiceAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteServiceAccountCallCount() int {
+	fake.deleteServiceAccountMutex.RLock()
+	defer fake.deleteServiceAccountMutex.RUnlock()
+	return len(fake.deleteServiceAccountArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteServiceAccountCalls(stub func(string) (bool, error)) {
+	fake.deleteServiceAccountMutex.Lock()
+	defer fake.deleteServiceAccountMutex.Unlock()
+	fake.DeleteServiceAccountStub = stub
+}
+
+func (fake *FakeTeam) DeleteServiceAccountArgsForCall(i int) string {
+	fake.deleteServiceAccountMutex.RLock()
+	defer fake.deleteServiceAccountMutex.RUnlock()
+	argsForCall := fake.deleteServiceAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteServiceAccountReturns(result1 bool, result2 error) {
+	fake.deleteServiceAccountMutex.Lock()
+	defer fake.deleteServiceAccountMutex.Unlock()
+	fake.DeleteServiceAccountStub = nil
+	fake.deleteServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteServiceAccountMutex.Lock()
+	defer fake.deleteServiceAccountMutex.Unlock()
+	fake.DeleteServiceAccountStub = nil
+	if fake.deleteServiceAccountReturnsOnCall == nil {
+		fake.deleteServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
@@ -1898,6 +2296,59 @@ func (fake *FakeTeam) PublicPipelinesReturnsOnCall(i int, result1 []db.Pipeline,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Quota() *atc.TeamQuota {
+	fake.quotaMutex.Lock()
+	ret, specificReturn := fake.quotaReturnsOnCall[len(fake.quotaArgsForCall)]
+	fake.quotaArgsForCall = append(fake.quotaArgsForCall, struct {
+	}{})
+	stub := fake.QuotaStub
+	fakeReturns := fake.quotaReturns
+	fake.recordInvocation("Quota", []interface{}{})
+	fake.quotaMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) QuotaCallCount() int {
+	fake.quotaMutex.RLock()
+	defer fake.quotaMutex.RUnlock()
+	return len(fake.quotaArgsForCall)
+}
+
+func (fake *FakeTeam) QuotaCalls(stub func() *atc.TeamQuota) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = stub
+}
+
+func (fake *FakeTeam) QuotaReturns(result1 *atc.TeamQuota) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = nil
+	fake.quotaReturns = struct {
+		result1 *atc.TeamQuota
+	}{result1}
+}
+
+func (fake *FakeTeam) QuotaReturnsOnCall(i int, result1 *atc.TeamQuota) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = nil
+	if fake.quotaReturnsOnCall == nil {
+		fake.quotaReturnsOnCall = make(map[int]struct {
+			result1 *atc.TeamQuota
+		})
+	}
+	fake.quotaReturnsOnCall[i] = struct {
+		result1 *atc.TeamQuota
+	}{result1}
+}
+
 func (fake *FakeTeam) Rename(arg1 string) error {
 	fake.renameMutex.Lock()
 	ret, specificReturn := fake.renameReturnsOnCall[len(fake.renameArgsForCall)]
@@ -2024,6 +2475,71 @@ func (fake *FakeTeam) RenamePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) RevokeServiceAccountToken(arg1 string, arg2 string) (bool, error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.revokeServiceAccountTokenReturnsOnCall[len(fake.revokeServiceAccountTokenArgsForCall)]
+	fake.revokeServiceAccountTokenArgsForCall = append(fake.revokeServiceAccountTokenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RevokeServiceAccountTokenStub
+	fakeReturns := fake.revokeServiceAccountTokenReturns
+	fake.recordInvocation("RevokeServiceAccountToken", []interface{}{arg1, arg2})
+	fake.revokeServiceAccountTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenCallCount() int {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	return len(fake.revokeServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenCalls(stub func(string, string) (bool, error)) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenArgsForCall(i int) (string, string) {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.revokeServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenReturns(result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	fake.revokeServiceAccountTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	if fake.revokeServiceAccountTokenReturnsOnCall == nil {
+		fake.revokeServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
@@ -2094,6 +2610,77 @@ func (fake *FakeTeam) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) SavePipelineWithChange(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool, arg5 db.ConfigChange) (db.Pipeline, bool, error) {
+	fake.savePipelineWithChangeMutex.Lock()
+	ret, specificReturn := fake.savePipelineWithChangeReturnsOnCall[len(fake.savePipelineWithChangeArgsForCall)]
+	fake.savePipelineWithChangeArgsForCall = append(fake.savePipelineWithChangeArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 bool
+		arg5 db.ConfigChange
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SavePipelineWithChangeStub
+	fakeReturns := fake.savePipelineWithChangeReturns
+	fake.recordInvocation("SavePipelineWithChange", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.savePipelineWithChangeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) SavePipelineWithChangeCallCount() int {
+	fake.savePipelineWithChangeMutex.RLock()
+	defer fake.savePipelineWithChangeMutex.RUnlock()
+	return len(fake.savePipelineWithChangeArgsForCall)
+}
+
+func (fake *FakeTeam) SavePipelineWithChangeCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, db.ConfigChange) (db.Pipeline, bool, error)) {
+	fake.savePipelineWithChangeMutex.Lock()
+	defer fake.savePipelineWithChangeMutex.Unlock()
+	fake.SavePipelineWithChangeStub = stub
+}
+
+func (fake *FakeTeam) SavePipelineWithChangeArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool, db.ConfigChange) {
+	fake.savePipelineWithChangeMutex.RLock()
+	defer fake.savePipelineWithChangeMutex.RUnlock()
+	argsForCall := fake.savePipelineWithChangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTeam) SavePipelineWithChangeReturns(result1 db.Pipeline, result2 bool, result3 error) {
+	fake.savePipelineWithChangeMutex.Lock()
+	defer fake.savePipelineWithChangeMutex.Unlock()
+	fake.SavePipelineWithChangeStub = nil
+	fake.savePipelineWithChangeReturns = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SavePipelineWithChangeReturnsOnCall(i int, result1 db.Pipeline, result2 bool, result3 error) {
+	fake.savePipelineWithChangeMutex.Lock()
+	defer fake.savePipelineWithChangeMutex.Unlock()
+	fake.SavePipelineWithChangeStub = nil
+	if fake.savePipelineWithChangeReturnsOnCall == nil {
+		fake.savePipelineWithChangeReturnsOnCall = make(map[int]struct {
+			result1 db.Pipeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.savePipelineWithChangeReturnsOnCall[i] = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) SaveWorker(arg1 atc.Worker, arg2 time.Duration) (db.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -2159,6 +2746,187 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(arg1 db.BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 db.BuildLogSearch
+	}{arg1})
+	stub := fake.SearchBuildLogsStub
+	fakeReturns := fake.searchBuildLogsReturns
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsCalls(stub func(db.BuildLogSearch) ([]atc.BuildLogMatch, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) db.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildLogMatch
+			result2 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ServiceAccounts() ([]atc.ServiceAccount, error) {
+	fake.serviceAccountsMutex.Lock()
+	ret, specificReturn := fake.serviceAccountsReturnsOnCall[len(fake.serviceAccountsArgsForCall)]
+	fake.serviceAccountsArgsForCall = append(fake.serviceAccountsArgsForCall, struct {
+	}{})
+	stub := fake.ServiceAccountsStub
+	fakeReturns := fake.serviceAccountsReturns
+	fake.recordInvocation("ServiceAccounts", []interface{}{})
+	fake.serviceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ServiceAccountsCallCount() int {
+	fake.serviceAccountsMutex.RLock()
+	defer fake.serviceAccountsMutex.RUnlock()
+	return len(fake.serviceAccountsArgsForCall)
+}
+
+func (fake *FakeTeam) ServiceAccountsCalls(stub func() ([]atc.ServiceAccount, error)) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = stub
+}
+
+func (fake *FakeTeam) ServiceAccountsReturns(result1 []atc.ServiceAccount, result2 error) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = nil
+	fake.serviceAccountsReturns = struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ServiceAccountsReturnsOnCall(i int, result1 []atc.ServiceAccount, result2 error) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = nil
+	if fake.serviceAccountsReturnsOnCall == nil {
+		fake.serviceAccountsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ServiceAccount
+			result2 error
+		})
+	}
+	fake.serviceAccountsReturnsOnCall[i] = struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UpdateCustomRoles(arg1 atc.CustomRoles) error {
+	fake.updateCustomRolesMutex.Lock()
+	ret, specificReturn := fake.updateCustomRolesReturnsOnCall[len(fake.updateCustomRolesArgsForCall)]
+	fake.updateCustomRolesArgsForCall = append(fake.updateCustomRolesArgsForCall, struct {
+		arg1 atc.CustomRoles
+	}{arg1})
+	stub := fake.UpdateCustomRolesStub
+	fakeReturns := fake.updateCustomRolesReturns
+	fake.recordInvocation("UpdateCustomRoles", []interface{}{arg1})
+	fake.updateCustomRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateCustomRolesCallCount() int {
+	fake.updateCustomRolesMutex.RLock()
+	defer fake.updateCustomRolesMutex.RUnlock()
+	return len(fake.updateCustomRolesArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateCustomRolesCalls(stub func(atc.CustomRoles) error) {
+	fake.updateCustomRolesMutex.Lock()
+	defer fake.updateCustomRolesMutex.Unlock()
+	fake.UpdateCustomRolesStub = stub
+}
+
+func (fake *FakeTeam) UpdateCustomRolesArgsForCall(i int) atc.CustomRoles {
+	fake.updateCustomRolesMutex.RLock()
+	defer fake.updateCustomRolesMutex.RUnlock()
+	argsForCall := fake.updateCustomRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateCustomRolesReturns(result1 error) {
+	fake.updateCustomRolesMutex.Lock()
+	defer fake.updateCustomRolesMutex.Unlock()
+	fake.UpdateCustomRolesStub = nil
+	fake.updateCustomRolesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateCustomRolesReturnsOnCall(i int, result1 error) {
+	fake.updateCustomRolesMutex.Lock()
+	defer fake.updateCustomRolesMutex.Unlock()
+	fake.UpdateCustomRolesStub = nil
+	if fake.updateCustomRolesReturnsOnCall == nil {
+		fake.updateCustomRolesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateCustomRolesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2220,6 +2988,123 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) UpdateQuota(arg1 atc.TeamQuota) error {
+	fake.updateQuotaMutex.Lock()
+	ret, specificReturn := fake.updateQuotaReturnsOnCall[len(fake.updateQuotaArgsForCall)]
+	fake.updateQuotaArgsForCall = append(fake.updateQuotaArgsForCall, struct {
+		arg1 atc.TeamQuota
+	}{arg1})
+	stub := fake.UpdateQuotaStub
+	fakeReturns := fake.updateQuotaReturns
+	fake.recordInvocation("UpdateQuota", []interface{}{arg1})
+	fake.updateQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateQuotaCallCount() int {
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
+	return len(fake.updateQuotaArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateQuotaCalls(stub func(atc.TeamQuota) error) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = stub
+}
+
+func (fake *FakeTeam) UpdateQuotaArgsForCall(i int) atc.TeamQuota {
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
+	argsForCall := fake.updateQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateQuotaReturns(result1 error) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = nil
+	fake.updateQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateQuotaReturnsOnCall(i int, result1 error) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = nil
+	if fake.updateQuotaReturnsOnCall == nil {
+		fake.updateQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) Usage() (atc.TeamUsage, error) {
+	fake.usageMutex.Lock()
+	ret, specificReturn := fake.usageReturnsOnCall[len(fake.usageArgsForCall)]
+	fake.usageArgsForCall = append(fake.usageArgsForCall, struct {
+	}{})
+	stub := fake.UsageStub
+	fakeReturns := fake.usageReturns
+	fake.recordInvocation("Usage", []interface{}{})
+	fake.usageMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) UsageCallCount() int {
+	fake.usageMutex.RLock()
+	defer fake.usageMutex.RUnlock()
+	return len(fake.usageArgsForCall)
+}
+
+func (fake *FakeTeam) UsageCalls(stub func() (atc.TeamUsage, error)) {
+	fake.usageMutex.Lock()
+	defer fake.usageMutex.Unlock()
+	fake.UsageStub = stub
+}
+
+func (fake *FakeTeam) UsageReturns(result1 atc.TeamUsage, result2 error) {
+	fake.usageMutex.Lock()
+	defer fake.usageMutex.Unlock()
+	fake.UsageStub = nil
+	fake.usageReturns = struct {
+		result1 atc.TeamUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UsageReturnsOnCall(i int, result1 atc.TeamUsage, result2 error) {
+	fake.usageMutex.Lock()
+	defer fake.usageMutex.Unlock()
+	fake.UsageStub = nil
+	if fake.usageReturnsOnCall == nil {
+		fake.usageReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamUsage
+			result2 error
+		})
+	}
+	fake.usageReturnsOnCall[i] = struct {
+		result1 atc.TeamUsage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2291,10 +3176,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.containersMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.deleteServiceAccountMutex.RLock()
+	defer fake.deleteServiceAccountMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2327,16 +3220,32 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.privateAndPublicBuildsMutex.RUnlock()
 	fake.publicPipelinesMutex.RLock()
 	defer fake.publicPipelinesMutex.RUnlock()
+	fake.quotaMutex.RLock()
+	defer fake.quotaMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
+	fake.savePipelineWithChangeMutex.RLock()
+	defer fake.savePipelineWithChangeMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	fake.serviceAccountsMutex.RLock()
+	defer fake.serviceAccountsMutex.RUnlock()
+	fake.updateCustomRolesMutex.RLock()
+	defer fake.updateCustomRolesMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
+	fake.usageMutex.RLock()
+	defer fake.usageMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
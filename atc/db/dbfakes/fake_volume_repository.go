@@ -282,6 +282,18 @@ type FakeVolumeRepository struct {
 		result1 int
 		result2 error
 	}
+	UpdateVolumeSizesStub        func(string, map[string]uint64) error
+	updateVolumeSizesMutex       sync.RWMutex
+	updateVolumeSizesArgsForCall []struct {
+		arg1 string
+		arg2 map[string]uint64
+	}
+	updateVolumeSizesReturns struct {
+		result1 error
+	}
+	updateVolumeSizesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateVolumesMissingSinceStub        func(string, []string) error
 	updateVolumesMissingSinceMutex       sync.RWMutex
 	updateVolumesMissingSinceArgsForCall []struct {
@@ -1542,6 +1554,68 @@ func (fake *FakeVolumeRepository) RemoveMissingVolumesReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeVolumeRepository) UpdateVolumeSizes(arg1 string, arg2 map[string]uint64) error {
+	fake.updateVolumeSizesMutex.Lock()
+	ret, specificReturn := fake.updateVolumeSizesReturnsOnCall[len(fake.updateVolumeSizesArgsForCall)]
+	fake.updateVolumeSizesArgsForCall = append(fake.updateVolumeSizesArgsForCall, struct {
+		arg1 string
+		arg2 map[string]uint64
+	}{arg1, arg2})
+	stub := fake.UpdateVolumeSizesStub
+	fakeReturns := fake.updateVolumeSizesReturns
+	fake.recordInvocation("UpdateVolumeSizes", []interface{}{arg1, arg2})
+	fake.updateVolumeSizesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumeSizesCallCount() int {
+	fake.updateVolumeSizesMutex.RLock()
+	defer fake.updateVolumeSizesMutex.RUnlock()
+	return len(fake.updateVolumeSizesArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumeSizesCalls(stub func(string, map[string]uint64) error) {
+	fake.updateVolumeSizesMutex.Lock()
+	defer fake.updateVolumeSizesMutex.Unlock()
+	fake.UpdateVolumeSizesStub = stub
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumeSizesArgsForCall(i int) (string, map[string]uint64) {
+	fake.updateVolumeSizesMutex.RLock()
+	defer fake.updateVolumeSizesMutex.RUnlock()
+	argsForCall := fake.updateVolumeSizesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumeSizesReturns(result1 error) {
+	fake.updateVolumeSizesMutex.Lock()
+	defer fake.updateVolumeSizesMutex.Unlock()
+	fake.UpdateVolumeSizesStub = nil
+	fake.updateVolumeSizesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeVolumeRepository) UpdateVolumeSizesReturnsOnCall(i int, result1 error) {
+	fake.updateVolumeSizesMutex.Lock()
+	defer fake.updateVolumeSizesMutex.Unlock()
+	fake.UpdateVolumeSizesStub = nil
+	if fake.updateVolumeSizesReturnsOnCall == nil {
+		fake.updateVolumeSizesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateVolumeSizesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeVolumeRepository) UpdateVolumesMissingSince(arg1 string, arg2 []string) error {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -1650,6 +1724,8 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.removeDestroyingVolumesMutex.RUnlock()
 	fake.removeMissingVolumesMutex.RLock()
 	defer fake.removeMissingVolumesMutex.RUnlock()
+	fake.updateVolumeSizesMutex.RLock()
+	defer fake.updateVolumeSizesMutex.RUnlock()
 	fake.updateVolumesMissingSinceMutex.RLock()
 	defer fake.updateVolumesMissingSinceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -42,6 +42,26 @@ type FakeWorker struct {
 	activeVolumesReturnsOnCall map[int]struct {
 		result1 int
 	}
+	AllocatableCPUStub        func() uint64
+	allocatableCPUMutex       sync.RWMutex
+	allocatableCPUArgsForCall []struct {
+	}
+	allocatableCPUReturns struct {
+		result1 uint64
+	}
+	allocatableCPUReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	AllocatableMemoryStub        func() uint64
+	allocatableMemoryMutex       sync.RWMutex
+	allocatableMemoryArgsForCall []struct {
+	}
+	allocatableMemoryReturns struct {
+		result1 uint64
+	}
+	allocatableMemoryReturnsOnCall map[int]struct {
+		result1 uint64
+	}
 	BaggageclaimURLStub        func() *string
 	baggageclaimURLMutex       sync.RWMutex
 	baggageclaimURLArgsForCall []struct {
@@ -175,6 +195,16 @@ type FakeWorker struct {
 		result1 int
 		result2 error
 	}
+	LabelsStub        func() atc.WorkerLabels
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 atc.WorkerLabels
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 atc.WorkerLabels
+	}
 	LandStub        func() error
 	landMutex       sync.RWMutex
 	landArgsForCall []struct {
@@ -225,6 +255,17 @@ type FakeWorker struct {
 	pruneReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReleaseResourcesStub        func(db.ContainerOwner) error
+	releaseResourcesMutex       sync.RWMutex
+	releaseResourcesArgsForCall []struct {
+		arg1 db.ContainerOwner
+	}
+	releaseResourcesReturns struct {
+		result1 error
+	}
+	releaseResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -237,6 +278,35 @@ type FakeWorker struct {
 		result1 bool
 		result2 error
 	}
+	ReserveResourcesStub        func(db.ContainerOwner, uint64, uint64) (bool, error)
+	reserveResourcesMutex       sync.RWMutex
+	reserveResourcesArgsForCall []struct {
+		arg1 db.ContainerOwner
+		arg2 uint64
+		arg3 uint64
+	}
+	reserveResourcesReturns struct {
+		result1 bool
+		result2 error
+	}
+	reserveResourcesReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ReservedResourcesStub        func() (uint64, uint64, error)
+	reservedResourcesMutex       sync.RWMutex
+	reservedResourcesArgsForCall []struct {
+	}
+	reservedResourcesReturns struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
+	reservedResourcesReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
 	ResourceCertsStub        func() (*db.UsedWorkerResourceCerts, bool, error)
 	resourceCertsMutex       sync.RWMutex
 	resourceCertsArgsForCall []struct {
@@ -497,6 +567,112 @@ func (fake *FakeWorker) ActiveVolumesReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorker) AllocatableCPU() uint64 {
+	fake.allocatableCPUMutex.Lock()
+	ret, specificReturn := fake.allocatableCPUReturnsOnCall[len(fake.allocatableCPUArgsForCall)]
+	fake.allocatableCPUArgsForCall = append(fake.allocatableCPUArgsForCall, struct {
+	}{})
+	stub := fake.AllocatableCPUStub
+	fakeReturns := fake.allocatableCPUReturns
+	fake.recordInvocation("AllocatableCPU", []interface{}{})
+	fake.allocatableCPUMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableCPUCallCount() int {
+	fake.allocatableCPUMutex.RLock()
+	defer fake.allocatableCPUMutex.RUnlock()
+	return len(fake.allocatableCPUArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableCPUCalls(stub func() uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = stub
+}
+
+func (fake *FakeWorker) AllocatableCPUReturns(result1 uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = nil
+	fake.allocatableCPUReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableCPUReturnsOnCall(i int, result1 uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = nil
+	if fake.allocatableCPUReturnsOnCall == nil {
+		fake.allocatableCPUReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.allocatableCPUReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableMemory() uint64 {
+	fake.allocatableMemoryMutex.Lock()
+	ret, specificReturn := fake.allocatableMemoryReturnsOnCall[len(fake.allocatableMemoryArgsForCall)]
+	fake.allocatableMemoryArgsForCall = append(fake.allocatableMemoryArgsForCall, struct {
+	}{})
+	stub := fake.AllocatableMemoryStub
+	fakeReturns := fake.allocatableMemoryReturns
+	fake.recordInvocation("AllocatableMemory", []interface{}{})
+	fake.allocatableMemoryMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableMemoryCallCount() int {
+	fake.allocatableMemoryMutex.RLock()
+	defer fake.allocatableMemoryMutex.RUnlock()
+	return len(fake.allocatableMemoryArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableMemoryCalls(stub func() uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = stub
+}
+
+func (fake *FakeWorker) AllocatableMemoryReturns(result1 uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = nil
+	fake.allocatableMemoryReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableMemoryReturnsOnCall(i int, result1 uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = nil
+	if fake.allocatableMemoryReturnsOnCall == nil {
+		fake.allocatableMemoryReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.allocatableMemoryReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
 func (fake *FakeWorker) BaggageclaimURL() *string {
 	fake.baggageclaimURLMutex.Lock()
 	ret, specificReturn := fake.baggageclaimURLReturnsOnCall[len(fake.baggageclaimURLArgsForCall)]
@@ -1165,6 +1341,59 @@ func (fake *FakeWorker) IncreaseActiveTasksReturnsOnCall(i int, result1 int, res
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) Labels() atc.WorkerLabels {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	stub := fake.LabelsStub
+	fakeReturns := fake.labelsReturns
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsCalls(stub func() atc.WorkerLabels) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = stub
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 atc.WorkerLabels) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 atc.WorkerLabels
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 atc.WorkerLabels) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerLabels
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 atc.WorkerLabels
+	}{result1}
+}
+
 func (fake *FakeWorker) Land() error {
 	fake.landMutex.Lock()
 	ret, specificReturn := fake.landReturnsOnCall[len(fake.landArgsForCall)]
@@ -1430,6 +1659,67 @@ func (fake *FakeWorker) PruneReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ReleaseResources(arg1 db.ContainerOwner) error {
+	fake.releaseResourcesMutex.Lock()
+	ret, specificReturn := fake.releaseResourcesReturnsOnCall[len(fake.releaseResourcesArgsForCall)]
+	fake.releaseResourcesArgsForCall = append(fake.releaseResourcesArgsForCall, struct {
+		arg1 db.ContainerOwner
+	}{arg1})
+	stub := fake.ReleaseResourcesStub
+	fakeReturns := fake.releaseResourcesReturns
+	fake.recordInvocation("ReleaseResources", []interface{}{arg1})
+	fake.releaseResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ReleaseResourcesCallCount() int {
+	fake.releaseResourcesMutex.RLock()
+	defer fake.releaseResourcesMutex.RUnlock()
+	return len(fake.releaseResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ReleaseResourcesCalls(stub func(db.ContainerOwner) error) {
+	fake.releaseResourcesMutex.Lock()
+	defer fake.releaseResourcesMutex.Unlock()
+	fake.ReleaseResourcesStub = stub
+}
+
+func (fake *FakeWorker) ReleaseResourcesArgsForCall(i int) db.ContainerOwner {
+	fake.releaseResourcesMutex.RLock()
+	defer fake.releaseResourcesMutex.RUnlock()
+	argsForCall := fake.releaseResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) ReleaseResourcesReturns(result1 error) {
+	fake.releaseResourcesMutex.Lock()
+	defer fake.releaseResourcesMutex.Unlock()
+	fake.ReleaseResourcesStub = nil
+	fake.releaseResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) ReleaseResourcesReturnsOnCall(i int, result1 error) {
+	fake.releaseResourcesMutex.Lock()
+	defer fake.releaseResourcesMutex.Unlock()
+	fake.ReleaseResourcesStub = nil
+	if fake.releaseResourcesReturnsOnCall == nil {
+		fake.releaseResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -1486,6 +1776,131 @@ func (fake *FakeWorker) ReloadReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) ReserveResources(arg1 db.ContainerOwner, arg2 uint64, arg3 uint64) (bool, error) {
+	fake.reserveResourcesMutex.Lock()
+	ret, specificReturn := fake.reserveResourcesReturnsOnCall[len(fake.reserveResourcesArgsForCall)]
+	fake.reserveResourcesArgsForCall = append(fake.reserveResourcesArgsForCall, struct {
+		arg1 db.ContainerOwner
+		arg2 uint64
+		arg3 uint64
+	}{arg1, arg2, arg3})
+	stub := fake.ReserveResourcesStub
+	fakeReturns := fake.reserveResourcesReturns
+	fake.recordInvocation("ReserveResources", []interface{}{arg1, arg2, arg3})
+	fake.reserveResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) ReserveResourcesCallCount() int {
+	fake.reserveResourcesMutex.RLock()
+	defer fake.reserveResourcesMutex.RUnlock()
+	return len(fake.reserveResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ReserveResourcesCalls(stub func(db.ContainerOwner, uint64, uint64) (bool, error)) {
+	fake.reserveResourcesMutex.Lock()
+	defer fake.reserveResourcesMutex.Unlock()
+	fake.ReserveResourcesStub = stub
+}
+
+func (fake *FakeWorker) ReserveResourcesArgsForCall(i int) (db.ContainerOwner, uint64, uint64) {
+	fake.reserveResourcesMutex.RLock()
+	defer fake.reserveResourcesMutex.RUnlock()
+	argsForCall := fake.reserveResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeWorker) ReserveResourcesReturns(result1 bool, result2 error) {
+	fake.reserveResourcesMutex.Lock()
+	defer fake.reserveResourcesMutex.Unlock()
+	fake.ReserveResourcesStub = nil
+	fake.reserveResourcesReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) ReserveResourcesReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.reserveResourcesMutex.Lock()
+	defer fake.reserveResourcesMutex.Unlock()
+	fake.ReserveResourcesStub = nil
+	if fake.reserveResourcesReturnsOnCall == nil {
+		fake.reserveResourcesReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.reserveResourcesReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) ReservedResources() (uint64, uint64, error) {
+	fake.reservedResourcesMutex.Lock()
+	ret, specificReturn := fake.reservedResourcesReturnsOnCall[len(fake.reservedResourcesArgsForCall)]
+	fake.reservedResourcesArgsForCall = append(fake.reservedResourcesArgsForCall, struct {
+	}{})
+	stub := fake.ReservedResourcesStub
+	fakeReturns := fake.reservedResourcesReturns
+	fake.recordInvocation("ReservedResources", []interface{}{})
+	fake.reservedResourcesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) ReservedResourcesCallCount() int {
+	fake.reservedResourcesMutex.RLock()
+	defer fake.reservedResourcesMutex.RUnlock()
+	return len(fake.reservedResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ReservedResourcesCalls(stub func() (uint64, uint64, error)) {
+	fake.reservedResourcesMutex.Lock()
+	defer fake.reservedResourcesMutex.Unlock()
+	fake.ReservedResourcesStub = stub
+}
+
+func (fake *FakeWorker) ReservedResourcesReturns(result1 uint64, result2 uint64, result3 error) {
+	fake.reservedResourcesMutex.Lock()
+	defer fake.reservedResourcesMutex.Unlock()
+	fake.ReservedResourcesStub = nil
+	fake.reservedResourcesReturns = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) ReservedResourcesReturnsOnCall(i int, result1 uint64, result2 uint64, result3 error) {
+	fake.reservedResourcesMutex.Lock()
+	defer fake.reservedResourcesMutex.Unlock()
+	fake.ReservedResourcesStub = nil
+	if fake.reservedResourcesReturnsOnCall == nil {
+		fake.reservedResourcesReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.reservedResourcesReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) ResourceCerts() (*db.UsedWorkerResourceCerts, bool, error) {
 	fake.resourceCertsMutex.Lock()
 	ret, specificReturn := fake.resourceCertsReturnsOnCall[len(fake.resourceCertsArgsForCall)]
@@ -1978,6 +2393,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.activeTasksMutex.RUnlock()
 	fake.activeVolumesMutex.RLock()
 	defer fake.activeVolumesMutex.RUnlock()
+	fake.allocatableCPUMutex.RLock()
+	defer fake.allocatableCPUMutex.RUnlock()
+	fake.allocatableMemoryMutex.RLock()
+	defer fake.allocatableMemoryMutex.RUnlock()
 	fake.baggageclaimURLMutex.RLock()
 	defer fake.baggageclaimURLMutex.RUnlock()
 	fake.certsPathMutex.RLock()
@@ -2002,6 +2421,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPSProxyURLMutex.RUnlock()
 	fake.increaseActiveTasksMutex.RLock()
 	defer fake.increaseActiveTasksMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -2012,8 +2433,14 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.platformMutex.RUnlock()
 	fake.pruneMutex.RLock()
 	defer fake.pruneMutex.RUnlock()
+	fake.releaseResourcesMutex.RLock()
+	defer fake.releaseResourcesMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.reserveResourcesMutex.RLock()
+	defer fake.reserveResourcesMutex.RUnlock()
+	fake.reservedResourcesMutex.RLock()
+	defer fake.reservedResourcesMutex.RUnlock()
 	fake.resourceCertsMutex.RLock()
 	defer fake.resourceCertsMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
@@ -194,7 +194,7 @@ func (builder Builder) WithResourceVersions(resourceName string, versions ...atc
 			return fmt.Errorf("find or create scope: %w", err)
 		}
 
-		err = scope.SaveVersions(scenario.SpanContext, versions)
+		err = scope.SaveVersions(scenario.SpanContext, versions, false)
 		if err != nil {
 			return fmt.Errorf("save versions: %w", err)
 		}
@@ -265,7 +265,7 @@ func (builder Builder) WithResourceTypeVersions(resourceTypeName string, version
 			return fmt.Errorf("find or create scope: %w", err)
 		}
 
-		err = scope.SaveVersions(db.SpanContext{}, versions)
+		err = scope.SaveVersions(db.SpanContext{}, versions, false)
 		if err != nil {
 			return fmt.Errorf("save versions: %w", err)
 		}
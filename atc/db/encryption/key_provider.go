@@ -0,0 +1,51 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+var ErrWrappedKeyTooShort = errors.New("wrapped data key is too short")
+
+//go:generate counterfeiter . KeyProvider
+
+// KeyProvider wraps and unwraps the data keys generated by a Keyring, i.e.
+// it is the key-encryption key of the envelope.
+type KeyProvider interface {
+	WrapKey(dataKey []byte) ([]byte, error)
+	UnwrapKey(wrapped []byte) ([]byte, error)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce, returning the nonce followed by
+// the ciphertext.
+func seal(aead cipher.AEAD, prefix, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(append(prefix, nonce...), nonce, plaintext, nil), nil
+}
+
+// open reverses seal.
+func open(aead cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrWrappedKeyTooShort
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
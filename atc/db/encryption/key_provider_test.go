@@ -0,0 +1,193 @@
+package encryption_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Key providers", func() {
+	var (
+		tmpDir  string
+		dataKey []byte
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "key-providers")
+		Expect(err).ToNot(HaveOccurred())
+
+		dataKey = []byte("data-key-32-characters-123456789")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	writeFile := func(name string, contents string) string {
+		path := filepath.Join(tmpDir, name)
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		return path
+	}
+
+	Describe("NewLocalKeyProvider", func() {
+		It("wraps and unwraps data keys", func() {
+			provider, err := encryption.NewLocalKeyProvider(writeFile("key", "000102030405060708090a0b0c0d0e0f\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			wrapped, err := provider.WrapKey(dataKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(wrapped).ToNot(ContainSubstring(string(dataKey)))
+
+			unwrapped, err := provider.UnwrapKey(wrapped)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unwrapped).To(Equal(dataKey))
+		})
+
+		It("rejects keys of the wrong length", func() {
+			_, err := encryption.NewLocalKeyProvider(writeFile("key", "0001020304"))
+			Expect(err).To(MatchError(ContainSubstring("must contain a 16 or 32 byte key, got 5 bytes")))
+		})
+
+		It("rejects keys which are not hex-encoded", func() {
+			_, err := encryption.NewLocalKeyProvider(writeFile("key", "not-hex"))
+			Expect(err).To(MatchError(ContainSubstring("is not hex-encoded")))
+		})
+	})
+
+	Describe("NewSoftwareKMS", func() {
+		writeKeystore := func(keystore encryption.SoftwareKMSKeystore) string {
+			payload, err := json.Marshal(keystore)
+			Expect(err).ToNot(HaveOccurred())
+			return writeFile("keystore.json", string(payload))
+		}
+
+		It("unwraps data keys wrapped with an earlier primary version", func() {
+			path := writeKeystore(encryption.SoftwareKMSKeystore{
+				PrimaryVersion: 1,
+				Versions: map[string]string{
+					"1": "000102030405060708090a0b0c0d0e0f",
+				},
+			})
+
+			kms, err := encryption.NewSoftwareKMS(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			wrapped, err := kms.WrapKey(dataKey)
+			Expect(err).ToNot(HaveOccurred())
+
+			path = writeKeystore(encryption.SoftwareKMSKeystore{
+				PrimaryVersion: 2,
+				Versions: map[string]string{
+					"1": "000102030405060708090a0b0c0d0e0f",
+					"2": "0f0e0d0c0b0a09080706050403020100",
+				},
+			})
+
+			rotated, err := encryption.NewSoftwareKMS(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			unwrapped, err := rotated.UnwrapKey(wrapped)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unwrapped).To(Equal(dataKey))
+
+			rewrapped, err := rotated.WrapKey(dataKey)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = kms.UnwrapKey(rewrapped)
+			Expect(err).To(MatchError("unknown master key version 2"))
+		})
+
+		It("rejects a keystore without its primary version", func() {
+			path := writeKeystore(encryption.SoftwareKMSKeystore{
+				PrimaryVersion: 2,
+				Versions: map[string]string{
+					"1": "000102030405060708090a0b0c0d0e0f",
+				},
+			})
+
+			_, err := encryption.NewSoftwareKMS(path)
+			Expect(err).To(MatchError(ContainSubstring("primary version 2 not found")))
+		})
+	})
+
+	Describe("VaultTransit", func() {
+		var (
+			server   *ghttp.Server
+			provider encryption.VaultTransit
+		)
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+
+			provider = encryption.VaultTransit{
+				URL:   server.URL(),
+				Token: "some-token",
+				Key:   "concourse",
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("wraps and unwraps data keys with the transit API", func() {
+			encoded := base64.StdEncoding.EncodeToString(dataKey)
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/transit/encrypt/concourse"),
+					ghttp.VerifyHeaderKV("X-Vault-Token", "some-token"),
+					ghttp.VerifyJSON(`{"plaintext":"`+encoded+`"}`),
+					ghttp.RespondWith(http.StatusOK, `{"data":{"ciphertext":"vault:v1:wrapped"}}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/transit/decrypt/concourse"),
+					ghttp.VerifyJSON(`{"ciphertext":"vault:v1:wrapped"}`),
+					ghttp.RespondWith(http.StatusOK, `{"data":{"plaintext":"`+encoded+`"}}`),
+				),
+			)
+
+			wrapped, err := provider.WrapKey(dataKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(wrapped)).To(Equal("vault:v1:wrapped"))
+
+			unwrapped, err := provider.UnwrapKey(wrapped)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unwrapped).To(Equal(dataKey))
+		})
+
+		It("uses the configured mount", func() {
+			provider.Mount = "/custom-transit/"
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/custom-transit/encrypt/concourse"),
+					ghttp.RespondWith(http.StatusOK, `{"data":{"ciphertext":"vault:v1:wrapped"}}`),
+				),
+			)
+
+			_, err := provider.WrapKey(dataKey)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the errors reported by the API", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusForbidden, `{"errors":["permission denied"]}`),
+			)
+
+			_, err := provider.WrapKey(dataKey)
+			Expect(err).To(HaveOccurred())
+			Expect(strings.Contains(err.Error(), "403 Forbidden: permission denied")).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,215 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// keyringTag prefixes every value encrypted by a Keyring. Values encrypted by
+// a plain Key are hex-encoded, so they can never carry the tag.
+const keyringTag = "kr1:"
+
+const (
+	dataKeySize = 32
+
+	// maxDataKeyUses bounds how many values are encrypted under one data key
+	// with random 96-bit nonces before a fresh data key is generated.
+	maxDataKeyUses = 1 << 24
+
+	// maxCachedDataKeys bounds the number of unwrapped data keys kept in
+	// memory so that reads do not round-trip to the key provider every time.
+	maxCachedDataKeys = 1024
+)
+
+var keyIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9.-]+$`)
+
+var ErrUnknownKeyID = errors.New("data was encrypted with an unknown key")
+var ErrMalformedCiphertext = errors.New("malformed keyring ciphertext")
+
+type dataKey struct {
+	plain   []byte
+	wrapped string
+	uses    int
+}
+
+// Keyring is a Strategy which encrypts every value under a data key that is
+// itself wrapped by one of several KeyProviders. The ciphertext is tagged with
+// the ID of the provider, so that any provider on the keyring can decrypt it
+// while only the active one is used to encrypt.
+//
+// Values which were encrypted before the keyring was configured are handed to
+// the legacy Strategy, if any.
+type Keyring struct {
+	activeID  string
+	providers map[string]KeyProvider
+	legacy    Strategy
+
+	lock      sync.Mutex
+	active    *dataKey
+	unwrapped map[string][]byte
+}
+
+func NewKeyring(activeID string, providers map[string]KeyProvider, legacy Strategy) (*Keyring, error) {
+	for id := range providers {
+		if !keyIDRegexp.MatchString(id) {
+			return nil, fmt.Errorf("invalid key ID '%s': must only contain letters, numbers, '.' and '-'", id)
+		}
+	}
+
+	if _, found := providers[activeID]; !found {
+		return nil, fmt.Errorf("active key '%s' is not on the keyring", activeID)
+	}
+
+	return &Keyring{
+		activeID:  activeID,
+		providers: providers,
+		legacy:    legacy,
+		unwrapped: map[string][]byte{},
+	}, nil
+}
+
+// ActiveKeyID returns the ID of the key which new values are encrypted with.
+func (k *Keyring) ActiveKeyID() string {
+	return k.activeID
+}
+
+// ActivePrefix returns the prefix shared by every value encrypted with the
+// active key, so that values needing re-encryption can be found in SQL.
+func (k *Keyring) ActivePrefix() string {
+	return keyringTag + k.activeID + ":"
+}
+
+func (k *Keyring) Encrypt(plaintext []byte) (string, *string, error) {
+	key, err := k.activeDataKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	aead, err := newAEAD(key.plain)
+	if err != nil {
+		return "", nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+
+	ciphertext := aead.Seal(nil, nonce, plaintext, nil)
+
+	noncense := hex.EncodeToString(nonce)
+
+	return k.ActivePrefix() + key.wrapped + ":" + hex.EncodeToString(ciphertext), &noncense, nil
+}
+
+func (k *Keyring) Decrypt(text string, n *string) ([]byte, error) {
+	if n == nil {
+		return nil, ErrDataIsNotEncrypted
+	}
+
+	if !strings.HasPrefix(text, keyringTag) {
+		if k.legacy == nil {
+			return nil, ErrUnknownKeyID
+		}
+
+		return k.legacy.Decrypt(text, n)
+	}
+
+	segments := strings.Split(strings.TrimPrefix(text, keyringTag), ":")
+	if len(segments) != 3 {
+		return nil, ErrMalformedCiphertext
+	}
+
+	id, wrapped, encoded := segments[0], segments[1], segments[2]
+
+	key, err := k.unwrap(id, wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := hex.DecodeString(*n)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func (k *Keyring) activeDataKey() (*dataKey, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.active == nil || k.active.uses >= maxDataKeyUses {
+		plain := make([]byte, dataKeySize)
+		if _, err := io.ReadFull(rand.Reader, plain); err != nil {
+			return nil, err
+		}
+
+		wrapped, err := k.providers[k.activeID].WrapKey(plain)
+		if err != nil {
+			return nil, fmt.Errorf("wrap data key with '%s': %w", k.activeID, err)
+		}
+
+		k.active = &dataKey{
+			plain:   plain,
+			wrapped: base64.StdEncoding.EncodeToString(wrapped),
+		}
+	}
+
+	k.active.uses++
+
+	return k.active, nil
+}
+
+func (k *Keyring) unwrap(id string, wrapped string) ([]byte, error) {
+	provider, found := k.providers[id]
+	if !found {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnknownKeyID, id)
+	}
+
+	cacheKey := id + ":" + wrapped
+
+	k.lock.Lock()
+	key, found := k.unwrapped[cacheKey]
+	k.lock.Unlock()
+
+	if found {
+		return key, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, ErrMalformedCiphertext
+	}
+
+	key, err = provider.UnwrapKey(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key with '%s': %w", id, err)
+	}
+
+	k.lock.Lock()
+	if len(k.unwrapped) >= maxCachedDataKeys {
+		k.unwrapped = map[string][]byte{}
+	}
+	k.unwrapped[cacheKey] = key
+	k.lock.Unlock()
+
+	return key, nil
+}
@@ -0,0 +1,88 @@
+package encryption
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// KeyringConfig configures the keys on a Keyring. Each kind of provider maps
+// a key ID to the provider-specific location of its key.
+type KeyringConfig struct {
+	ActiveKey string `long:"active-key" description:"ID of the keyring key used to encrypt new data. Data encrypted with any other key is re-encrypted with it in the background."`
+
+	LocalKeys    map[string]string `long:"local-key" value-name:"ID:PATH" description:"Key which wraps data keys with a hex-encoded 16 or 32 byte AES key read from a file. Can be specified multiple times."`
+	KMSKeystores map[string]string `long:"kms-keystore" value-name:"ID:PATH" description:"Key which wraps data keys with the primary version of a software KMS keystore file. Can be specified multiple times."`
+
+	VaultTransitKeys    map[string]string `long:"vault-transit-key" value-name:"ID:NAME" description:"Key which wraps data keys with a named key of a Vault Transit compatible API. Can be specified multiple times."`
+	VaultTransitURL     string            `long:"vault-transit-url" description:"URL of the Vault Transit compatible API."`
+	VaultTransitToken   string            `long:"vault-transit-token" description:"Token used to authenticate with the Vault Transit compatible API."`
+	VaultTransitMount   string            `long:"vault-transit-mount" default:"transit" description:"Path at which the transit secrets engine is mounted."`
+	VaultTransitTimeout time.Duration     `long:"vault-transit-timeout" default:"10s" description:"Timeout for requests to the Vault Transit compatible API."`
+
+	RotationInterval  time.Duration `long:"rotation-interval" default:"1m" description:"Interval on which data not yet encrypted with the active key is re-encrypted."`
+	RotationBatchSize int           `long:"rotation-batch-size" default:"100" description:"Maximum number of values re-encrypted at a time."`
+}
+
+// IsConfigured identifies if an ActiveKey has been set
+func (c KeyringConfig) IsConfigured() bool {
+	return c.ActiveKey != ""
+}
+
+// Keyring returns a Keyring with every configured key, falling back on the
+// legacy Strategy for data which was encrypted before the keyring existed.
+func (c KeyringConfig) Keyring(legacy Strategy) (*Keyring, error) {
+	providers := map[string]KeyProvider{}
+
+	add := func(id string, provider KeyProvider) error {
+		if _, found := providers[id]; found {
+			return fmt.Errorf("keyring key '%s' is configured more than once", id)
+		}
+
+		providers[id] = provider
+
+		return nil
+	}
+
+	for id, path := range c.LocalKeys {
+		provider, err := NewLocalKeyProvider(path)
+		if err != nil {
+			return nil, fmt.Errorf("keyring key '%s': %w", id, err)
+		}
+
+		if err := add(id, provider); err != nil {
+			return nil, err
+		}
+	}
+
+	for id, path := range c.KMSKeystores {
+		provider, err := NewSoftwareKMS(path)
+		if err != nil {
+			return nil, fmt.Errorf("keyring key '%s': %w", id, err)
+		}
+
+		if err := add(id, provider); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(c.VaultTransitKeys) > 0 && c.VaultTransitURL == "" {
+		return nil, errors.New("a vault transit URL must be configured to use vault transit keys")
+	}
+
+	for id, name := range c.VaultTransitKeys {
+		err := add(id, VaultTransit{
+			URL:    c.VaultTransitURL,
+			Token:  c.VaultTransitToken,
+			Mount:  c.VaultTransitMount,
+			Key:    name,
+			Client: &http.Client{Timeout: c.VaultTransitTimeout},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewKeyring(c.ActiveKey, providers, legacy)
+}
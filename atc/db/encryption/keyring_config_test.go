@@ -0,0 +1,62 @@
+package encryption_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KeyringConfig", func() {
+	var (
+		config encryption.KeyringConfig
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "keyring-config")
+		Expect(err).ToNot(HaveOccurred())
+
+		keyPath := filepath.Join(tmpDir, "key")
+		Expect(ioutil.WriteFile(keyPath, []byte("000102030405060708090a0b0c0d0e0f"), 0600)).To(Succeed())
+
+		config = encryption.KeyringConfig{
+			ActiveKey: "local",
+			LocalKeys: map[string]string{"local": keyPath},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("is only configured with an active key", func() {
+		Expect(config.IsConfigured()).To(BeTrue())
+		Expect(encryption.KeyringConfig{}.IsConfigured()).To(BeFalse())
+	})
+
+	It("returns a keyring with the configured keys", func() {
+		keyring, err := config.Keyring(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyring.ActiveKeyID()).To(Equal("local"))
+	})
+
+	It("rejects a key ID configured for several providers", func() {
+		config.VaultTransitURL = "https://vault.example.com"
+		config.VaultTransitKeys = map[string]string{"local": "concourse"}
+
+		_, err := config.Keyring(nil)
+		Expect(err).To(MatchError("keyring key 'local' is configured more than once"))
+	})
+
+	It("requires a URL for vault transit keys", func() {
+		config.VaultTransitKeys = map[string]string{"vault": "concourse"}
+
+		_, err := config.Keyring(nil)
+		Expect(err).To(MatchError("a vault transit URL must be configured to use vault transit keys"))
+	})
+})
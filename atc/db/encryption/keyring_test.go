@@ -0,0 +1,175 @@
+package encryption_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/encryption/encryptionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// xorProvider is a trivially reversible KeyProvider so that tests can tell
+// which provider wrapped a data key.
+type xorProvider byte
+
+func (p xorProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	wrapped := make([]byte, len(dataKey))
+	for i, b := range dataKey {
+		wrapped[i] = b ^ byte(p)
+	}
+	return wrapped, nil
+}
+
+func (p xorProvider) UnwrapKey(wrapped []byte) ([]byte, error) {
+	return p.WrapKey(wrapped)
+}
+
+var _ = Describe("Keyring", func() {
+	var (
+		providers map[string]encryption.KeyProvider
+		legacy    *encryptionfakes.FakeStrategy
+		keyring   *encryption.Keyring
+	)
+
+	BeforeEach(func() {
+		providers = map[string]encryption.KeyProvider{
+			"old": xorProvider(1),
+			"new": xorProvider(2),
+		}
+
+		legacy = new(encryptionfakes.FakeStrategy)
+
+		var err error
+		keyring, err = encryption.NewKeyring("new", providers, legacy)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects an active key which is not on the keyring", func() {
+		_, err := encryption.NewKeyring("missing", providers, legacy)
+		Expect(err).To(MatchError("active key 'missing' is not on the keyring"))
+	})
+
+	It("rejects key IDs which cannot be tagged onto ciphertext", func() {
+		providers["a:b"] = xorProvider(3)
+
+		_, err := encryption.NewKeyring("new", providers, legacy)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("tags ciphertext with the active key", func() {
+		ciphertext, nonce, err := keyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nonce).ToNot(BeNil())
+		Expect(ciphertext).To(HavePrefix(keyring.ActivePrefix()))
+		Expect(keyring.ActivePrefix()).To(Equal("kr1:new:"))
+
+		plaintext, err := keyring.Decrypt(ciphertext, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plaintext).To(Equal([]byte("secret")))
+	})
+
+	It("decrypts data encrypted with any key on the keyring", func() {
+		oldKeyring, err := encryption.NewKeyring("old", providers, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		ciphertext, nonce, err := oldKeyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ciphertext).To(HavePrefix("kr1:old:"))
+
+		plaintext, err := keyring.Decrypt(ciphertext, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plaintext).To(Equal([]byte("secret")))
+	})
+
+	It("fails to decrypt data encrypted with a key that has been removed", func() {
+		otherKeyring, err := encryption.NewKeyring("other", map[string]encryption.KeyProvider{
+			"other": xorProvider(4),
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		ciphertext, nonce, err := otherKeyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = keyring.Decrypt(ciphertext, nonce)
+		Expect(errors.Is(err, encryption.ErrUnknownKeyID)).To(BeTrue())
+	})
+
+	It("fails to decrypt tampered ciphertext", func() {
+		ciphertext, nonce, err := keyring.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = keyring.Decrypt(ciphertext[:len(ciphertext)-2], nonce)
+		Expect(err).To(HaveOccurred())
+
+		_, err = keyring.Decrypt(ciphertext+":extra", nonce)
+		Expect(err).To(Equal(encryption.ErrMalformedCiphertext))
+	})
+
+	It("does not decrypt data that is not encrypted", func() {
+		_, err := keyring.Decrypt("plaintext", nil)
+		Expect(err).To(Equal(encryption.ErrDataIsNotEncrypted))
+	})
+
+	It("wraps data keys with the active provider and caches unwrapped keys", func() {
+		fakeProvider := new(encryptionfakes.FakeKeyProvider)
+		fakeProvider.WrapKeyStub = xorProvider(5).WrapKey
+		fakeProvider.UnwrapKeyStub = xorProvider(5).UnwrapKey
+
+		keyring, err := encryption.NewKeyring("fake", map[string]encryption.KeyProvider{
+			"fake": fakeProvider,
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		for i := 0; i < 3; i++ {
+			ciphertext, nonce, err := keyring.Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = keyring.Decrypt(ciphertext, nonce)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(fakeProvider.WrapKeyCallCount()).To(Equal(1))
+		Expect(fakeProvider.UnwrapKeyCallCount()).To(Equal(1))
+	})
+
+	Context("when the data was encrypted before the keyring was configured", func() {
+		var (
+			key        *encryption.Key
+			ciphertext string
+			nonce      *string
+		)
+
+		BeforeEach(func() {
+			block, err := aes.NewCipher([]byte("AES256Key-32Characters1234567890"))
+			Expect(err).ToNot(HaveOccurred())
+
+			aesgcm, err := cipher.NewGCM(block)
+			Expect(err).ToNot(HaveOccurred())
+
+			key = encryption.NewKey(aesgcm)
+
+			ciphertext, nonce, err = key.Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("decrypts it with the legacy strategy", func() {
+			keyring, err := encryption.NewKeyring("new", providers, key)
+			Expect(err).ToNot(HaveOccurred())
+
+			plaintext, err := keyring.Decrypt(ciphertext, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("secret")))
+		})
+
+		It("fails without a legacy strategy", func() {
+			keyring, err := encryption.NewKeyring("new", providers, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = keyring.Decrypt(ciphertext, nonce)
+			Expect(err).To(Equal(encryption.ErrUnknownKeyID))
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package encryption
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+type localKeyProvider struct {
+	aead cipher.AEAD
+}
+
+// NewLocalKeyProvider returns a KeyProvider which wraps data keys with a
+// hex-encoded 16 or 32 byte AES key read from the file at path.
+func NewLocalKeyProvider(path string) (KeyProvider, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, fmt.Errorf("key file %s is not hex-encoded: %w", path, err)
+	}
+
+	if len(key) != 16 && len(key) != 32 {
+		return nil, fmt.Errorf("key file %s must contain a 16 or 32 byte key, got %d bytes", path, len(key))
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return localKeyProvider{aead: aead}, nil
+}
+
+func (p localKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	return seal(p.aead, nil, dataKey)
+}
+
+func (p localKeyProvider) UnwrapKey(wrapped []byte) ([]byte, error) {
+	return open(p.aead, wrapped)
+}
@@ -0,0 +1,95 @@
+package encryption
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+)
+
+// SoftwareKMSKeystore is the on-disk format read by NewSoftwareKMS. Versions
+// maps a master key version to its hex-encoded 16 or 32 byte AES key.
+type SoftwareKMSKeystore struct {
+	PrimaryVersion uint32            `json:"primary_version"`
+	Versions       map[string]string `json:"versions"`
+}
+
+type softwareKMS struct {
+	primary  uint32
+	versions map[uint32]cipher.AEAD
+}
+
+// NewSoftwareKMS returns a KeyProvider backed by a keystore file holding
+// several versions of a master key. Data keys are always wrapped with the
+// primary version, but any version in the keystore can unwrap them, so the
+// master key can be rotated by adding a version and making it primary without
+// touching the data that it protects.
+func NewSoftwareKMS(path string) (KeyProvider, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var keystore SoftwareKMSKeystore
+	err = json.Unmarshal(contents, &keystore)
+	if err != nil {
+		return nil, fmt.Errorf("malformed keystore %s: %w", path, err)
+	}
+
+	kms := softwareKMS{
+		primary:  keystore.PrimaryVersion,
+		versions: map[uint32]cipher.AEAD{},
+	}
+
+	for v, hexKey := range keystore.Versions {
+		version, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("keystore %s: invalid version '%s'", path, v)
+		}
+
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("keystore %s: version %d is not hex-encoded: %w", path, version, err)
+		}
+
+		if len(key) != 16 && len(key) != 32 {
+			return nil, fmt.Errorf("keystore %s: version %d must be a 16 or 32 byte key, got %d bytes", path, version, len(key))
+		}
+
+		kms.versions[uint32(version)], err = newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, found := kms.versions[kms.primary]; !found {
+		return nil, fmt.Errorf("keystore %s: primary version %d not found", path, kms.primary)
+	}
+
+	return kms, nil
+}
+
+func (kms softwareKMS) WrapKey(dataKey []byte) ([]byte, error) {
+	version := make([]byte, 4)
+	binary.BigEndian.PutUint32(version, kms.primary)
+
+	return seal(kms.versions[kms.primary], version, dataKey)
+}
+
+func (kms softwareKMS) UnwrapKey(wrapped []byte) ([]byte, error) {
+	if len(wrapped) < 4 {
+		return nil, ErrWrappedKeyTooShort
+	}
+
+	version := binary.BigEndian.Uint32(wrapped[:4])
+
+	aead, found := kms.versions[version]
+	if !found {
+		return nil, fmt.Errorf("unknown master key version %d", version)
+	}
+
+	return open(aead, wrapped[4:])
+}
@@ -0,0 +1,107 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// VaultTransit is a KeyProvider which wraps data keys using the encrypt and
+// decrypt endpoints of a Vault Transit secrets engine, or any API compatible
+// with it.
+type VaultTransit struct {
+	URL    string
+	Token  string
+	Mount  string
+	Key    string
+	Client *http.Client
+}
+
+type vaultTransitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultTransitResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func (v VaultTransit) WrapKey(dataKey []byte) ([]byte, error) {
+	response, err := v.call("encrypt", vaultTransitRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(dataKey),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(response.Data.Ciphertext), nil
+}
+
+func (v VaultTransit) UnwrapKey(wrapped []byte) ([]byte, error) {
+	response, err := v.call("decrypt", vaultTransitRequest{
+		Ciphertext: string(wrapped),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(response.Data.Plaintext)
+}
+
+func (v VaultTransit) call(operation string, request vaultTransitRequest) (vaultTransitResponse, error) {
+	var response vaultTransitResponse
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return response, err
+	}
+
+	mount := v.Mount
+	if mount == "" {
+		mount = "transit"
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", strings.TrimSuffix(v.URL, "/"), strings.Trim(mount, "/"), operation, v.Key)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return response, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", v.Token)
+
+	client := v.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return response, err
+	}
+
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if resp.StatusCode != http.StatusOK {
+		if err == nil && len(response.Errors) > 0 {
+			return response, fmt.Errorf("vault transit %s failed: %s: %s", operation, resp.Status, strings.Join(response.Errors, "; "))
+		}
+
+		return response, fmt.Errorf("vault transit %s failed: %s", operation, resp.Status)
+	}
+
+	if err != nil {
+		return response, fmt.Errorf("malformed vault transit %s response: %w", operation, err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,257 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/migration"
+)
+
+//go:generate counterfeiter . EncryptionRotation
+
+// EncryptionRotation re-encrypts the data in every encrypted column with the
+// active key of a keyring, a few rows at a time so that it can run alongside
+// everything else.
+type EncryptionRotation interface {
+	Progress() (atc.EncryptionProgress, error)
+
+	// Reencrypt re-encrypts up to limit values which are plaintext or
+	// encrypted with anything but the active key. Values which cannot be
+	// decrypted are logged, recorded as failed and passed over until the next
+	// pass through the columns, which starts once a call goes through fewer
+	// than limit values.
+	Reencrypt(logger lager.Logger, limit int) (ReencryptResult, error)
+}
+
+// ReencryptResult counts the values a call to Reencrypt went through.
+type ReencryptResult struct {
+	Reencrypted int
+	Failed      int
+}
+
+// Total is the number of values which were either re-encrypted or failed.
+func (result ReencryptResult) Total() int {
+	return result.Reencrypted + result.Failed
+}
+
+type encryptionRotation struct {
+	conn    Conn
+	keyring *encryption.Keyring
+
+	// column is the index of the column the current pass has reached, and
+	// cursor the primary key of the last row it went through in that column
+	column int
+	cursor interface{}
+}
+
+func NewEncryptionRotation(conn Conn, keyring *encryption.Keyring) EncryptionRotation {
+	return &encryptionRotation{
+		conn:    conn,
+		keyring: keyring,
+	}
+}
+
+// pendingCondition matches the rows of the column which still need to be
+// re-encrypted, given the active prefix as $1.
+func pendingCondition(ec migration.EncryptedColumn) string {
+	return ec.Column + ` IS NOT NULL AND (` + ec.Nonce + ` IS NULL OR substr(` + ec.Column + `::text, 1, length($1)) <> $1)`
+}
+
+// failedCondition matches the pending rows of the column which failed to be
+// re-encrypted, given the table and column names as $2 and $3.
+func failedCondition(ec migration.EncryptedColumn) string {
+	return pendingCondition(ec) + ` AND ` + ec.PrimaryKey + `::text IN (
+		SELECT primary_key
+		FROM encryption_rotation_failures
+		WHERE table_name = $2
+		AND column_name = $3
+	)`
+}
+
+func (rotation *encryptionRotation) Progress() (atc.EncryptionProgress, error) {
+	progress := atc.EncryptionProgress{
+		ActiveKey: rotation.keyring.ActiveKeyID(),
+		Columns:   []atc.EncryptedColumnProgress{},
+	}
+
+	for _, ec := range migration.EncryptedColumns {
+		column := atc.EncryptedColumnProgress{
+			Table:  ec.Table,
+			Column: ec.Column,
+		}
+
+		err := rotation.conn.QueryRow(`
+			SELECT count(*),
+				count(*) FILTER (WHERE `+pendingCondition(ec)+`),
+				count(*) FILTER (WHERE `+failedCondition(ec)+`)
+			FROM `+ec.Table+`
+			WHERE `+ec.Column+` IS NOT NULL
+		`, rotation.keyring.ActivePrefix(), ec.Table, ec.Column).Scan(&column.Total, &column.Pending, &column.Failed)
+		if err != nil {
+			return atc.EncryptionProgress{}, err
+		}
+
+		column.Pending -= column.Failed
+
+		progress.Total += column.Total
+		progress.Pending += column.Pending
+		progress.Failed += column.Failed
+		progress.Columns = append(progress.Columns, column)
+	}
+
+	return progress, nil
+}
+
+func (rotation *encryptionRotation) Reencrypt(logger lager.Logger, limit int) (ReencryptResult, error) {
+	var result ReencryptResult
+
+	for result.Total() < limit && rotation.column < len(migration.EncryptedColumns) {
+		ec := migration.EncryptedColumns[rotation.column]
+
+		want := limit - result.Total()
+
+		columnResult, err := rotation.reencryptColumn(logger, ec, want)
+		result.Reencrypted += columnResult.Reencrypted
+		result.Failed += columnResult.Failed
+		if err != nil {
+			return result, err
+		}
+
+		if columnResult.Total() < want {
+			err = rotation.forgetFixedFailures(ec)
+			if err != nil {
+				return result, err
+			}
+
+			rotation.column++
+			rotation.cursor = nil
+		}
+	}
+
+	if rotation.column >= len(migration.EncryptedColumns) {
+		rotation.column = 0
+		rotation.cursor = nil
+	}
+
+	return result, nil
+}
+
+type pendingValue struct {
+	primaryKey interface{}
+	value      string
+	nonce      sql.NullString
+}
+
+func (rotation *encryptionRotation) reencryptColumn(logger lager.Logger, ec migration.EncryptedColumn, limit int) (ReencryptResult, error) {
+	var result ReencryptResult
+
+	pageCondition := `TRUE`
+	args := []interface{}{rotation.keyring.ActivePrefix(), limit}
+	if rotation.cursor != nil {
+		pageCondition = ec.PrimaryKey + ` > $3`
+		args = append(args, rotation.cursor)
+	}
+
+	rows, err := rotation.conn.Query(`
+		SELECT `+ec.PrimaryKey+`, `+ec.Column+`, `+ec.Nonce+`
+		FROM `+ec.Table+`
+		WHERE `+pendingCondition(ec)+`
+		AND `+pageCondition+`
+		ORDER BY `+ec.PrimaryKey+`
+		LIMIT $2
+	`, args...)
+	if err != nil {
+		return result, err
+	}
+
+	// read every row up-front so that the updates below do not need a second
+	// connection while the result set is still open
+	var pending []pendingValue
+	for rows.Next() {
+		var value pendingValue
+		err = rows.Scan(&value.primaryKey, &value.value, &value.nonce)
+		if err != nil {
+			rows.Close()
+			return result, err
+		}
+
+		pending = append(pending, value)
+	}
+
+	rows.Close()
+
+	for _, value := range pending {
+		rotation.cursor = value.primaryKey
+
+		plaintext := []byte(value.value)
+		if value.nonce.Valid {
+			plaintext, err = rotation.keyring.Decrypt(value.value, &value.nonce.String)
+			if err != nil {
+				logger.Error("failed-to-decrypt", err, lager.Data{
+					"table":       ec.Table,
+					"column":      ec.Column,
+					"primary-key": value.primaryKey,
+				})
+
+				err = rotation.recordFailure(ec, value.primaryKey, err)
+				if err != nil {
+					return result, err
+				}
+
+				result.Failed++
+				continue
+			}
+		}
+
+		encrypted, nonce, err := rotation.keyring.Encrypt(plaintext)
+		if err != nil {
+			return result, err
+		}
+
+		// only overwrite the value if it has not been changed since it was
+		// read, in which case it has already been encrypted with the active key
+		_, err = rotation.conn.Exec(`
+			UPDATE `+ec.Table+`
+			SET `+ec.Column+` = $1, `+ec.Nonce+` = $2
+			WHERE `+ec.PrimaryKey+` = $3
+			AND `+ec.Column+`::text = $4
+		`, encrypted, nonce, value.primaryKey, value.value)
+		if err != nil {
+			return result, err
+		}
+
+		result.Reencrypted++
+	}
+
+	return result, nil
+}
+
+func (rotation *encryptionRotation) recordFailure(ec migration.EncryptedColumn, primaryKey interface{}, failure error) error {
+	_, err := rotation.conn.Exec(`
+		INSERT INTO encryption_rotation_failures (table_name, column_name, primary_key, error)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (table_name, column_name, primary_key) DO UPDATE
+		SET error = EXCLUDED.error, failed_at = now()
+	`, ec.Table, ec.Column, fmt.Sprint(primaryKey), failure.Error())
+	return err
+}
+
+// forgetFixedFailures removes the recorded failures of rows which are gone or
+// have since been encrypted with the active key.
+func (rotation *encryptionRotation) forgetFixedFailures(ec migration.EncryptedColumn) error {
+	_, err := rotation.conn.Exec(`
+		DELETE FROM encryption_rotation_failures f
+		WHERE f.table_name = $2
+		AND f.column_name = $3
+		AND NOT EXISTS (
+			SELECT 1
+			FROM `+ec.Table+`
+			WHERE `+ec.PrimaryKey+`::text = f.primary_key
+			AND `+pendingCondition(ec)+`
+		)
+	`, rotation.keyring.ActivePrefix(), ec.Table, ec.Column)
+	return err
+}
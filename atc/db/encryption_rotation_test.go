@@ -0,0 +1,180 @@
+package db_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/encryption"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type reversingProvider struct{}
+
+func (reversingProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	wrapped := make([]byte, len(dataKey))
+	for i, b := range dataKey {
+		wrapped[len(dataKey)-1-i] = b
+	}
+	return wrapped, nil
+}
+
+func (p reversingProvider) UnwrapKey(wrapped []byte) ([]byte, error) {
+	return p.WrapKey(wrapped)
+}
+
+var _ = Describe("EncryptionRotation", func() {
+	var (
+		keyring  *encryption.Keyring
+		rotation db.EncryptionRotation
+		logger   *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		var err error
+		keyring, err = encryption.NewKeyring("some-key", map[string]encryption.KeyProvider{
+			"some-key": reversingProvider{},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		rotation = db.NewEncryptionRotation(dbConn, keyring)
+		logger = lagertest.NewTestLogger("test")
+
+		_, err = dbConn.Exec(`INSERT INTO cert_cache (domain, cert) VALUES ('some-domain', 'some-cert'), ('other-domain', 'other-cert')`)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	certCacheProgress := func() atc.EncryptedColumnProgress {
+		progress, err := rotation.Progress()
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, column := range progress.Columns {
+			if column.Table == "cert_cache" {
+				return column
+			}
+		}
+
+		Fail("cert_cache is missing from the progress")
+		return atc.EncryptedColumnProgress{}
+	}
+
+	// reencryptPass re-encrypts one value at a time until a pass through
+	// every column is complete
+	reencryptPass := func() db.ReencryptResult {
+		var total db.ReencryptResult
+		for {
+			result, err := rotation.Reencrypt(logger, 1)
+			Expect(err).ToNot(HaveOccurred())
+
+			total.Reencrypted += result.Reencrypted
+			total.Failed += result.Failed
+
+			if result.Total() == 0 {
+				return total
+			}
+		}
+	}
+
+	It("reports data which is not yet encrypted with the active key as pending", func() {
+		progress, err := rotation.Progress()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(progress.ActiveKey).To(Equal("some-key"))
+		Expect(progress.Pending).To(BeNumerically(">=", 2))
+
+		Expect(certCacheProgress()).To(Equal(atc.EncryptedColumnProgress{
+			Table:   "cert_cache",
+			Column:  "cert",
+			Total:   2,
+			Pending: 2,
+		}))
+	})
+
+	It("re-encrypts pending data with the active key", func() {
+		reencryptPass()
+
+		Expect(certCacheProgress().Pending).To(BeZero())
+
+		var (
+			cert  string
+			nonce string
+		)
+		err := dbConn.QueryRow(`SELECT cert, nonce FROM cert_cache WHERE domain = 'some-domain'`).Scan(&cert, &nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert).To(HavePrefix(keyring.ActivePrefix()))
+
+		plaintext, err := keyring.Decrypt(cert, &nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("some-cert"))
+	})
+
+	It("re-encrypts no more than the limit at a time", func() {
+		result, err := rotation.Reencrypt(logger, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(db.ReencryptResult{Reencrypted: 1}))
+	})
+
+	Context("when a value cannot be decrypted", func() {
+		BeforeEach(func() {
+			_, err := dbConn.Exec(`INSERT INTO cert_cache (domain, cert, nonce) VALUES ('broken-domain', 'not-encrypted-with-any-key', 'some-nonce')`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("skips it, re-encrypts everything else and reports it as failed", func() {
+			Expect(reencryptPass().Failed).To(BeNumerically(">=", 1))
+
+			Expect(certCacheProgress()).To(Equal(atc.EncryptedColumnProgress{
+				Table:   "cert_cache",
+				Column:  "cert",
+				Total:   3,
+				Pending: 0,
+				Failed:  1,
+			}))
+		})
+
+		It("forgets the failure once the value has been fixed", func() {
+			reencryptPass()
+
+			_, err := dbConn.Exec(`UPDATE cert_cache SET cert = 'broken-cert', nonce = NULL WHERE domain = 'broken-domain'`)
+			Expect(err).ToNot(HaveOccurred())
+
+			reencryptPass()
+
+			Expect(certCacheProgress()).To(Equal(atc.EncryptedColumnProgress{
+				Table:   "cert_cache",
+				Column:  "cert",
+				Total:   3,
+				Pending: 0,
+				Failed:  0,
+			}))
+
+			var failures int
+			err = dbConn.QueryRow(`SELECT count(*) FROM encryption_rotation_failures WHERE table_name = 'cert_cache'`).Scan(&failures)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failures).To(BeZero())
+		})
+	})
+
+	Context("when the active key changes", func() {
+		BeforeEach(func() {
+			_, err := rotation.Reencrypt(logger, 100)
+			Expect(err).ToNot(HaveOccurred())
+
+			keyring, err = encryption.NewKeyring("new-key", map[string]encryption.KeyProvider{
+				"some-key": reversingProvider{},
+				"new-key":  reversingProvider{},
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			rotation = db.NewEncryptionRotation(dbConn, keyring)
+		})
+
+		It("re-encrypts the data encrypted with the previous key", func() {
+			Expect(certCacheProgress().Pending).To(Equal(2))
+
+			reencryptPass()
+
+			Expect(certCacheProgress().Pending).To(BeZero())
+		})
+	})
+})
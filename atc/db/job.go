@@ -14,6 +14,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/tracing"
 	"github.com/lib/pq"
 )
@@ -67,6 +68,9 @@ type Job interface {
 	ScheduleRequestedTime() time.Time
 	MaxInFlight() int
 	DisableManualTrigger() bool
+	Flakiness() *atc.JobFlakiness
+	NextScheduledTime() time.Time
+	NextFireTime() time.Time
 
 	Config() (atc.JobConfig, error)
 	Inputs() ([]atc.JobInput, error)
@@ -90,7 +94,7 @@ type Job interface {
 	Build(name string) (Build, bool, error)
 	FinishedAndNextBuild() (Build, Build, error)
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
-	EnsurePendingBuildExists(context.Context) error
+	EnsurePendingBuildExists(context.Context, atc.BuildCause) error
 	GetPendingBuilds() ([]Build, error)
 
 	GetNextBuildInputs() ([]BuildInput, error)
@@ -103,11 +107,19 @@ type Job interface {
 
 	SetHasNewInputs(bool) error
 	HasNewInputs() bool
+
+	FlakinessBuilds(limit int) ([]FlakinessBuild, error)
+	SaveFlakiness(atc.JobFlakiness) error
+
+	SaveNextSchedule(scheduledTime time.Time, fireTime time.Time) error
+	CreateScheduledBuild(cause atc.BuildCause, nextScheduledTime time.Time, nextFireTime time.Time) (Build, bool, error)
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger", "jf.score", "jf.flaky_builds", "jf.analyzed_builds", "js.scheduled_time", "js.fire_time").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
+	LeftJoin("job_flakiness jf ON jf.job_id = j.id").
+	LeftJoin("job_schedules js ON js.job_id = j.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
 
 type FirstLoggedBuildIDDecreasedError struct {
@@ -135,6 +147,9 @@ type job struct {
 	scheduleRequestedTime time.Time
 	maxInFlight           int
 	disableManualTrigger  bool
+	flakiness             *atc.JobFlakiness
+	nextScheduledTime     time.Time
+	nextFireTime          time.Time
 
 	config    *atc.JobConfig
 	rawConfig *string
@@ -196,6 +211,9 @@ func (j *job) HasNewInputs() bool               { return j.hasNewInputs }
 func (j *job) ScheduleRequestedTime() time.Time { return j.scheduleRequestedTime }
 func (j *job) MaxInFlight() int                 { return j.maxInFlight }
 func (j *job) DisableManualTrigger() bool       { return j.disableManualTrigger }
+func (j *job) Flakiness() *atc.JobFlakiness     { return j.flakiness }
+func (j *job) NextScheduledTime() time.Time     { return j.nextScheduledTime }
+func (j *job) NextFireTime() time.Time          { return j.nextFireTime }
 
 func (j *job) Config() (atc.JobConfig, error) {
 	if j.config != nil {
@@ -547,10 +565,15 @@ func (j *job) ScheduleBuild(build Build) (bool, error) {
 	}
 
 	if paused {
-		return false, nil
+		err = setQueueReason(tx, build.ID(), atc.QueueReasonPaused)
+		if err != nil {
+			return false, err
+		}
+
+		return false, tx.Commit()
 	}
 
-	reached, err := j.isMaxInFlightReached(tx, build.ID())
+	reached, serialGroups, err := j.isMaxInFlightReached(tx, build.ID())
 	if err != nil {
 		return false, err
 	}
@@ -575,10 +598,35 @@ func (j *job) ScheduleBuild(build Build) (bool, error) {
 		return false, NonOneRowAffectedError{rowsAffected}
 	}
 
-	var scheduled bool
+	var quota string
+	var quotaReached bool
 	if !reached {
+		quota, quotaReached, err = j.teamQuotaReached(tx)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	var scheduled bool
+	if reached {
+		reason := atc.QueueReasonMaxInFlight
+		if len(serialGroups) > 0 {
+			reason = atc.QueueReasonSerialGroup
+		}
+
+		err = setQueueReason(tx, build.ID(), reason)
+		if err != nil {
+			return false, err
+		}
+	} else if quotaReached {
+		err = setQueueReason(tx, build.ID(), atc.QueueReasonQuota)
+		if err != nil {
+			return false, err
+		}
+	} else {
 		result, err = psql.Update("builds").
 			Set("scheduled", true).
+			Set("queue_reason", nil).
 			Where(sq.Eq{"id": build.ID()}).
 			RunWith(tx).
 			Exec()
@@ -603,6 +651,17 @@ func (j *job) ScheduleBuild(build Build) (bool, error) {
 		return false, err
 	}
 
+	// only let the build know the first time it gets held back by the quota
+	if quotaReached && build.QueueReason() != atc.QueueReasonQuota {
+		err = build.SaveEvent(event.WaitingForQuota{
+			Time:  time.Now().Unix(),
+			Quota: quota,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
 	return scheduled, nil
 }
 
@@ -665,7 +724,7 @@ func (j *job) GetNextBuildInputs() ([]BuildInput, error) {
 	return buildInputs, nil
 }
 
-func (j *job) EnsurePendingBuildExists(ctx context.Context) error {
+func (j *job) EnsurePendingBuildExists(ctx context.Context, cause atc.BuildCause) error {
 	defer tracing.FromContext(ctx).End()
 	spanContextJSON, err := json.Marshal(NewSpanContext(ctx))
 	if err != nil {
@@ -684,13 +743,23 @@ func (j *job) EnsurePendingBuildExists(ctx context.Context) error {
 		return err
 	}
 
+	err = resolveBuildCauseBuilds(tx, &cause)
+	if err != nil {
+		return err
+	}
+
+	causeJSON, err := buildCauseValue(cause)
+	if err != nil {
+		return err
+	}
+
 	rows, err := tx.Query(`
-		INSERT INTO builds (name, job_id, pipeline_id, team_id, status, needs_v6_migration, span_context)
-		SELECT $1, $2, $3, $4, 'pending', false, $5
+		INSERT INTO builds (name, job_id, pipeline_id, team_id, status, needs_v6_migration, span_context, cause)
+		SELECT $1, $2, $3, $4, 'pending', false, $5, $6
 		WHERE NOT EXISTS
 			(SELECT id FROM builds WHERE job_id = $2 AND status = 'pending')
 		RETURNING id
-	`, buildName, j.id, j.pipelineID, j.teamID, string(spanContextJSON))
+	`, buildName, j.id, j.pipelineID, j.teamID, string(spanContextJSON), causeJSON)
 	if err != nil {
 		return err
 	}
@@ -773,6 +842,24 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 }
 
 func (j *job) CreateBuild(createdBy string) (Build, error) {
+	cause, err := buildCauseValue(atc.BuildCause{
+		Type:      atc.BuildCauseManual,
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return j.createManualBuild(map[string]interface{}{
+		"created_by": createdBy,
+		"cause":      cause,
+	})
+}
+
+// createManualBuild creates a pending build whose inputs are determined once
+// it is scheduled, like a manually triggered build, along with any extra
+// columns.
+func (j *job) createManualBuild(vals map[string]interface{}) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -785,16 +872,21 @@ func (j *job) CreateBuild(createdBy string) (Build, error) {
 		return nil, err
 	}
 
-	build := newEmptyBuild(j.conn, j.lockFactory)
-	err = createBuild(tx, build, map[string]interface{}{
+	buildVals := map[string]interface{}{
 		"name":               buildName,
 		"job_id":             j.id,
 		"pipeline_id":        j.pipelineID,
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
 		"manually_triggered": true,
-		"created_by":         createdBy,
-	})
+	}
+
+	for name, value := range vals {
+		buildVals[name] = value
+	}
+
+	build := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, build, buildVals)
 	if err != nil {
 		return nil, err
 	}
@@ -846,8 +938,10 @@ func (j *job) tryRerunBuild(buildToRerun Build, createdBy string) (Build, error)
 	defer Rollback(tx)
 
 	buildToRerunID := buildToRerun.ID()
+	buildToRerunName := buildToRerun.Name()
 	if buildToRerun.RerunOf() != 0 {
 		buildToRerunID = buildToRerun.RerunOf()
+		buildToRerunName = buildToRerun.RerunOfName()
 	}
 
 	rerunBuildName, rerunNumber, err := j.getNewRerunBuildName(tx, buildToRerunID)
@@ -855,6 +949,18 @@ func (j *job) tryRerunBuild(buildToRerun Build, createdBy string) (Build, error)
 		return nil, err
 	}
 
+	cause, err := buildCauseValue(atc.BuildCause{
+		Type:      atc.BuildCauseRerun,
+		CreatedBy: createdBy,
+		RerunOf: &atc.RerunOfBuild{
+			ID:   buildToRerunID,
+			Name: buildToRerunName,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
 	err = createBuild(tx, rerunBuild, map[string]interface{}{
 		"name":         rerunBuildName,
@@ -865,6 +971,7 @@ func (j *job) tryRerunBuild(buildToRerun Build, createdBy string) (Build, error)
 		"rerun_of":     buildToRerunID,
 		"rerun_number": rerunNumber,
 		"created_by":   createdBy,
+		"cause":        cause,
 	})
 	if err != nil {
 		return nil, err
@@ -938,39 +1045,86 @@ func (j *job) AcquireSchedulingLock(logger lager.Logger) (lock.Lock, bool, error
 	)
 }
 
-func (j *job) isMaxInFlightReached(tx Tx, buildID int) (bool, error) {
+// isMaxInFlightReached also returns the job's serial groups, so that a build
+// held back by them can be told apart from one held back by max_in_flight.
+func (j *job) isMaxInFlightReached(tx Tx, buildID int) (bool, []string, error) {
 	if j.maxInFlight == 0 {
-		return false, nil
+		return false, nil, nil
 	}
 
 	serialGroups, err := j.getSerialGroups(tx)
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	builds, err := j.getRunningBuildsBySerialGroup(tx, serialGroups)
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	if len(builds) >= j.maxInFlight {
-		return true, nil
+		return true, serialGroups, nil
 	}
 
 	nextMostPendingBuild, found, err := j.getNextPendingBuildBySerialGroup(tx, serialGroups)
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 
 	if !found {
-		return true, nil
+		return true, serialGroups, nil
 	}
 
 	if nextMostPendingBuild.ID() != buildID {
-		return true, nil
+		return true, serialGroups, nil
+	}
+
+	return false, serialGroups, nil
+}
+
+// teamQuotaReached returns the name of the team quota which stops the build
+// from starting, if any. The worker quotas are enforced here rather than as
+// each step picks a worker, so that running builds, which may already hold
+// containers, can always finish and free them up.
+//
+// Jobs are scheduled concurrently, so the team is locked for the rest of the
+// transaction while its usage is checked; otherwise two of its jobs could both
+// see room for one more build and go over the quota together.
+func (j *job) teamQuotaReached(tx Tx) (string, bool, error) {
+	quota, err := teamQuota(tx, j.teamID)
+	if err != nil {
+		return "", false, err
+	}
+
+	if !limitsScheduling(quota) {
+		return "", false, nil
 	}
 
-	return false, nil
+	// read the quota again under the lock, in case it changed in the meantime
+	quota, err = lockTeamQuota(tx, j.teamID)
+	if err != nil {
+		return "", false, err
+	}
+
+	if !limitsScheduling(quota) {
+		return "", false, nil
+	}
+
+	usage, err := teamUsage(tx, j.teamID)
+	if err != nil {
+		return "", false, err
+	}
+
+	if quota.BuildsReached(usage) {
+		return atc.QuotaMaxConcurrentBuilds, true, nil
+	}
+
+	name, reached := quota.WorkerQuotaReached(usage)
+	return name, reached, nil
+}
+
+func limitsScheduling(quota *atc.TeamQuota) bool {
+	return quota != nil && (quota.MaxConcurrentBuilds != 0 || quota.MaxContainers != 0 || quota.MaxVolumeDisk != 0)
 }
 
 func (j *job) getSerialGroups(tx Tx) ([]string, error) {
@@ -1279,7 +1433,7 @@ func (j *job) getNewRerunBuildName(tx Tx, buildID int) (string, int, error) {
 }
 
 func (j *job) getNextBuildInputs(tx Tx) ([]BuildInput, error) {
-	rows, err := psql.Select("i.input_name, i.first_occurrence, i.resource_id, v.version, i.resolve_error, v.span_context").
+	rows, err := psql.Select("i.input_name, i.first_occurrence, i.resource_id, v.version, i.resolve_error, v.span_context, v.webhook").
 		From("next_build_inputs i").
 		LeftJoin("resources r ON r.id = i.resource_id").
 		LeftJoin("resource_config_versions v ON v.version_md5 = i.version_md5 AND r.resource_config_scope_id = v.resource_config_scope_id").
@@ -1301,9 +1455,10 @@ func (j *job) getNextBuildInputs(tx Tx) ([]BuildInput, error) {
 			resID           sql.NullString
 			resolveErr      sql.NullString
 			spanContextJSON sql.NullString
+			webhook         sql.NullBool
 		)
 
-		err := rows.Scan(&inputName, &firstOcc, &resID, &versionBlob, &resolveErr, &spanContextJSON)
+		err := rows.Scan(&inputName, &firstOcc, &resID, &versionBlob, &resolveErr, &spanContextJSON, &webhook)
 		if err != nil {
 			return nil, err
 		}
@@ -1348,6 +1503,7 @@ func (j *job) getNextBuildInputs(tx Tx) ([]BuildInput, error) {
 			Version:         version,
 			FirstOccurrence: firstOccurrence,
 			ResolveError:    resolveError,
+			Webhook:         webhook.Bool,
 			Context:         spanContext,
 		})
 	}
@@ -1379,13 +1535,30 @@ func scanJob(j *job, row scannable) error {
 		config               sql.NullString
 		nonce                sql.NullString
 		pipelineInstanceVars sql.NullString
+
+		flakinessScore                       sql.NullFloat64
+		flakyBuilds, flakinessAnalyzedBuilds sql.NullInt64
+
+		nextScheduledTime, nextFireTime pq.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &flakinessScore, &flakyBuilds, &flakinessAnalyzedBuilds, &nextScheduledTime, &nextFireTime)
 	if err != nil {
 		return err
 	}
 
+	j.nextScheduledTime = nextScheduledTime.Time
+	j.nextFireTime = nextFireTime.Time
+
+	j.flakiness = nil
+	if flakinessScore.Valid {
+		j.flakiness = &atc.JobFlakiness{
+			Score:          flakinessScore.Float64,
+			FlakyBuilds:    int(flakyBuilds.Int64),
+			AnalyzedBuilds: int(flakinessAnalyzedBuilds.Int64),
+		}
+	}
+
 	if nonce.Valid {
 		j.nonce = &nonce.String
 	}
@@ -1422,6 +1595,15 @@ func scanJobs(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) (Jobs, er
 	return jobs, nil
 }
 
+func setQueueReason(tx Tx, buildID int, reason atc.QueueReason) error {
+	_, err := psql.Update("builds").
+		Set("queue_reason", string(reason)).
+		Where(sq.Eq{"id": buildID}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
 func requestSchedule(tx Tx, jobID int) error {
 	result, err := psql.Update("jobs").
 		Set("schedule_requested", sq.Expr("now()")).
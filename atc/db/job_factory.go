@@ -21,6 +21,8 @@ type JobFactory interface {
 	VisibleJobs([]string) ([]atc.JobSummary, error)
 	AllActiveJobs() ([]atc.JobSummary, error)
 	JobsToSchedule() (SchedulerJobs, error)
+	JobsToAnalyzeFlakiness() (Jobs, error)
+	ScheduledJobs() (Jobs, error)
 }
 
 type jobFactory struct {
@@ -74,6 +76,43 @@ func (resources SchedulerResources) Lookup(name string) (*SchedulerResource, boo
 	return nil, false
 }
 
+// JobsToAnalyzeFlakiness returns the active jobs which have completed builds
+// since their flakiness was last analysed.
+func (j *jobFactory) JobsToAnalyzeFlakiness() (Jobs, error) {
+	rows, err := jobsQuery.
+		Where(sq.Eq{"j.active": true}).
+		Where(sq.Expr("j.latest_completed_build_id > COALESCE(jf.last_build_id, 0)")).
+		OrderBy("j.id").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(j.conn, j.lockFactory, rows)
+}
+
+// ScheduledJobs returns the active jobs which have a schedule and whose
+// pipelines and themselves are not paused.
+func (j *jobFactory) ScheduledJobs() (Jobs, error) {
+	rows, err := jobsQuery.
+		Where(sq.Expr("js.job_id IS NOT NULL")).
+		Where(sq.Eq{
+			"j.active":   true,
+			"j.paused":   false,
+			"p.paused":   false,
+			"p.archived": false,
+		}).
+		OrderBy("j.id").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(j.conn, j.lockFactory, rows)
+}
+
 func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -89,6 +128,7 @@ func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 			"j.paused": false,
 			"p.paused": false,
 		}).
+		OrderBy("j.priority DESC", "j.id").
 		RunWith(tx).
 		Query()
 	if err != nil {
@@ -284,13 +324,17 @@ func (d dashboardFactory) constructJobsForDashboard() ([]atc.JobSummary, error)
 	rows, err := psql.Select("j.id", "j.name", "p.id", "p.name", "p.instance_vars", "j.paused", "j.has_new_inputs", "j.tags", "tm.name",
 		"l.id", "l.name", "l.status", "l.start_time", "l.end_time",
 		"n.id", "n.name", "n.status", "n.start_time", "n.end_time",
-		"t.id", "t.name", "t.status", "t.start_time", "t.end_time").
+		"t.id", "t.name", "t.status", "t.start_time", "t.end_time",
+		"jf.score", "jf.flaky_builds", "jf.analyzed_builds",
+		"js.fire_time").
 		From("jobs j").
 		Join("pipelines p ON j.pipeline_id = p.id").
 		Join("teams tm ON p.team_id = tm.id").
 		LeftJoin("builds l on j.latest_completed_build_id = l.id").
 		LeftJoin("builds n on j.next_build_id = n.id").
 		LeftJoin("builds t on j.transition_build_id = t.id").
+		LeftJoin("job_flakiness jf ON jf.job_id = j.id").
+		LeftJoin("job_schedules js ON js.job_id = j.id").
 		Where(sq.Eq{
 			"j.active": true,
 		}).
@@ -316,17 +360,36 @@ func (d dashboardFactory) constructJobsForDashboard() ([]atc.JobSummary, error)
 			f, n, t nullableBuild
 
 			pipelineInstanceVars sql.NullString
+
+			flakinessScore                       sql.NullFloat64
+			flakyBuilds, flakinessAnalyzedBuilds sql.NullInt64
+
+			nextFireTime pq.NullTime
 		)
 
 		j := atc.JobSummary{}
 		err = rows.Scan(&j.ID, &j.Name, &j.PipelineID, &j.PipelineName, &pipelineInstanceVars, &j.Paused, &j.HasNewInputs, pq.Array(&j.Groups), &j.TeamName,
 			&f.id, &f.name, &f.status, &f.startTime, &f.endTime,
 			&n.id, &n.name, &n.status, &n.startTime, &n.endTime,
-			&t.id, &t.name, &t.status, &t.startTime, &t.endTime)
+			&t.id, &t.name, &t.status, &t.startTime, &t.endTime,
+			&flakinessScore, &flakyBuilds, &flakinessAnalyzedBuilds,
+			&nextFireTime)
 		if err != nil {
 			return nil, err
 		}
 
+		if nextFireTime.Valid {
+			j.NextFireTime = nextFireTime.Time.Unix()
+		}
+
+		if flakinessScore.Valid {
+			j.Flakiness = &atc.JobFlakiness{
+				Score:          flakinessScore.Float64,
+				FlakyBuilds:    int(flakyBuilds.Int64),
+				AnalyzedBuilds: int(flakinessAnalyzedBuilds.Int64),
+			}
+		}
+
 		if pipelineInstanceVars.Valid {
 			err = json.Unmarshal([]byte(pipelineInstanceVars.String), &j.PipelineInstanceVars)
 			if err != nil {
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"github.com/concourse/concourse/atc"
+)
+
+// FlakinessBuild is a succeeded or failed build of a job along with a
+// fingerprint of the input versions it ran with. Two builds with the same
+// fingerprint ran with exactly the same input versions.
+type FlakinessBuild struct {
+	ID     int
+	Status BuildStatus
+	Inputs string
+}
+
+// FlakinessBuilds returns the job's most recent succeeded or failed builds
+// which have recorded inputs, most recent first.
+func (j *job) FlakinessBuilds(limit int) ([]FlakinessBuild, error) {
+	rows, err := j.conn.Query(`
+		SELECT b.id, b.status, string_agg(i.name || ':' || i.resource_id || ':' || i.version_md5, ',' ORDER BY i.name, i.resource_id)
+		FROM (
+			SELECT id, status
+			FROM builds
+			WHERE job_id = $1
+			AND status IN ('succeeded', 'failed')
+			ORDER BY id DESC
+			LIMIT $2
+		) b
+		JOIN build_resource_config_version_inputs i ON i.build_id = b.id
+		GROUP BY b.id, b.status
+		ORDER BY b.id DESC
+	`, j.id, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	builds := []FlakinessBuild{}
+	for rows.Next() {
+		var build FlakinessBuild
+		err = rows.Scan(&build.ID, &build.Status, &build.Inputs)
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, rows.Err()
+}
+
+// SaveFlakiness stores the result of analysing the job's builds, marking
+// every build completed so far as analysed.
+func (j *job) SaveFlakiness(flakiness atc.JobFlakiness) error {
+	_, err := j.conn.Exec(`
+		INSERT INTO job_flakiness (job_id, score, flaky_builds, analyzed_builds, last_build_id, analyzed_at)
+		SELECT id, $2, $3, $4, COALESCE(latest_completed_build_id, 0), now()
+		FROM jobs
+		WHERE id = $1
+		ON CONFLICT (job_id) DO UPDATE SET
+			score = EXCLUDED.score,
+			flaky_builds = EXCLUDED.flaky_builds,
+			analyzed_builds = EXCLUDED.analyzed_builds,
+			last_build_id = EXCLUDED.last_build_id,
+			analyzed_at = EXCLUDED.analyzed_at
+	`, j.id, flakiness.Score, flakiness.FlakyBuilds, flakiness.AnalyzedBuilds)
+	if err != nil {
+		return err
+	}
+
+	j.flakiness = &flakiness
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package db
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// saveJobSchedule keeps the job's schedule in sync with its config. The next
+// fire time is forgotten whenever the schedule changes, so that it is
+// computed again from the new schedule.
+func saveJobSchedule(tx Tx, jobID int, schedule *atc.JobSchedule) error {
+	if schedule == nil {
+		_, err := psql.Delete("job_schedules").
+			Where(sq.Eq{"job_id": jobID}).
+			RunWith(tx).
+			Exec()
+		return err
+	}
+
+	payload, err := json.Marshal(schedule)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO job_schedules (job_id, schedule)
+		VALUES ($1, $2)
+		ON CONFLICT (job_id) DO UPDATE SET
+			schedule = EXCLUDED.schedule,
+			scheduled_time = CASE WHEN job_schedules.schedule = EXCLUDED.schedule THEN job_schedules.scheduled_time END,
+			fire_time = CASE WHEN job_schedules.schedule = EXCLUDED.schedule THEN job_schedules.fire_time END
+	`, jobID, string(payload))
+	return err
+}
+
+// SaveNextSchedule records the next time the job's schedule fires, along
+// with the time it will actually be triggered at once jitter is applied.
+func (j *job) SaveNextSchedule(scheduledTime time.Time, fireTime time.Time) error {
+	_, err := psql.Update("job_schedules").
+		Set("scheduled_time", scheduledTime).
+		Set("fire_time", fireTime).
+		Where(sq.Eq{"job_id": j.id}).
+		RunWith(j.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	j.nextScheduledTime = scheduledTime
+	j.nextFireTime = fireTime
+
+	return nil
+}
+
+// CreateScheduledBuild creates a build triggered by the job's schedule and
+// moves the schedule on to its next fire time, in one transaction. Nothing is
+// created if the schedule has moved on since the job was loaded, so that each
+// fire time is only triggered once no matter how many web nodes see it.
+//
+// Like a build created for new versions, the build runs with the job's next
+// build inputs.
+func (j *job) CreateScheduledBuild(cause atc.BuildCause, nextScheduledTime time.Time, nextFireTime time.Time) (Build, bool, error) {
+	payload, err := buildCauseValue(cause)
+	if err != nil {
+		return nil, false, err
+	}
+
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("job_schedules").
+		Set("scheduled_time", nextScheduledTime).
+		Set("fire_time", nextFireTime).
+		Where(sq.Eq{
+			"job_id":         j.id,
+			"scheduled_time": j.nextScheduledTime,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if affected == 0 {
+		return nil, false, nil
+	}
+
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	build := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, build, map[string]interface{}{
+		"name":        buildName,
+		"job_id":      j.id,
+		"pipeline_id": j.pipelineID,
+		"team_id":     j.teamID,
+		"status":      BuildStatusPending,
+		"cause":       payload,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	latestNonRerunID, err := latestCompletedNonRerunBuild(tx, j.id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = updateNextBuildForJob(tx, j.id, latestNonRerunID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = requestSchedule(tx, j.id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	j.nextScheduledTime = nextScheduledTime
+	j.nextFireTime = nextFireTime
+
+	return build, true, nil
+}
@@ -3,6 +3,7 @@ package db_test
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -619,6 +620,15 @@ var _ = Describe("Job", func() {
 				Expect(build.Status()).To(Equal(rerunBuild.Status()))
 			})
 
+			It("records the rerun build as the cause", func() {
+				Expect(rerunErr).ToNot(HaveOccurred())
+				Expect(rerunBuild.Cause()).To(Equal(&atc.BuildCause{
+					Type:      atc.BuildCauseRerun,
+					CreatedBy: defaultBuildCreatedBy,
+					RerunOf:   &atc.RerunOfBuild{ID: firstBuild.ID(), Name: firstBuild.Name()},
+				}))
+			})
+
 			It("requests schedule on the job", func() {
 				requestedSchedule := job.ScheduleRequestedTime()
 
@@ -888,6 +898,132 @@ var _ = Describe("Job", func() {
 					Expect(scheduleFound).To(BeTrue())
 				})
 
+				Context("when the team is running as many builds as its quota allows", func() {
+					BeforeEach(func() {
+						err := team.UpdateQuota(atc.TeamQuota{MaxConcurrentBuilds: 1})
+						Expect(err).ToNot(HaveOccurred())
+
+						runningBuild, err := team.CreateOneOffBuild()
+						Expect(err).ToNot(HaveOccurred())
+
+						_, err = runningBuild.Start(atc.Plan{})
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("does not schedule the build and records that it is waiting for the quota", func() {
+						Expect(schedulingErr).ToNot(HaveOccurred())
+						Expect(scheduleFound).To(BeFalse())
+						Expect(schedulingBuild.QueueReason()).To(Equal(atc.QueueReasonQuota))
+					})
+
+					It("saves a waiting-for-quota event", func() {
+						events, err := schedulingBuild.Events(0)
+						Expect(err).ToNot(HaveOccurred())
+
+						defer db.Close(events)
+
+						e, err := events.Next()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(e.Event).To(Equal(atc.EventType("waiting-for-quota")))
+					})
+				})
+
+				Context("when the team's jobs are scheduled concurrently", func() {
+					var jobs []db.Job
+					var builds []db.Build
+
+					BeforeEach(func() {
+						err := team.UpdateQuota(atc.TeamQuota{MaxConcurrentBuilds: 2})
+						Expect(err).ToNot(HaveOccurred())
+
+						config := atc.Config{}
+						for i := 0; i < 5; i++ {
+							config.Jobs = append(config.Jobs, atc.JobConfig{Name: fmt.Sprintf("concurrent-job-%d", i)})
+						}
+
+						concurrentPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "concurrent-pipeline"}, config, db.ConfigVersion(0), false)
+						Expect(err).ToNot(HaveOccurred())
+
+						jobs = nil
+						builds = nil
+						for _, jobConfig := range config.Jobs {
+							job, found, err := concurrentPipeline.Job(jobConfig.Name)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(found).To(BeTrue())
+
+							build, err := job.CreateBuild(defaultBuildCreatedBy)
+							Expect(err).ToNot(HaveOccurred())
+
+							jobs = append(jobs, job)
+							builds = append(builds, build)
+						}
+					})
+
+					It("never schedules more builds than the quota allows", func() {
+						// the scheduling build already takes one of the two
+						scheduled := make(chan bool, len(jobs))
+
+						wg := new(sync.WaitGroup)
+						for i := range jobs {
+							wg.Add(1)
+							go func(job db.Job, build db.Build) {
+								defer GinkgoRecover()
+								defer wg.Done()
+
+								found, err := job.ScheduleBuild(build)
+								Expect(err).ToNot(HaveOccurred())
+								scheduled <- found
+							}(jobs[i], builds[i])
+						}
+
+						wg.Wait()
+						close(scheduled)
+
+						count := 0
+						for found := range scheduled {
+							if found {
+								count++
+							}
+						}
+
+						Expect(count).To(Equal(1))
+					})
+				})
+
+				Context("when the team has as many containers as its quota allows", func() {
+					BeforeEach(func() {
+						runningBuild, err := team.CreateOneOffBuild()
+						Expect(err).ToNot(HaveOccurred())
+
+						_, err = defaultWorker.CreateContainer(
+							db.NewBuildStepContainerOwner(runningBuild.ID(), atc.PlanID("some-plan"), team.ID()),
+							db.ContainerMetadata{Type: "task", StepName: "some-task"},
+						)
+						Expect(err).ToNot(HaveOccurred())
+
+						err = team.UpdateQuota(atc.TeamQuota{MaxContainers: 1})
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("does not schedule the build", func() {
+						Expect(schedulingErr).ToNot(HaveOccurred())
+						Expect(scheduleFound).To(BeFalse())
+						Expect(schedulingBuild.QueueReason()).To(Equal(atc.QueueReasonQuota))
+					})
+
+					It("records which quota the build is waiting for", func() {
+						events, err := schedulingBuild.Events(0)
+						Expect(err).ToNot(HaveOccurred())
+
+						defer db.Close(events)
+
+						e, err := events.Next()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(e.Event).To(Equal(atc.EventType("waiting-for-quota")))
+						Expect(string(*e.Data)).To(ContainSubstring(atc.QuotaMaxContainers))
+					})
+				})
+
 				Context("when build exists", func() {
 					Context("when the pipeline is paused", func() {
 						BeforeEach(func() {
@@ -1927,7 +2063,7 @@ var _ = Describe("Job", func() {
 	Describe("EnsurePendingBuildExists", func() {
 		Context("when only a started build exists", func() {
 			It("creates a build and updates the next build for the job", func() {
-				err := job.EnsurePendingBuildExists(context.TODO())
+				err := job.EnsurePendingBuildExists(context.TODO(), atc.BuildCause{Type: atc.BuildCauseInputs})
 				Expect(err).NotTo(HaveOccurred())
 
 				pendingBuilds, err := job.GetPendingBuilds()
@@ -1952,7 +2088,7 @@ var _ = Describe("Job", func() {
 					ctx, span := tracing.StartSpan(context.Background(), "fake-operation", nil)
 					traceID := span.SpanContext().TraceID.String()
 
-					job.EnsurePendingBuildExists(ctx)
+					job.EnsurePendingBuildExists(ctx, atc.BuildCause{Type: atc.BuildCauseInputs})
 
 					pendingBuilds, _ := job.GetPendingBuilds()
 					spanContext := pendingBuilds[0].SpanContext()
@@ -1961,11 +2097,52 @@ var _ = Describe("Job", func() {
 				})
 			})
 
+			It("records the cause, along with the upstream builds the inputs passed", func() {
+				upstreamJob, found, err := pipeline.Job("job-1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				upstreamBuild, err := upstreamJob.CreateBuild(defaultBuildCreatedBy)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = job.EnsurePendingBuildExists(context.TODO(), atc.BuildCause{
+					Type: atc.BuildCauseInputs,
+					Inputs: []atc.BuildCauseInput{
+						{
+							Name:     "some-input",
+							Resource: "some-resource",
+							Version:  atc.Version{"ref": "v1"},
+							Trigger:  true,
+							Passed:   []atc.BuildCauseBuild{{ID: upstreamBuild.ID()}},
+						},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				pendingBuilds, err := job.GetPendingBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pendingBuilds).To(HaveLen(1))
+				Expect(pendingBuilds[0].Cause()).To(Equal(&atc.BuildCause{
+					Type: atc.BuildCauseInputs,
+					Inputs: []atc.BuildCauseInput{
+						{
+							Name:     "some-input",
+							Resource: "some-resource",
+							Version:  atc.Version{"ref": "v1"},
+							Trigger:  true,
+							Passed: []atc.BuildCauseBuild{
+								{ID: upstreamBuild.ID(), Name: upstreamBuild.Name(), JobName: "job-1"},
+							},
+						},
+					},
+				}))
+			})
+
 			It("doesn't create another build the second time it's called", func() {
-				err := job.EnsurePendingBuildExists(context.TODO())
+				err := job.EnsurePendingBuildExists(context.TODO(), atc.BuildCause{Type: atc.BuildCauseInputs})
 				Expect(err).NotTo(HaveOccurred())
 
-				err = job.EnsurePendingBuildExists(context.TODO())
+				err = job.EnsurePendingBuildExists(context.TODO(), atc.BuildCause{Type: atc.BuildCauseInputs})
 				Expect(err).NotTo(HaveOccurred())
 
 				builds2, err := job.GetPendingBuilds()
@@ -2706,4 +2883,292 @@ var _ = Describe("Job", func() {
 			}))
 		})
 	})
+
+	Describe("Flakiness", func() {
+		var resource db.Resource
+
+		createFinishedBuild := func(status db.BuildStatus, versionMD5 string) db.Build {
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			if versionMD5 != "" {
+				_, err = dbConn.Exec(`INSERT INTO build_resource_config_version_inputs (build_id, resource_id, version_md5, name) VALUES ($1, $2, $3, 'some-input')`, build.ID(), resource.ID(), versionMD5)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			err = build.Finish(status)
+			Expect(err).ToNot(HaveOccurred())
+
+			return build
+		}
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("is not set until the job has been analysed", func() {
+			Expect(job.Flakiness()).To(BeNil())
+		})
+
+		Describe("FlakinessBuilds", func() {
+			var failed, succeeded db.Build
+
+			BeforeEach(func() {
+				createFinishedBuild(db.BuildStatusSucceeded, "")
+				failed = createFinishedBuild(db.BuildStatusFailed, "v1")
+				createFinishedBuild(db.BuildStatusErrored, "v1")
+				succeeded = createFinishedBuild(db.BuildStatusSucceeded, "v1")
+			})
+
+			It("returns the succeeded and failed builds with inputs, most recent first", func() {
+				builds, err := job.FlakinessBuilds(10)
+				Expect(err).ToNot(HaveOccurred())
+
+				inputs := fmt.Sprintf("some-input:%d:v1", resource.ID())
+				Expect(builds).To(Equal([]db.FlakinessBuild{
+					{ID: succeeded.ID(), Status: db.BuildStatusSucceeded, Inputs: inputs},
+					{ID: failed.ID(), Status: db.BuildStatusFailed, Inputs: inputs},
+				}))
+			})
+
+			It("only looks at up to the given number of builds", func() {
+				builds, err := job.FlakinessBuilds(1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(builds).To(HaveLen(1))
+				Expect(builds[0].ID).To(Equal(succeeded.ID()))
+			})
+		})
+
+		Describe("SaveFlakiness", func() {
+			flakiness := atc.JobFlakiness{Score: 0.25, FlakyBuilds: 1, AnalyzedBuilds: 4}
+
+			BeforeEach(func() {
+				createFinishedBuild(db.BuildStatusFailed, "v1")
+			})
+
+			It("stores the flakiness of the job", func() {
+				err := job.SaveFlakiness(flakiness)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := job.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.Flakiness()).To(Equal(&flakiness))
+			})
+
+			It("is no longer to be analysed until another build completes", func() {
+				jobFactory := db.NewJobFactory(dbConn, lockFactory)
+
+				jobs, err := jobFactory.JobsToAnalyzeFlakiness()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobNames(jobs)).To(ContainElement("some-job"))
+
+				err = job.SaveFlakiness(flakiness)
+				Expect(err).ToNot(HaveOccurred())
+
+				jobs, err = jobFactory.JobsToAnalyzeFlakiness()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobNames(jobs)).ToNot(ContainElement("some-job"))
+
+				createFinishedBuild(db.BuildStatusSucceeded, "v1")
+
+				jobs, err = jobFactory.JobsToAnalyzeFlakiness()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobNames(jobs)).To(ContainElement("some-job"))
+			})
+		})
+	})
+
+	Describe("Schedule", func() {
+		var (
+			jobFactory    db.JobFactory
+			scheduledTime time.Time
+			fireTime      time.Time
+		)
+
+		saveSchedule := func(schedule *atc.JobSchedule) {
+			config, err := pipeline.Config()
+			Expect(err).ToNot(HaveOccurred())
+
+			for i := range config.Jobs {
+				if config.Jobs[i].Name == "some-job" {
+					config.Jobs[i].Schedule = schedule
+				}
+			}
+
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, config, pipeline.ConfigVersion(), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		}
+
+		BeforeEach(func() {
+			jobFactory = db.NewJobFactory(dbConn, lockFactory)
+
+			scheduledTime = time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+			fireTime = scheduledTime.Add(time.Minute)
+
+			saveSchedule(&atc.JobSchedule{Cron: "0 * * * *", Jitter: "5m"})
+		})
+
+		It("is returned as a scheduled job", func() {
+			jobs, err := jobFactory.ScheduledJobs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobNames(jobs)).To(Equal([]string{"some-job"}))
+		})
+
+		It("has no next fire time until it is saved", func() {
+			Expect(job.NextScheduledTime()).To(BeZero())
+			Expect(job.NextFireTime()).To(BeZero())
+
+			err := job.SaveNextSchedule(scheduledTime, fireTime)
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.NextScheduledTime()).To(BeTemporally("==", scheduledTime))
+			Expect(job.NextFireTime()).To(BeTemporally("==", fireTime))
+		})
+
+		Context("when the next fire time has been saved", func() {
+			BeforeEach(func() {
+				err := job.SaveNextSchedule(scheduledTime, fireTime)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps it when the schedule is unchanged", func() {
+				saveSchedule(&atc.JobSchedule{Cron: "0 * * * *", Jitter: "5m"})
+				Expect(job.NextFireTime()).To(BeTemporally("==", fireTime))
+			})
+
+			It("forgets it when the schedule changes", func() {
+				saveSchedule(&atc.JobSchedule{Cron: "30 * * * *"})
+				Expect(job.NextFireTime()).To(BeZero())
+			})
+		})
+
+		Context("when the schedule is removed", func() {
+			BeforeEach(func() {
+				saveSchedule(nil)
+			})
+
+			It("is no longer a scheduled job", func() {
+				jobs, err := jobFactory.ScheduledJobs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobs).To(BeEmpty())
+			})
+		})
+
+		Context("when the job is paused", func() {
+			BeforeEach(func() {
+				err := job.Pause()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("is not a scheduled job", func() {
+				jobs, err := jobFactory.ScheduledJobs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobs).To(BeEmpty())
+			})
+		})
+
+		Describe("CreateScheduledBuild", func() {
+			var (
+				cause             atc.BuildCause
+				nextScheduledTime time.Time
+				nextFireTime      time.Time
+			)
+
+			BeforeEach(func() {
+				err := job.SaveNextSchedule(scheduledTime, fireTime)
+				Expect(err).ToNot(HaveOccurred())
+
+				cause = atc.BuildCause{
+					Type: atc.BuildCauseSchedule,
+					Schedule: &atc.ScheduleCause{
+						Cron:          "0 * * * *",
+						ScheduledTime: scheduledTime.Unix(),
+					},
+				}
+
+				nextScheduledTime = scheduledTime.Add(time.Hour)
+				nextFireTime = nextScheduledTime.Add(2 * time.Minute)
+			})
+
+			It("creates a pending build recording its cause", func() {
+				build, created, err := job.CreateScheduledBuild(cause, nextScheduledTime, nextFireTime)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+				Expect(build.Status()).To(Equal(db.BuildStatusPending))
+				Expect(build.IsManuallyTriggered()).To(BeFalse())
+
+				found, err := build.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.Cause()).To(Equal(&cause))
+				Expect(build.CreatedBy()).To(BeNil())
+			})
+
+			It("saves the next fire time", func() {
+				_, _, err := job.CreateScheduledBuild(cause, nextScheduledTime, nextFireTime)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := job.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.NextScheduledTime()).To(BeTemporally("==", nextScheduledTime))
+				Expect(job.NextFireTime()).To(BeTemporally("==", nextFireTime))
+			})
+
+			It("requests the job to be scheduled", func() {
+				requestedSchedule := job.ScheduleRequestedTime()
+
+				_, _, err := job.CreateScheduledBuild(cause, nextScheduledTime, nextFireTime)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := job.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.ScheduleRequestedTime()).To(BeTemporally(">", requestedSchedule))
+			})
+
+			Context("when the schedule has already moved on", func() {
+				BeforeEach(func() {
+					staleJob, found, err := pipeline.Job("some-job")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					_, created, err := staleJob.CreateScheduledBuild(cause, nextScheduledTime, nextFireTime)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(created).To(BeTrue())
+				})
+
+				It("does not create another build", func() {
+					_, created, err := job.CreateScheduledBuild(cause, nextScheduledTime, nextFireTime)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(created).To(BeFalse())
+
+					builds, _, err := job.Builds(db.Page{Limit: 10})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(builds).To(HaveLen(1))
+				})
+			})
+		})
+	})
 })
+
+func jobNames(jobs db.Jobs) []string {
+	var names []string
+	for _, job := range jobs {
+		names = append(names, job.Name())
+	}
+
+	return names
+}
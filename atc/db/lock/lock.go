@@ -21,6 +21,7 @@ const (
 	LockTypeDatabaseMigration
 	LockTypeResourceScanning
 	LockTypeJobScheduling
+	LockTypeAuditEventSaving
 )
 
 var ErrLostLock = errors.New("lock was lost while held, possibly due to connection breakage")
@@ -0,0 +1,6 @@
+
+ALTER TABLE workers
+  DROP COLUMN allocatable_cpu,
+  DROP COLUMN allocatable_memory,
+  DROP COLUMN reserved_cpu,
+  DROP COLUMN reserved_memory;
@@ -0,0 +1,6 @@
+
+ALTER TABLE workers
+  ADD COLUMN allocatable_cpu bigint,
+  ADD COLUMN allocatable_memory bigint,
+  ADD COLUMN reserved_cpu bigint NOT NULL DEFAULT 0,
+  ADD COLUMN reserved_memory bigint NOT NULL DEFAULT 0;
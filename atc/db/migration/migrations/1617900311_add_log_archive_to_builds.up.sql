@@ -0,0 +1,2 @@
+
+ALTER TABLE builds ADD COLUMN log_archive text;
@@ -0,0 +1,8 @@
+
+DROP TABLE notification_deliveries;
+
+DROP TABLE build_notifications;
+
+ALTER TABLE pipelines
+  DROP COLUMN notifications,
+  DROP COLUMN notifications_nonce;
@@ -0,0 +1,25 @@
+
+ALTER TABLE pipelines
+  ADD COLUMN notifications text,
+  ADD COLUMN notifications_nonce text;
+
+CREATE TABLE build_notifications (
+  build_id bigint PRIMARY KEY REFERENCES builds (id) ON DELETE CASCADE,
+  previous_status build_status
+);
+
+CREATE TABLE notification_deliveries (
+  id serial PRIMARY KEY,
+  build_id bigint NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+  sink text NOT NULL,
+  status text NOT NULL DEFAULT 'pending',
+  attempts integer NOT NULL DEFAULT 0,
+  last_error text,
+  next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX notification_deliveries_build_id_idx ON notification_deliveries (build_id);
+
+CREATE INDEX notification_deliveries_pending_idx ON notification_deliveries (next_attempt_at) WHERE status = 'pending';
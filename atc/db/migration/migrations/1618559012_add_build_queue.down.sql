@@ -0,0 +1,3 @@
+ALTER TABLE builds DROP COLUMN queue_reason;
+
+ALTER TABLE jobs DROP COLUMN priority;
@@ -0,0 +1,3 @@
+ALTER TABLE jobs ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+ALTER TABLE builds ADD COLUMN queue_reason text;
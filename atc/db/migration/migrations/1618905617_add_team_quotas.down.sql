@@ -0,0 +1,3 @@
+ALTER TABLE volumes DROP COLUMN size;
+
+ALTER TABLE teams DROP COLUMN quota;
@@ -0,0 +1,3 @@
+ALTER TABLE teams ADD COLUMN quota json;
+
+ALTER TABLE volumes ADD COLUMN size bigint NOT NULL DEFAULT 0;
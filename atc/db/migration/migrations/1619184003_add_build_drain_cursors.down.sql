@@ -0,0 +1 @@
+DROP TABLE build_drain_cursors;
@@ -0,0 +1,6 @@
+CREATE TABLE build_drain_cursors (
+  build_id bigint NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+  sink text NOT NULL,
+  cursor integer NOT NULL DEFAULT 0,
+  PRIMARY KEY (build_id, sink)
+);
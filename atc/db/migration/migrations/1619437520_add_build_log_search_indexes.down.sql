@@ -0,0 +1,40 @@
+CREATE OR REPLACE FUNCTION on_pipeline_insert() RETURNS TRIGGER AS $$
+BEGIN
+  EXECUTE format('CREATE TABLE IF NOT EXISTS pipeline_build_events_%s () INHERITS (build_events)', NEW.id);
+  EXECUTE format('CREATE UNIQUE INDEX pipeline_build_events_%s_build_id_event_id ON pipeline_build_events_%s (build_id, event_id)', NEW.id, NEW.id);
+  EXECUTE format('CREATE UNIQUE INDEX pipeline_build_events_%s_build_id_old_event_id ON pipeline_build_events_%s (build_id_old, event_id)', NEW.id, NEW.id);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION on_team_insert() RETURNS TRIGGER AS $$
+BEGIN
+  EXECUTE format('CREATE TABLE IF NOT EXISTS team_build_events_%s () INHERITS (build_events)', NEW.id);
+  EXECUTE format('CREATE UNIQUE INDEX team_build_events_%s_build_id_event_id ON team_build_events_%s (build_id, event_id)', NEW.id, NEW.id);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+  pipeline record;
+BEGIN
+FOR pipeline IN
+  SELECT id, name FROM pipelines
+LOOP
+  EXECUTE format('DROP INDEX IF EXISTS pipeline_build_events_%s_log_search', pipeline.id);
+END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+  team record;
+BEGIN
+FOR team IN
+  SELECT id, name FROM teams
+LOOP
+  EXECUTE format('DROP INDEX IF EXISTS team_build_events_%s_log_search', team.id);
+END LOOP;
+END;
+$$ LANGUAGE plpgsql;
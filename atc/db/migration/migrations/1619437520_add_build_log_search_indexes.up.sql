@@ -0,0 +1,21 @@
+-- new partitions are indexed for log search as they are created; existing
+-- ones are indexed concurrently by the log search indexer, since building
+-- them here would lock every partition for the whole migration
+CREATE OR REPLACE FUNCTION on_pipeline_insert() RETURNS TRIGGER AS $$
+BEGIN
+  EXECUTE format('CREATE TABLE IF NOT EXISTS pipeline_build_events_%s () INHERITS (build_events)', NEW.id);
+  EXECUTE format('CREATE UNIQUE INDEX pipeline_build_events_%s_build_id_event_id ON pipeline_build_events_%s (build_id, event_id)', NEW.id, NEW.id);
+  EXECUTE format('CREATE UNIQUE INDEX pipeline_build_events_%s_build_id_old_event_id ON pipeline_build_events_%s (build_id_old, event_id)', NEW.id, NEW.id);
+  EXECUTE format('CREATE INDEX pipeline_build_events_%s_log_search ON pipeline_build_events_%s USING gin (to_tsvector(''simple'', payload::json->>''payload'')) WHERE type = ''log''', NEW.id, NEW.id);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION on_team_insert() RETURNS TRIGGER AS $$
+BEGIN
+  EXECUTE format('CREATE TABLE IF NOT EXISTS team_build_events_%s () INHERITS (build_events)', NEW.id);
+  EXECUTE format('CREATE UNIQUE INDEX team_build_events_%s_build_id_event_id ON team_build_events_%s (build_id, event_id)', NEW.id, NEW.id);
+  EXECUTE format('CREATE INDEX team_build_events_%s_log_search ON team_build_events_%s USING gin (to_tsvector(''simple'', payload::json->>''payload'')) WHERE type = ''log''', NEW.id, NEW.id);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
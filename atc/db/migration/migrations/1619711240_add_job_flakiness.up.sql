@@ -0,0 +1,8 @@
+CREATE TABLE job_flakiness (
+  job_id integer PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE,
+  score double precision NOT NULL DEFAULT 0,
+  flaky_builds integer NOT NULL DEFAULT 0,
+  analyzed_builds integer NOT NULL DEFAULT 0,
+  last_build_id integer NOT NULL DEFAULT 0,
+  analyzed_at timestamp with time zone NOT NULL DEFAULT now()
+);
@@ -0,0 +1 @@
+ALTER TABLE workers ADD COLUMN labels text;
@@ -0,0 +1,3 @@
+ALTER TABLE builds DROP COLUMN cause;
+
+DROP TABLE job_schedules;
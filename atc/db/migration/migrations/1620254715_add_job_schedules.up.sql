@@ -0,0 +1,8 @@
+CREATE TABLE job_schedules (
+  job_id integer PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE,
+  schedule text NOT NULL,
+  scheduled_time timestamp with time zone,
+  fire_time timestamp with time zone
+);
+
+ALTER TABLE builds ADD COLUMN cause jsonb;
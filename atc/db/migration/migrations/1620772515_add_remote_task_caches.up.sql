@@ -0,0 +1,15 @@
+CREATE TABLE remote_task_caches (
+  id serial PRIMARY KEY,
+  team_id integer NOT NULL,
+  job_id integer NOT NULL,
+  step_name text NOT NULL,
+  path text NOT NULL,
+  key text NOT NULL,
+  encoding text NOT NULL,
+  size bigint NOT NULL DEFAULT 0,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  last_used_at timestamp with time zone NOT NULL DEFAULT now(),
+  UNIQUE (job_id, step_name, path)
+);
+
+CREATE INDEX remote_task_caches_team_id_idx ON remote_task_caches (team_id);
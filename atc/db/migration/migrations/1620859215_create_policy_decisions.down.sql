@@ -0,0 +1 @@
+DROP TABLE policy_decisions;
@@ -0,0 +1,13 @@
+CREATE TABLE policy_decisions (
+  id bigserial PRIMARY KEY,
+  input_hash text NOT NULL,
+  action text NOT NULL,
+  team text,
+  pipeline text,
+  result text NOT NULL,
+  reasons text[] NOT NULL DEFAULT '{}',
+  created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX policy_decisions_team_idx ON policy_decisions (team);
+CREATE INDEX policy_decisions_created_at_idx ON policy_decisions (created_at);
@@ -0,0 +1,3 @@
+DROP TABLE audit_drain_cursors;
+
+DROP TABLE audit_events;
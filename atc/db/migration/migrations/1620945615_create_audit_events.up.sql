@@ -0,0 +1,18 @@
+CREATE TABLE audit_events (
+  id bigserial PRIMARY KEY,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  user_name text NOT NULL,
+  team text,
+  action text NOT NULL,
+  target text NOT NULL,
+  params jsonb,
+  status integer NOT NULL
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+CREATE INDEX audit_events_team_idx ON audit_events (team);
+
+CREATE TABLE audit_drain_cursors (
+  sink text PRIMARY KEY,
+  cursor bigint NOT NULL
+);
@@ -0,0 +1,3 @@
+DROP TABLE service_account_tokens;
+
+DROP TABLE service_accounts;
@@ -0,0 +1 @@
+ALTER TABLE teams ADD COLUMN custom_roles json;
@@ -0,0 +1,11 @@
+CREATE TABLE pipeline_config_history (
+  id serial PRIMARY KEY,
+  pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+  revision integer NOT NULL,
+  config text NOT NULL,
+  nonce text,
+  author text,
+  message text,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  UNIQUE (pipeline_id, revision)
+);
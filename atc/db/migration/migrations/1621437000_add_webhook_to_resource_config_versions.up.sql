@@ -0,0 +1 @@
+ALTER TABLE resource_config_versions ADD COLUMN webhook boolean NOT NULL DEFAULT false;
@@ -0,0 +1,8 @@
+CREATE TABLE encryption_rotation_failures (
+  table_name text NOT NULL,
+  column_name text NOT NULL,
+  primary_key text NOT NULL,
+  error text NOT NULL,
+  failed_at timestamp with time zone NOT NULL DEFAULT now(),
+  PRIMARY KEY (table_name, column_name, primary_key)
+);
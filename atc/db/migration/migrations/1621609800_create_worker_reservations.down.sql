@@ -0,0 +1,5 @@
+ALTER TABLE workers
+  ADD COLUMN reserved_cpu bigint NOT NULL DEFAULT 0,
+  ADD COLUMN reserved_memory bigint NOT NULL DEFAULT 0;
+
+DROP TABLE worker_reservations;
@@ -0,0 +1,15 @@
+CREATE TABLE worker_reservations (
+  worker_name text NOT NULL REFERENCES workers (name) ON DELETE CASCADE,
+  owner text NOT NULL,
+  cpu bigint NOT NULL,
+  memory bigint NOT NULL,
+  container_id integer REFERENCES containers (id) ON DELETE CASCADE,
+  reserved_at timestamp with time zone NOT NULL DEFAULT now(),
+  PRIMARY KEY (worker_name, owner)
+);
+
+CREATE INDEX worker_reservations_container_id_idx ON worker_reservations (container_id);
+
+ALTER TABLE workers
+  DROP COLUMN reserved_cpu,
+  DROP COLUMN reserved_memory;
@@ -0,0 +1 @@
+DROP INDEX builds_team_id_running_idx;
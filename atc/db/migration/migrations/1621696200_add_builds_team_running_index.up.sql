@@ -0,0 +1 @@
+CREATE INDEX builds_team_id_running_idx ON builds (team_id) WHERE status IN ('pending', 'started');
@@ -0,0 +1,202 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+var notificationDeliveriesQuery = psql.Select(`
+		d.id,
+		d.build_id,
+		d.sink,
+		d.status,
+		d.attempts,
+		d.last_error,
+		d.created_at,
+		d.updated_at,
+		b.name,
+		b.status,
+		j.name,
+		p.id,
+		p.name,
+		p.instance_vars,
+		t.name
+	`).
+	From("notification_deliveries d").
+	Join("builds b ON b.id = d.build_id").
+	Join("jobs j ON j.id = b.job_id").
+	Join("pipelines p ON p.id = j.pipeline_id").
+	Join("teams t ON t.id = p.team_id")
+
+//go:generate counterfeiter . NotificationDelivery
+
+// NotificationDelivery is a single attempt-tracked delivery of a finished
+// build's notification to one of its pipeline's sinks.
+type NotificationDelivery interface {
+	ID() int
+	Sink() string
+	Status() atc.NotificationDeliveryStatus
+	Attempts() int
+	LastError() string
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+
+	BuildID() int
+	BuildName() string
+	BuildStatus() BuildStatus
+	JobName() string
+	PipelineID() int
+	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
+	TeamName() string
+
+	Delivered() error
+	Retry(cause error, after time.Duration) error
+	GiveUp(cause error) error
+}
+
+type notificationDelivery struct {
+	id        int
+	sink      string
+	status    atc.NotificationDeliveryStatus
+	attempts  int
+	lastError string
+	createdAt time.Time
+	updatedAt time.Time
+
+	buildID              int
+	buildName            string
+	buildStatus          BuildStatus
+	jobName              string
+	pipelineID           int
+	pipelineName         string
+	pipelineInstanceVars atc.InstanceVars
+	teamName             string
+
+	conn Conn
+}
+
+func (d *notificationDelivery) ID() int                                { return d.id }
+func (d *notificationDelivery) Sink() string                           { return d.sink }
+func (d *notificationDelivery) Status() atc.NotificationDeliveryStatus { return d.status }
+func (d *notificationDelivery) Attempts() int                          { return d.attempts }
+func (d *notificationDelivery) LastError() string                      { return d.lastError }
+func (d *notificationDelivery) CreatedAt() time.Time                   { return d.createdAt }
+func (d *notificationDelivery) UpdatedAt() time.Time                   { return d.updatedAt }
+
+func (d *notificationDelivery) BuildID() int                           { return d.buildID }
+func (d *notificationDelivery) BuildName() string                      { return d.buildName }
+func (d *notificationDelivery) BuildStatus() BuildStatus               { return d.buildStatus }
+func (d *notificationDelivery) JobName() string                        { return d.jobName }
+func (d *notificationDelivery) PipelineID() int                        { return d.pipelineID }
+func (d *notificationDelivery) PipelineName() string                   { return d.pipelineName }
+func (d *notificationDelivery) PipelineInstanceVars() atc.InstanceVars { return d.pipelineInstanceVars }
+func (d *notificationDelivery) TeamName() string                       { return d.teamName }
+
+func (d *notificationDelivery) Delivered() error {
+	return d.update(atc.NotificationDeliverySucceeded, nil, sq.Expr("now()"))
+}
+
+// Retry records a failed attempt and schedules the next one.
+func (d *notificationDelivery) Retry(cause error, after time.Duration) error {
+	return d.update(atc.NotificationDeliveryPending, cause, sq.Expr("now() + ? * interval '1 second'", after.Seconds()))
+}
+
+// GiveUp records a failed attempt and stops retrying the delivery.
+func (d *notificationDelivery) GiveUp(cause error) error {
+	return d.update(atc.NotificationDeliveryFailed, cause, sq.Expr("now()"))
+}
+
+func (d *notificationDelivery) update(status atc.NotificationDeliveryStatus, cause error, nextAttempt sq.Sqlizer) error {
+	var lastError sql.NullString
+	if cause != nil {
+		lastError = sql.NullString{String: cause.Error(), Valid: true}
+	}
+
+	var updatedAt time.Time
+	err := psql.Update("notification_deliveries").
+		Set("status", status).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", lastError).
+		Set("next_attempt_at", nextAttempt).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": d.id}).
+		Suffix("RETURNING updated_at").
+		RunWith(d.conn).
+		QueryRow().
+		Scan(&updatedAt)
+	if err != nil {
+		return err
+	}
+
+	d.status = status
+	d.attempts++
+	d.lastError = lastError.String
+	d.updatedAt = updatedAt
+
+	return nil
+}
+
+func scanNotificationDelivery(d *notificationDelivery, row scannable) error {
+	var (
+		status, buildStatus string
+		lastError           sql.NullString
+		instanceVars        sql.NullString
+	)
+
+	err := row.Scan(
+		&d.id,
+		&d.buildID,
+		&d.sink,
+		&status,
+		&d.attempts,
+		&lastError,
+		&d.createdAt,
+		&d.updatedAt,
+		&d.buildName,
+		&buildStatus,
+		&d.jobName,
+		&d.pipelineID,
+		&d.pipelineName,
+		&instanceVars,
+		&d.teamName,
+	)
+	if err != nil {
+		return err
+	}
+
+	d.status = atc.NotificationDeliveryStatus(status)
+	d.buildStatus = BuildStatus(buildStatus)
+	d.lastError = lastError.String
+
+	if instanceVars.Valid {
+		err = json.Unmarshal([]byte(instanceVars.String), &d.pipelineInstanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func scanNotificationDeliveries(conn Conn, rows *sql.Rows) ([]NotificationDelivery, error) {
+	defer Close(rows)
+
+	deliveries := []NotificationDelivery{}
+	for rows.Next() {
+		delivery := &notificationDelivery{conn: conn}
+
+		err := scanNotificationDelivery(delivery, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
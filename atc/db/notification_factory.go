@@ -0,0 +1,107 @@
+package db
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . NotificationFactory
+
+type NotificationFactory interface {
+	QueuedBuilds() ([]QueuedBuildNotification, error)
+	ScheduleDeliveries(buildID int, sinks []string) error
+	DueDeliveries(limit int) ([]NotificationDelivery, error)
+}
+
+// QueuedBuildNotification is a finished build whose pipeline's notification
+// rules have not yet been evaluated.
+type QueuedBuildNotification struct {
+	BuildID        int
+	PreviousStatus BuildStatus
+}
+
+type notificationFactory struct {
+	conn Conn
+}
+
+func NewNotificationFactory(conn Conn) NotificationFactory {
+	return &notificationFactory{
+		conn: conn,
+	}
+}
+
+func (f *notificationFactory) QueuedBuilds() ([]QueuedBuildNotification, error) {
+	rows, err := psql.Select("build_id", "COALESCE(previous_status::text, '')").
+		From("build_notifications").
+		OrderBy("build_id").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	queued := []QueuedBuildNotification{}
+	for rows.Next() {
+		var notification QueuedBuildNotification
+		var previousStatus string
+
+		err = rows.Scan(&notification.BuildID, &previousStatus)
+		if err != nil {
+			return nil, err
+		}
+
+		notification.PreviousStatus = BuildStatus(previousStatus)
+		queued = append(queued, notification)
+	}
+
+	return queued, nil
+}
+
+// ScheduleDeliveries dequeues the build and creates a pending delivery for
+// each of the given sinks.
+func (f *notificationFactory) ScheduleDeliveries(buildID int, sinks []string) error {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("build_notifications").
+		Where(sq.Eq{"build_id": buildID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, sink := range sinks {
+		_, err = psql.Insert("notification_deliveries").
+			Columns("build_id", "sink").
+			Values(buildID, sink).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (f *notificationFactory) DueDeliveries(limit int) ([]NotificationDelivery, error) {
+	rows, err := notificationDeliveriesQuery.
+		Where(sq.Eq{"d.status": atc.NotificationDeliveryPending}).
+		Where(sq.Expr("d.next_attempt_at <= now()")).
+		OrderBy("d.id").
+		Limit(uint64(limit)).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanNotificationDeliveries(f.conn, rows)
+}
@@ -0,0 +1,167 @@
+package db_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationFactory", func() {
+	var (
+		notificationFactory db.NotificationFactory
+		pipeline            db.Pipeline
+		job                 db.Job
+	)
+
+	BeforeEach(func() {
+		notificationFactory = db.NewNotificationFactory(dbConn)
+
+		config := defaultPipelineConfig
+		config.Notifications = &atc.NotificationsConfig{
+			Sinks: atc.NotificationSinkConfigs{
+				{Name: "some-sink", Type: atc.NotificationSinkTypeWebhook, Source: atc.Source{"url": "https://example.com"}},
+			},
+			Rules: atc.NotificationRuleConfigs{
+				{Sinks: []string{"some-sink"}},
+			},
+		}
+
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "notifying-pipeline"}, config, db.ConfigVersion(0), false)
+		Expect(err).NotTo(HaveOccurred())
+
+		var found bool
+		job, found, err = pipeline.Job("some-job")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+	})
+
+	It("saves the pipeline's notifications config", func() {
+		Expect(pipeline.Notifications()).ToNot(BeNil())
+		Expect(pipeline.Notifications().Sinks[0].Source).To(Equal(atc.Source{"url": "https://example.com"}))
+
+		config, err := pipeline.Config()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Notifications).To(Equal(pipeline.Notifications()))
+	})
+
+	Describe("QueuedBuilds", func() {
+		It("queues finished builds of pipelines with notifications", func() {
+			firstBuild, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(firstBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			secondBuild, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secondBuild.Finish(db.BuildStatusFailed)).To(Succeed())
+
+			queued, err := notificationFactory.QueuedBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(Equal([]db.QueuedBuildNotification{
+				{BuildID: firstBuild.ID(), PreviousStatus: ""},
+				{BuildID: secondBuild.ID(), PreviousStatus: db.BuildStatusSucceeded},
+			}))
+		})
+
+		It("does not queue builds of pipelines without notifications", func() {
+			build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Finish(db.BuildStatusFailed)).To(Succeed())
+
+			queued, err := notificationFactory.QueuedBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeEmpty())
+		})
+	})
+
+	Describe("ScheduleDeliveries", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Finish(db.BuildStatusFailed)).To(Succeed())
+
+			err = notificationFactory.ScheduleDeliveries(build.ID(), []string{"some-sink", "other-sink"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("dequeues the build", func() {
+			queued, err := notificationFactory.QueuedBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queued).To(BeEmpty())
+		})
+
+		It("creates a pending delivery for each sink", func() {
+			deliveries, err := notificationFactory.DueDeliveries(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(HaveLen(2))
+
+			Expect(deliveries[0].Sink()).To(Equal("some-sink"))
+			Expect(deliveries[0].Status()).To(Equal(atc.NotificationDeliveryPending))
+			Expect(deliveries[0].BuildID()).To(Equal(build.ID()))
+			Expect(deliveries[0].BuildStatus()).To(Equal(db.BuildStatusFailed))
+			Expect(deliveries[0].JobName()).To(Equal("some-job"))
+			Expect(deliveries[0].PipelineName()).To(Equal("notifying-pipeline"))
+			Expect(deliveries[0].TeamName()).To(Equal(defaultTeam.Name()))
+
+			Expect(deliveries[1].Sink()).To(Equal("other-sink"))
+		})
+
+		Context("when a delivery is retried", func() {
+			BeforeEach(func() {
+				deliveries, err := notificationFactory.DueDeliveries(10)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = deliveries[0].Retry(errors.New("nope"), time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = deliveries[1].Delivered()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is no longer due", func() {
+				deliveries, err := notificationFactory.DueDeliveries(10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries).To(BeEmpty())
+			})
+
+			It("records the attempt", func() {
+				deliveries, err := pipeline.NotificationDeliveries(10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries).To(HaveLen(2))
+
+				Expect(deliveries[0].Sink()).To(Equal("other-sink"))
+				Expect(deliveries[0].Status()).To(Equal(atc.NotificationDeliverySucceeded))
+				Expect(deliveries[0].Attempts()).To(Equal(1))
+
+				Expect(deliveries[1].Sink()).To(Equal("some-sink"))
+				Expect(deliveries[1].Status()).To(Equal(atc.NotificationDeliveryPending))
+				Expect(deliveries[1].Attempts()).To(Equal(1))
+				Expect(deliveries[1].LastError()).To(Equal("nope"))
+			})
+		})
+
+		Context("when a delivery is given up on", func() {
+			BeforeEach(func() {
+				deliveries, err := notificationFactory.DueDeliveries(10)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = deliveries[0].GiveUp(errors.New("nope"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("marks it as failed", func() {
+				deliveries, err := pipeline.NotificationDeliveries(10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries[1].Status()).To(Equal(atc.NotificationDeliveryFailed))
+				Expect(deliveries[1].LastError()).To(Equal("nope"))
+			})
+		})
+	})
+})
@@ -64,9 +64,19 @@ type Tx interface {
 	EncryptionStrategy() encryption.Strategy
 }
 
-func Open(logger lager.Logger, driver, dsn string, newKey, oldKey *encryption.Key, name string, lockFactory lock.LockFactory) (Conn, error) {
+// Open connects to the database, migrating it to the latest version. If a
+// keyring is given it is used to encrypt all data, and newKey and oldKey are
+// only used to read data that the keyring has not yet re-encrypted.
+func Open(logger lager.Logger, driver, dsn string, newKey, oldKey *encryption.Key, keyring *encryption.Keyring, name string, lockFactory lock.LockFactory) (Conn, error) {
+	migrationOldKey := oldKey
+	if keyring != nil {
+		// the old key must not be used to decrypt or rotate data up-front; the
+		// keyring re-encrypts it in the background instead
+		migrationOldKey = nil
+	}
+
 	for {
-		sqlDB, err := migration.NewOpenHelper(driver, dsn, lockFactory, newKey, oldKey).Open()
+		sqlDB, err := migration.NewOpenHelper(driver, dsn, lockFactory, newKey, migrationOldKey).Open()
 		if err != nil {
 			if shouldRetry(err) {
 				logger.Error("failed-to-open-db-retrying", err)
@@ -77,15 +87,17 @@ func Open(logger lager.Logger, driver, dsn string, newKey, oldKey *encryption.Ke
 			return nil, err
 		}
 
-		return NewConn(name, sqlDB, dsn, oldKey, newKey), nil
+		return NewConn(name, sqlDB, dsn, oldKey, newKey, keyring), nil
 	}
 }
 
-func NewConn(name string, sqlDB *sql.DB, dsn string, oldKey, newKey *encryption.Key) Conn {
+func NewConn(name string, sqlDB *sql.DB, dsn string, oldKey, newKey *encryption.Key, keyring *encryption.Keyring) Conn {
 	listener := pq.NewDialListener(keepAliveDialer{}, dsn, time.Second, time.Minute, nil)
 
 	var strategy encryption.Strategy
-	if newKey != nil {
+	if keyring != nil {
+		strategy = keyring
+	} else if newKey != nil {
 		strategy = newKey
 	} else {
 		strategy = encryption.NewNoEncryption()
@@ -46,8 +46,11 @@ type Pipeline interface {
 	Groups() atc.GroupConfigs
 	VarSources() atc.VarSourceConfigs
 	Display() *atc.DisplayConfig
+	Notifications() *atc.NotificationsConfig
 	ConfigVersion() ConfigVersion
 	Config() (atc.Config, error)
+	ConfigHistory() ([]atc.PipelineConfigRevision, error)
+	ConfigRevision(revision int) (atc.PipelineConfigRevision, bool, error)
 	Public() bool
 	Paused() bool
 	Archived() bool
@@ -70,6 +73,8 @@ type Pipeline interface {
 
 	DeleteBuildEventsByBuildIDs(buildIDs []int) error
 
+	NotificationDeliveries(limit int) ([]NotificationDelivery, error)
+
 	LoadDebugVersionsDB() (*atc.DebugVersionsDB, error)
 
 	Resource(name string) (Resource, bool, error)
@@ -110,6 +115,7 @@ type pipeline struct {
 	groups        atc.GroupConfigs
 	varSources    atc.VarSourceConfigs
 	display       *atc.DisplayConfig
+	notifications *atc.NotificationsConfig
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
@@ -139,7 +145,9 @@ var pipelinesQuery = psql.Select(`
 		p.last_updated,
 		p.parent_job_id,
 		p.parent_build_id,
-		p.instance_vars
+		p.instance_vars,
+		p.notifications,
+		p.notifications_nonce
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -160,13 +168,14 @@ func (p *pipeline) ParentBuildID() int             { return p.parentBuildID }
 func (p *pipeline) InstanceVars() atc.InstanceVars { return p.instanceVars }
 func (p *pipeline) Groups() atc.GroupConfigs       { return p.groups }
 
-func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
-func (p *pipeline) Display() *atc.DisplayConfig      { return p.display }
-func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
-func (p *pipeline) Public() bool                     { return p.public }
-func (p *pipeline) Paused() bool                     { return p.paused }
-func (p *pipeline) Archived() bool                   { return p.archived }
-func (p *pipeline) LastUpdated() time.Time           { return p.lastUpdated }
+func (p *pipeline) VarSources() atc.VarSourceConfigs        { return p.varSources }
+func (p *pipeline) Display() *atc.DisplayConfig             { return p.display }
+func (p *pipeline) Notifications() *atc.NotificationsConfig { return p.notifications }
+func (p *pipeline) ConfigVersion() ConfigVersion            { return p.configVersion }
+func (p *pipeline) Public() bool                            { return p.public }
+func (p *pipeline) Paused() bool                            { return p.paused }
+func (p *pipeline) Archived() bool                          { return p.archived }
+func (p *pipeline) LastUpdated() time.Time                  { return p.lastUpdated }
 
 // IMPORTANT: This method is broken with the new resource config versions changes
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
@@ -276,6 +285,7 @@ func (p *pipeline) Config() (atc.Config, error) {
 		ResourceTypes: resourceTypes.Configs(),
 		Jobs:          jobConfigs,
 		Display:       p.Display(),
+		Notifications: p.Notifications(),
 	}
 
 	return config, nil
@@ -745,6 +755,20 @@ func (p *pipeline) Destroy() error {
 	return tx.Commit()
 }
 
+func (p *pipeline) NotificationDeliveries(limit int) ([]NotificationDelivery, error) {
+	rows, err := notificationDeliveriesQuery.
+		Where(sq.Eq{"p.id": p.id}).
+		OrderBy("d.id DESC").
+		Limit(uint64(limit)).
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanNotificationDeliveries(p.conn, rows)
+}
+
 func (p *pipeline) LoadDebugVersionsDB() (*atc.DebugVersionsDB, error) {
 	db := &atc.DebugVersionsDB{
 		BuildOutputs:     []atc.DebugBuildOutput{},
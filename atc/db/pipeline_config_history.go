@@ -0,0 +1,136 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// ConfigChange describes who saved a pipeline config, and why. It is recorded
+// along with the config in the pipeline's config history.
+type ConfigChange struct {
+	Author  string
+	Message string
+}
+
+func saveConfigRevision(tx Tx, pipelineID int, config atc.Config, change ConfigChange) error {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	// saving the config has locked the pipeline's row, so the next revision
+	// cannot be taken by a concurrent save
+	_, err = psql.Insert("pipeline_config_history").
+		Columns("pipeline_id", "revision", "config", "nonce", "author", "message").
+		Select(psql.Select().
+			Column("?", pipelineID).
+			Column("COALESCE(MAX(revision), 0) + 1").
+			Column("?", encryptedPayload).
+			Column("?", nonce).
+			Column("?", newNullString(change.Author)).
+			Column("?", newNullString(change.Message)).
+			From("pipeline_config_history").
+			Where(sq.Eq{"pipeline_id": pipelineID})).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func newNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func (p *pipeline) ConfigHistory() ([]atc.PipelineConfigRevision, error) {
+	rows, err := psql.Select("revision", "author", "message", "created_at").
+		From("pipeline_config_history").
+		Where(sq.Eq{"pipeline_id": p.id}).
+		OrderBy("revision DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	revisions := []atc.PipelineConfigRevision{}
+	for rows.Next() {
+		var (
+			revision        atc.PipelineConfigRevision
+			author, message sql.NullString
+			savedAt         time.Time
+		)
+
+		err = rows.Scan(&revision.Revision, &author, &message, &savedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		revision.Author = author.String
+		revision.Message = message.String
+		revision.SavedAt = savedAt.Unix()
+
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+func (p *pipeline) ConfigRevision(number int) (atc.PipelineConfigRevision, bool, error) {
+	var (
+		revision        atc.PipelineConfigRevision
+		author, message sql.NullString
+		savedAt         time.Time
+		payload         string
+		nonce           sql.NullString
+	)
+
+	err := psql.Select("revision", "author", "message", "created_at", "config", "nonce").
+		From("pipeline_config_history").
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+			"revision":    number,
+		}).
+		RunWith(p.conn).
+		QueryRow().
+		Scan(&revision.Revision, &author, &message, &savedAt, &payload, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.PipelineConfigRevision{}, false, nil
+		}
+
+		return atc.PipelineConfigRevision{}, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := p.conn.EncryptionStrategy().Decrypt(payload, noncense)
+	if err != nil {
+		return atc.PipelineConfigRevision{}, false, err
+	}
+
+	var config atc.Config
+	err = json.Unmarshal(decrypted, &config)
+	if err != nil {
+		return atc.PipelineConfigRevision{}, false, err
+	}
+
+	revision.Author = author.String
+	revision.Message = message.String
+	revision.SavedAt = savedAt.Unix()
+	revision.Config = &config
+
+	return revision, true, nil
+}
@@ -0,0 +1,80 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline config history", func() {
+	var (
+		pipelineRef  atc.PipelineRef
+		firstConfig  atc.Config
+		secondConfig atc.Config
+		pipeline     db.Pipeline
+	)
+
+	BeforeEach(func() {
+		pipelineRef = atc.PipelineRef{Name: "history-pipeline"}
+
+		firstConfig = atc.Config{
+			Jobs: atc.JobConfigs{{Name: "some-job"}},
+		}
+
+		secondConfig = atc.Config{
+			Jobs: atc.JobConfigs{{Name: "some-job"}, {Name: "other-job"}},
+		}
+
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline(pipelineRef, firstConfig, db.ConfigVersion(0), false)
+		Expect(err).ToNot(HaveOccurred())
+
+		pipeline, _, err = defaultTeam.SavePipelineWithChange(pipelineRef, secondConfig, pipeline.ConfigVersion(), false, db.ConfigChange{
+			Author:  "some-user",
+			Message: "add other-job",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("records every saved config, most recent first", func() {
+		history, err := pipeline.ConfigHistory()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(2))
+
+		Expect(history[0].Revision).To(Equal(2))
+		Expect(history[0].Author).To(Equal("some-user"))
+		Expect(history[0].Message).To(Equal("add other-job"))
+		Expect(history[0].SavedAt).ToNot(BeZero())
+		Expect(history[0].Config).To(BeNil())
+
+		Expect(history[1].Revision).To(Equal(1))
+		Expect(history[1].Author).To(BeEmpty())
+	})
+
+	It("returns the config of a revision", func() {
+		revision, found, err := pipeline.ConfigRevision(1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(revision.Config).ToNot(BeNil())
+		Expect(revision.Config.Jobs).To(HaveLen(1))
+		Expect(revision.Config.Jobs[0].Name).To(Equal("some-job"))
+	})
+
+	It("does not find revisions which were never saved", func() {
+		_, found, err := pipeline.ConfigRevision(3)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("keeps the history of each pipeline separate", func() {
+		otherPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, firstConfig, db.ConfigVersion(0), false)
+		Expect(err).ToNot(HaveOccurred())
+
+		history, err := otherPipeline.ConfigHistory()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].Revision).To(Equal(1))
+	})
+})
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// PolicyDecisionFilter narrows down the decisions returned; empty fields
+// match any decision.
+type PolicyDecisionFilter struct {
+	Team   string
+	Action string
+	Result string
+	Limit  int
+}
+
+//go:generate counterfeiter . PolicyDecisionRepository
+
+type PolicyDecisionRepository interface {
+	SaveDecision(atc.PolicyDecision) error
+
+	// Decisions returns the matching decisions, most recent first.
+	Decisions(PolicyDecisionFilter) ([]atc.PolicyDecision, error)
+
+	// DeleteDecisionsBefore removes every decision recorded before the given
+	// time and returns how many were removed.
+	DeleteDecisionsBefore(time.Time) (int64, error)
+}
+
+type policyDecisionRepository struct {
+	conn Conn
+}
+
+func NewPolicyDecisionRepository(conn Conn) PolicyDecisionRepository {
+	return &policyDecisionRepository{conn: conn}
+}
+
+func (repository *policyDecisionRepository) SaveDecision(decision atc.PolicyDecision) error {
+	reasons := decision.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	_, err := psql.Insert("policy_decisions").
+		Columns("input_hash", "action", "team", "pipeline", "result", "reasons").
+		Values(
+			decision.InputHash,
+			decision.Action,
+			sql.NullString{String: decision.Team, Valid: decision.Team != ""},
+			sql.NullString{String: decision.Pipeline, Valid: decision.Pipeline != ""},
+			decision.Result,
+			pq.Array(reasons),
+		).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+func (repository *policyDecisionRepository) Decisions(filter PolicyDecisionFilter) ([]atc.PolicyDecision, error) {
+	query := psql.Select("id", "input_hash", "action", "team", "pipeline", "result", "reasons", "created_at").
+		From("policy_decisions").
+		OrderBy("id DESC")
+
+	if filter.Team != "" {
+		query = query.Where(sq.Eq{"team": filter.Team})
+	}
+
+	if filter.Action != "" {
+		query = query.Where(sq.Eq{"action": filter.Action})
+	}
+
+	if filter.Result != "" {
+		query = query.Where(sq.Eq{"result": filter.Result})
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+
+	rows, err := query.RunWith(repository.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	decisions := []atc.PolicyDecision{}
+	for rows.Next() {
+		var (
+			decision       atc.PolicyDecision
+			team, pipeline sql.NullString
+			createdAt      time.Time
+		)
+
+		err = rows.Scan(
+			&decision.ID,
+			&decision.InputHash,
+			&decision.Action,
+			&team,
+			&pipeline,
+			&decision.Result,
+			pq.Array(&decision.Reasons),
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		decision.Team = team.String
+		decision.Pipeline = pipeline.String
+		decision.Time = createdAt.Unix()
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, rows.Err()
+}
+
+func (repository *policyDecisionRepository) DeleteDecisionsBefore(before time.Time) (int64, error) {
+	result, err := psql.Delete("policy_decisions").
+		Where(sq.Lt{"created_at": before}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,78 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PolicyDecisionRepository", func() {
+	var repository db.PolicyDecisionRepository
+
+	BeforeEach(func() {
+		repository = db.NewPolicyDecisionRepository(dbConn)
+
+		Expect(repository.SaveDecision(atc.PolicyDecision{
+			InputHash: "some-hash",
+			Action:    "RunTask",
+			Team:      "some-team",
+			Pipeline:  "some-pipeline",
+			Result:    atc.PolicyDecisionWarned,
+			Reasons:   []string{"no privileged tasks"},
+		})).To(Succeed())
+
+		Expect(repository.SaveDecision(atc.PolicyDecision{
+			InputHash: "other-hash",
+			Action:    "SaveConfig",
+			Team:      "other-team",
+			Result:    atc.PolicyDecisionAllowed,
+		})).To(Succeed())
+	})
+
+	It("returns the most recent decisions first", func() {
+		decisions, err := repository.Decisions(db.PolicyDecisionFilter{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decisions).To(HaveLen(2))
+
+		Expect(decisions[0].Action).To(Equal("SaveConfig"))
+		Expect(decisions[0].Pipeline).To(BeEmpty())
+		Expect(decisions[0].Reasons).To(BeEmpty())
+
+		Expect(decisions[1].InputHash).To(Equal("some-hash"))
+		Expect(decisions[1].Team).To(Equal("some-team"))
+		Expect(decisions[1].Pipeline).To(Equal("some-pipeline"))
+		Expect(decisions[1].Result).To(Equal(atc.PolicyDecisionWarned))
+		Expect(decisions[1].Reasons).To(Equal([]string{"no privileged tasks"}))
+		Expect(decisions[1].Time).ToNot(BeZero())
+	})
+
+	It("filters the decisions", func() {
+		decisions, err := repository.Decisions(db.PolicyDecisionFilter{Team: "some-team"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decisions).To(HaveLen(1))
+		Expect(decisions[0].Action).To(Equal("RunTask"))
+
+		decisions, err = repository.Decisions(db.PolicyDecisionFilter{Result: atc.PolicyDecisionAllowed})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decisions).To(HaveLen(1))
+		Expect(decisions[0].Action).To(Equal("SaveConfig"))
+
+		decisions, err = repository.Decisions(db.PolicyDecisionFilter{Limit: 1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decisions).To(HaveLen(1))
+	})
+
+	It("deletes decisions before a time", func() {
+		deleted, err := repository.DeleteDecisionsBefore(time.Now().Add(-time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeZero())
+
+		deleted, err = repository.DeleteDecisionsBefore(time.Now().Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(Equal(int64(2)))
+	})
+})
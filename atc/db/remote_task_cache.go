@@ -0,0 +1,173 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// RemoteTaskCache is a task cache which has been uploaded to the cluster-wide
+// cache tier, shared by all workers.
+type RemoteTaskCache struct {
+	ID       int
+	TeamID   int
+	JobID    int
+	StepName string
+	Path     string
+
+	// Key locates the cache's blob in the store, which was written with the
+	// given stream Encoding.
+	Key      string
+	Encoding string
+	Size     int64
+
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+//go:generate counterfeiter . RemoteTaskCacheRepository
+
+type RemoteTaskCacheRepository interface {
+	Find(jobID int, stepName string, path string) (RemoteTaskCache, bool, error)
+	Save(RemoteTaskCache) error
+	MarkUsed(id int) error
+
+	// TeamCaches returns the team's caches, most recently used first.
+	TeamCaches(teamID int) ([]RemoteTaskCache, error)
+
+	// OrphanedCaches returns caches whose job or team no longer exists.
+	OrphanedCaches() ([]RemoteTaskCache, error)
+
+	Delete(id int) error
+}
+
+type remoteTaskCacheRepository struct {
+	conn Conn
+}
+
+func NewRemoteTaskCacheRepository(conn Conn) RemoteTaskCacheRepository {
+	return &remoteTaskCacheRepository{conn: conn}
+}
+
+var remoteTaskCachesQuery = psql.Select(
+	"rtc.id",
+	"rtc.team_id",
+	"rtc.job_id",
+	"rtc.step_name",
+	"rtc.path",
+	"rtc.key",
+	"rtc.encoding",
+	"rtc.size",
+	"rtc.created_at",
+	"rtc.last_used_at",
+).From("remote_task_caches rtc")
+
+func (repository *remoteTaskCacheRepository) Find(jobID int, stepName string, path string) (RemoteTaskCache, bool, error) {
+	cache, err := scanRemoteTaskCache(remoteTaskCachesQuery.
+		Where(sq.Eq{
+			"rtc.job_id":    jobID,
+			"rtc.step_name": stepName,
+			"rtc.path":      path,
+		}).
+		RunWith(repository.conn).
+		QueryRow())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return RemoteTaskCache{}, false, nil
+		}
+
+		return RemoteTaskCache{}, false, err
+	}
+
+	return cache, true, nil
+}
+
+// Save records the cache, replacing any previous upload of the same cache.
+func (repository *remoteTaskCacheRepository) Save(cache RemoteTaskCache) error {
+	_, err := psql.Insert("remote_task_caches").
+		Columns("team_id", "job_id", "step_name", "path", "key", "encoding", "size").
+		Values(cache.TeamID, cache.JobID, cache.StepName, cache.Path, cache.Key, cache.Encoding, cache.Size).
+		Suffix(`ON CONFLICT (job_id, step_name, path) DO UPDATE SET
+			team_id = EXCLUDED.team_id,
+			key = EXCLUDED.key,
+			encoding = EXCLUDED.encoding,
+			size = EXCLUDED.size,
+			created_at = now(),
+			last_used_at = now()`).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+func (repository *remoteTaskCacheRepository) MarkUsed(id int) error {
+	_, err := psql.Update("remote_task_caches").
+		Set("last_used_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+func (repository *remoteTaskCacheRepository) TeamCaches(teamID int) ([]RemoteTaskCache, error) {
+	return repository.queryCaches(remoteTaskCachesQuery.
+		Where(sq.Eq{"rtc.team_id": teamID}).
+		OrderBy("rtc.last_used_at DESC", "rtc.id DESC"))
+}
+
+func (repository *remoteTaskCacheRepository) OrphanedCaches() ([]RemoteTaskCache, error) {
+	return repository.queryCaches(remoteTaskCachesQuery.
+		LeftJoin("jobs j ON j.id = rtc.job_id").
+		LeftJoin("teams t ON t.id = rtc.team_id").
+		Where(sq.Or{
+			sq.Eq{"j.id": nil},
+			sq.Eq{"t.id": nil},
+		}).
+		OrderBy("rtc.id"))
+}
+
+func (repository *remoteTaskCacheRepository) Delete(id int) error {
+	_, err := psql.Delete("remote_task_caches").
+		Where(sq.Eq{"id": id}).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+func (repository *remoteTaskCacheRepository) queryCaches(query sq.SelectBuilder) ([]RemoteTaskCache, error) {
+	rows, err := query.RunWith(repository.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var caches []RemoteTaskCache
+	for rows.Next() {
+		cache, err := scanRemoteTaskCache(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		caches = append(caches, cache)
+	}
+
+	return caches, rows.Err()
+}
+
+func scanRemoteTaskCache(row scannable) (RemoteTaskCache, error) {
+	var cache RemoteTaskCache
+	err := row.Scan(
+		&cache.ID,
+		&cache.TeamID,
+		&cache.JobID,
+		&cache.StepName,
+		&cache.Path,
+		&cache.Key,
+		&cache.Encoding,
+		&cache.Size,
+		&cache.CreatedAt,
+		&cache.LastUsedAt,
+	)
+	return cache, err
+}
@@ -0,0 +1,89 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RemoteTaskCacheRepository", func() {
+	var repository db.RemoteTaskCacheRepository
+
+	BeforeEach(func() {
+		repository = db.NewRemoteTaskCacheRepository(dbConn)
+	})
+
+	cache := func(path string, size int64) db.RemoteTaskCache {
+		return db.RemoteTaskCache{
+			TeamID:   defaultTeam.ID(),
+			JobID:    defaultJob.ID(),
+			StepName: "some-task",
+			Path:     path,
+			Key:      "key-" + path,
+			Encoding: "gzip",
+			Size:     size,
+		}
+	}
+
+	Describe("Save", func() {
+		It("records the cache", func() {
+			Expect(repository.Save(cache("some-path", 10))).To(Succeed())
+
+			found, exists, err := repository.Find(defaultJob.ID(), "some-task", "some-path")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(found.Key).To(Equal("key-some-path"))
+			Expect(found.Encoding).To(Equal("gzip"))
+			Expect(found.Size).To(Equal(int64(10)))
+		})
+
+		It("replaces a previous upload of the same cache", func() {
+			Expect(repository.Save(cache("some-path", 10))).To(Succeed())
+			Expect(repository.Save(cache("some-path", 20))).To(Succeed())
+
+			caches, err := repository.TeamCaches(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caches).To(HaveLen(1))
+			Expect(caches[0].Size).To(Equal(int64(20)))
+		})
+	})
+
+	Describe("TeamCaches", func() {
+		It("returns the most recently used caches first", func() {
+			Expect(repository.Save(cache("first", 1))).To(Succeed())
+			Expect(repository.Save(cache("second", 1))).To(Succeed())
+
+			first, _, err := repository.Find(defaultJob.ID(), "some-task", "first")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(repository.MarkUsed(first.ID)).To(Succeed())
+
+			caches, err := repository.TeamCaches(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caches).To(HaveLen(2))
+			Expect(caches[0].Path).To(Equal("first"))
+			Expect(caches[1].Path).To(Equal("second"))
+		})
+	})
+
+	Describe("OrphanedCaches", func() {
+		It("returns caches whose job no longer exists", func() {
+			Expect(repository.Save(cache("some-path", 1))).To(Succeed())
+
+			orphaned := cache("other-path", 1)
+			orphaned.JobID = defaultJob.ID() + 1000
+			Expect(repository.Save(orphaned)).To(Succeed())
+
+			caches, err := repository.OrphanedCaches()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caches).To(HaveLen(1))
+			Expect(caches[0].Path).To(Equal("other-path"))
+
+			Expect(repository.Delete(caches[0].ID)).To(Succeed())
+
+			caches, err = repository.OrphanedCaches()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caches).To(BeEmpty())
+		})
+	})
+})
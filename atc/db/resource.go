@@ -262,6 +262,8 @@ func (r *resource) CheckPlan(from atc.Version, interval time.Duration, resourceT
 		Tags:    r.Tags(),
 		Timeout: r.CheckTimeout(),
 
+		NodeSelector: r.config.NodeSelector,
+
 		FromVersion:            from,
 		Interval:               interval.String(),
 		VersionedResourceTypes: resourceTypes.Deserialize(),
@@ -25,7 +25,7 @@ type ResourceConfigScope interface {
 	Resource() Resource
 	ResourceConfig() ResourceConfig
 
-	SaveVersions(SpanContext, []atc.Version) error
+	SaveVersions(SpanContext, []atc.Version, bool) error
 	FindVersion(atc.Version) (ResourceConfigVersion, bool, error)
 	LatestVersion() (ResourceConfigVersion, bool, error)
 
@@ -70,15 +70,17 @@ func (r *resourceConfigScope) LastCheckEndTime() (time.Time, error) {
 // SaveVersions stores a list of version in the db for a resource config
 // Each version will also have its check order field updated and the
 // Cache index for pipelines using the resource config will be bumped.
+// Versions which are new are recorded as found through the resource's webhook
+// if the check was triggered by it.
 //
 // In the case of a check resource from an older version, the versions
 // that already exist in the DB will be re-ordered using
 // incrementCheckOrder to input the correct check order
-func (r *resourceConfigScope) SaveVersions(spanContext SpanContext, versions []atc.Version) error {
-	return saveVersions(r.conn, r.ID(), versions, spanContext)
+func (r *resourceConfigScope) SaveVersions(spanContext SpanContext, versions []atc.Version, webhook bool) error {
+	return saveVersions(r.conn, r.ID(), versions, spanContext, webhook)
 }
 
-func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext SpanContext) error {
+func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext SpanContext, webhook bool) error {
 	tx, err := conn.Begin()
 	if err != nil {
 		return err
@@ -88,7 +90,7 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 
 	var containsNewVersion bool
 	for _, version := range versions {
-		newVersion, err := saveResourceVersion(tx, rcsID, version, nil, spanContext)
+		newVersion, err := saveResourceVersion(tx, rcsID, version, nil, spanContext, webhook)
 		if err != nil {
 			return err
 		}
@@ -244,7 +246,7 @@ func (r *resourceConfigScope) UpdateLastCheckEndTime() (bool, error) {
 	return true, nil
 }
 
-func saveResourceVersion(tx Tx, rcsID int, version atc.Version, metadata ResourceConfigMetadataFields, spanContext SpanContext) (bool, error) {
+func saveResourceVersion(tx Tx, rcsID int, version atc.Version, metadata ResourceConfigMetadataFields, spanContext SpanContext, webhook bool) (bool, error) {
 	versionJSON, err := json.Marshal(version)
 	if err != nil {
 		return false, err
@@ -262,12 +264,12 @@ func saveResourceVersion(tx Tx, rcsID int, version atc.Version, metadata Resourc
 
 	var checkOrder int
 	err = tx.QueryRow(`
-		INSERT INTO resource_config_versions (resource_config_scope_id, version, version_md5, metadata, span_context)
-		SELECT $1, $2, md5($3), $4, $5
+		INSERT INTO resource_config_versions (resource_config_scope_id, version, version_md5, metadata, span_context, webhook)
+		SELECT $1, $2, md5($3), $4, $5, $6
 		ON CONFLICT (resource_config_scope_id, version_md5)
 		DO UPDATE SET metadata = COALESCE(NULLIF(excluded.metadata, 'null'::jsonb), resource_config_versions.metadata)
 		RETURNING check_order
-		`, rcsID, string(versionJSON), string(versionJSON), string(metadataJSON), string(spanContextJSON)).Scan(&checkOrder)
+		`, rcsID, string(versionJSON), string(versionJSON), string(metadataJSON), string(spanContextJSON), webhook).Scan(&checkOrder)
 	if err != nil {
 		return false, err
 	}
@@ -79,7 +79,7 @@ var _ = Describe("Resource Config Scope", func() {
 
 		// XXX: Can make test more resilient if there is a method that gives all versions by descending check order
 		It("ensures versioned resources have the correct check_order", func() {
-			err := resourceScope.SaveVersions(nil, originalVersionSlice)
+			err := resourceScope.SaveVersions(nil, originalVersionSlice, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			latestVR, found, err := resourceScope.LatestVersion()
@@ -94,7 +94,7 @@ var _ = Describe("Resource Config Scope", func() {
 				{"ref": "v3"},
 			}
 
-			err = resourceScope.SaveVersions(nil, pretendCheckResults)
+			err = resourceScope.SaveVersions(nil, pretendCheckResults, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			latestVR, found, err = resourceScope.LatestVersion()
@@ -105,6 +105,33 @@ var _ = Describe("Resource Config Scope", func() {
 			Expect(latestVR.CheckOrder()).To(Equal(4))
 		})
 
+		Describe("versions found through the webhook", func() {
+			webhookFor := func(version string) bool {
+				var webhook bool
+				err := dbConn.QueryRow(`
+					SELECT webhook
+					FROM resource_config_versions
+					WHERE resource_config_scope_id = $1
+					AND version_md5 = md5($2)
+				`, resourceScope.ID(), `{"ref":"`+version+`"}`).Scan(&webhook)
+				Expect(err).ToNot(HaveOccurred())
+				return webhook
+			}
+
+			BeforeEach(func() {
+				err := resourceScope.SaveVersions(nil, []atc.Version{{"ref": "v1"}}, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = resourceScope.SaveVersions(nil, []atc.Version{{"ref": "v1"}, {"ref": "v2"}}, true)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records only the new versions as found through the webhook", func() {
+				Expect(webhookFor("v1")).To(BeFalse())
+				Expect(webhookFor("v2")).To(BeTrue())
+			})
+		})
+
 		Context("when the versions already exists", func() {
 			var newVersionSlice []atc.Version
 
@@ -114,7 +141,7 @@ var _ = Describe("Resource Config Scope", func() {
 					{"ref": "v3"},
 				}
 
-				err := resourceScope.SaveVersions(nil, originalVersionSlice)
+				err := resourceScope.SaveVersions(nil, originalVersionSlice, false)
 				Expect(err).ToNot(HaveOccurred())
 
 				latestVR, found, err := resourceScope.LatestVersion()
@@ -126,7 +153,7 @@ var _ = Describe("Resource Config Scope", func() {
 			})
 
 			It("does not change the check order", func() {
-				err := resourceScope.SaveVersions(nil, newVersionSlice)
+				err := resourceScope.SaveVersions(nil, newVersionSlice, false)
 				Expect(err).ToNot(HaveOccurred())
 
 				latestVR, found, err := resourceScope.LatestVersion()
@@ -139,7 +166,7 @@ var _ = Describe("Resource Config Scope", func() {
 
 			Context("when a new version is added", func() {
 				It("requests schedule on the jobs that use the resource", func() {
-					err := resourceScope.SaveVersions(nil, originalVersionSlice)
+					err := resourceScope.SaveVersions(nil, originalVersionSlice, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					requestedSchedule := scenario.Job("some-job").ScheduleRequestedTime()
@@ -148,14 +175,14 @@ var _ = Describe("Resource Config Scope", func() {
 						{"ref": "v0"},
 						{"ref": "v3"},
 					}
-					err = resourceScope.SaveVersions(nil, newVersions)
+					err = resourceScope.SaveVersions(nil, newVersions, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(scenario.Job("some-job").ScheduleRequestedTime()).Should(BeTemporally(">", requestedSchedule))
 				})
 
 				It("does not request schedule on the jobs that use the resource but through passed constraints", func() {
-					err := resourceScope.SaveVersions(nil, originalVersionSlice)
+					err := resourceScope.SaveVersions(nil, originalVersionSlice, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					requestedSchedule := scenario.Job("downstream-job").ScheduleRequestedTime()
@@ -164,14 +191,14 @@ var _ = Describe("Resource Config Scope", func() {
 						{"ref": "v0"},
 						{"ref": "v3"},
 					}
-					err = resourceScope.SaveVersions(nil, newVersions)
+					err = resourceScope.SaveVersions(nil, newVersions, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(scenario.Job("downstream-job").ScheduleRequestedTime()).Should(BeTemporally("==", requestedSchedule))
 				})
 
 				It("does not request schedule on the jobs that do not use the resource", func() {
-					err := resourceScope.SaveVersions(nil, originalVersionSlice)
+					err := resourceScope.SaveVersions(nil, originalVersionSlice, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					requestedSchedule := scenario.Job("some-other-job").ScheduleRequestedTime()
@@ -180,7 +207,7 @@ var _ = Describe("Resource Config Scope", func() {
 						{"ref": "v0"},
 						{"ref": "v3"},
 					}
-					err = resourceScope.SaveVersions(nil, newVersions)
+					err = resourceScope.SaveVersions(nil, newVersions, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(scenario.Job("some-other-job").ScheduleRequestedTime()).Should(BeTemporally("==", requestedSchedule))
@@ -199,7 +226,7 @@ var _ = Describe("Resource Config Scope", func() {
 					{"ref": "v3"},
 				}
 
-				err := resourceScope.SaveVersions(nil, originalVersionSlice)
+				err := resourceScope.SaveVersions(nil, originalVersionSlice, false)
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -214,7 +241,7 @@ var _ = Describe("Resource Config Scope", func() {
 			})
 
 			It("disabled versions do not affect fetching the latest version", func() {
-				err := resourceScope.SaveVersions(nil, []atc.Version{{"version": "1"}})
+				err := resourceScope.SaveVersions(nil, []atc.Version{{"version": "1"}}, false)
 				Expect(err).ToNot(HaveOccurred())
 
 				savedRCV, found, err := resourceScope.LatestVersion()
@@ -239,7 +266,7 @@ var _ = Describe("Resource Config Scope", func() {
 			})
 
 			It("saving versioned resources updates the latest versioned resource", func() {
-				err := resourceScope.SaveVersions(nil, []atc.Version{{"ref": "4"}, {"ref": "5"}})
+				err := resourceScope.SaveVersions(nil, []atc.Version{{"ref": "4"}, {"ref": "5"}}, false)
 				Expect(err).ToNot(HaveOccurred())
 
 				savedVR, found, err := resourceScope.LatestVersion()
@@ -258,7 +285,7 @@ var _ = Describe("Resource Config Scope", func() {
 				{"ref": "v3"},
 			}
 
-			err := resourceScope.SaveVersions(nil, originalVersionSlice)
+			err := resourceScope.SaveVersions(nil, originalVersionSlice, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
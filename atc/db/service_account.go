@@ -0,0 +1,268 @@
+package db
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// ServiceAccountTokenPrefix starts every service account token, which tells
+// them apart from the access tokens issued on login.
+const ServiceAccountTokenPrefix = "csa_"
+
+// ServiceAccountConnector is the connector of the claims of service account
+// tokens.
+const ServiceAccountConnector = "service-account"
+
+// ServiceAccountClaim is the claim holding the team and role which a service
+// account token grants.
+const ServiceAccountClaim = "service_account"
+
+var ErrServiceAccountNotFound = errors.New("service account not found")
+var ErrServiceAccountTokenExists = errors.New("service account token already exists")
+
+// HashServiceAccountToken returns the hash under which a service account
+// token is stored; the token itself is never saved.
+func HashServiceAccountToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *team) ServiceAccounts() ([]atc.ServiceAccount, error) {
+	rows, err := psql.Select("id", "name", "created_at").
+		From("service_accounts").
+		Where(sq.Eq{"team_id": t.id}).
+		OrderBy("name").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	accounts := []atc.ServiceAccount{}
+	indexes := map[int]int{}
+	for rows.Next() {
+		var (
+			id        int
+			account   atc.ServiceAccount
+			createdAt time.Time
+		)
+
+		err = rows.Scan(&id, &account.Name, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		account.TeamName = t.name
+		account.CreatedAt = createdAt.Unix()
+		account.Tokens = []atc.ServiceAccountToken{}
+
+		indexes[id] = len(accounts)
+		accounts = append(accounts, account)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenRows, err := psql.Select("t.service_account_id", "t.name", "t.role", "t.created_at", "t.expires_at").
+		From("service_account_tokens t").
+		Join("service_accounts a ON a.id = t.service_account_id").
+		Where(sq.Eq{"a.team_id": t.id}).
+		OrderBy("t.name").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(tokenRows)
+
+	for tokenRows.Next() {
+		var (
+			accountID int
+			token     atc.ServiceAccountToken
+			createdAt time.Time
+			expiresAt pq.NullTime
+		)
+
+		err = tokenRows.Scan(&accountID, &token.Name, &token.Role, &createdAt, &expiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		token.CreatedAt = createdAt.Unix()
+		if expiresAt.Valid {
+			token.ExpiresAt = expiresAt.Time.Unix()
+		}
+
+		i, found := indexes[accountID]
+		if found {
+			accounts[i].Tokens = append(accounts[i].Tokens, token)
+		}
+	}
+
+	return accounts, tokenRows.Err()
+}
+
+func (t *team) CreateServiceAccount(name string) (bool, error) {
+	result, err := psql.Insert("service_accounts").
+		Columns("team_id", "name").
+		Values(t.id, name).
+		Suffix("ON CONFLICT (team_id, name) DO NOTHING").
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (t *team) DeleteServiceAccount(name string) (bool, error) {
+	result, err := psql.Delete("service_accounts").
+		Where(sq.Eq{"team_id": t.id, "name": name}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// CreateServiceAccountToken saves the hash of token.Token for the named
+// service account, and returns the token as it was saved.
+func (t *team) CreateServiceAccountToken(accountName string, token atc.ServiceAccountToken) (atc.ServiceAccountToken, error) {
+	var accountID int
+	err := psql.Select("id").
+		From("service_accounts").
+		Where(sq.Eq{"team_id": t.id, "name": accountName}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.ServiceAccountToken{}, ErrServiceAccountNotFound
+		}
+		return atc.ServiceAccountToken{}, err
+	}
+
+	var expiresAt pq.NullTime
+	if token.ExpiresAt != 0 {
+		expiresAt = pq.NullTime{Time: time.Unix(token.ExpiresAt, 0), Valid: true}
+	}
+
+	var createdAt time.Time
+	err = psql.Insert("service_account_tokens").
+		Columns("service_account_id", "name", "token_hash", "role", "expires_at").
+		Values(accountID, token.Name, HashServiceAccountToken(token.Token), token.Role, expiresAt).
+		Suffix("RETURNING created_at").
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&createdAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return atc.ServiceAccountToken{}, ErrServiceAccountTokenExists
+		}
+		return atc.ServiceAccountToken{}, err
+	}
+
+	token.CreatedAt = createdAt.Unix()
+
+	return token, nil
+}
+
+func (t *team) RevokeServiceAccountToken(accountName string, tokenName string) (bool, error) {
+	result, err := psql.Delete("service_account_tokens").
+		Where(sq.Eq{"name": tokenName}).
+		Where(sq.Expr("service_account_id = (SELECT id FROM service_accounts WHERE team_id = ? AND name = ?)", t.id, accountName)).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// getServiceAccountToken looks up a service account token by its hash and
+// returns claims granting its role on the account's team.
+func getServiceAccountToken(conn Conn, rawToken string) (AccessToken, bool, error) {
+	var (
+		role, accountName, teamName string
+		expiresAt                   pq.NullTime
+	)
+
+	err := psql.Select("t.role", "t.expires_at", "a.name", "tm.name").
+		From("service_account_tokens t").
+		Join("service_accounts a ON a.id = t.service_account_id").
+		Join("teams tm ON tm.id = a.team_id").
+		Where(sq.Eq{"t.token_hash": HashServiceAccountToken(rawToken)}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&role, &expiresAt, &accountName, &teamName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return AccessToken{}, false, nil
+		}
+		return AccessToken{}, false, err
+	}
+
+	rawClaims := map[string]interface{}{
+		"sub":  ServiceAccountConnector + ":" + teamName + "/" + accountName,
+		"name": accountName,
+		"federated_claims": map[string]interface{}{
+			"connector_id": ServiceAccountConnector,
+			"user_id":      teamName + "/" + accountName,
+		},
+		ServiceAccountClaim: map[string]interface{}{
+			"team": teamName,
+			"role": role,
+		},
+	}
+
+	if expiresAt.Valid {
+		rawClaims["exp"] = expiresAt.Time.Unix()
+	}
+
+	// round-trip the claims so that the standard ones are parsed just as
+	// for access tokens
+	payload, err := json.Marshal(rawClaims)
+	if err != nil {
+		return AccessToken{}, false, err
+	}
+
+	var claims Claims
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return AccessToken{}, false, err
+	}
+
+	return AccessToken{Token: rawToken, Claims: claims}, true, nil
+}
@@ -0,0 +1,101 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service accounts", func() {
+	var expiresAt int64
+
+	BeforeEach(func() {
+		expiresAt = time.Now().Add(time.Hour).Unix()
+
+		created, err := defaultTeam.CreateServiceAccount("ci")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeTrue())
+
+		_, err = defaultTeam.CreateServiceAccountToken("ci", atc.ServiceAccountToken{
+			Name:      "deploy",
+			Role:      "member",
+			ExpiresAt: expiresAt,
+			Token:     db.ServiceAccountTokenPrefix + "some-token",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("does not create an account twice", func() {
+		created, err := defaultTeam.CreateServiceAccount("ci")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeFalse())
+	})
+
+	It("lists the accounts with their tokens", func() {
+		accounts, err := defaultTeam.ServiceAccounts()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(accounts).To(HaveLen(1))
+		Expect(accounts[0].Name).To(Equal("ci"))
+		Expect(accounts[0].TeamName).To(Equal("default-team"))
+		Expect(accounts[0].Tokens).To(HaveLen(1))
+		Expect(accounts[0].Tokens[0].Name).To(Equal("deploy"))
+		Expect(accounts[0].Tokens[0].Role).To(Equal("member"))
+		Expect(accounts[0].Tokens[0].ExpiresAt).To(Equal(expiresAt))
+		Expect(accounts[0].Tokens[0].Token).To(BeEmpty())
+	})
+
+	It("rejects a token with a name which is taken", func() {
+		_, err := defaultTeam.CreateServiceAccountToken("ci", atc.ServiceAccountToken{
+			Name:  "deploy",
+			Role:  "viewer",
+			Token: db.ServiceAccountTokenPrefix + "other-token",
+		})
+		Expect(err).To(Equal(db.ErrServiceAccountTokenExists))
+	})
+
+	It("rejects a token for a missing account", func() {
+		_, err := defaultTeam.CreateServiceAccountToken("bogus", atc.ServiceAccountToken{
+			Name:  "deploy",
+			Role:  "viewer",
+			Token: db.ServiceAccountTokenPrefix + "other-token",
+		})
+		Expect(err).To(Equal(db.ErrServiceAccountNotFound))
+	})
+
+	It("resolves the token to claims for the account's team and role", func() {
+		token, found, err := db.NewAccessTokenFactory(dbConn).GetAccessToken(db.ServiceAccountTokenPrefix + "some-token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(token.Claims.Username).To(Equal("ci"))
+		Expect(token.Claims.Connector).To(Equal(db.ServiceAccountConnector))
+		Expect(int64(*token.Claims.Expiry)).To(Equal(expiresAt))
+		Expect(token.Claims.RawClaims[db.ServiceAccountClaim]).To(Equal(map[string]interface{}{
+			"team": "default-team",
+			"role": "member",
+		}))
+	})
+
+	It("no longer resolves a revoked token", func() {
+		revoked, err := defaultTeam.RevokeServiceAccountToken("ci", "deploy")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+
+		_, found, err := db.NewAccessTokenFactory(dbConn).GetAccessToken(db.ServiceAccountTokenPrefix + "some-token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("deletes the tokens along with the account", func() {
+		deleted, err := defaultTeam.DeleteServiceAccount("ci")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+
+		_, found, err := db.NewAccessTokenFactory(dbConn).GetAccessToken(db.ServiceAccountTokenPrefix + "some-token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+})
@@ -37,6 +37,8 @@ type Team interface {
 	Admin() bool
 
 	Auth() atc.TeamAuth
+	Quota() *atc.TeamQuota
+	CustomRoles() atc.CustomRoles
 
 	Delete() error
 	Rename(string) error
@@ -47,6 +49,16 @@ type Team interface {
 		from ConfigVersion,
 		initiallyPaused bool,
 	) (Pipeline, bool, error)
+
+	// SavePipelineWithChange saves the pipeline like SavePipeline, recording
+	// who changed its config and why in the pipeline's config history.
+	SavePipelineWithChange(
+		pipelineRef atc.PipelineRef,
+		config atc.Config,
+		from ConfigVersion,
+		initiallyPaused bool,
+		change ConfigChange,
+	) (Pipeline, bool, error)
 	RenamePipeline(oldName string, newName string) (bool, error)
 
 	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
@@ -60,6 +72,13 @@ type Team interface {
 	PrivateAndPublicBuilds(Page) ([]Build, Pagination, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
+	SearchBuildLogs(BuildLogSearch) ([]atc.BuildLogMatch, error)
+
+	ServiceAccounts() ([]atc.ServiceAccount, error)
+	CreateServiceAccount(name string) (bool, error)
+	DeleteServiceAccount(name string) (bool, error)
+	CreateServiceAccountToken(accountName string, token atc.ServiceAccountToken) (atc.ServiceAccountToken, error)
+	RevokeServiceAccountToken(accountName string, tokenName string) (bool, error)
 
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
@@ -77,6 +96,9 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdateQuota(quota atc.TeamQuota) error
+	UpdateCustomRoles(roles atc.CustomRoles) error
+	Usage() (atc.TeamUsage, error)
 }
 
 type team struct {
@@ -87,7 +109,9 @@ type team struct {
 	name  string
 	admin bool
 
-	auth atc.TeamAuth
+	auth        atc.TeamAuth
+	quota       *atc.TeamQuota
+	customRoles atc.CustomRoles
 }
 
 func (t *team) ID() int      { return t.id }
@@ -96,6 +120,10 @@ func (t *team) Admin() bool  { return t.admin }
 
 func (t *team) Auth() atc.TeamAuth { return t.auth }
 
+func (t *team) Quota() *atc.TeamQuota { return t.quota }
+
+func (t *team) CustomRoles() atc.CustomRoles { return t.customRoles }
+
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
 		Where(sq.Eq{
@@ -365,6 +393,7 @@ func savePipeline(
 	teamID int,
 	jobID sql.NullInt64,
 	buildID sql.NullInt64,
+	change ConfigChange,
 ) (int, bool, error) {
 
 	var instanceVars sql.NullString
@@ -414,21 +443,39 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	var encryptedNotificationsPayload, notificationsNonce *string
+	if config.Notifications != nil {
+		notificationsPayload, err := json.Marshal(config.Notifications)
+		if err != nil {
+			return 0, false, err
+		}
+
+		encrypted, nonce, err := tx.EncryptionStrategy().Encrypt(notificationsPayload)
+		if err != nil {
+			return 0, false, err
+		}
+
+		encryptedNotificationsPayload = &encrypted
+		notificationsNonce = nonce
+	}
+
 	var pipelineID int
 	if !existingConfig {
 		values := map[string]interface{}{
-			"name":            pipelineRef.Name,
-			"groups":          groupsPayload,
-			"var_sources":     encryptedVarSourcesPayload,
-			"display":         displayPayload,
-			"nonce":           nonce,
-			"version":         sq.Expr("nextval('config_version_seq')"),
-			"paused":          initiallyPaused,
-			"last_updated":    sq.Expr("now()"),
-			"team_id":         teamID,
-			"parent_job_id":   jobID,
-			"parent_build_id": buildID,
-			"instance_vars":   instanceVars,
+			"name":                pipelineRef.Name,
+			"groups":              groupsPayload,
+			"var_sources":         encryptedVarSourcesPayload,
+			"display":             displayPayload,
+			"notifications":       encryptedNotificationsPayload,
+			"notifications_nonce": notificationsNonce,
+			"nonce":               nonce,
+			"version":             sq.Expr("nextval('config_version_seq')"),
+			"paused":              initiallyPaused,
+			"last_updated":        sq.Expr("now()"),
+			"team_id":             teamID,
+			"parent_job_id":       jobID,
+			"parent_build_id":     buildID,
+			"instance_vars":       instanceVars,
 		}
 		var ordering sql.NullInt64
 		err := psql.Select("max(ordering)").
@@ -463,6 +510,8 @@ func savePipeline(
 			Set("groups", groupsPayload).
 			Set("var_sources", encryptedVarSourcesPayload).
 			Set("display", displayPayload).
+			Set("notifications", encryptedNotificationsPayload).
+			Set("notifications_nonce", notificationsNonce).
 			Set("nonce", nonce).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
 			Set("last_updated", sq.Expr("now()")).
@@ -560,6 +609,11 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	err = saveConfigRevision(tx, pipelineID, config, change)
+	if err != nil {
+		return 0, false, err
+	}
+
 	return pipelineID, !existingConfig, nil
 }
 
@@ -568,6 +622,16 @@ func (t *team) SavePipeline(
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
+) (Pipeline, bool, error) {
+	return t.SavePipelineWithChange(pipelineRef, config, from, initiallyPaused, ConfigChange{})
+}
+
+func (t *team) SavePipelineWithChange(
+	pipelineRef atc.PipelineRef,
+	config atc.Config,
+	from ConfigVersion,
+	initiallyPaused bool,
+	change ConfigChange,
 ) (Pipeline, bool, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -577,7 +641,7 @@ func (t *team) SavePipeline(
 	defer Rollback(tx)
 
 	nullID := sql.NullInt64{Valid: false}
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID)
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID, change)
 	if err != nil {
 		return nil, false, err
 	}
@@ -868,7 +932,7 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, quota, custom_roles, nonce
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -878,6 +942,171 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+// UpdateQuota replaces the team's quota. A quota without any limits is
+// removed.
+func (t *team) UpdateQuota(quota atc.TeamQuota) error {
+	var value interface{}
+	if quota != (atc.TeamQuota{}) {
+		payload, err := json.Marshal(quota)
+		if err != nil {
+			return err
+		}
+
+		value = payload
+	}
+
+	_, err := psql.Update("teams").
+		Set("quota", value).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.quota = nil
+	if value != nil {
+		t.quota = &quota
+	}
+
+	return nil
+}
+
+// UpdateCustomRoles replaces the roles defined by the team. Any users or
+// groups still granted a role which no longer exists lose it.
+func (t *team) UpdateCustomRoles(roles atc.CustomRoles) error {
+	var value interface{}
+	if len(roles) > 0 {
+		payload, err := json.Marshal(roles)
+		if err != nil {
+			return err
+		}
+
+		value = payload
+	}
+
+	_, err := psql.Update("teams").
+		Set("custom_roles", value).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.customRoles = nil
+	if value != nil {
+		t.customRoles = roles
+	}
+
+	return nil
+}
+
+// Usage counts the team's running builds, its active containers and the disk
+// used by its volumes, as last reported by the workers. Check builds are not
+// counted.
+func (t *team) Usage() (atc.TeamUsage, error) {
+	return teamUsage(t.conn, t.id)
+}
+
+func teamUsage(runner sq.BaseRunner, teamID int) (atc.TeamUsage, error) {
+	var usage atc.TeamUsage
+
+	err := teamConcurrentBuildsQuery(teamID).
+		RunWith(runner).
+		QueryRow().
+		Scan(&usage.ConcurrentBuilds)
+	if err != nil {
+		return atc.TeamUsage{}, err
+	}
+
+	err = psql.Select("COUNT(*)").
+		From("containers").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"state":   []string{atc.ContainerStateCreating, atc.ContainerStateCreated},
+		}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&usage.Containers)
+	if err != nil {
+		return atc.TeamUsage{}, err
+	}
+
+	err = psql.Select("COALESCE(SUM(size), 0)").
+		From("volumes").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"state":   string(VolumeStateCreated),
+		}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&usage.VolumeDisk)
+	if err != nil {
+		return atc.TeamUsage{}, err
+	}
+
+	return usage, nil
+}
+
+func teamQuota(runner sq.BaseRunner, teamID int) (*atc.TeamQuota, error) {
+	return scanTeamQuota(psql.Select("quota").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		RunWith(runner).
+		QueryRow())
+}
+
+// lockTeamQuota reads the team's quota and locks the team until the
+// transaction ends, serializing the scheduling of the team's builds.
+func lockTeamQuota(tx Tx, teamID int) (*atc.TeamQuota, error) {
+	return scanTeamQuota(psql.Select("quota").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow())
+}
+
+func scanTeamQuota(row sq.RowScanner) (*atc.TeamQuota, error) {
+	var quotaJSON sql.NullString
+	err := row.Scan(&quotaJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if !quotaJSON.Valid {
+		return nil, nil
+	}
+
+	var quota atc.TeamQuota
+	err = json.Unmarshal([]byte(quotaJSON.String), &quota)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quota, nil
+}
+
+// teamConcurrentBuildsQuery counts the team's builds which are running or
+// have been scheduled to run.
+func teamConcurrentBuildsQuery(teamID int) sq.SelectBuilder {
+	return psql.Select("COUNT(*)").
+		From("builds").
+		Where(sq.Eq{
+			"team_id":          teamID,
+			"resource_id":      nil,
+			"resource_type_id": nil,
+		}).
+		Where(sq.Or{
+			sq.Eq{"status": BuildStatusStarted},
+			sq.Eq{
+				"status":    BuildStatusPending,
+				"scheduled": true,
+			},
+		})
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
@@ -1153,9 +1382,9 @@ func saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string) (int, er
 
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "disable_manual_trigger", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.DisableManualTrigger, job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, disable_manual_trigger = EXCLUDED.disable_manual_trigger, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "disable_manual_trigger", "interruptible", "active", "nonce", "tags", "priority").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.DisableManualTrigger, job.Interruptible, true, nonce, pq.Array(groups), job.Priority.Rank()).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, disable_manual_trigger = EXCLUDED.disable_manual_trigger, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags, priority = EXCLUDED.priority").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -1164,6 +1393,11 @@ func saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string) (int, er
 		return 0, err
 	}
 
+	err = saveJobSchedule(tx, jobID, job.Schedule)
+	if err != nil {
+		return 0, err
+	}
+
 	return jobID, nil
 }
 
@@ -1305,8 +1539,10 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		parentJobID   sql.NullInt64
 		parentBuildID sql.NullInt64
 		instanceVars  sql.NullString
+		notifications sql.NullString
+		notifNonce    sql.NullString
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &display, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &instanceVars)
+	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &display, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &instanceVars, &notifications, &notifNonce)
 	if err != nil {
 		return err
 	}
@@ -1360,6 +1596,23 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		}
 	}
 
+	if notifications.Valid {
+		var notifNonceStr *string
+		if notifNonce.Valid {
+			notifNonceStr = &notifNonce.String
+		}
+
+		decryptedNotifications, err := p.conn.EncryptionStrategy().Decrypt(notifications.String, notifNonceStr)
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(decryptedNotifications, &p.notifications)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1410,19 +1663,37 @@ func scanContainers(rows *sql.Rows, conn Conn, initContainers []Container) ([]Co
 }
 
 func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
-	var providerAuth, nonce sql.NullString
+	var providerAuth, quota, customRoles, nonce sql.NullString
 
 	err := tx.QueryRow(query, params...).Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&quota,
+		&customRoles,
 		&nonce,
 	)
 	if err != nil {
 		return err
 	}
 
+	t.quota = nil
+	if quota.Valid {
+		err = json.Unmarshal([]byte(quota.String), &t.quota)
+		if err != nil {
+			return err
+		}
+	}
+
+	t.customRoles = nil
+	if customRoles.Valid {
+		err = json.Unmarshal([]byte(customRoles.String), &t.customRoles)
+		if err != nil {
+			return err
+		}
+	}
+
 	if providerAuth.Valid {
 		var auth atc.TeamAuth
 		err = json.Unmarshal([]byte(providerAuth.String), &auth)
@@ -52,10 +52,26 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	var quota interface{}
+	if t.Quota != nil {
+		quota, err = json.Marshal(t.Quota)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var customRoles interface{}
+	if len(t.CustomRoles) > 0 {
+		customRoles, err = json.Marshal(t.CustomRoles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, quota, custom_roles").
+		Values(t.Name, auth, admin, quota, customRoles).
+		Suffix("RETURNING id, name, admin, auth, quota, custom_roles").
 		RunWith(tx).
 		QueryRow()
 
@@ -91,7 +107,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, quota, custom_roles").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -110,7 +126,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, quota, custom_roles").
 		From("teams").
 		OrderBy("name ASC").
 		RunWith(factory.conn).
@@ -176,13 +192,15 @@ func (factory *teamFactory) NotifyCacher() error {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var providerAuth, quota, customRoles sql.NullString
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&quota,
+		&customRoles,
 	)
 
 	if providerAuth.Valid {
@@ -192,5 +210,19 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		}
 	}
 
+	if quota.Valid {
+		err = json.Unmarshal([]byte(quota.String), &t.quota)
+		if err != nil {
+			return err
+		}
+	}
+
+	if customRoles.Valid {
+		err = json.Unmarshal([]byte(customRoles.String), &t.customRoles)
+		if err != nil {
+			return err
+		}
+	}
+
 	return err
 }
@@ -920,6 +920,220 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("SearchBuildLogs", func() {
+		var (
+			jobBuild    db.Build
+			oneOffBuild db.Build
+			search      db.BuildLogSearch
+		)
+
+		BeforeEach(func() {
+			var err error
+			jobBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = jobBuild.SaveEvent(event.Log{
+				Origin:  event.Origin{ID: "some-task"},
+				Time:    100,
+				Payload: "dialing db\nerror: Connection refused\nretrying\n",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = jobBuild.SaveEvent(event.Log{
+				Origin:  event.Origin{ID: "some-task"},
+				Payload: "all good\n",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			oneOffBuild, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = oneOffBuild.SaveEvent(event.Log{
+				Origin:  event.Origin{ID: "some-other-task"},
+				Payload: "connection refused\n",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			search = db.BuildLogSearch{Query: "connection refused", Limit: 10}
+		})
+
+		It("finds the matching lines across the team's builds, most recent first", func() {
+			matches, err := defaultTeam.SearchBuildLogs(search)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matches).To(HaveLen(2))
+			Expect(matches[0].BuildID).To(Equal(oneOffBuild.ID()))
+			Expect(matches[0].Origin).To(Equal("some-other-task"))
+			Expect(matches[0].Line).To(Equal("connection refused"))
+
+			Expect(matches[1].BuildID).To(Equal(jobBuild.ID()))
+			Expect(matches[1].PipelineName).To(Equal(defaultPipelineRef.Name))
+			Expect(matches[1].PipelineInstanceVars).To(Equal(defaultPipelineRef.InstanceVars))
+			Expect(matches[1].JobName).To(Equal("some-job"))
+			Expect(matches[1].Time).To(Equal(int64(100)))
+			Expect(matches[1].Line).To(Equal("error: Connection refused"))
+		})
+
+		It("includes the lines around each match", func() {
+			search.Pipeline = &defaultPipelineRef
+			search.Context = 1
+
+			matches, err := defaultTeam.SearchBuildLogs(search)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matches).To(HaveLen(1))
+			Expect(matches[0].Before).To(Equal([]string{"dialing db"}))
+			Expect(matches[0].After).To(Equal([]string{"retrying"}))
+		})
+
+		It("only searches the builds of the given job", func() {
+			search.Job = "some-job"
+
+			matches, err := defaultTeam.SearchBuildLogs(search)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matches).To(HaveLen(1))
+			Expect(matches[0].BuildID).To(Equal(jobBuild.ID()))
+		})
+
+		It("does not find builds of other teams", func() {
+			matches, err := otherTeam.SearchBuildLogs(search)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(BeEmpty())
+		})
+
+		It("stops at the limit", func() {
+			search.Limit = 1
+
+			matches, err := defaultTeam.SearchBuildLogs(search)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(HaveLen(1))
+		})
+
+		Context("when events hold several lines", func() {
+			BeforeEach(func() {
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.SaveEvent(event.Log{
+					Origin:  event.Origin{ID: "some-task"},
+					Payload: "refused the connection\n",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.SaveEvent(event.Log{
+					Origin:  event.Origin{ID: "some-task"},
+					Payload: "connection refused\nconnection refused\nconnection refused\n",
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("limits the matching lines rather than the events", func() {
+				search.Limit = 2
+
+				matches, err := defaultTeam.SearchBuildLogs(search)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(matches).To(HaveLen(2))
+			})
+
+			It("does not count events without a matching line towards the limit", func() {
+				search.Limit = 1
+
+				matches, err := defaultTeam.SearchBuildLogs(search)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(matches).To(HaveLen(1))
+				Expect(matches[0].Line).To(Equal("connection refused"))
+			})
+		})
+	})
+
+	Describe("Quotas", func() {
+		Describe("UpdateQuota", func() {
+			It("saves the quota to the team", func() {
+				quota := atc.TeamQuota{MaxConcurrentBuilds: 2, MaxVolumeDisk: 1024}
+
+				err := team.UpdateQuota(quota)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(team.Quota()).To(Equal(&quota))
+
+				reloaded, found, err := teamFactory.FindTeam("some-team")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(reloaded.Quota()).To(Equal(&quota))
+			})
+
+			It("clears the quota when it is empty", func() {
+				err := team.UpdateQuota(atc.TeamQuota{MaxContainers: 1})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = team.UpdateQuota(atc.TeamQuota{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(team.Quota()).To(BeNil())
+
+				reloaded, _, err := teamFactory.FindTeam("some-team")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reloaded.Quota()).To(BeNil())
+			})
+		})
+
+		Describe("UpdateCustomRoles", func() {
+			It("saves the custom roles to the team", func() {
+				roles := atc.CustomRoles{"releaser": {atc.CreateJobBuild, atc.PinResourceVersion}}
+
+				err := team.UpdateCustomRoles(roles)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(team.CustomRoles()).To(Equal(roles))
+
+				reloaded, found, err := teamFactory.FindTeam("some-team")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(reloaded.CustomRoles()).To(Equal(roles))
+			})
+
+			It("clears the custom roles when there are none", func() {
+				err := team.UpdateCustomRoles(atc.CustomRoles{"releaser": {atc.CreateJobBuild}})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = team.UpdateCustomRoles(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(team.CustomRoles()).To(BeNil())
+
+				reloaded, _, err := teamFactory.FindTeam("some-team")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reloaded.CustomRoles()).To(BeNil())
+			})
+		})
+
+		Describe("Usage", func() {
+			It("counts running one-off builds", func() {
+				build, err := team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = build.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+
+				usage, err := team.Usage()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usage.ConcurrentBuilds).To(Equal(1))
+			})
+
+			It("does not count other teams' builds", func() {
+				build, err := otherTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = build.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+
+				usage, err := team.Usage()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usage).To(Equal(atc.TeamUsage{}))
+			})
+		})
+	})
+
 	Describe("Pipelines", func() {
 		var (
 			pipelines []db.Pipeline
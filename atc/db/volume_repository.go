@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 	uuid "github.com/nu7hatch/gouuid"
 )
 
@@ -41,6 +42,7 @@ type VolumeRepository interface {
 	RemoveDestroyingVolumes(workerName string, handles []string) (int, error)
 
 	UpdateVolumesMissingSince(workerName string, handles []string) error
+	UpdateVolumeSizes(workerName string, sizes map[string]uint64) error
 	RemoveMissingVolumes(gracePeriod time.Duration) (removed int, err error)
 
 	DestroyUnknownVolumes(workerName string, handles []string) (int, error)
@@ -142,6 +144,31 @@ func (repository *volumeRepository) UpdateVolumesMissingSince(workerName string,
 	return tx.Commit()
 }
 
+// UpdateVolumeSizes records the disk usage of a worker's volumes, keyed by
+// handle, so that it can be counted towards team quotas.
+func (repository *volumeRepository) UpdateVolumeSizes(workerName string, sizes map[string]uint64) error {
+	if len(sizes) == 0 {
+		return nil
+	}
+
+	handles := make([]string, 0, len(sizes))
+	bytes := make([]int64, 0, len(sizes))
+	for handle, size := range sizes {
+		handles = append(handles, handle)
+		bytes = append(bytes, int64(size))
+	}
+
+	_, err := repository.conn.Exec(`
+		UPDATE volumes v
+		SET size = s.size
+		FROM unnest($2::text[], $3::bigint[]) AS s(handle, size)
+		WHERE v.worker_name = $1
+		AND v.handle = s.handle
+	`, workerName, pq.Array(handles), pq.Array(bytes))
+
+	return err
+}
+
 // Removes any volumes that exist in the database but are missing on the worker
 // for over the designated grace time period.
 func (repository *volumeRepository) RemoveMissingVolumes(gracePeriod time.Duration) (int, error) {
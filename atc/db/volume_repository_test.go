@@ -429,6 +429,39 @@ var _ = Describe("VolumeRepository", func() {
 		})
 	})
 
+	Describe("UpdateVolumeSizes", func() {
+		It("records the size of the worker's volumes", func() {
+			volume, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeArtifact)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = volumeRepository.UpdateVolumeSizes(defaultWorker.Name(), map[string]uint64{
+				volume.Handle():  1024,
+				"unknown-handle": 2048,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var size int64
+			err = psql.Select("size").From("volumes").
+				Where(sq.Eq{"handle": volume.Handle()}).RunWith(dbConn).QueryRow().Scan(&size)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(size).To(Equal(int64(1024)))
+		})
+
+		It("ignores volumes on other workers", func() {
+			volume, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeArtifact)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = volumeRepository.UpdateVolumeSizes("other-worker", map[string]uint64{volume.Handle(): 1024})
+			Expect(err).NotTo(HaveOccurred())
+
+			var size int64
+			err = psql.Select("size").From("volumes").
+				Where(sq.Eq{"handle": volume.Handle()}).RunWith(dbConn).QueryRow().Scan(&size)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(size).To(BeZero())
+		})
+	})
+
 	Describe("FindBaseResourceTypeVolume", func() {
 		var usedWorkerBaseResourceType *db.UsedWorkerBaseResourceType
 		BeforeEach(func() {
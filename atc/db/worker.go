@@ -61,9 +61,12 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	AllocatableCPU() uint64
+	AllocatableMemory() uint64
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
+	Labels() atc.WorkerLabels
 	TeamID() int
 	TeamName() string
 	StartTime() time.Time
@@ -81,6 +84,10 @@ type Worker interface {
 	IncreaseActiveTasks() (int, error)
 	DecreaseActiveTasks() (int, error)
 
+	ReservedResources() (uint64, uint64, error)
+	ReserveResources(owner ContainerOwner, cpu uint64, memory uint64) (bool, error)
+	ReleaseResources(owner ContainerOwner) error
+
 	FindContainer(owner ContainerOwner) (CreatingContainer, CreatedContainer, error)
 	CreateContainer(owner ContainerOwner, meta ContainerMetadata) (CreatingContainer, error)
 }
@@ -88,26 +95,29 @@ type Worker interface {
 type worker struct {
 	conn Conn
 
-	name             string
-	version          *string
-	state            WorkerState
-	gardenAddr       *string
-	baggageclaimURL  *string
-	httpProxyURL     string
-	httpsProxyURL    string
-	noProxy          string
-	activeContainers int
-	activeVolumes    int
-	activeTasks      int
-	resourceTypes    []atc.WorkerResourceType
-	platform         string
-	tags             []string
-	teamID           int
-	teamName         string
-	startTime        time.Time
-	expiresAt        time.Time
-	certsPath        *string
-	ephemeral        bool
+	name              string
+	version           *string
+	state             WorkerState
+	gardenAddr        *string
+	baggageclaimURL   *string
+	httpProxyURL      string
+	httpsProxyURL     string
+	noProxy           string
+	activeContainers  int
+	activeVolumes     int
+	activeTasks       int
+	allocatableCPU    uint64
+	allocatableMemory uint64
+	resourceTypes     []atc.WorkerResourceType
+	platform          string
+	tags              []string
+	labels            atc.WorkerLabels
+	teamID            int
+	teamName          string
+	startTime         time.Time
+	expiresAt         time.Time
+	certsPath         *string
+	ephemeral         bool
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -122,9 +132,12 @@ func (worker *worker) HTTPSProxyURL() string                   { return worker.h
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) AllocatableCPU() uint64                  { return worker.allocatableCPU }
+func (worker *worker) AllocatableMemory() uint64               { return worker.allocatableMemory }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
+func (worker *worker) Labels() atc.WorkerLabels                { return worker.labels }
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
@@ -276,6 +289,105 @@ func (worker *worker) ResourceCerts() (*UsedWorkerResourceCerts, bool, error) {
 	return nil, false, nil
 }
 
+// ReservedResources returns the CPU and memory currently reserved on the
+// worker, summed over the reservations of every container owner.
+func (worker *worker) ReservedResources() (uint64, uint64, error) {
+	var cpu, memory uint64
+	err := psql.Select("COALESCE(SUM(cpu), 0)", "COALESCE(SUM(memory), 0)").
+		From("worker_reservations").
+		Where(sq.Eq{"worker_name": worker.name}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&cpu, &memory)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return cpu, memory, nil
+}
+
+// ReserveResources atomically reserves the given CPU and memory on the worker
+// on behalf of the container owner, as long as doing so does not exceed the
+// capacity advertised by the worker. Workers which do not advertise a
+// capacity for a resource are never considered full for that resource.
+//
+// An owner holds at most one reservation on a worker, so reserving again for
+// an owner which already holds one succeeds without reserving any more. The
+// reservation lasts until it is released, or until the worker or the
+// container created for the owner is destroyed.
+func (worker *worker) ReserveResources(owner ContainerOwner, cpu uint64, memory uint64) (bool, error) {
+	tx, err := worker.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	// lock the worker so that concurrent reservations are checked against
+	// each other's totals
+	var allocatableCPU, allocatableMemory sql.NullInt64
+	err = psql.Select("allocatable_cpu", "allocatable_memory").
+		From("workers").
+		Where(sq.Eq{"name": worker.name}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&allocatableCPU, &allocatableMemory)
+	if err != nil {
+		return false, err
+	}
+
+	var reservedCPU, reservedMemory uint64
+	var held bool
+	err = psql.Select("COALESCE(SUM(cpu), 0)", "COALESCE(SUM(memory), 0)").
+		Column("COALESCE(bool_or(owner = ?), false)", owner.ReservationKey()).
+		From("worker_reservations").
+		Where(sq.Eq{"worker_name": worker.name}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&reservedCPU, &reservedMemory, &held)
+	if err != nil {
+		return false, err
+	}
+
+	if held {
+		return true, nil
+	}
+
+	if allocatableCPU.Valid && reservedCPU+cpu > uint64(allocatableCPU.Int64) {
+		return false, nil
+	}
+
+	if allocatableMemory.Valid && reservedMemory+memory > uint64(allocatableMemory.Int64) {
+		return false, nil
+	}
+
+	_, err = psql.Insert("worker_reservations").
+		Columns("worker_name", "owner", "cpu", "memory").
+		Values(worker.name, owner.ReservationKey(), cpu, memory).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// ReleaseResources releases whatever the container owner has reserved on the
+// worker. Releasing an owner which holds no reservation, e.g. because it was
+// already released, does nothing.
+func (worker *worker) ReleaseResources(owner ContainerOwner) error {
+	_, err := psql.Delete("worker_reservations").
+		Where(sq.Eq{
+			"worker_name": worker.name,
+			"owner":       owner.ReservationKey(),
+		}).
+		RunWith(worker.conn).
+		Exec()
+	return err
+}
+
 func (worker *worker) FindContainer(owner ContainerOwner) (CreatingContainer, CreatedContainer, error) {
 	ownerQuery, found, err := owner.Find(worker.conn)
 	if err != nil {
@@ -338,6 +450,20 @@ func (worker *worker) CreateContainer(owner ContainerOwner, meta ContainerMetada
 		return nil, fmt.Errorf("insert container: %w", err)
 	}
 
+	// tie the owner's reservation to the container, so that it goes away
+	// with the container even if the owner is never around to release it
+	_, err = psql.Update("worker_reservations").
+		Set("container_id", containerID).
+		Where(sq.Eq{
+			"worker_name": worker.name,
+			"owner":       owner.ReservationKey(),
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("link reservation: %w", err)
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -47,9 +47,12 @@ var workersQuery = psql.Select(`
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.allocatable_cpu,
+		w.allocatable_memory,
 		w.resource_types,
 		w.platform,
 		w.tags,
+		w.labels,
 		t.name,
 		w.team_id,
 		w.start_time,
@@ -135,11 +138,15 @@ func scanWorker(worker *worker, row scannable) error {
 		resourceTypes []byte
 		platform      sql.NullString
 		tags          []byte
+		labels        []byte
 		teamName      sql.NullString
 		teamID        sql.NullInt64
 		startTime     pq.NullTime
 		expiresAt     pq.NullTime
 		ephemeral     sql.NullBool
+
+		allocatableCPU    sql.NullInt64
+		allocatableMemory sql.NullInt64
 	)
 
 	err := row.Scan(
@@ -154,9 +161,12 @@ func scanWorker(worker *worker, row scannable) error {
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&allocatableCPU,
+		&allocatableMemory,
 		&resourceTypes,
 		&platform,
 		&tags,
+		&labels,
 		&teamName,
 		&teamID,
 		&startTime,
@@ -215,11 +225,27 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.ephemeral = ephemeral.Bool
 	}
 
+	if allocatableCPU.Valid {
+		worker.allocatableCPU = uint64(allocatableCPU.Int64)
+	}
+
+	if allocatableMemory.Valid {
+		worker.allocatableMemory = uint64(allocatableMemory.Int64)
+	}
+
 	err = json.Unmarshal(resourceTypes, &worker.resourceTypes)
 	if err != nil {
 		return err
 	}
 
+	worker.labels = nil
+	if labels != nil {
+		err = json.Unmarshal(labels, &worker.labels)
+		if err != nil {
+			return err
+		}
+	}
+
 	return json.Unmarshal(tags, &worker.tags)
 }
 
@@ -375,6 +401,17 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	var labels *string
+	if len(atcWorker.Labels) > 0 {
+		payload, err := json.Marshal(atcWorker.Labels)
+		if err != nil {
+			return nil, err
+		}
+
+		labelsJSON := string(payload)
+		labels = &labelsJSON
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -394,6 +431,14 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		workerVersion = &atcWorker.Version
 	}
 
+	var allocatableCPU, allocatableMemory *uint64
+	if atcWorker.AllocatableCPU != 0 {
+		allocatableCPU = &atcWorker.AllocatableCPU
+	}
+	if atcWorker.AllocatableMemory != 0 {
+		allocatableMemory = &atcWorker.AllocatableMemory
+	}
+
 	values := []interface{}{
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
@@ -411,6 +456,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		string(workerState),
 		teamID,
 		atcWorker.Ephemeral,
+		allocatableCPU,
+		allocatableMemory,
+		labels,
 	}
 
 	conflictValues := values
@@ -442,6 +490,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"state",
 			"team_id",
 			"ephemeral",
+			"allocatable_cpu",
+			"allocatable_memory",
+			"labels",
 		).
 		Values(append([]interface{}{
 			sq.Expr(expires),
@@ -466,7 +517,10 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				version = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				allocatable_cpu = ?,
+				allocatable_memory = ?,
+				labels = ?
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -510,6 +564,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		startTime:        time.Unix(atcWorker.StartTime, 0),
 		ephemeral:        atcWorker.Ephemeral,
 		conn:             conn,
+
+		allocatableCPU:    atcWorker.AllocatableCPU,
+		allocatableMemory: atcWorker.AllocatableMemory,
+
+		labels: atcWorker.Labels,
 	}
 
 	workerBaseResourceTypeIDs := []int{}
@@ -45,6 +45,7 @@ var _ = Describe("WorkerFactory", func() {
 			},
 			Platform:  "some-platform",
 			Tags:      atc.Tags{"some", "tags"},
+			Labels:    atc.WorkerLabels{"zone": "eu-1"},
 			Name:      "some-name",
 			StartTime: 1565367209,
 		}
@@ -243,6 +244,7 @@ var _ = Describe("WorkerFactory", func() {
 				}))
 				Expect(foundWorker.Platform()).To(Equal("some-platform"))
 				Expect(foundWorker.Tags()).To(Equal([]string{"some", "tags"}))
+				Expect(foundWorker.Labels()).To(Equal(atc.WorkerLabels{"zone": "eu-1"}))
 				Expect(foundWorker.StartTime().Unix()).To(Equal(int64(1565367209)))
 				Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
 			})
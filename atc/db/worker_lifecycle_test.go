@@ -100,6 +100,32 @@ var _ = Describe("Worker Lifecycle", func() {
 				Expect(len(stalledWorkers)).To(Equal(1))
 				Expect(stalledWorkers[0]).To(Equal("some-name"))
 			})
+
+			Context("when the worker has reserved resources", func() {
+				var dbWorker db.Worker
+
+				BeforeEach(func() {
+					var found bool
+					var err error
+					dbWorker, found, err = workerFactory.GetWorker(atcWorker.Name)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					reserved, err := dbWorker.ReserveResources(db.NewBuildStepContainerOwner(1, atc.PlanID("some-plan"), 1), 512, 1024)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reserved).To(BeTrue())
+				})
+
+				It("keeps them, as its containers may still be running", func() {
+					_, err := workerLifecycle.StallUnresponsiveWorkers()
+					Expect(err).ToNot(HaveOccurred())
+
+					cpu, memory, err := dbWorker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(Equal(uint64(512)))
+					Expect(memory).To(Equal(uint64(1024)))
+				})
+			})
 		})
 	})
 
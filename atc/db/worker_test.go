@@ -441,4 +441,200 @@ var _ = Describe("Worker", func() {
 			})
 		})
 	})
+
+	Describe("Reserved resources", func() {
+		var (
+			owner      ContainerOwner
+			otherOwner ContainerOwner
+		)
+
+		BeforeEach(func() {
+			atcWorker.AllocatableCPU = 1024
+			atcWorker.AllocatableMemory = 4096
+
+			owner = NewBuildStepContainerOwner(1, atc.PlanID("some-plan"), 1)
+			otherOwner = NewBuildStepContainerOwner(1, atc.PlanID("other-plan"), 1)
+
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has the advertised capacity", func() {
+			Expect(worker.AllocatableCPU()).To(Equal(uint64(1024)))
+			Expect(worker.AllocatableMemory()).To(Equal(uint64(4096)))
+		})
+
+		Context("when the worker registers", func() {
+			It("has no reserved resources", func() {
+				cpu, memory, err := worker.ReservedResources()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cpu).To(BeZero())
+				Expect(memory).To(BeZero())
+			})
+		})
+
+		Context("when resources are reserved within the capacity", func() {
+			BeforeEach(func() {
+				reserved, err := worker.ReserveResources(owner, 512, 4096)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reserved).To(BeTrue())
+			})
+
+			It("increases the reserved resources", func() {
+				cpu, memory, err := worker.ReservedResources()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cpu).To(Equal(uint64(512)))
+				Expect(memory).To(Equal(uint64(4096)))
+			})
+
+			Context("when more resources are reserved than are left", func() {
+				It("does not reserve them", func() {
+					reserved, err := worker.ReserveResources(otherOwner, 0, 1)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reserved).To(BeFalse())
+
+					cpu, memory, err := worker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(Equal(uint64(512)))
+					Expect(memory).To(Equal(uint64(4096)))
+				})
+			})
+
+			Context("when the same owner reserves them again", func() {
+				It("keeps the existing reservation", func() {
+					reserved, err := worker.ReserveResources(owner, 512, 4096)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reserved).To(BeTrue())
+
+					cpu, memory, err := worker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(Equal(uint64(512)))
+					Expect(memory).To(Equal(uint64(4096)))
+				})
+			})
+
+			Context("when the worker registers again", func() {
+				BeforeEach(func() {
+					_, err := workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("keeps the reserved resources, as its containers are still running", func() {
+					cpu, memory, err := worker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(Equal(uint64(512)))
+					Expect(memory).To(Equal(uint64(4096)))
+				})
+			})
+
+			Context("when a container is created for the owner", func() {
+				var container CreatingContainer
+
+				BeforeEach(func() {
+					build, err := defaultTeam.CreateOneOffBuild()
+					Expect(err).ToNot(HaveOccurred())
+
+					owner = NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-plan"), defaultTeam.ID())
+
+					reserved, err := worker.ReserveResources(owner, 256, 0)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reserved).To(BeTrue())
+
+					container, err = worker.CreateContainer(owner, ContainerMetadata{Type: "task"})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("releases the reservation once the container is destroyed", func() {
+					cpu, _, err := worker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(Equal(uint64(768)))
+
+					_, err = dbConn.Exec(`DELETE FROM containers WHERE id = $1`, container.ID())
+					Expect(err).ToNot(HaveOccurred())
+
+					cpu, _, err = worker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(Equal(uint64(512)))
+				})
+
+				It("is kept when unclaimed reservations are removed", func() {
+					removed, err := NewContainerRepository(dbConn).RemoveUnclaimedReservations(0)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(removed).To(Equal(1))
+
+					cpu, _, err := worker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(Equal(uint64(256)))
+				})
+			})
+
+			Context("when the resources are released", func() {
+				BeforeEach(func() {
+					err := worker.ReleaseResources(owner)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("resets the reserved resources to 0", func() {
+					cpu, memory, err := worker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(BeZero())
+					Expect(memory).To(BeZero())
+				})
+
+				Context("when they are released again", func() {
+					BeforeEach(func() {
+						reserved, err := worker.ReserveResources(otherOwner, 256, 1024)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(reserved).To(BeTrue())
+
+						err = worker.ReleaseResources(owner)
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("does not release anyone else's reservation", func() {
+						cpu, memory, err := worker.ReservedResources()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cpu).To(Equal(uint64(256)))
+						Expect(memory).To(Equal(uint64(1024)))
+					})
+				})
+			})
+
+			Context("when the resources are released through another lookup of the worker", func() {
+				BeforeEach(func() {
+					otherWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					err = otherWorker.ReleaseResources(owner)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("resets the reserved resources to 0", func() {
+					cpu, memory, err := worker.ReservedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(BeZero())
+					Expect(memory).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the worker does not advertise its capacity", func() {
+			BeforeEach(func() {
+				atcWorker.AllocatableCPU = 0
+				atcWorker.AllocatableMemory = 0
+
+				var err error
+				worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("always reserves the resources", func() {
+				reserved, err := worker.ReserveResources(owner, 1<<20, 1<<40)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reserved).To(BeTrue())
+			})
+		})
+	})
 })
@@ -0,0 +1,24 @@
+package atc
+
+// EncryptionProgress reports how much of the encrypted data in the database
+// has been re-encrypted with the active keyring key.
+type EncryptionProgress struct {
+	ActiveKey string `json:"active_key"`
+
+	// Total is the number of values across every encrypted column, Pending
+	// how many of them are not yet encrypted with the active key, and Failed
+	// how many could not be re-encrypted because they failed to decrypt.
+	Total   int `json:"total"`
+	Pending int `json:"pending"`
+	Failed  int `json:"failed"`
+
+	Columns []EncryptedColumnProgress `json:"columns"`
+}
+
+type EncryptedColumnProgress struct {
+	Table   string `json:"table"`
+	Column  string `json:"column"`
+	Total   int    `json:"total"`
+	Pending int    `json:"pending"`
+	Failed  int    `json:"failed"`
+}
@@ -195,6 +195,12 @@ func (delegate *buildStepDelegate) WaitingForWorker(logger lager.Logger) {
 		logger.Error("failed-to-save-waiting-for-worker-event", err)
 		return
 	}
+
+	err = delegate.build.SetQueueReason(atc.QueueReasonNoWorker)
+	if err != nil {
+		logger.Error("failed-to-set-queue-reason", err)
+		return
+	}
 }
 
 func (delegate *buildStepDelegate) SelectedWorker(logger lager.Logger, worker string) {
@@ -210,6 +216,14 @@ func (delegate *buildStepDelegate) SelectedWorker(logger lager.Logger, worker st
 		logger.Error("failed-to-save-selected-worker-event", err)
 		return
 	}
+
+	if delegate.build.QueueReason() == atc.QueueReasonNoWorker {
+		err = delegate.build.SetQueueReason("")
+		if err != nil {
+			logger.Error("failed-to-clear-queue-reason", err)
+			return
+		}
+	}
 }
 
 func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string) {
@@ -385,6 +399,51 @@ func (delegate *buildStepDelegate) checkImagePolicy(image atc.ImageResource, pri
 	return nil
 }
 
+// CheckPolicy evaluates the step's action against the policy checker, if the
+// action is configured to be checked, and records the result as a build event.
+// The team and pipeline default to the build's, and the data is passed on to
+// the checker as-is.
+func (delegate *buildStepDelegate) CheckPolicy(logger lager.Logger, input policy.PolicyCheckInput) error {
+	if !delegate.policyChecker.ShouldCheckAction(input.Action) {
+		return nil
+	}
+
+	if input.Team == "" {
+		input.Team = delegate.build.TeamName()
+	}
+
+	if input.Pipeline == "" {
+		input.Pipeline = delegate.build.PipelineName()
+	}
+
+	result, err := delegate.policyChecker.Check(input)
+	if err != nil {
+		return fmt.Errorf("perform check: %w", err)
+	}
+
+	err = delegate.build.SaveEvent(event.PolicyCheck{
+		Time: time.Now().Unix(),
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Action:  input.Action,
+		Allowed: result.Allowed,
+		Warned:  result.Warned,
+		Reasons: result.Reasons,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-policy-check-event", err)
+	}
+
+	if !result.Allowed {
+		return policy.PolicyCheckNotPass{
+			Reasons: result.Reasons,
+		}
+	}
+
+	return nil
+}
+
 func (delegate *buildStepDelegate) buildOutputFilter(str string) string {
 	it := &credVarsIterator{line: str}
 	delegate.state.IterateInterpolatedCreds(it)
@@ -93,6 +93,194 @@ var _ = Describe("BuildStepDelegate", func() {
 		})
 	})
 
+	Describe("WaitingForWorker", func() {
+		JustBeforeEach(func() {
+			delegate.WaitingForWorker(logger)
+		})
+
+		It("saves an event", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			event := fakeBuild.SaveEventArgsForCall(0)
+			Expect(event.EventType()).To(Equal(atc.EventType("waiting-for-worker")))
+		})
+
+		It("records that the build is waiting for a worker", func() {
+			Expect(fakeBuild.SetQueueReasonCallCount()).To(Equal(1))
+			Expect(fakeBuild.SetQueueReasonArgsForCall(0)).To(Equal(atc.QueueReasonNoWorker))
+		})
+	})
+
+	Describe("CheckPolicy", func() {
+		var input policy.PolicyCheckInput
+		var checkErr error
+
+		BeforeEach(func() {
+			fakeBuild.TeamNameReturns("some-team")
+			fakeBuild.PipelineNameReturns("some-pipeline")
+
+			input = policy.PolicyCheckInput{
+				Action: policy.ActionPutResource,
+				Data: map[string]interface{}{
+					"resource_type": "git",
+					"params":        atc.Params{"private_key": "some-key"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			checkErr = delegate.CheckPolicy(logger, input)
+		})
+
+		Context("when the action does not need to be checked", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.ShouldCheckActionReturns(false)
+			})
+
+			It("succeeds without checking", func() {
+				Expect(checkErr).ToNot(HaveOccurred())
+				Expect(fakePolicyChecker.ShouldCheckActionArgsForCall(0)).To(Equal(policy.ActionPutResource))
+				Expect(fakePolicyChecker.CheckCallCount()).To(Equal(0))
+			})
+
+			It("does not save an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the action needs to be checked", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.ShouldCheckActionReturns(true)
+
+			})
+
+			Context("when the check is allowed", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+						Allowed: true,
+					}, nil)
+				})
+
+				It("succeeds", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+				})
+
+				It("checks with the build's team and pipeline and the data as given", func() {
+					Expect(fakePolicyChecker.CheckCallCount()).To(Equal(1))
+					Expect(fakePolicyChecker.CheckArgsForCall(0)).To(Equal(policy.PolicyCheckInput{
+						Action:   policy.ActionPutResource,
+						Team:     "some-team",
+						Pipeline: "some-pipeline",
+						Data: map[string]interface{}{
+							"resource_type": "git",
+							"params":        atc.Params{"private_key": "some-key"},
+						},
+					}))
+				})
+
+				It("saves an event", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					e := fakeBuild.SaveEventArgsForCall(0)
+					Expect(e).To(Equal(event.PolicyCheck{
+						Time:    e.(event.PolicyCheck).Time,
+						Origin:  event.Origin{ID: event.OriginID(planID)},
+						Action:  policy.ActionPutResource,
+						Allowed: true,
+					}))
+				})
+
+				Context("when the team and pipeline are given", func() {
+					BeforeEach(func() {
+						input.Team = "other-team"
+						input.Pipeline = "other-pipeline"
+					})
+
+					It("checks with them", func() {
+						checked := fakePolicyChecker.CheckArgsForCall(0)
+						Expect(checked.Team).To(Equal("other-team"))
+						Expect(checked.Pipeline).To(Equal("other-pipeline"))
+					})
+				})
+			})
+
+			Context("when the check is not allowed", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+						Allowed: false,
+						Reasons: []string{"a", "b"},
+					}, nil)
+				})
+
+				It("returns the reasons", func() {
+					Expect(checkErr).To(Equal(policy.PolicyCheckNotPass{
+						Reasons: []string{"a", "b"},
+					}))
+				})
+
+				It("saves an event with the reasons", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					e := fakeBuild.SaveEventArgsForCall(0).(event.PolicyCheck)
+					Expect(e.Allowed).To(BeFalse())
+					Expect(e.Reasons).To(Equal([]string{"a", "b"}))
+				})
+			})
+
+			Context("when the check is only warned about", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+						Allowed: true,
+						Warned:  true,
+						Reasons: []string{"a"},
+					}, nil)
+				})
+
+				It("succeeds", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+				})
+
+				It("saves an event with the warning", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					e := fakeBuild.SaveEventArgsForCall(0).(event.PolicyCheck)
+					Expect(e.Allowed).To(BeTrue())
+					Expect(e.Warned).To(BeTrue())
+					Expect(e.Reasons).To(Equal([]string{"a"}))
+				})
+			})
+
+			Context("when the check errors", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{}, errors.New("nope"))
+				})
+
+				It("returns the error", func() {
+					Expect(checkErr).To(MatchError(ContainSubstring("nope")))
+				})
+			})
+		})
+	})
+
+	Describe("SelectedWorker", func() {
+		JustBeforeEach(func() {
+			delegate.SelectedWorker(logger, "some-worker")
+		})
+
+		Context("when the build was waiting for a worker", func() {
+			BeforeEach(func() {
+				fakeBuild.QueueReasonReturns(atc.QueueReasonNoWorker)
+			})
+
+			It("clears the queue reason", func() {
+				Expect(fakeBuild.SetQueueReasonCallCount()).To(Equal(1))
+				Expect(fakeBuild.SetQueueReasonArgsForCall(0)).To(BeEmpty())
+			})
+		})
+
+		Context("when the build was not waiting for a worker", func() {
+			It("leaves the queue reason alone", func() {
+				Expect(fakeBuild.SetQueueReasonCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("FetchImage", func() {
 		var expectedCheckPlan, expectedGetPlan atc.Plan
 		var fakeArtifact *runtimefakes.FakeArtifact
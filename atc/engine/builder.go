@@ -469,6 +469,7 @@ func (factory *stepperFactory) stepMetadata(
 		PipelineName:         build.PipelineName(),
 		PipelineInstanceVars: build.PipelineInstanceVars(),
 		ExternalURL:          externalURL,
+		Priority:             build.Priority(),
 	}
 	if exposeBuildCreatedBy && build.CreatedBy() != nil {
 		meta.CreatedBy = *build.CreatedBy()
@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/provenance"
 	"github.com/concourse/concourse/atc/util"
 	"github.com/concourse/concourse/tracing"
 )
@@ -37,6 +38,7 @@ func NewEngine(
 	stepperFactory StepperFactory,
 	secrets creds.Secrets,
 	varSourcePool creds.VarSourcePool,
+	provenanceRecorder provenance.Recorder,
 ) Engine {
 	return &engine{
 		stepperFactory: stepperFactory,
@@ -46,6 +48,8 @@ func NewEngine(
 
 		globalSecrets: secrets,
 		varSourcePool: varSourcePool,
+
+		provenanceRecorder: provenanceRecorder,
 	}
 }
 
@@ -57,6 +61,8 @@ type engine struct {
 
 	globalSecrets creds.Secrets
 	varSourcePool creds.VarSourcePool
+
+	provenanceRecorder provenance.Recorder
 }
 
 func (engine *engine) Drain(ctx context.Context) {
@@ -78,6 +84,7 @@ func (engine *engine) NewBuild(build db.Build) Runnable {
 		engine.stepperFactory,
 		engine.globalSecrets,
 		engine.varSourcePool,
+		engine.provenanceRecorder,
 		engine.release,
 		engine.trackedStates,
 		engine.waitGroup,
@@ -89,6 +96,7 @@ func NewBuild(
 	builder StepperFactory,
 	globalSecrets creds.Secrets,
 	varSourcePool creds.VarSourcePool,
+	provenanceRecorder provenance.Recorder,
 	release chan bool,
 	trackedStates *sync.Map,
 	waitGroup *sync.WaitGroup,
@@ -100,6 +108,8 @@ func NewBuild(
 		globalSecrets: globalSecrets,
 		varSourcePool: varSourcePool,
 
+		provenanceRecorder: provenanceRecorder,
+
 		release:       release,
 		trackedStates: trackedStates,
 		waitGroup:     waitGroup,
@@ -113,6 +123,8 @@ type engineBuild struct {
 	globalSecrets creds.Secrets
 	varSourcePool creds.VarSourcePool
 
+	provenanceRecorder provenance.Recorder
+
 	release       chan bool
 	trackedStates *sync.Map
 	waitGroup     *sync.WaitGroup
@@ -264,6 +276,8 @@ func (b *engineBuild) finish(logger lager.Logger, err error, succeeded bool) {
 		b.saveStatus(logger, atc.StatusSucceeded)
 		logger.Info("succeeded")
 
+		b.recordProvenance(logger)
+
 	} else {
 		b.saveStatus(logger, atc.StatusFailed)
 		logger.Info("failed")
@@ -276,6 +290,19 @@ func (b *engineBuild) saveStatus(logger lager.Logger, status atc.BuildStatus) {
 	}
 }
 
+// recordProvenance is best-effort; failing to record provenance does not
+// fail the build.
+func (b *engineBuild) recordProvenance(logger lager.Logger) {
+	if b.provenanceRecorder == nil {
+		return
+	}
+
+	err := b.provenanceRecorder.Record(logger.Session("provenance"), b.build)
+	if err != nil {
+		logger.Error("failed-to-record-provenance", err)
+	}
+}
+
 func (b *engineBuild) trackStarted(logger lager.Logger) {
 	if b.build.Name() != db.CheckBuildName {
 		metric.BuildStarted{
@@ -20,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/provenance/provenancefakes"
 	"github.com/concourse/concourse/vars"
 
 	. "github.com/onsi/ginkgo"
@@ -33,6 +34,8 @@ var _ = Describe("Engine", func() {
 
 		fakeGlobalCreds   *credsfakes.FakeSecrets
 		fakeVarSourcePool *credsfakes.FakeVarSourcePool
+
+		fakeProvenanceRecorder *provenancefakes.FakeRecorder
 	)
 
 	BeforeEach(func() {
@@ -43,6 +46,8 @@ var _ = Describe("Engine", func() {
 
 		fakeGlobalCreds = new(credsfakes.FakeSecrets)
 		fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
+
+		fakeProvenanceRecorder = new(provenancefakes.FakeRecorder)
 	})
 
 	Describe("NewBuild", func() {
@@ -52,7 +57,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepperFactory, fakeGlobalCreds, fakeVarSourcePool)
+			engine = NewEngine(fakeStepperFactory, fakeGlobalCreds, fakeVarSourcePool, fakeProvenanceRecorder)
 		})
 
 		JustBeforeEach(func() {
@@ -82,6 +87,7 @@ var _ = Describe("Engine", func() {
 				fakeStepperFactory,
 				fakeGlobalCreds,
 				fakeVarSourcePool,
+				fakeProvenanceRecorder,
 				release,
 				trackedStates,
 				waitGroup,
@@ -245,6 +251,13 @@ var _ = Describe("Engine", func() {
 										Expect(fakeBuild.FinishCallCount()).To(Equal(1))
 										Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusSucceeded))
 									})
+
+									It("records the build's provenance", func() {
+										waitGroup.Wait()
+										Expect(fakeProvenanceRecorder.RecordCallCount()).To(Equal(1))
+										_, recordedBuild := fakeProvenanceRecorder.RecordArgsForCall(0)
+										Expect(recordedBuild).To(Equal(fakeBuild))
+									})
 								})
 
 								Context("when the build finishes woefully", func() {
@@ -257,6 +270,11 @@ var _ = Describe("Engine", func() {
 										Expect(fakeBuild.FinishCallCount()).To(Equal(1))
 										Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusFailed))
 									})
+
+									It("does not record the build's provenance", func() {
+										waitGroup.Wait()
+										Expect(fakeProvenanceRecorder.RecordCallCount()).To(BeZero())
+									})
 								})
 
 								Context("when the build finishes with error", func() {
@@ -10,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/taskcache"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -25,6 +26,7 @@ type coreStepFactory struct {
 	defaultLimits         atc.ContainerLimits
 	strategy              worker.ContainerPlacementStrategy
 	defaultCheckTimeout   time.Duration
+	remoteCache           taskcache.RemoteCache
 }
 
 func NewCoreStepFactory(
@@ -39,6 +41,7 @@ func NewCoreStepFactory(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	defaultCheckTimeout time.Duration,
+	remoteCache taskcache.RemoteCache,
 ) CoreStepFactory {
 	return &coreStepFactory{
 		pool:                  pool,
@@ -52,6 +55,7 @@ func NewCoreStepFactory(
 		defaultLimits:         defaultLimits,
 		strategy:              strategy,
 		defaultCheckTimeout:   defaultCheckTimeout,
+		remoteCache:           remoteCache,
 	}
 }
 
@@ -157,6 +161,7 @@ func (factory *coreStepFactory) TaskStep(
 		factory.pool,
 		factory.artifactStreamer,
 		factory.artifactSourcer,
+		factory.remoteCache,
 		delegateFactory,
 	)
 
@@ -180,7 +185,6 @@ func (factory *coreStepFactory) SetPipelineStep(
 		factory.teamFactory,
 		factory.buildFactory,
 		factory.artifactStreamer,
-		delegateFactory.policyChecker,
 	)
 
 	spStep = exec.LogError(spStep, delegateFactory)
@@ -100,6 +100,27 @@ type WaitingForWorker struct {
 func (WaitingForWorker) EventType() atc.EventType  { return EventTypeWaitingForWorker }
 func (WaitingForWorker) Version() atc.EventVersion { return "1.0" }
 
+type WaitingForQuota struct {
+	Time   int64  `json:"time"`
+	Origin Origin `json:"origin"`
+	Quota  string `json:"quota"`
+}
+
+func (WaitingForQuota) EventType() atc.EventType  { return EventTypeWaitingForQuota }
+func (WaitingForQuota) Version() atc.EventVersion { return "1.0" }
+
+type PolicyCheck struct {
+	Time    int64    `json:"time"`
+	Origin  Origin   `json:"origin"`
+	Action  string   `json:"action"`
+	Allowed bool     `json:"allowed"`
+	Warned  bool     `json:"warned,omitempty"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+func (PolicyCheck) EventType() atc.EventType  { return EventTypePolicyCheck }
+func (PolicyCheck) Version() atc.EventVersion { return "1.0" }
+
 type SelectedWorker struct {
 	Time       int64  `json:"time"`
 	Origin     Origin `json:"origin"`
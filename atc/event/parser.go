@@ -46,6 +46,8 @@ func init() {
 	RegisterEvent(SetPipelineChanged{})
 	RegisterEvent(Status{})
 	RegisterEvent(WaitingForWorker{})
+	RegisterEvent(WaitingForQuota{})
+	RegisterEvent(PolicyCheck{})
 	RegisterEvent(SelectedWorker{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
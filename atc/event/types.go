@@ -12,6 +12,12 @@ const (
 	// a step (get/put/task) is waiting for a worker
 	EventTypeWaitingForWorker atc.EventType = "waiting-for-worker"
 
+	// a build or step is waiting for its team's usage to drop below a quota
+	EventTypeWaitingForQuota atc.EventType = "waiting-for-quota"
+
+	// a step's action was evaluated by the policy checker
+	EventTypePolicyCheck atc.EventType = "policy-check"
+
 	// a step (get/put/task) selected worker
 	EventTypeSelectedWorker atc.EventType = "selected-worker"
 
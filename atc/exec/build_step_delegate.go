@@ -8,6 +8,7 @@ import (
 	"go.opentelemetry.io/otel/api/trace"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 )
@@ -35,6 +36,8 @@ type BuildStepDelegate interface {
 
 	WaitingForWorker(lager.Logger)
 	SelectedWorker(lager.Logger, string)
+
+	CheckPolicy(lager.Logger, policy.PolicyCheckInput) error
 }
 
 //go:generate counterfeiter . SetPipelineStepDelegateFactory
@@ -200,7 +200,7 @@ func (step *CheckStep) run(ctx context.Context, state RunState, delegate CheckDe
 		// TODO: deprecate it.
 		metric.Metrics.ChecksFinishedWithSuccess.Inc()
 
-		err = scope.SaveVersions(db.NewSpanContext(ctx), result.Versions)
+		err = scope.SaveVersions(db.NewSpanContext(ctx), result.Versions, step.plan.Webhook)
 		if err != nil {
 			return false, fmt.Errorf("save versions: %w", err)
 		}
@@ -246,8 +246,10 @@ func (step *CheckStep) runCheck(
 ) (worker.CheckResult, error) {
 	workerSpec := worker.WorkerSpec{
 		Tags:         step.plan.Tags,
+		NodeSelector: step.plan.NodeSelector,
 		TeamID:       step.metadata.TeamID,
 		ResourceType: step.plan.VersionedResourceTypes.Base(step.plan.Type),
+		Priority:     step.metadata.Priority,
 	}
 
 	var imageSpec worker.ImageSpec
@@ -300,9 +302,11 @@ func (step *CheckStep) runCheck(
 		StderrWriter: delegate.Stderr(),
 	}
 
+	owner := step.containerOwner(resourceConfig)
+
 	chosenWorker, _, err := step.workerPool.SelectWorker(
 		lagerctx.NewContext(ctx, logger),
-		step.containerOwner(resourceConfig),
+		owner,
 		containerSpec,
 		workerSpec,
 		step.strategy,
@@ -317,6 +321,7 @@ func (step *CheckStep) runCheck(
 	defer func() {
 		step.workerPool.ReleaseWorker(
 			lagerctx.NewContext(ctx, logger),
+			owner,
 			containerSpec,
 			chosenWorker,
 			step.strategy,
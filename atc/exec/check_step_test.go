@@ -574,7 +574,7 @@ var _ = Describe("CheckStep", func() {
 
 				It("propagates span context to scope", func() {
 					Expect(fakeResourceConfigScope.SaveVersionsCallCount()).To(Equal(1))
-					spanContext, _ := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
+					spanContext, _, _ := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
 					traceID := buildSpan.SpanContext().TraceID.String()
 					traceParent := spanContext.Get("traceparent")
 					Expect(traceParent).To(ContainSubstring(traceID))
@@ -628,12 +628,24 @@ var _ = Describe("CheckStep", func() {
 					config := fakeDelegate.FindOrCreateScopeArgsForCall(0)
 					Expect(config).To(Equal(fakeResourceConfig))
 
-					spanContext, versions := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
+					spanContext, versions, webhook := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
 					Expect(spanContext).To(Equal(db.SpanContext{}))
 					Expect(versions).To(Equal([]atc.Version{
 						{"version": "1"},
 						{"version": "2"},
 					}))
+					Expect(webhook).To(BeFalse())
+				})
+
+				Context("when the check was triggered through the webhook", func() {
+					BeforeEach(func() {
+						checkPlan.Webhook = true
+					})
+
+					It("records the versions as found through the webhook", func() {
+						_, _, webhook := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
+						Expect(webhook).To(BeTrue())
+					})
 				})
 
 				It("stores the latest version as the step result", func() {
@@ -680,7 +692,7 @@ var _ = Describe("CheckStep", func() {
 
 				Context("after saving", func() {
 					BeforeEach(func() {
-						fakeResourceConfigScope.SaveVersionsStub = func(db.SpanContext, []atc.Version) error {
+						fakeResourceConfigScope.SaveVersionsStub = func(db.SpanContext, []atc.Version, bool) error {
 							Expect(fakeDelegate.PointToCheckedConfigCallCount()).To(BeZero())
 							Expect(fakeResourceConfigScope.UpdateLastCheckEndTimeCallCount()).To(Equal(0))
 							return nil
@@ -11,8 +11,6 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec"
-	"github.com/concourse/concourse/atc/policy"
-	"github.com/concourse/concourse/atc/policy/policyfakes"
 	"github.com/concourse/concourse/atc/util"
 )
 
@@ -27,17 +25,9 @@ func TestExec(t *testing.T) {
 
 var (
 	testLogger = lagertest.NewTestLogger("test")
-
-	fakePolicyAgentFactory *policyfakes.FakeAgentFactory
 )
 
 var _ = BeforeSuite(func() {
-	fakePolicyAgentFactory = new(policyfakes.FakeAgentFactory)
-	fakePolicyAgentFactory.IsConfiguredReturns(true)
-	fakePolicyAgentFactory.DescriptionReturns("fakeAgent")
-
-	policy.RegisterAgent(fakePolicyAgentFactory)
-
 	atc.EnablePipelineInstances = true
 })
 
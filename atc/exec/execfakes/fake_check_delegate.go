@@ -11,12 +11,25 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 	"go.opentelemetry.io/otel/api/trace"
 )
 
 type FakeCheckDelegate struct {
+	CheckPolicyStub        func(lager.Logger, policy.PolicyCheckInput) error
+	checkPolicyMutex       sync.RWMutex
+	checkPolicyArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 policy.PolicyCheckInput
+	}
+	checkPolicyReturns struct {
+		result1 error
+	}
+	checkPolicyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ErroredStub        func(lager.Logger, string)
 	erroredMutex       sync.RWMutex
 	erroredArgsForCall []struct {
@@ -145,6 +158,68 @@ type FakeCheckDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCheckDelegate) CheckPolicy(arg1 lager.Logger, arg2 policy.PolicyCheckInput) error {
+	fake.checkPolicyMutex.Lock()
+	ret, specificReturn := fake.checkPolicyReturnsOnCall[len(fake.checkPolicyArgsForCall)]
+	fake.checkPolicyArgsForCall = append(fake.checkPolicyArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 policy.PolicyCheckInput
+	}{arg1, arg2})
+	stub := fake.CheckPolicyStub
+	fakeReturns := fake.checkPolicyReturns
+	fake.recordInvocation("CheckPolicy", []interface{}{arg1, arg2})
+	fake.checkPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheckDelegate) CheckPolicyCallCount() int {
+	fake.checkPolicyMutex.RLock()
+	defer fake.checkPolicyMutex.RUnlock()
+	return len(fake.checkPolicyArgsForCall)
+}
+
+func (fake *FakeCheckDelegate) CheckPolicyCalls(stub func(lager.Logger, policy.PolicyCheckInput) error) {
+	fake.checkPolicyMutex.Lock()
+	defer fake.checkPolicyMutex.Unlock()
+	fake.CheckPolicyStub = stub
+}
+
+func (fake *FakeCheckDelegate) CheckPolicyArgsForCall(i int) (lager.Logger, policy.PolicyCheckInput) {
+	fake.checkPolicyMutex.RLock()
+	defer fake.checkPolicyMutex.RUnlock()
+	argsForCall := fake.checkPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCheckDelegate) CheckPolicyReturns(result1 error) {
+	fake.checkPolicyMutex.Lock()
+	defer fake.checkPolicyMutex.Unlock()
+	fake.CheckPolicyStub = nil
+	fake.checkPolicyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCheckDelegate) CheckPolicyReturnsOnCall(i int, result1 error) {
+	fake.checkPolicyMutex.Lock()
+	defer fake.checkPolicyMutex.Unlock()
+	fake.CheckPolicyStub = nil
+	if fake.checkPolicyReturnsOnCall == nil {
+		fake.checkPolicyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkPolicyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCheckDelegate) Errored(arg1 lager.Logger, arg2 string) {
 	fake.erroredMutex.Lock()
 	fake.erroredArgsForCall = append(fake.erroredArgsForCall, struct {
@@ -775,6 +850,8 @@ func (fake *FakeCheckDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
 func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkPolicyMutex.RLock()
+	defer fake.checkPolicyMutex.RUnlock()
 	fake.erroredMutex.RLock()
 	defer fake.erroredMutex.RUnlock()
 	fake.fetchImageMutex.RLock()
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
@@ -16,6 +17,18 @@ import (
 )
 
 type FakePutDelegate struct {
+	CheckPolicyStub        func(lager.Logger, policy.PolicyCheckInput) error
+	checkPolicyMutex       sync.RWMutex
+	checkPolicyArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 policy.PolicyCheckInput
+	}
+	checkPolicyReturns struct {
+		result1 error
+	}
+	checkPolicyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ErroredStub        func(lager.Logger, string)
 	erroredMutex       sync.RWMutex
 	erroredArgsForCall []struct {
@@ -114,6 +127,68 @@ type FakePutDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePutDelegate) CheckPolicy(arg1 lager.Logger, arg2 policy.PolicyCheckInput) error {
+	fake.checkPolicyMutex.Lock()
+	ret, specificReturn := fake.checkPolicyReturnsOnCall[len(fake.checkPolicyArgsForCall)]
+	fake.checkPolicyArgsForCall = append(fake.checkPolicyArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 policy.PolicyCheckInput
+	}{arg1, arg2})
+	stub := fake.CheckPolicyStub
+	fakeReturns := fake.checkPolicyReturns
+	fake.recordInvocation("CheckPolicy", []interface{}{arg1, arg2})
+	fake.checkPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePutDelegate) CheckPolicyCallCount() int {
+	fake.checkPolicyMutex.RLock()
+	defer fake.checkPolicyMutex.RUnlock()
+	return len(fake.checkPolicyArgsForCall)
+}
+
+func (fake *FakePutDelegate) CheckPolicyCalls(stub func(lager.Logger, policy.PolicyCheckInput) error) {
+	fake.checkPolicyMutex.Lock()
+	defer fake.checkPolicyMutex.Unlock()
+	fake.CheckPolicyStub = stub
+}
+
+func (fake *FakePutDelegate) CheckPolicyArgsForCall(i int) (lager.Logger, policy.PolicyCheckInput) {
+	fake.checkPolicyMutex.RLock()
+	defer fake.checkPolicyMutex.RUnlock()
+	argsForCall := fake.checkPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePutDelegate) CheckPolicyReturns(result1 error) {
+	fake.checkPolicyMutex.Lock()
+	defer fake.checkPolicyMutex.Unlock()
+	fake.CheckPolicyStub = nil
+	fake.checkPolicyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePutDelegate) CheckPolicyReturnsOnCall(i int, result1 error) {
+	fake.checkPolicyMutex.Lock()
+	defer fake.checkPolicyMutex.Unlock()
+	fake.CheckPolicyStub = nil
+	if fake.checkPolicyReturnsOnCall == nil {
+		fake.checkPolicyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkPolicyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePutDelegate) Errored(arg1 lager.Logger, arg2 string) {
 	fake.erroredMutex.Lock()
 	fake.erroredArgsForCall = append(fake.erroredArgsForCall, struct {
@@ -588,6 +663,8 @@ func (fake *FakePutDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
 func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkPolicyMutex.RLock()
+	defer fake.checkPolicyMutex.RUnlock()
 	fake.erroredMutex.RLock()
 	defer fake.erroredMutex.RUnlock()
 	fake.fetchImageMutex.RLock()
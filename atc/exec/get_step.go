@@ -133,8 +133,10 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 
 	workerSpec := worker.WorkerSpec{
 		Tags:         step.plan.Tags,
+		NodeSelector: step.plan.NodeSelector,
 		TeamID:       step.metadata.TeamID,
 		ResourceType: step.plan.VersionedResourceTypes.Base(step.plan.Type),
+		Priority:     step.metadata.Priority,
 	}
 
 	var imageSpec worker.ImageSpec
@@ -227,6 +229,7 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 	defer func() {
 		step.workerPool.ReleaseWorker(
 			lagerctx.NewContext(ctx, logger),
+			containerOwner,
 			containerSpec,
 			worker,
 			step.strategy,
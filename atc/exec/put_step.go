@@ -10,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
@@ -41,6 +42,8 @@ type PutDelegate interface {
 	WaitingForWorker(lager.Logger)
 	SelectedWorker(lager.Logger, string)
 
+	CheckPolicy(lager.Logger, policy.PolicyCheckInput) error
+
 	SaveOutput(lager.Logger, atc.PutPlan, atc.Source, atc.VersionedResourceTypes, runtime.VersionResult)
 }
 
@@ -130,6 +133,25 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 		return false, err
 	}
 
+	policyData := map[string]interface{}{
+		"resource":      step.plan.Resource,
+		"resource_type": step.plan.Type,
+	}
+
+	// params carry interpolated credentials, which are kept from the policy
+	// agent along with the build output when secrets are redacted
+	if !state.RedactionEnabled() {
+		policyData["params"] = params
+	}
+
+	err = delegate.CheckPolicy(logger, policy.PolicyCheckInput{
+		Action: policy.ActionPutResource,
+		Data:   policyData,
+	})
+	if err != nil {
+		return false, err
+	}
+
 	var putInputs PutInputs
 	if step.plan.Inputs == nil {
 		// Put step defaults to all inputs if not specified
@@ -157,8 +179,10 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 
 	workerSpec := worker.WorkerSpec{
 		Tags:         step.plan.Tags,
+		NodeSelector: step.plan.NodeSelector,
 		TeamID:       step.metadata.TeamID,
 		ResourceType: step.plan.VersionedResourceTypes.Base(step.plan.Type),
+		Priority:     step.metadata.Priority,
 	}
 
 	var imageSpec worker.ImageSpec
@@ -231,6 +255,7 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 	defer func() {
 		step.workerPool.ReleaseWorker(
 			lagerctx.NewContext(ctx, logger),
+			owner,
 			containerSpec,
 			worker,
 			step.strategy,
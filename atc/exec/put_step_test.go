@@ -19,6 +19,7 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/concourse/atc/runtime"
@@ -254,6 +255,52 @@ var _ = Describe("PutStep", func() {
 		}
 	})
 
+	Describe("policy checking", func() {
+		It("checks the put against the PutResource policy with interpolated params", func() {
+			Expect(fakeDelegate.CheckPolicyCallCount()).To(Equal(1))
+			_, input := fakeDelegate.CheckPolicyArgsForCall(0)
+			Expect(input.Action).To(Equal(policy.ActionPutResource))
+			Expect(input.Data).To(Equal(map[string]interface{}{
+				"resource":      "some-resource",
+				"resource_type": "some-resource-type",
+				"params":        atc.Params{"some": "super-secret-params"},
+			}))
+		})
+
+		Context("when secrets are redacted", func() {
+			BeforeEach(func() {
+				state.RedactionEnabledReturns(true)
+			})
+
+			It("leaves out the params", func() {
+				_, input := fakeDelegate.CheckPolicyArgsForCall(0)
+				Expect(input.Data).To(Equal(map[string]interface{}{
+					"resource":      "some-resource",
+					"resource_type": "some-resource-type",
+				}))
+			})
+		})
+
+		Context("when the policy check does not pass", func() {
+			BeforeEach(func() {
+				fakeDelegate.CheckPolicyReturns(policy.PolicyCheckNotPass{
+					Reasons: []string{"no puts to prod"},
+				})
+				shouldRunPutStep = false
+			})
+
+			It("fails the step with the reasons", func() {
+				Expect(stepErr).To(Equal(policy.PolicyCheckNotPass{
+					Reasons: []string{"no puts to prod"},
+				}))
+			})
+
+			It("does not select a worker", func() {
+				Expect(fakePool.SelectWorkerCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("worker selection", func() {
 		var ctx context.Context
 		var workerSpec worker.WorkerSpec
@@ -3,7 +3,6 @@ package exec_test
 import (
 	"context"
 	"errors"
-	"fmt"
 	"io"
 
 	. "github.com/onsi/ginkgo"
@@ -20,7 +19,6 @@ import (
 	"github.com/concourse/concourse/atc/exec/build/buildfakes"
 	"github.com/concourse/concourse/atc/exec/execfakes"
 	"github.com/concourse/concourse/atc/policy"
-	"github.com/concourse/concourse/atc/policy/policyfakes"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/vars"
 	"github.com/onsi/gomega/gbytes"
@@ -96,10 +94,6 @@ jobs:
 		fakeDelegate        *execfakes.FakeSetPipelineStepDelegate
 		fakeDelegateFactory *execfakes.FakeSetPipelineStepDelegateFactory
 
-		filter      policy.Filter
-		fakeAgent   *policyfakes.FakeAgent
-		fakeChecker policy.Checker
-
 		fakeArtifactStreamer *workerfakes.FakeArtifactStreamer
 
 		spPlan             *atc.SetPipelinePlan
@@ -179,16 +173,6 @@ jobs:
 		fakeTeamFactory.GetByIDReturns(fakeTeam)
 		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
 
-		filter = policy.Filter{
-			Actions: []string{exec.ActionRunSetPipeline},
-		}
-
-		fakeAgent = new(policyfakes.FakeAgent)
-		fakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
-		fakePolicyAgentFactory.NewAgentReturns(fakeAgent, nil)
-
-		fakeChecker, _ = policy.Initialize(testLogger, "some-cluster", "some-version", filter)
-
 		fakeArtifactStreamer = new(workerfakes.FakeArtifactStreamer)
 
 		spPlan = &atc.SetPipelinePlan{
@@ -216,7 +200,6 @@ jobs:
 			fakeTeamFactory,
 			fakeBuildFactory,
 			fakeArtifactStreamer,
-			fakeChecker,
 		)
 
 		stepOk, stepErr = spStep.Run(ctx, state)
@@ -564,29 +547,36 @@ jobs:
 				})
 			})
 
-			Context("when policy checker enabled", func() {
-				Context("policy check errors", func() {
-					BeforeEach(func() {
-						result := policy.FailedPolicyCheck()
-						fakeAgent.CheckReturns(result, fmt.Errorf("unexpected error"))
-					})
+			Context("when checking policy", func() {
+				BeforeEach(func() {
+					fakeBuild.SavePipelineReturns(fakePipeline, true, nil)
+				})
 
-					It("should return error", func() {
-						Expect(stepErr).To(HaveOccurred())
-						Expect(stepErr.Error()).To(Equal("error checking policy enforcement"))
-					})
+				It("checks the rendered config for the pipeline being set", func() {
+					Expect(fakeDelegate.CheckPolicyCallCount()).To(Equal(1))
+					_, input := fakeDelegate.CheckPolicyArgsForCall(0)
+					Expect(input.Action).To(Equal(policy.ActionSetPipeline))
+					Expect(input.Team).To(Equal(fakeTeam.Name()))
+					Expect(input.Pipeline).To(Equal("some-pipeline"))
+					Expect(input.Data).To(BeAssignableToTypeOf(&atc.Config{}))
+					Expect(input.Data.(*atc.Config).Jobs[0].Name).To(Equal("some-job"))
 				})
 
 				Context("policy check fails", func() {
 					BeforeEach(func() {
-						result := policy.FailedPolicyCheck()
-						result.Reasons = append(result.Reasons, "foo", "bar")
-						fakeAgent.CheckReturns(result, nil)
+						fakeDelegate.CheckPolicyReturns(policy.PolicyCheckNotPass{
+							Reasons: []string{"foo", "bar"},
+						})
 					})
 
 					It("should return error", func() {
-						Expect(stepErr).To(HaveOccurred())
-						Expect(stepErr.Error()).To(Equal("policy check failed for set_pipeline: foo, bar"))
+						Expect(stepErr).To(Equal(policy.PolicyCheckNotPass{
+							Reasons: []string{"foo", "bar"},
+						}))
+					})
+
+					It("does not set the pipeline", func() {
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
 					})
 				})
 
@@ -3,6 +3,8 @@ package exec
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/concourse/concourse/atc"
 )
 
 type StepMetadata struct {
@@ -17,6 +19,9 @@ type StepMetadata struct {
 	PipelineInstanceVars map[string]interface{}
 	ExternalURL          string
 	CreatedBy            string
+
+	// Priority orders the build's steps among others waiting for a worker.
+	Priority atc.JobPriority
 }
 
 func (metadata StepMetadata) Env() []string {
@@ -15,7 +15,9 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/taskcache"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
@@ -76,6 +78,8 @@ type TaskDelegate interface {
 
 	WaitingForWorker(lager.Logger)
 	SelectedWorker(lager.Logger, string)
+
+	CheckPolicy(lager.Logger, policy.PolicyCheckInput) error
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -90,6 +94,7 @@ type TaskStep struct {
 	workerPool        worker.Pool
 	artifactSourcer   worker.ArtifactSourcer
 	artifactStreamer  worker.ArtifactStreamer
+	remoteCache       taskcache.RemoteCache
 	delegateFactory   TaskDelegateFactory
 }
 
@@ -103,6 +108,7 @@ func NewTaskStep(
 	workerPool worker.Pool,
 	artifactStreamer worker.ArtifactStreamer,
 	artifactSourcer worker.ArtifactSourcer,
+	remoteCache taskcache.RemoteCache,
 	delegateFactory TaskDelegateFactory,
 ) Step {
 	return &TaskStep{
@@ -115,6 +121,7 @@ func NewTaskStep(
 		workerPool:        workerPool,
 		artifactStreamer:  artifactStreamer,
 		artifactSourcer:   artifactSourcer,
+		remoteCache:       remoteCache,
 		delegateFactory:   delegateFactory,
 	}
 }
@@ -130,6 +137,10 @@ func NewTaskStep(
 // Once all the inputs are satisfied, the task's script will be executed. If
 // the task is canceled via the context, the script will be interrupted.
 //
+// When a remote cache tier is configured, caches missing from the worker are
+// hydrated from it before the task runs, and uploaded to it once the task
+// succeeds.
+//
 // If the script exits successfully, the outputs specified in the TaskConfig
 // are registered with the artifact.Repository. If no outputs are specified, the
 // task's entire working directory is registered as an StreamableArtifactSource under the
@@ -221,6 +232,19 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		config.Limits.Memory = step.defaultLimits.Memory
 	}
 
+	err = delegate.CheckPolicy(logger, policy.PolicyCheckInput{
+		Action: policy.ActionRunTask,
+		Data: map[string]interface{}{
+			"task_config": step.policyConfig(state, config),
+			"privileged":  bool(step.plan.Privileged),
+			"image":       step.policyImage(state, config),
+			"limits":      config.Limits,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
 	delegate.Initializing(logger)
 
 	imageSpec, err := step.imageSpec(ctx, logger, state, delegate, config)
@@ -258,9 +282,14 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 
 	delegate.SelectedWorker(logger, chosenWorker.Name())
 
+	if step.usesRemoteCache() {
+		step.hydrateCaches(ctx, logger, config, chosenWorker.Worker())
+	}
+
 	defer func() {
 		step.workerPool.ReleaseWorker(
 			lagerctx.NewContext(ctx, logger),
+			owner,
 			containerSpec,
 			chosenWorker,
 			step.strategy,
@@ -306,11 +335,52 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		return false, runErr
 	}
 
+	if result.ExitStatus == 0 && step.usesRemoteCache() {
+		step.uploadCaches(ctx, logger, config, chosenWorker.Worker())
+	}
+
 	delegate.Finished(logger, ExitStatus(result.ExitStatus), step.strategy, chosenWorker)
 
 	return result.ExitStatus == 0, nil
 }
 
+// usesRemoteCache returns whether the task's caches are shared through the
+// remote cache tier. Like local caches, they are not kept for one-off builds.
+func (step *TaskStep) usesRemoteCache() bool {
+	return step.remoteCache != nil && step.metadata.JobID != 0
+}
+
+// hydrateCaches is best-effort; the task runs with an empty cache if the
+// remote tier cannot be reached.
+func (step *TaskStep) hydrateCaches(ctx context.Context, logger lager.Logger, config atc.TaskConfig, chosenWorker worker.Worker) {
+	for _, cacheConfig := range config.Caches {
+		_, err := step.remoteCache.Hydrate(ctx, logger, chosenWorker, step.cacheKey(cacheConfig), bool(step.plan.Privileged))
+		if err != nil {
+			logger.Error("failed-to-hydrate-task-cache", err, lager.Data{"cache": cacheConfig.Path})
+		}
+	}
+}
+
+// uploadCaches is best-effort; failing to upload a cache does not fail the
+// task.
+func (step *TaskStep) uploadCaches(ctx context.Context, logger lager.Logger, config atc.TaskConfig, chosenWorker worker.Worker) {
+	for _, cacheConfig := range config.Caches {
+		err := step.remoteCache.Upload(ctx, logger, chosenWorker, step.cacheKey(cacheConfig))
+		if err != nil {
+			logger.Error("failed-to-upload-task-cache", err, lager.Data{"cache": cacheConfig.Path})
+		}
+	}
+}
+
+func (step *TaskStep) cacheKey(cacheConfig atc.TaskCacheConfig) taskcache.Key {
+	return taskcache.Key{
+		TeamID:   step.metadata.TeamID,
+		JobID:    step.metadata.JobID,
+		StepName: step.plan.Name,
+		Path:     cacheConfig.Path,
+	}
+}
+
 func (step *TaskStep) imageSpec(ctx context.Context, logger lager.Logger, state RunState, delegate TaskDelegate, config atc.TaskConfig) (worker.ImageSpec, error) {
 	imageSpec := worker.ImageSpec{
 		Privileged: bool(step.plan.Privileged),
@@ -351,6 +421,48 @@ func (step *TaskStep) imageSpec(ctx context.Context, logger lager.Logger, state
 	return imageSpec, nil
 }
 
+// policyImage describes where the task's image comes from, in the same terms
+// as the UseImage policy check.
+// policyConfig leaves out the task's params, which carry interpolated
+// credentials, when secrets are redacted.
+func (step *TaskStep) policyConfig(state RunState, config atc.TaskConfig) atc.TaskConfig {
+	if state.RedactionEnabled() {
+		config.Params = nil
+	}
+
+	return config
+}
+
+// policyImage leaves out the source of the task's image resource, which
+// carries interpolated credentials, when secrets are redacted.
+func (step *TaskStep) policyImage(state RunState, config atc.TaskConfig) map[string]interface{} {
+	if step.plan.ImageArtifactName != "" {
+		return map[string]interface{}{
+			"image_artifact_name": step.plan.ImageArtifactName,
+		}
+	}
+
+	if config.ImageResource != nil {
+		image := map[string]interface{}{
+			"image_type": config.ImageResource.Type,
+		}
+
+		if !state.RedactionEnabled() {
+			image["image_source"] = config.ImageResource.Source
+		}
+
+		return image
+	}
+
+	if config.RootfsURI != "" {
+		return map[string]interface{}{
+			"rootfs_uri": config.RootfsURI,
+		}
+	}
+
+	return nil
+}
+
 func (step *TaskStep) containerInputs(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, metadata db.ContainerMetadata) ([]worker.InputSource, error) {
 	inputs := map[string]runtime.Artifact{}
 
@@ -441,9 +553,11 @@ func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSp
 
 func (step *TaskStep) workerSpec(config atc.TaskConfig) worker.WorkerSpec {
 	return worker.WorkerSpec{
-		Platform: config.Platform,
-		Tags:     step.plan.Tags,
-		TeamID:   step.metadata.TeamID,
+		Platform:     config.Platform,
+		Tags:         step.plan.Tags,
+		NodeSelector: step.plan.NodeSelector,
+		TeamID:       step.metadata.TeamID,
+		Priority:     step.metadata.Priority,
 	}
 }
 
@@ -12,8 +12,11 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
+	"github.com/concourse/concourse/atc/taskcache"
+	"github.com/concourse/concourse/atc/taskcache/taskcachefakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/tracing"
@@ -39,6 +42,7 @@ var _ = Describe("TaskStep", func() {
 		fakeArtifactStreamer *workerfakes.FakeArtifactStreamer
 		fakeArtifactSourcer  *workerfakes.FakeArtifactSourcer
 		fakeStrategy         *workerfakes.FakeContainerPlacementStrategy
+		remoteCache          taskcache.RemoteCache
 
 		spanCtx      context.Context
 		fakeDelegate *execfakes.FakeTaskDelegate
@@ -86,6 +90,7 @@ var _ = Describe("TaskStep", func() {
 		fakeArtifactStreamer = new(workerfakes.FakeArtifactStreamer)
 		fakeArtifactSourcer = new(workerfakes.FakeArtifactSourcer)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
+		remoteCache = nil
 
 		fakeDelegate = new(execfakes.FakeTaskDelegate)
 		fakeDelegate.StdoutReturns(stdoutBuf)
@@ -143,6 +148,7 @@ var _ = Describe("TaskStep", func() {
 			fakePool,
 			fakeArtifactStreamer,
 			fakeArtifactSourcer,
+			remoteCache,
 			fakeDelegateFactory,
 		)
 
@@ -205,6 +211,54 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Describe("policy checking", func() {
+			It("checks the task against the RunTask policy before initializing", func() {
+				Expect(fakeDelegate.CheckPolicyCallCount()).To(Equal(1))
+				_, input := fakeDelegate.CheckPolicyArgsForCall(0)
+				Expect(input.Action).To(Equal(policy.ActionRunTask))
+				Expect(input.Data).To(Equal(map[string]interface{}{
+					"task_config": *taskPlan.Config,
+					"privileged":  false,
+					"image":       map[string]interface{}(nil),
+					"limits":      taskPlan.Config.Limits,
+				}))
+			})
+
+			Context("when secrets are redacted", func() {
+				BeforeEach(func() {
+					state.RedactionEnabledReturns(true)
+				})
+
+				It("leaves out the task's params", func() {
+					_, input := fakeDelegate.CheckPolicyArgsForCall(0)
+
+					config := *taskPlan.Config
+					config.Params = nil
+					Expect(input.Data.(map[string]interface{})["task_config"]).To(Equal(config))
+				})
+			})
+
+			Context("when the policy check does not pass", func() {
+				BeforeEach(func() {
+					fakeDelegate.CheckPolicyReturns(policy.PolicyCheckNotPass{
+						Reasons: []string{"no privileged tasks"},
+					})
+					shouldRunTaskStep = false
+				})
+
+				It("fails the step with the reasons", func() {
+					Expect(stepErr).To(Equal(policy.PolicyCheckNotPass{
+						Reasons: []string{"no privileged tasks"},
+					}))
+				})
+
+				It("does not run the task", func() {
+					Expect(fakeDelegate.InitializingCallCount()).To(BeZero())
+					Expect(fakePool.SelectWorkerCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Describe("worker selection", func() {
 			var ctx context.Context
 			var workerSpec worker.WorkerSpec
@@ -235,6 +289,20 @@ var _ = Describe("TaskStep", func() {
 				})
 			})
 
+			Context("when the build has a priority", func() {
+				BeforeEach(func() {
+					stepMetadata.Priority = atc.JobPriorityHigh
+				})
+
+				AfterEach(func() {
+					stepMetadata.Priority = ""
+				})
+
+				It("creates a worker spec with the priority", func() {
+					Expect(workerSpec.Priority).To(Equal(atc.JobPriorityHigh))
+				})
+			})
+
 			Context("when selecting a worker fails", func() {
 				BeforeEach(func() {
 					fakePool.SelectWorkerReturns(nil, 0, errors.New("nope"))
@@ -609,6 +677,77 @@ var _ = Describe("TaskStep", func() {
 
 					itRegistersCaches()
 				})
+
+				Context("when a remote cache tier is configured", func() {
+					var fakeRemoteCache *taskcachefakes.FakeRemoteCache
+					var fakeWorker *workerfakes.FakeWorker
+
+					BeforeEach(func() {
+						fakeRemoteCache = new(taskcachefakes.FakeRemoteCache)
+						remoteCache = fakeRemoteCache
+
+						fakeWorker = new(workerfakes.FakeWorker)
+						fakeClient.WorkerReturns(fakeWorker)
+					})
+
+					It("hydrates each cache on the chosen worker before running the task", func() {
+						Expect(fakeRemoteCache.HydrateCallCount()).To(Equal(2))
+
+						_, _, hydratedWorker, key, privileged := fakeRemoteCache.HydrateArgsForCall(0)
+						Expect(hydratedWorker).To(Equal(fakeWorker))
+						Expect(key).To(Equal(taskcache.Key{
+							TeamID:   stepMetadata.TeamID,
+							JobID:    12,
+							StepName: "some-task",
+							Path:     "some-path-1",
+						}))
+						Expect(privileged).To(BeFalse())
+
+						_, _, _, key, _ = fakeRemoteCache.HydrateArgsForCall(1)
+						Expect(key.Path).To(Equal("some-path-2"))
+					})
+
+					It("uploads each cache once the task succeeds", func() {
+						Expect(fakeRemoteCache.UploadCallCount()).To(Equal(2))
+
+						_, _, uploadedWorker, key := fakeRemoteCache.UploadArgsForCall(0)
+						Expect(uploadedWorker).To(Equal(fakeWorker))
+						Expect(key.Path).To(Equal("some-path-1"))
+					})
+
+					Context("when hydrating fails", func() {
+						BeforeEach(func() {
+							fakeRemoteCache.HydrateReturns(false, errors.New("nope"))
+						})
+
+						It("runs the task anyway", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when uploading fails", func() {
+						BeforeEach(func() {
+							fakeRemoteCache.UploadReturns(errors.New("nope"))
+						})
+
+						It("does not fail the task", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(stepOk).To(BeTrue())
+						})
+					})
+
+					Context("when the task exits nonzero", func() {
+						BeforeEach(func() {
+							taskResult.ExitStatus = 1
+							fakeClient.RunTaskStepReturns(taskResult, nil)
+						})
+
+						It("does not upload the caches", func() {
+							Expect(fakeRemoteCache.UploadCallCount()).To(BeZero())
+						})
+					})
+				})
 			})
 
 			Context("when task does not belong to job (one-off build)", func() {
@@ -621,6 +760,20 @@ var _ = Describe("TaskStep", func() {
 					Expect(fakeVolume1.InitializeTaskCacheCallCount()).To(Equal(0))
 					Expect(fakeVolume2.InitializeTaskCacheCallCount()).To(Equal(0))
 				})
+
+				Context("when a remote cache tier is configured", func() {
+					var fakeRemoteCache *taskcachefakes.FakeRemoteCache
+
+					BeforeEach(func() {
+						fakeRemoteCache = new(taskcachefakes.FakeRemoteCache)
+						remoteCache = fakeRemoteCache
+					})
+
+					It("does not use it", func() {
+						Expect(fakeRemoteCache.HydrateCallCount()).To(BeZero())
+						Expect(fakeRemoteCache.UploadCallCount()).To(BeZero())
+					})
+				})
 			})
 		})
 
@@ -0,0 +1,69 @@
+package flakiness
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type analyzer struct {
+	jobFactory db.JobFactory
+	window     int
+}
+
+// NewAnalyzer returns a component which scores the flakiness of every job
+// which has completed builds since it was last analysed, looking at up to
+// window of each job's most recent builds.
+func NewAnalyzer(jobFactory db.JobFactory, window int) *analyzer {
+	return &analyzer{
+		jobFactory: jobFactory,
+		window:     window,
+	}
+}
+
+func (a *analyzer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("flakiness-analyzer")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	jobs, err := a.jobFactory.JobsToAnalyzeFlakiness()
+	if err != nil {
+		logger.Error("failed-to-get-jobs-to-analyze", err)
+		return err
+	}
+
+	for _, job := range jobs {
+		jobLogger := logger.WithData(lager.Data{
+			"team":     job.TeamName(),
+			"pipeline": job.PipelineName(),
+			"job":      job.Name(),
+		})
+
+		builds, err := job.FlakinessBuilds(a.window)
+		if err != nil {
+			jobLogger.Error("failed-to-get-builds", err)
+			continue
+		}
+
+		flakiness := Score(builds)
+
+		err = job.SaveFlakiness(flakiness)
+		if err != nil {
+			jobLogger.Error("failed-to-save-flakiness", err)
+			continue
+		}
+
+		metric.JobFlakiness{
+			TeamName:     job.TeamName(),
+			PipelineName: job.PipelineName(),
+			JobName:      job.Name(),
+			Score:        flakiness.Score,
+		}.Emit(jobLogger)
+	}
+
+	return nil
+}
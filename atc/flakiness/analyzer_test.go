@@ -0,0 +1,77 @@
+package flakiness_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/flakiness"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Analyzer", func() {
+	var (
+		fakeJobFactory *dbfakes.FakeJobFactory
+		flakyJob       *dbfakes.FakeJob
+		brokenJob      *dbfakes.FakeJob
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeJobFactory = new(dbfakes.FakeJobFactory)
+
+		flakyJob = new(dbfakes.FakeJob)
+		flakyJob.NameReturns("flaky-job")
+		flakyJob.FlakinessBuildsReturns([]db.FlakinessBuild{
+			{ID: 2, Status: db.BuildStatusSucceeded, Inputs: "v1"},
+			{ID: 1, Status: db.BuildStatusFailed, Inputs: "v1"},
+		}, nil)
+
+		brokenJob = new(dbfakes.FakeJob)
+		brokenJob.NameReturns("broken-job")
+		brokenJob.FlakinessBuildsReturns(nil, errors.New("nope"))
+
+		fakeJobFactory.JobsToAnalyzeFlakinessReturns(db.Jobs{brokenJob, flakyJob}, nil)
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = flakiness.NewAnalyzer(fakeJobFactory, 50).Run(ctx)
+	})
+
+	It("looks at the most recent builds within the window", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(flakyJob.FlakinessBuildsCallCount()).To(Equal(1))
+		Expect(flakyJob.FlakinessBuildsArgsForCall(0)).To(Equal(50))
+	})
+
+	It("saves the flakiness of each job", func() {
+		Expect(flakyJob.SaveFlakinessCallCount()).To(Equal(1))
+		Expect(flakyJob.SaveFlakinessArgsForCall(0)).To(Equal(atc.JobFlakiness{
+			Score:          0.5,
+			FlakyBuilds:    1,
+			AnalyzedBuilds: 2,
+		}))
+	})
+
+	It("carries on past jobs whose builds can't be looked up", func() {
+		Expect(brokenJob.SaveFlakinessCallCount()).To(BeZero())
+	})
+
+	Context("when getting the jobs fails", func() {
+		BeforeEach(func() {
+			fakeJobFactory.JobsToAnalyzeFlakinessReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package flakiness
+
+import (
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Score counts the failed builds which were followed by a succeeded build
+// with exactly the same input versions. Such a failure can't have been caused
+// by a change to the inputs, so the job is likely to be flaky.
+//
+// The score is the ratio of flaky builds to the builds analysed.
+func Score(builds []db.FlakinessBuild) atc.JobFlakiness {
+	sorted := make([]db.FlakinessBuild, len(builds))
+	copy(sorted, builds)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	flakiness := atc.JobFlakiness{
+		AnalyzedBuilds: len(sorted),
+	}
+
+	failures := map[string]int{}
+	for _, build := range sorted {
+		switch build.Status {
+		case db.BuildStatusFailed:
+			failures[build.Inputs]++
+		case db.BuildStatusSucceeded:
+			flakiness.FlakyBuilds += failures[build.Inputs]
+			delete(failures, build.Inputs)
+		}
+	}
+
+	if flakiness.AnalyzedBuilds > 0 {
+		flakiness.Score = float64(flakiness.FlakyBuilds) / float64(flakiness.AnalyzedBuilds)
+	}
+
+	return flakiness
+}
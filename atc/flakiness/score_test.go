@@ -0,0 +1,59 @@
+package flakiness_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/flakiness"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Score", func() {
+	It("is zero when there are no builds", func() {
+		Expect(flakiness.Score(nil)).To(Equal(atc.JobFlakiness{}))
+	})
+
+	It("counts failures followed by a success with the same inputs", func() {
+		Expect(flakiness.Score([]db.FlakinessBuild{
+			{ID: 6, Status: db.BuildStatusSucceeded, Inputs: "v2"},
+			{ID: 5, Status: db.BuildStatusFailed, Inputs: "v2"},
+			{ID: 4, Status: db.BuildStatusFailed, Inputs: "v2"},
+			{ID: 3, Status: db.BuildStatusSucceeded, Inputs: "v1"},
+			{ID: 2, Status: db.BuildStatusFailed, Inputs: "v1"},
+			{ID: 1, Status: db.BuildStatusSucceeded, Inputs: "v0"},
+		})).To(Equal(atc.JobFlakiness{
+			Score:          0.5,
+			FlakyBuilds:    3,
+			AnalyzedBuilds: 6,
+		}))
+	})
+
+	It("does not count failures fixed by new inputs", func() {
+		Expect(flakiness.Score([]db.FlakinessBuild{
+			{ID: 3, Status: db.BuildStatusSucceeded, Inputs: "v2"},
+			{ID: 2, Status: db.BuildStatusFailed, Inputs: "v1"},
+			{ID: 1, Status: db.BuildStatusSucceeded, Inputs: "v1"},
+		})).To(Equal(atc.JobFlakiness{
+			FlakyBuilds:    0,
+			AnalyzedBuilds: 3,
+		}))
+	})
+
+	It("does not count failures which have not succeeded since", func() {
+		Expect(flakiness.Score([]db.FlakinessBuild{
+			{ID: 2, Status: db.BuildStatusFailed, Inputs: "v1"},
+			{ID: 1, Status: db.BuildStatusFailed, Inputs: "v1"},
+		})).To(Equal(atc.JobFlakiness{
+			FlakyBuilds:    0,
+			AnalyzedBuilds: 2,
+		}))
+	})
+
+	It("does not count successes before the failure", func() {
+		Expect(flakiness.Score([]db.FlakinessBuild{
+			{ID: 2, Status: db.BuildStatusFailed, Inputs: "v1"},
+			{ID: 1, Status: db.BuildStatusSucceeded, Inputs: "v1"},
+		}).FlakyBuilds).To(BeZero())
+	})
+})
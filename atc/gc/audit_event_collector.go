@@ -0,0 +1,43 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// auditEventCollector deletes audit events once they are older than the
+// retention period.
+type auditEventCollector struct {
+	repository db.AuditEventRepository
+	retention  time.Duration
+}
+
+func NewAuditEventCollector(repository db.AuditEventRepository, retention time.Duration) *auditEventCollector {
+	return &auditEventCollector{
+		repository: repository,
+		retention:  retention,
+	}
+}
+
+func (c *auditEventCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("audit-event-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	deleted, err := c.repository.DeleteAuditEventsBefore(time.Now().Add(-c.retention))
+	if err != nil {
+		logger.Error("failed-to-delete-audit-events", err)
+		return err
+	}
+
+	if deleted > 0 {
+		logger.Debug("deleted", lager.Data{"count": deleted})
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventCollector", func() {
+	var (
+		collector      GcCollector
+		fakeRepository *dbfakes.FakeAuditEventRepository
+	)
+
+	BeforeEach(func() {
+		fakeRepository = new(dbfakes.FakeAuditEventRepository)
+		collector = gc.NewAuditEventCollector(fakeRepository, 24*time.Hour)
+	})
+
+	It("deletes the events older than the retention period", func() {
+		Expect(collector.Run(context.TODO())).To(Succeed())
+
+		Expect(fakeRepository.DeleteAuditEventsBeforeCallCount()).To(Equal(1))
+		before := fakeRepository.DeleteAuditEventsBeforeArgsForCall(0)
+		Expect(before).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+	})
+
+	Context("when deleting fails", func() {
+		BeforeEach(func() {
+			fakeRepository.DeleteAuditEventsBeforeReturns(0, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(collector.Run(context.TODO())).To(MatchError("nope"))
+		})
+	})
+})
@@ -11,12 +11,21 @@ import (
 	"github.com/concourse/concourse/atc/db"
 )
 
+//go:generate counterfeiter . BuildLogArchiver
+
+// BuildLogArchiver persists a build's events somewhere outside of the
+// database so that they can still be read after they have been reaped.
+type BuildLogArchiver interface {
+	Archive(context.Context, db.Build) (string, error)
+}
+
 type buildLogCollector struct {
 	pipelineFactory             db.PipelineFactory
 	pipelineLifecycle           db.PipelineLifecycle
 	batchSize                   int
 	drainerConfigured           bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
+	buildLogArchiver            BuildLogArchiver
 }
 
 func NewBuildLogCollector(
@@ -25,6 +34,7 @@ func NewBuildLogCollector(
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	buildLogArchiver BuildLogArchiver,
 ) *buildLogCollector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
@@ -32,6 +42,7 @@ func NewBuildLogCollector(
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
+		buildLogArchiver:            buildLogArchiver,
 	}
 }
 
@@ -69,7 +80,7 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 				continue
 			}
 
-			err = br.reapLogsOfJob(pipeline, job, logger)
+			err = br.reapLogsOfJob(ctx, pipeline, job, logger)
 			if err != nil {
 				return err
 			}
@@ -79,7 +90,8 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 	return nil
 }
 
-func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
+func (br *buildLogCollector) reapLogsOfJob(ctx context.Context,
+	pipeline db.Pipeline,
 	job db.Job,
 	logger lager.Logger) error {
 
@@ -202,6 +214,22 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 		}
 	}
 
+	if br.buildLogArchiver != nil {
+		var failedBuildID int
+		buildIDsToDelete, failedBuildID = br.archiveBuilds(ctx, logger, buildsToConsiderDeleting, buildIDsToDelete)
+
+		// Builds that failed to archive keep their events, so they are still
+		// logged and will be retried on the next run.
+		if failedBuildID != 0 && (firstLoggedBuildID == 0 || failedBuildID < firstLoggedBuildID) {
+			firstLoggedBuildID = failedBuildID
+		}
+
+		if len(buildIDsToDelete) == 0 {
+			logger.Debug("no-builds-archived")
+			return nil
+		}
+	}
+
 	logger.Debug("reaping-builds", lager.Data{
 		"build_ids": buildIDsToDelete,
 	})
@@ -222,3 +250,47 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 
 	return nil
 }
+
+// archiveBuilds archives the events of each build to be reaped, returning the
+// IDs of the builds that were archived successfully along with the lowest ID
+// of any build that failed to archive.
+func (br *buildLogCollector) archiveBuilds(ctx context.Context, logger lager.Logger, builds []db.Build, buildIDs []int) ([]int, int) {
+	buildsByID := map[int]db.Build{}
+	for _, build := range builds {
+		buildsByID[build.ID()] = build
+	}
+
+	archivedBuildIDs := []int{}
+	failedBuildID := 0
+	for _, buildID := range buildIDs {
+		build := buildsByID[buildID]
+
+		err := br.archiveBuild(ctx, build)
+		if err != nil {
+			logger.Error("failed-to-archive-build-events", err, build.LagerData())
+
+			if failedBuildID == 0 || buildID < failedBuildID {
+				failedBuildID = buildID
+			}
+
+			continue
+		}
+
+		archivedBuildIDs = append(archivedBuildIDs, buildID)
+	}
+
+	return archivedBuildIDs, failedBuildID
+}
+
+func (br *buildLogCollector) archiveBuild(ctx context.Context, build db.Build) error {
+	if build.LogArchive() != "" {
+		return nil
+	}
+
+	location, err := br.buildLogArchiver.Archive(ctx, build)
+	if err != nil {
+		return err
+	}
+
+	return build.SetLogArchive(location)
+}
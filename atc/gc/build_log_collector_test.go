@@ -10,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/gc/gcfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -38,6 +39,7 @@ var _ = Describe("BuildLogCollector", func() {
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			nil,
 		)
 	})
 
@@ -104,6 +106,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						nil,
 					)
 				})
 				BeforeEach(func() {
@@ -157,6 +160,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						false,
+						nil,
 					)
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
 						if *page.From == 5 {
@@ -311,6 +315,115 @@ var _ = Describe("BuildLogCollector", func() {
 				})
 			})
 
+			Context("when a build log archiver is configured", func() {
+				var (
+					fakeArchiver *gcfakes.FakeBuildLogArchiver
+					builds       map[int]*dbfakes.FakeBuild
+				)
+
+				BeforeEach(func() {
+					fakeArchiver = new(gcfakes.FakeBuildLogArchiver)
+					fakeArchiver.ArchiveStub = func(_ context.Context, build db.Build) (string, error) {
+						return fmt.Sprintf("builds/%d.json.gz", build.ID()), nil
+					}
+
+					builds = map[int]*dbfakes.FakeBuild{}
+					for _, id := range []int{8, 7, 6, 5} {
+						build := new(dbfakes.FakeBuild)
+						build.IDReturns(id)
+						builds[id] = build
+					}
+
+					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+						if *page.From == 5 {
+							return []db.Build{builds[8], builds[7], builds[6], builds[5]}, db.Pagination{}, nil
+						}
+						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+						return []db.Build{}, db.Pagination{}, nil
+					}
+
+					fakePipeline.DeleteBuildEventsByBuildIDsReturns(nil)
+					fakeJob.UpdateFirstLoggedBuildIDReturns(nil)
+				})
+
+				JustBeforeEach(func() {
+					buildLogCollector = NewBuildLogCollector(
+						fakePipelineFactory,
+						fakePipelineLifecycle,
+						batchSize,
+						buildLogRetainCalc,
+						false,
+						fakeArchiver,
+					)
+
+					err := buildLogCollector.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("archives the builds before reaping them", func() {
+					Expect(fakeArchiver.ArchiveCallCount()).To(Equal(2))
+
+					_, archived := fakeArchiver.ArchiveArgsForCall(0)
+					Expect(archived.ID()).To(Equal(6))
+					_, archived = fakeArchiver.ArchiveArgsForCall(1)
+					Expect(archived.ID()).To(Equal(5))
+
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6, 5))
+				})
+
+				It("records the archive location on each build", func() {
+					Expect(builds[6].SetLogArchiveCallCount()).To(Equal(1))
+					Expect(builds[6].SetLogArchiveArgsForCall(0)).To(Equal("builds/6.json.gz"))
+					Expect(builds[5].SetLogArchiveCallCount()).To(Equal(1))
+					Expect(builds[5].SetLogArchiveArgsForCall(0)).To(Equal("builds/5.json.gz"))
+				})
+
+				Context("when a build has already been archived", func() {
+					BeforeEach(func() {
+						builds[5].LogArchiveReturns("builds/5.json.gz")
+					})
+
+					It("does not archive it again", func() {
+						Expect(fakeArchiver.ArchiveCallCount()).To(Equal(1))
+						Expect(builds[5].SetLogArchiveCallCount()).To(BeZero())
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6, 5))
+					})
+				})
+
+				Context("when archiving a build fails", func() {
+					BeforeEach(func() {
+						fakeArchiver.ArchiveStub = func(_ context.Context, build db.Build) (string, error) {
+							if build.ID() == 5 {
+								return "", errors.New("nope")
+							}
+							return fmt.Sprintf("builds/%d.json.gz", build.ID()), nil
+						}
+					})
+
+					It("only reaps the builds that were archived", func() {
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6))
+					})
+
+					It("does not move first logged build id past the failed build", func() {
+						Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when archiving every build fails", func() {
+					BeforeEach(func() {
+						fakeArchiver.ArchiveReturns("", errors.New("nope"))
+						fakeArchiver.ArchiveStub = nil
+					})
+
+					It("does not reap any builds", func() {
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+					})
+				})
+			})
+
 			Context("when no builds exist", func() {
 				BeforeEach(func() {
 					fakeJob.BuildsReturns(nil, db.Pagination{}, nil)
@@ -62,6 +62,12 @@ func (c *containerCollector) Run(ctx context.Context) error {
 		logger.Error("failed-to-clean-up-missing-containers", err)
 	}
 
+	_, err = c.containerRepository.RemoveUnclaimedReservations(c.missingContainerGracePeriod)
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-clean-up-unclaimed-reservations", err)
+	}
+
 	return errs
 }
 
@@ -63,6 +63,11 @@ var _ = Describe("ContainerCollector", func() {
 			Expect(fakeContainerRepository.RemoveMissingContainersArgsForCall(0)).To(Equal(missingContainerGracePeriod))
 		})
 
+		It("always tries to delete reservations no container was created for", func() {
+			Expect(fakeContainerRepository.RemoveUnclaimedReservationsCallCount()).To(Equal(1))
+			Expect(fakeContainerRepository.RemoveUnclaimedReservationsArgsForCall(0)).To(Equal(missingContainerGracePeriod))
+		})
+
 		Describe("Failed Containers", func() {
 			Context("when there are failed containers", func() {
 				It("tries to delete them from the database", func() {
@@ -0,0 +1,43 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// policyDecisionCollector deletes policy decisions once they are older than
+// the retention period.
+type policyDecisionCollector struct {
+	repository db.PolicyDecisionRepository
+	retention  time.Duration
+}
+
+func NewPolicyDecisionCollector(repository db.PolicyDecisionRepository, retention time.Duration) *policyDecisionCollector {
+	return &policyDecisionCollector{
+		repository: repository,
+		retention:  retention,
+	}
+}
+
+func (c *policyDecisionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("policy-decision-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	deleted, err := c.repository.DeleteDecisionsBefore(time.Now().Add(-c.retention))
+	if err != nil {
+		logger.Error("failed-to-delete-policy-decisions", err)
+		return err
+	}
+
+	if deleted > 0 {
+		logger.Debug("deleted", lager.Data{"count": deleted})
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PolicyDecisionCollector", func() {
+	var (
+		collector      GcCollector
+		fakeRepository *dbfakes.FakePolicyDecisionRepository
+	)
+
+	BeforeEach(func() {
+		fakeRepository = new(dbfakes.FakePolicyDecisionRepository)
+		collector = gc.NewPolicyDecisionCollector(fakeRepository, 24*time.Hour)
+	})
+
+	It("deletes the decisions older than the retention period", func() {
+		Expect(collector.Run(context.TODO())).To(Succeed())
+
+		Expect(fakeRepository.DeleteDecisionsBeforeCallCount()).To(Equal(1))
+		before := fakeRepository.DeleteDecisionsBeforeArgsForCall(0)
+		Expect(before).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+	})
+
+	Context("when deleting fails", func() {
+		BeforeEach(func() {
+			fakeRepository.DeleteDecisionsBeforeReturns(0, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(collector.Run(context.TODO())).To(MatchError("nope"))
+		})
+	})
+})
@@ -0,0 +1,125 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . TaskCacheStore
+
+// TaskCacheStore holds the blobs of the remote task cache tier.
+type TaskCacheStore interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// taskCacheCollector evicts caches from the remote task cache tier once they
+// exceed their team's quota, and those whose job or team is gone.
+type taskCacheCollector struct {
+	repository  db.RemoteTaskCacheRepository
+	teamFactory db.TeamFactory
+	store       TaskCacheStore
+}
+
+func NewTaskCacheCollector(
+	repository db.RemoteTaskCacheRepository,
+	teamFactory db.TeamFactory,
+	store TaskCacheStore,
+) *taskCacheCollector {
+	return &taskCacheCollector{
+		repository:  repository,
+		teamFactory: teamFactory,
+		store:       store,
+	}
+}
+
+func (c *taskCacheCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("task-cache-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	orphaned, err := c.repository.OrphanedCaches()
+	if err != nil {
+		logger.Error("failed-to-get-orphaned-task-caches", err)
+		return err
+	}
+
+	c.evict(ctx, logger, orphaned)
+
+	teams, err := c.teamFactory.GetTeams()
+	if err != nil {
+		logger.Error("failed-to-get-teams", err)
+		return err
+	}
+
+	for _, team := range teams {
+		quota := team.Quota()
+		if quota == nil || (quota.MaxTaskCacheSize == 0 && quota.TaskCacheMaxAge == "") {
+			continue
+		}
+
+		maxAge, err := quota.TaskCacheMaxAgeDuration()
+		if err != nil {
+			logger.Error("failed-to-parse-task-cache-max-age", err, lager.Data{"team": team.Name()})
+			continue
+		}
+
+		caches, err := c.repository.TeamCaches(team.ID())
+		if err != nil {
+			logger.Error("failed-to-get-team-task-caches", err, lager.Data{"team": team.Name()})
+			return err
+		}
+
+		c.evict(ctx, logger, evictedCaches(caches, quota.MaxTaskCacheSize, maxAge, time.Now()))
+	}
+
+	return nil
+}
+
+// evict removes the blob before forgetting the cache, so that a blob is never
+// left behind without a record of it.
+func (c *taskCacheCollector) evict(ctx context.Context, logger lager.Logger, caches []db.RemoteTaskCache) {
+	for _, cache := range caches {
+		err := c.store.Delete(ctx, cache.Key)
+		if err != nil {
+			logger.Error("failed-to-delete-task-cache-blob", err, lager.Data{"key": cache.Key})
+			continue
+		}
+
+		err = c.repository.Delete(cache.ID)
+		if err != nil {
+			logger.Error("failed-to-delete-task-cache", err, lager.Data{"key": cache.Key})
+			continue
+		}
+
+		logger.Debug("evicted", lager.Data{"key": cache.Key, "size": cache.Size})
+	}
+}
+
+// evictedCaches returns the caches which have gone unused for longer than
+// maxAge, and the least recently used caches beyond maxSize. The caches must
+// be ordered by most recently used first. A limit of zero is unlimited.
+func evictedCaches(caches []db.RemoteTaskCache, maxSize uint64, maxAge time.Duration, now time.Time) []db.RemoteTaskCache {
+	var evicted []db.RemoteTaskCache
+
+	var size uint64
+	for _, cache := range caches {
+		if maxAge > 0 && now.Sub(cache.LastUsedAt) > maxAge {
+			evicted = append(evicted, cache)
+			continue
+		}
+
+		if maxSize > 0 && size+uint64(cache.Size) > maxSize {
+			evicted = append(evicted, cache)
+			continue
+		}
+
+		size += uint64(cache.Size)
+	}
+
+	return evicted
+}
@@ -0,0 +1,115 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/gc/gcfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskCacheCollector", func() {
+	var (
+		collector GcCollector
+
+		fakeRepository  *dbfakes.FakeRemoteTaskCacheRepository
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeStore       *gcfakes.FakeTaskCacheStore
+		fakeTeam        *dbfakes.FakeTeam
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeRepository = new(dbfakes.FakeRemoteTaskCacheRepository)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeStore = new(gcfakes.FakeTaskCacheStore)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.IDReturns(1)
+		fakeTeam.NameReturns("some-team")
+		fakeTeamFactory.GetTeamsReturns([]db.Team{fakeTeam}, nil)
+
+		collector = gc.NewTaskCacheCollector(fakeRepository, fakeTeamFactory, fakeStore)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(context.TODO())
+	})
+
+	deletedKeys := func() []string {
+		var keys []string
+		for i := 0; i < fakeStore.DeleteCallCount(); i++ {
+			_, key := fakeStore.DeleteArgsForCall(i)
+			keys = append(keys, key)
+		}
+
+		return keys
+	}
+
+	Context("when there are orphaned caches", func() {
+		BeforeEach(func() {
+			fakeRepository.OrphanedCachesReturns([]db.RemoteTaskCache{
+				{ID: 1, Key: "orphaned-1"},
+				{ID: 2, Key: "orphaned-2"},
+			}, nil)
+		})
+
+		It("deletes their blobs and records", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(deletedKeys()).To(Equal([]string{"orphaned-1", "orphaned-2"}))
+
+			Expect(fakeRepository.DeleteCallCount()).To(Equal(2))
+			Expect(fakeRepository.DeleteArgsForCall(0)).To(Equal(1))
+			Expect(fakeRepository.DeleteArgsForCall(1)).To(Equal(2))
+		})
+
+		Context("when deleting a blob fails", func() {
+			BeforeEach(func() {
+				fakeStore.DeleteReturnsOnCall(0, errors.New("nope"))
+			})
+
+			It("keeps its record so that it is retried", func() {
+				Expect(fakeRepository.DeleteCallCount()).To(Equal(1))
+				Expect(fakeRepository.DeleteArgsForCall(0)).To(Equal(2))
+			})
+		})
+	})
+
+	Context("when the team has no task cache quota", func() {
+		It("does not look at its caches", func() {
+			Expect(fakeRepository.TeamCachesCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the team has a task cache quota", func() {
+		now := time.Now()
+
+		BeforeEach(func() {
+			fakeTeam.QuotaReturns(&atc.TeamQuota{
+				MaxTaskCacheSize: 100,
+				TaskCacheMaxAge:  "24h",
+			})
+
+			fakeRepository.TeamCachesReturns([]db.RemoteTaskCache{
+				{ID: 1, Key: "recent", Size: 60, LastUsedAt: now.Add(-time.Minute)},
+				{ID: 2, Key: "too-big", Size: 50, LastUsedAt: now.Add(-time.Hour)},
+				{ID: 3, Key: "fits", Size: 40, LastUsedAt: now.Add(-2 * time.Hour)},
+				{ID: 4, Key: "stale", Size: 1, LastUsedAt: now.Add(-48 * time.Hour)},
+			}, nil)
+		})
+
+		It("evicts the caches which are too old or do not fit", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeRepository.TeamCachesArgsForCall(0)).To(Equal(1))
+			Expect(deletedKeys()).To(Equal([]string{"too-big", "stale"}))
+		})
+	})
+})
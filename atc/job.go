@@ -24,6 +24,18 @@ type Job struct {
 
 	Inputs  []JobInput  `json:"inputs,omitempty"`
 	Outputs []JobOutput `json:"outputs,omitempty"`
+
+	Flakiness *JobFlakiness `json:"flakiness,omitempty"`
+
+	NextFireTime int64 `json:"next_fire_time,omitempty"`
+}
+
+// JobFlakiness describes how often a job's builds failed and then succeeded
+// again with exactly the same input versions.
+type JobFlakiness struct {
+	Score          float64 `json:"score"`
+	FlakyBuilds    int     `json:"flaky_builds"`
+	AnalyzedBuilds int     `json:"analyzed_builds"`
 }
 
 type JobInput struct {
@@ -1,5 +1,17 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	yamlv2 "gopkg.in/yaml.v2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/vars"
+)
+
 type JobConfig struct {
 	Name    string `json:"name"`
 	OldName string `json:"old_name,omitempty"`
@@ -12,8 +24,14 @@ type JobConfig struct {
 	RawMaxInFlight       int      `json:"max_in_flight,omitempty"`
 	BuildLogsToRetain    int      `json:"build_logs_to_retain,omitempty"`
 
+	Priority JobPriority `json:"priority,omitempty"`
+
+	Schedule *JobSchedule `json:"schedule,omitempty"`
+
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	Matrix []MatrixVarConfig `json:"matrix,omitempty"`
+
 	OnSuccess *Step `json:"on_success,omitempty"`
 	OnFailure *Step `json:"on_failure,omitempty"`
 	OnAbort   *Step `json:"on_abort,omitempty"`
@@ -23,6 +41,13 @@ type JobConfig struct {
 	PlanSequence []Step `json:"plan"`
 }
 
+// MatrixVarConfig declares a local var, along with the values it takes on,
+// across which a job is expanded into one concrete job per combination.
+type MatrixVarConfig struct {
+	Var    string        `json:"var"`
+	Values []interface{} `json:"values"`
+}
+
 type BuildLogRetention struct {
 	Builds                 int `json:"builds,omitempty"`
 	MinimumSucceededBuilds int `json:"minimum_succeeded_builds,omitempty"`
@@ -128,3 +153,193 @@ func (config JobConfig) Outputs() []JobOutput {
 
 	return outputs
 }
+
+// ExpandMatrix expands a job which declares a matrix into one job per
+// combination of matrix values. Each combination's values are interpolated
+// into the job's config as local vars, i.e. ((.:var)), and the resulting job
+// is named after the original job and the values, e.g. "test-1.16-linux".
+//
+// A job without a matrix is returned as-is.
+func (config JobConfig) ExpandMatrix() ([]JobConfig, error) {
+	if len(config.Matrix) == 0 {
+		return []JobConfig{config}, nil
+	}
+
+	template := config
+	template.Matrix = nil
+	template.OldName = ""
+
+	payload, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+
+	combinations := []matrixVars{{}}
+	for _, matrixVar := range config.Matrix {
+		// the values make up the names of the expanded jobs, so only values
+		// which read well as part of a name are allowed
+		for _, value := range matrixVar.Values {
+			switch value.(type) {
+			case string, bool, float64, int, json.Number:
+			default:
+				return nil, fmt.Errorf("expand matrix of job '%s': value of var '%s' must be a string, number or boolean, got %s", config.Name, matrixVar.Var, formatMatrixValue(value))
+			}
+		}
+
+		var expanded []matrixVars
+		for _, combination := range combinations {
+			for _, value := range matrixVar.Values {
+				next := matrixVars{}
+				for k, v := range combination {
+					next[k] = v
+				}
+
+				next[matrixVar.Var] = value
+				expanded = append(expanded, next)
+			}
+		}
+
+		combinations = expanded
+	}
+
+	jobs := make([]JobConfig, len(combinations))
+	for i, combination := range combinations {
+		evaluated, err := vars.NewTemplate(payload).Evaluate(combination, vars.EvaluateOpts{})
+		if err != nil {
+			return nil, fmt.Errorf("expand matrix of job '%s': %w", config.Name, err)
+		}
+
+		err = yaml.Unmarshal(evaluated, &jobs[i])
+		if err != nil {
+			return nil, fmt.Errorf("expand matrix of job '%s': %w", config.Name, err)
+		}
+
+		nameParts := []string{config.Name}
+		for _, matrixVar := range config.Matrix {
+			nameParts = append(nameParts, formatMatrixName(combination[matrixVar.Var]))
+		}
+
+		jobs[i].Name = strings.Join(nameParts, "-")
+	}
+
+	return jobs, nil
+}
+
+// formatMatrixName formats a matrix value as part of an expanded job's name.
+// Numbers are kept as written when the config was parsed (see
+// KeepMatrixValueText), and are otherwise never formatted with an exponent.
+func formatMatrixName(value interface{}) string {
+	switch v := value.(type) {
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// KeepMatrixValueText replaces the numbers among the config's matrix values
+// with json.Numbers holding the text they are written as in the YAML (or
+// JSON) payload the config was parsed from, so that e.g. 1.20 expands to a
+// job named "test-1.20" rather than "test-1.2". Parsing the payload into a
+// Config decodes them as float64s, which loses the original text.
+//
+// Numbers which are not written as valid JSON numbers (e.g. 0x1F) are left
+// as they are.
+func KeepMatrixValueText(payload []byte, config *Config) error {
+	var raw struct {
+		Jobs []struct {
+			Matrix []struct {
+				Values []matrixValueText `yaml:"values"`
+			} `yaml:"matrix"`
+		} `yaml:"jobs"`
+	}
+
+	err := yamlv2.Unmarshal(payload, &raw)
+	if err != nil {
+		return err
+	}
+
+	for i, job := range config.Jobs {
+		if i >= len(raw.Jobs) {
+			break
+		}
+
+		for j, matrixVar := range job.Matrix {
+			if j >= len(raw.Jobs[i].Matrix) {
+				break
+			}
+
+			texts := raw.Jobs[i].Matrix[j].Values
+			for k, value := range matrixVar.Values {
+				if k >= len(texts) {
+					break
+				}
+
+				if _, isNumber := value.(float64); !isNumber {
+					continue
+				}
+
+				text := string(texts[k])
+				if json.Valid([]byte(text)) {
+					matrixVar.Values[k] = json.Number(text)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// matrixValueText holds the text a scalar matrix value is written as.
+type matrixValueText string
+
+func (text *matrixValueText) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var scalar string
+	if unmarshal(&scalar) != nil {
+		// not a scalar; rejected when expanding the matrix
+		return nil
+	}
+
+	*text = matrixValueText(scalar)
+	return nil
+}
+
+func formatMatrixValue(value interface{}) string {
+	if value == nil {
+		return "null"
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(payload)
+}
+
+// matrixVars resolves the local vars (e.g. ((.:var))) of a single matrix
+// combination, leaving every other var to be resolved at runtime.
+type matrixVars map[string]interface{}
+
+func (v matrixVars) Get(ref vars.Reference) (interface{}, bool, error) {
+	if ref.Source != "." {
+		return nil, false, nil
+	}
+
+	return vars.StaticVariables(v).Get(ref.WithoutSource())
+}
+
+func (v matrixVars) List() ([]vars.Reference, error) {
+	refs, err := vars.StaticVariables(v).List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range refs {
+		refs[i].Source = "."
+	}
+
+	return refs, nil
+}
@@ -1,6 +1,8 @@
 package atc_test
 
 import (
+	"encoding/json"
+
 	"github.com/concourse/concourse/atc"
 
 	. "github.com/onsi/ginkgo"
@@ -671,4 +673,147 @@ var _ = Describe("JobConfig", func() {
 			})
 		})
 	})
+
+	Describe("KeepMatrixValueText", func() {
+		It("keeps the text numeric matrix values are written as", func() {
+			payload := []byte(`
+jobs:
+- name: some-job
+  matrix:
+  - var: go_version
+    values: [1.20, "1.30", 10000000, 0x1F, true]
+  plan: []
+`)
+
+			var config atc.Config
+			err := atc.UnmarshalConfig(payload, &config)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.Jobs[0].Matrix[0].Values).To(Equal([]interface{}{
+				json.Number("1.20"),
+				"1.30",
+				json.Number("10000000"),
+				float64(31),
+				true,
+			}))
+		})
+	})
+
+	Describe("ExpandMatrix", func() {
+		var (
+			jobConfig atc.JobConfig
+
+			expanded  []atc.JobConfig
+			expandErr error
+		)
+
+		BeforeEach(func() {
+			jobConfig = atc.JobConfig{
+				Name:    "some-job",
+				OldName: "some-old-job",
+				PlanSequence: []atc.Step{
+					{
+						Config: &atc.TaskStep{
+							Name: "test",
+							Params: atc.TaskEnv{
+								"GO_VERSION": "((.:go_version))",
+								"OS":         "((.:os))",
+								"SECRET":     "((some-secret))",
+							},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			expanded, expandErr = jobConfig.ExpandMatrix()
+		})
+
+		Context("when the job has no matrix", func() {
+			It("returns the job as-is", func() {
+				Expect(expandErr).ToNot(HaveOccurred())
+				Expect(expanded).To(Equal([]atc.JobConfig{jobConfig}))
+			})
+		})
+
+		Context("when the job has a matrix", func() {
+			BeforeEach(func() {
+				jobConfig.Matrix = []atc.MatrixVarConfig{
+					{Var: "go_version", Values: []interface{}{"1.15", "1.16"}},
+					{Var: "os", Values: []interface{}{"linux", "windows"}},
+				}
+			})
+
+			It("returns a job for each combination of values", func() {
+				Expect(expandErr).ToNot(HaveOccurred())
+
+				names := []string{}
+				for _, job := range expanded {
+					names = append(names, job.Name)
+					Expect(job.Matrix).To(BeEmpty())
+					Expect(job.OldName).To(BeEmpty())
+				}
+
+				Expect(names).To(Equal([]string{
+					"some-job-1.15-linux",
+					"some-job-1.15-windows",
+					"some-job-1.16-linux",
+					"some-job-1.16-windows",
+				}))
+			})
+
+			It("interpolates the values into each job's plan", func() {
+				Expect(expandErr).ToNot(HaveOccurred())
+				Expect(expanded[1].PlanSequence).To(Equal([]atc.Step{
+					{
+						Config: &atc.TaskStep{
+							Name: "test",
+							Params: atc.TaskEnv{
+								"GO_VERSION": "1.15",
+								"OS":         "windows",
+								"SECRET":     "((some-secret))",
+							},
+						},
+					},
+				}))
+			})
+
+			Context("when the values are numbers", func() {
+				BeforeEach(func() {
+					jobConfig.Matrix = []atc.MatrixVarConfig{
+						{Var: "go_version", Values: []interface{}{json.Number("1.20"), 1.3}},
+						{Var: "os", Values: []interface{}{float64(10000000)}},
+					}
+				})
+
+				It("names the jobs after the numbers without reformatting them", func() {
+					Expect(expandErr).ToNot(HaveOccurred())
+
+					names := []string{}
+					for _, job := range expanded {
+						names = append(names, job.Name)
+					}
+
+					Expect(names).To(Equal([]string{
+						"some-job-1.20-10000000",
+						"some-job-1.3-10000000",
+					}))
+				})
+			})
+
+			Context("when a value is not a scalar", func() {
+				BeforeEach(func() {
+					jobConfig.Matrix[1].Values = []interface{}{
+						"linux",
+						map[string]interface{}{"name": "windows"},
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(expandErr).To(MatchError(`expand matrix of job 'some-job': value of var 'os' must be a string, number or boolean, got {"name":"windows"}`))
+				})
+			})
+		})
+	})
 })
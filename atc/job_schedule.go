@@ -0,0 +1,93 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// JobSchedule periodically triggers builds of a job according to a cron
+// expression, without needing a time resource to be checked.
+type JobSchedule struct {
+	Cron     string `json:"cron"`
+	Location string `json:"location,omitempty"`
+	Jitter   string `json:"jitter,omitempty"`
+}
+
+func (schedule JobSchedule) Validate() error {
+	if schedule.Cron == "" {
+		return errors.New("cron expression must not be empty")
+	}
+
+	_, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return fmt.Errorf("invalid cron expression '%s': %w", schedule.Cron, err)
+	}
+
+	_, err = schedule.location()
+	if err != nil {
+		return err
+	}
+
+	_, err = schedule.JitterDuration()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Next returns the first time after the given time at which the cron
+// expression fires, evaluated in the schedule's location.
+func (schedule JobSchedule) Next(after time.Time) (time.Time, error) {
+	spec, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expression '%s': %w", schedule.Cron, err)
+	}
+
+	location, err := schedule.location()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := spec.Next(after.In(location))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expression '%s' never fires", schedule.Cron)
+	}
+
+	return next, nil
+}
+
+// JitterDuration returns the maximum random delay added to each fire time,
+// so that many jobs on the same schedule don't all start at once.
+func (schedule JobSchedule) JitterDuration() (time.Duration, error) {
+	if schedule.Jitter == "" {
+		return 0, nil
+	}
+
+	jitter, err := time.ParseDuration(schedule.Jitter)
+	if err != nil {
+		return 0, fmt.Errorf("invalid jitter '%s'", schedule.Jitter)
+	}
+
+	if jitter < 0 {
+		return 0, fmt.Errorf("jitter '%s' must not be negative", schedule.Jitter)
+	}
+
+	return jitter, nil
+}
+
+func (schedule JobSchedule) location() (*time.Location, error) {
+	if schedule.Location == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(schedule.Location)
+	if err != nil {
+		return nil, fmt.Errorf("unknown location '%s'", schedule.Location)
+	}
+
+	return location, nil
+}
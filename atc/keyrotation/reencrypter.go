@@ -0,0 +1,60 @@
+package keyrotation
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type reencrypter struct {
+	rotation  db.EncryptionRotation
+	batchSize int
+}
+
+// NewReencrypter returns a component which re-encrypts everything that is not
+// yet encrypted with the active keyring key, batchSize values at a time, until
+// nothing is left or the component is interrupted. Values which fail to
+// decrypt are skipped, and retried on its next run.
+func NewReencrypter(rotation db.EncryptionRotation, batchSize int) *reencrypter {
+	return &reencrypter{
+		rotation:  rotation,
+		batchSize: batchSize,
+	}
+}
+
+func (r *reencrypter) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("reencrypter")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	var total db.ReencryptResult
+	for {
+		result, err := r.rotation.Reencrypt(logger, r.batchSize)
+		total.Reencrypted += result.Reencrypted
+		total.Failed += result.Failed
+		if err != nil {
+			logger.Error("failed-to-reencrypt", err, lager.Data{"reencrypted": total.Reencrypted, "failed": total.Failed})
+			return err
+		}
+
+		if result.Total() < r.batchSize {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("interrupted", lager.Data{"reencrypted": total.Reencrypted, "failed": total.Failed})
+			return nil
+		default:
+		}
+	}
+
+	if total.Total() > 0 {
+		logger.Info("reencrypted", lager.Data{"reencrypted": total.Reencrypted, "failed": total.Failed})
+	}
+
+	return nil
+}
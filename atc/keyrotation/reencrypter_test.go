@@ -0,0 +1,86 @@
+package keyrotation_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/keyrotation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reencrypter", func() {
+	var (
+		fakeRotation *dbfakes.FakeEncryptionRotation
+
+		ctx    context.Context
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeRotation = new(dbfakes.FakeEncryptionRotation)
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+	})
+
+	JustBeforeEach(func() {
+		runErr = keyrotation.NewReencrypter(fakeRotation, 10).Run(ctx)
+	})
+
+	Context("when there are several batches to re-encrypt", func() {
+		BeforeEach(func() {
+			fakeRotation.ReencryptReturnsOnCall(0, db.ReencryptResult{Reencrypted: 10}, nil)
+			fakeRotation.ReencryptReturnsOnCall(1, db.ReencryptResult{Reencrypted: 10}, nil)
+			fakeRotation.ReencryptReturnsOnCall(2, db.ReencryptResult{Reencrypted: 3}, nil)
+		})
+
+		It("re-encrypts batches until one is not full", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeRotation.ReencryptCallCount()).To(Equal(3))
+
+			_, limit := fakeRotation.ReencryptArgsForCall(0)
+			Expect(limit).To(Equal(10))
+		})
+	})
+
+	Context("when some values in a batch fail to decrypt", func() {
+		BeforeEach(func() {
+			fakeRotation.ReencryptReturnsOnCall(0, db.ReencryptResult{Reencrypted: 7, Failed: 3}, nil)
+			fakeRotation.ReencryptReturnsOnCall(1, db.ReencryptResult{Reencrypted: 2}, nil)
+		})
+
+		It("counts them towards the batch and carries on", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeRotation.ReencryptCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when the component is interrupted", func() {
+		BeforeEach(func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			cancel()
+
+			fakeRotation.ReencryptReturns(db.ReencryptResult{Reencrypted: 10}, nil)
+		})
+
+		It("stops after the current batch", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeRotation.ReencryptCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when re-encrypting fails", func() {
+		BeforeEach(func() {
+			fakeRotation.ReencryptReturns(db.ReencryptResult{}, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package logarchive
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// Archiver serializes a build's events into a gzipped stream of JSON
+// envelopes, one per line, and reads them back as a db.EventSource.
+type Archiver struct {
+	store blobstore.Store
+}
+
+func NewArchiver(store blobstore.Store) *Archiver {
+	return &Archiver{store: store}
+}
+
+// Archive writes all of the build's events to the store, returning the
+// location of the archive to be recorded on the build.
+func (archiver *Archiver) Archive(ctx context.Context, build db.Build) (string, error) {
+	events, err := build.Events(0)
+	if err != nil {
+		return "", err
+	}
+
+	defer db.Close(events)
+
+	location := fmt.Sprintf("builds/%d/events.json.gz", build.ID())
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(writeEvents(writer, events))
+	}()
+
+	err = archiver.store.Put(ctx, location, reader)
+
+	// unblock the writer if the store stopped reading early
+	reader.CloseWithError(io.ErrClosedPipe)
+
+	if err != nil {
+		return "", err
+	}
+
+	return location, nil
+}
+
+func writeEvents(w io.Writer, events db.EventSource) error {
+	zw := gzip.NewWriter(w)
+	enc := json.NewEncoder(zw)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			return err
+		}
+
+		err = enc.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// Events returns the archived events stored at location, starting from the
+// given event ID.
+func (archiver *Archiver) Events(ctx context.Context, location string, from uint) (db.EventSource, error) {
+	blob, err := archiver.store.Get(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := gzip.NewReader(blob)
+	if err != nil {
+		blob.Close()
+		return nil, err
+	}
+
+	source := &archivedEventSource{
+		blob: blob,
+		zr:   zr,
+		dec:  json.NewDecoder(zr),
+	}
+
+	for i := uint(0); i < from; i++ {
+		_, err := source.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			source.Close()
+			return nil, err
+		}
+	}
+
+	return source, nil
+}
+
+type archivedEventSource struct {
+	blob io.Closer
+	zr   *gzip.Reader
+	dec  *json.Decoder
+}
+
+func (source *archivedEventSource) Next() (event.Envelope, error) {
+	var ev event.Envelope
+	err := source.dec.Decode(&ev)
+	if err != nil {
+		if err == io.EOF {
+			return event.Envelope{}, db.ErrEndOfBuildEventStream
+		}
+
+		return event.Envelope{}, err
+	}
+
+	return ev, nil
+}
+
+func (source *archivedEventSource) Close() error {
+	source.zr.Close()
+	return source.blob.Close()
+}
@@ -0,0 +1,167 @@
+package logarchive_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/blobstore/blobstorefakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logarchive"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archiver", func() {
+	var (
+		dir      string
+		store    blobstore.Store
+		archiver *logarchive.Archiver
+
+		fakeBuild  *dbfakes.FakeBuild
+		fakeEvents *dbfakes.FakeEventSource
+		envelopes  []event.Envelope
+	)
+
+	envelope := func(ev atc.Event) event.Envelope {
+		payload, err := json.Marshal(ev)
+		Expect(err).ToNot(HaveOccurred())
+
+		data := json.RawMessage(payload)
+		return event.Envelope{
+			Data:    &data,
+			Event:   ev.EventType(),
+			Version: ev.Version(),
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "logarchive")
+		Expect(err).ToNot(HaveOccurred())
+
+		store, err = blobstore.Local{Dir: dir}.Store()
+		Expect(err).ToNot(HaveOccurred())
+
+		envelopes = []event.Envelope{
+			envelope(event.Log{Payload: "hello"}),
+			envelope(event.Log{Payload: "world"}),
+			envelope(event.Status{Status: atc.StatusSucceeded}),
+		}
+
+		fakeEvents = new(dbfakes.FakeEventSource)
+		for i, ev := range envelopes {
+			fakeEvents.NextReturnsOnCall(i, ev, nil)
+		}
+		fakeEvents.NextReturnsOnCall(len(envelopes), event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.EventsReturns(fakeEvents, nil)
+	})
+
+	JustBeforeEach(func() {
+		archiver = logarchive.NewArchiver(store)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	readAll := func(source db.EventSource) []event.Envelope {
+		read := []event.Envelope{}
+		for {
+			ev, err := source.Next()
+			if err == db.ErrEndOfBuildEventStream {
+				return read
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+			read = append(read, ev)
+		}
+	}
+
+	Describe("Archive", func() {
+		It("archives every event from the start of the build", func() {
+			location, err := archiver.Archive(context.TODO(), fakeBuild)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(location).To(Equal("builds/42/events.json.gz"))
+
+			Expect(fakeBuild.EventsArgsForCall(0)).To(BeZero())
+			Expect(fakeEvents.CloseCallCount()).To(Equal(1))
+
+			source, err := archiver.Events(context.TODO(), location, 0)
+			Expect(err).ToNot(HaveOccurred())
+			defer source.Close()
+
+			Expect(readAll(source)).To(Equal(envelopes))
+		})
+
+		Context("when reading the events fails", func() {
+			BeforeEach(func() {
+				fakeEvents.NextReturnsOnCall(1, event.Envelope{}, errors.New("nope"))
+			})
+
+			It("returns the error and does not store an archive", func() {
+				_, err := archiver.Archive(context.TODO(), fakeBuild)
+				Expect(err).To(MatchError("nope"))
+
+				_, err = archiver.Events(context.TODO(), "builds/42/events.json.gz", 0)
+				Expect(err).To(Equal(blobstore.ErrNotFound))
+			})
+		})
+
+		Context("when storing the archive fails", func() {
+			var fakeStore *blobstorefakes.FakeStore
+
+			BeforeEach(func() {
+				fakeStore = new(blobstorefakes.FakeStore)
+				fakeStore.PutReturns(errors.New("disk full"))
+				store = fakeStore
+			})
+
+			It("returns the error", func() {
+				_, err := archiver.Archive(context.TODO(), fakeBuild)
+				Expect(err).To(MatchError("disk full"))
+			})
+		})
+	})
+
+	Describe("Events", func() {
+		var location string
+
+		JustBeforeEach(func() {
+			var err error
+			location, err = archiver.Archive(context.TODO(), fakeBuild)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("starts from the given event", func() {
+			source, err := archiver.Events(context.TODO(), location, 2)
+			Expect(err).ToNot(HaveOccurred())
+			defer source.Close()
+
+			Expect(readAll(source)).To(Equal(envelopes[2:]))
+		})
+
+		It("ends the stream when starting past the last event", func() {
+			source, err := archiver.Events(context.TODO(), location, 10)
+			Expect(err).ToNot(HaveOccurred())
+			defer source.Close()
+
+			Expect(readAll(source)).To(BeEmpty())
+		})
+
+		It("returns ErrNotFound for an unknown location", func() {
+			_, err := archiver.Events(context.TODO(), "builds/43/events.json.gz", 0)
+			Expect(err).To(Equal(blobstore.ErrNotFound))
+		})
+	})
+})
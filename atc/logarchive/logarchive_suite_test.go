@@ -0,0 +1,13 @@
+package logarchive_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLogArchive(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Log Archive Suite")
+}
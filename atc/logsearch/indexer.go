@@ -0,0 +1,50 @@
+package logsearch
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type indexer struct {
+	indexes db.BuildLogSearchIndexer
+}
+
+// NewIndexer returns a component which builds the missing log search indexes
+// of build events partitions one at a time, until every partition is indexed
+// or the component is interrupted.
+func NewIndexer(indexes db.BuildLogSearchIndexer) *indexer {
+	return &indexer{
+		indexes: indexes,
+	}
+}
+
+func (i *indexer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("log-search-indexer")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	for {
+		table, err := i.indexes.IndexNext()
+		if err != nil {
+			logger.Error("failed-to-index", err)
+			return err
+		}
+
+		if table == "" {
+			return nil
+		}
+
+		logger.Info("indexed", lager.Data{"table": table})
+
+		select {
+		case <-ctx.Done():
+			logger.Info("interrupted")
+			return nil
+		default:
+		}
+	}
+}
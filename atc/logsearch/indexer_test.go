@@ -0,0 +1,73 @@
+package logsearch_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/logsearch"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Indexer", func() {
+	var (
+		fakeIndexes *dbfakes.FakeBuildLogSearchIndexer
+
+		ctx    context.Context
+		cancel context.CancelFunc
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeIndexes = new(dbfakes.FakeBuildLogSearchIndexer)
+		ctx, cancel = context.WithCancel(lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test")))
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		runErr = logsearch.NewIndexer(fakeIndexes).Run(ctx)
+	})
+
+	Context("when several partitions are missing their index", func() {
+		BeforeEach(func() {
+			fakeIndexes.IndexNextReturnsOnCall(0, "pipeline_build_events_1", nil)
+			fakeIndexes.IndexNextReturnsOnCall(1, "team_build_events_1", nil)
+			fakeIndexes.IndexNextReturnsOnCall(2, "", nil)
+		})
+
+		It("indexes them until none are left", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeIndexes.IndexNextCallCount()).To(Equal(3))
+		})
+	})
+
+	Context("when it is interrupted", func() {
+		BeforeEach(func() {
+			fakeIndexes.IndexNextReturns("pipeline_build_events_1", nil)
+			cancel()
+		})
+
+		It("stops after the index it is building", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeIndexes.IndexNextCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when indexing fails", func() {
+		BeforeEach(func() {
+			fakeIndexes.IndexNextReturns("", errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(runErr).To(MatchError("nope"))
+			Expect(fakeIndexes.IndexNextCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -17,8 +17,31 @@ type Event struct {
 	Attributes map[string]string
 	Host       string
 	Time       time.Time
+
+	// Kind and Unit describe what the value measures, for emitters which
+	// aggregate events before sending them on.
+	Kind Kind
+	Unit string
 }
 
+// Kind is the kind of instrument an event is a measurement of.
+type Kind int
+
+const (
+	// KindGauge events are the current value of something.
+	KindGauge Kind = iota
+
+	// KindCounter events are the number of occurrences since the previous
+	// event.
+	KindCounter
+
+	// KindOccurrence events each count once, whatever their value.
+	KindOccurrence
+
+	// KindDuration events are durations, in the event's unit.
+	KindDuration
+)
+
 //go:generate counterfeiter . Emitter
 type Emitter interface {
 	Emit(lager.Logger, Event)
@@ -0,0 +1,191 @@
+package emitter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/pkg/errors"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http"
+
+	otlpMetricsPath = "/v1/metrics"
+
+	otlpExportTimeout = time.Minute
+)
+
+type OTLPConfig struct {
+	Address  string            `long:"otlp-metrics-address" description:"OTLP collector to export metrics to. A host:port for gRPC, or a URL for HTTP."`
+	Protocol string            `long:"otlp-metrics-protocol" default:"grpc" choice:"grpc" choice:"http" description:"Protocol used to export metrics to the OTLP collector."`
+	Headers  map[string]string `long:"otlp-metrics-header" description:"Header to attach to each export request. Can be specified multiple times."`
+	UseTLS   bool              `long:"otlp-metrics-use-tls" description:"Use TLS when exporting metrics over gRPC."`
+
+	BatchSize     uint64        `long:"otlp-metrics-batch-size" default:"1000" description:"Number of events to batch together before exporting them."`
+	BatchDuration time.Duration `long:"otlp-metrics-batch-duration" default:"15s" description:"The duration to wait before exporting a batch of events, disregarding otlp-metrics-batch-size."`
+}
+
+// OTLPExporter sends an ExportMetricsServiceRequest to a collector.
+type OTLPExporter interface {
+	Export(context.Context, *collectormetrics.ExportMetricsServiceRequest) error
+}
+
+type OTLPEmitter struct {
+	Exporter      OTLPExporter
+	BatchSize     int
+	BatchDuration time.Duration
+
+	batch         []metric.Event
+	lastBatchTime time.Time
+}
+
+func init() {
+	metric.Metrics.RegisterEmitter(&OTLPConfig{})
+}
+
+func (config *OTLPConfig) Description() string { return "OTLP" }
+func (config *OTLPConfig) IsConfigured() bool  { return config.Address != "" }
+
+func (config *OTLPConfig) NewEmitter() (metric.Emitter, error) {
+	var exporter OTLPExporter
+	switch config.Protocol {
+	case OTLPProtocolHTTP:
+		exporter = &otlpHTTPExporter{
+			client: &http.Client{
+				Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+				Timeout:   otlpExportTimeout,
+			},
+			url:     strings.TrimSuffix(config.Address, "/") + otlpMetricsPath,
+			headers: config.Headers,
+		}
+	default:
+		security := grpc.WithInsecure()
+		if config.UseTLS {
+			security = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+		}
+
+		conn, err := grpc.Dial(config.Address, security)
+		if err != nil {
+			return nil, err
+		}
+
+		exporter = &otlpGRPCExporter{
+			client:  collectormetrics.NewMetricsServiceClient(conn),
+			headers: config.Headers,
+		}
+	}
+
+	return &OTLPEmitter{
+		Exporter:      exporter,
+		BatchSize:     int(config.BatchSize),
+		BatchDuration: config.BatchDuration,
+		lastBatchTime: time.Now(),
+	}, nil
+}
+
+func (emitter *OTLPEmitter) Emit(logger lager.Logger, event metric.Event) {
+	emitter.batch = append(emitter.batch, event)
+
+	duration := time.Since(emitter.lastBatchTime)
+	if len(emitter.batch) >= emitter.BatchSize || duration >= emitter.BatchDuration {
+		logger.Debug("otlp-pre-emit-batch", lager.Data{
+			"otlp-batch-size":     emitter.BatchSize,
+			"current-batch-size":  len(emitter.batch),
+			"otlp-batch-duration": emitter.BatchDuration,
+			"current-duration":    duration,
+		})
+
+		emitter.SubmitBatch(logger)
+	}
+}
+
+func (emitter *OTLPEmitter) SubmitBatch(logger lager.Logger) {
+	batchToSubmit := emitter.batch
+	emitter.batch = nil
+	emitter.lastBatchTime = time.Now()
+
+	go emitter.emitBatch(logger, batchToSubmit)
+}
+
+func (emitter *OTLPEmitter) emitBatch(logger lager.Logger, events []metric.Event) {
+	logger.Debug("otlp-emit-batch", lager.Data{
+		"size": len(events),
+	})
+
+	request := OTLPMetricsRequest(events)
+
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+
+	err := emitter.Exporter.Export(ctx, request)
+	if err != nil {
+		logger.Error("failed-to-export-metrics",
+			errors.Wrap(metric.ErrFailedToEmit, err.Error()))
+	}
+}
+
+type otlpGRPCExporter struct {
+	client  collectormetrics.MetricsServiceClient
+	headers map[string]string
+}
+
+func (exporter *otlpGRPCExporter) Export(ctx context.Context, request *collectormetrics.ExportMetricsServiceRequest) error {
+	if len(exporter.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(exporter.headers))
+	}
+
+	_, err := exporter.client.Export(ctx, request)
+	return err
+}
+
+type otlpHTTPExporter struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func (exporter *otlpHTTPExporter) Export(ctx context.Context, request *collectormetrics.ExportMetricsServiceRequest) error {
+	payload, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, exporter.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for name, value := range exporter.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := exporter.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("collector responded with %s: %s", resp.Status, body)
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package emitter
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/metric"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+// otlpHistogramBounds are the bucket bounds, in milliseconds, of every
+// duration.
+var otlpHistogramBounds = []float64{10, 50, 100, 500, 1000, 5000, 10000, 30000, 60000, 300000, 900000, 3600000}
+
+var otlpNameSeparators = regexp.MustCompile(`[^a-z0-9.]+`)
+
+// OTLPMetricName converts an event name like "gc: build collector duration
+// (ms)" to "concourse.gc.build_collector_duration".
+func OTLPMetricName(name string) string {
+	name = strings.ToLower(name)
+	name = strings.TrimSuffix(name, " (ms)")
+	name = strings.ReplaceAll(name, ": ", ".")
+	name = otlpNameSeparators.ReplaceAllString(name, "_")
+	return "concourse." + strings.Trim(name, "_")
+}
+
+type otlpSeries struct {
+	kind       metric.Kind
+	name       string
+	unit       string
+	attributes map[string]string
+
+	start time.Time
+	end   time.Time
+
+	value   float64
+	count   uint64
+	buckets []uint64
+}
+
+func (series *otlpSeries) add(event metric.Event) {
+	if series.count == 0 || event.Time.Before(series.start) {
+		series.start = event.Time
+	}
+
+	if !event.Time.Before(series.end) {
+		series.end = event.Time
+
+		if series.kind == metric.KindGauge {
+			series.value = event.Value
+		}
+	}
+
+	series.count++
+
+	switch series.kind {
+	case metric.KindCounter:
+		series.value += event.Value
+	case metric.KindOccurrence:
+		series.value++
+	case metric.KindDuration:
+		series.value += event.Value
+
+		ms := event.Value
+		if series.unit == "s" {
+			ms *= 1000
+		}
+
+		bucket := sort.SearchFloat64s(otlpHistogramBounds, ms)
+		series.buckets[bucket]++
+	}
+}
+
+// OTLPMetricsRequest aggregates a batch of events into one data point per
+// metric and set of attributes. Gauges keep their latest value, counters are
+// summed and durations are collected into histograms.
+func OTLPMetricsRequest(events []metric.Event) *collectormetrics.ExportMetricsServiceRequest {
+	var hosts []string
+	seriesByHost := map[string][]*otlpSeries{}
+	seriesByKey := map[string]*otlpSeries{}
+
+	for _, event := range events {
+		key := otlpSeriesKey(event)
+
+		series, found := seriesByKey[key]
+		if !found {
+			series = &otlpSeries{
+				kind:       event.Kind,
+				name:       OTLPMetricName(event.Name),
+				unit:       event.Unit,
+				attributes: event.Attributes,
+			}
+
+			if series.kind == metric.KindCounter || series.kind == metric.KindOccurrence {
+				series.unit = "1"
+			}
+
+			if series.kind == metric.KindDuration {
+				series.buckets = make([]uint64, len(otlpHistogramBounds)+1)
+			}
+
+			seriesByKey[key] = series
+
+			if _, found := seriesByHost[event.Host]; !found {
+				hosts = append(hosts, event.Host)
+			}
+
+			seriesByHost[event.Host] = append(seriesByHost[event.Host], series)
+		}
+
+		series.add(event)
+	}
+
+	request := &collectormetrics.ExportMetricsServiceRequest{}
+	for _, host := range hosts {
+		resource := &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{otlpStringAttribute("service.name", "concourse")},
+		}
+
+		if host != "" {
+			resource.Attributes = append(resource.Attributes, otlpStringAttribute("host.name", host))
+		}
+
+		library := &metricspb.InstrumentationLibraryMetrics{
+			InstrumentationLibrary: &commonpb.InstrumentationLibrary{
+				Name: "github.com/concourse/concourse/atc/metric",
+			},
+		}
+
+		for _, series := range seriesByHost[host] {
+			library.Metrics = append(library.Metrics, series.metric())
+		}
+
+		request.ResourceMetrics = append(request.ResourceMetrics, &metricspb.ResourceMetrics{
+			Resource:                      resource,
+			InstrumentationLibraryMetrics: []*metricspb.InstrumentationLibraryMetrics{library},
+		})
+	}
+
+	return request
+}
+
+func (series *otlpSeries) metric() *metricspb.Metric {
+	m := &metricspb.Metric{
+		Name: series.name,
+		Unit: series.unit,
+	}
+
+	start := uint64(series.start.UnixNano())
+	end := uint64(series.end.UnixNano())
+	attributes := otlpAttributes(series.attributes)
+
+	switch series.kind {
+	case metric.KindDuration:
+		m.Data = &metricspb.Metric_Histogram{
+			Histogram: &metricspb.Histogram{
+				DataPoints: []*metricspb.HistogramDataPoint{{
+					Attributes:        attributes,
+					StartTimeUnixNano: start,
+					TimeUnixNano:      end,
+					Count:             series.count,
+					Sum:               series.value,
+					BucketCounts:      series.buckets,
+					ExplicitBounds:    series.bounds(),
+				}},
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+			},
+		}
+
+	case metric.KindCounter, metric.KindOccurrence:
+		m.Data = &metricspb.Metric_Sum{
+			Sum: &metricspb.Sum{
+				DataPoints: []*metricspb.NumberDataPoint{{
+					Attributes:        attributes,
+					StartTimeUnixNano: start,
+					TimeUnixNano:      end,
+					Value:             &metricspb.NumberDataPoint_AsDouble{AsDouble: series.value},
+				}},
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				IsMonotonic:            true,
+			},
+		}
+
+	default:
+		m.Data = &metricspb.Metric_Gauge{
+			Gauge: &metricspb.Gauge{
+				DataPoints: []*metricspb.NumberDataPoint{{
+					Attributes:        attributes,
+					StartTimeUnixNano: start,
+					TimeUnixNano:      end,
+					Value:             &metricspb.NumberDataPoint_AsDouble{AsDouble: series.value},
+				}},
+			},
+		}
+	}
+
+	return m
+}
+
+func (series *otlpSeries) bounds() []float64 {
+	if series.unit != "s" {
+		return otlpHistogramBounds
+	}
+
+	bounds := make([]float64, len(otlpHistogramBounds))
+	for i, bound := range otlpHistogramBounds {
+		bounds[i] = bound / 1000
+	}
+
+	return bounds
+}
+
+func otlpAttributes(attributes map[string]string) []*commonpb.KeyValue {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var kvs []*commonpb.KeyValue
+	for _, name := range names {
+		kvs = append(kvs, otlpStringAttribute(name, attributes[name]))
+	}
+
+	return kvs
+}
+
+func otlpStringAttribute(key string, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
+
+func otlpSeriesKey(event metric.Event) string {
+	names := make([]string, 0, len(event.Attributes))
+	for name := range event.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	key := []string{event.Host, event.Name}
+	for _, name := range names {
+		key = append(key, name+"="+event.Attributes[name])
+	}
+
+	return strings.Join(key, "\x00")
+}
@@ -0,0 +1,279 @@
+package emitter_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/emitter"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+// otlpCollector is an in-process stand-in for an OTLP collector's gRPC
+// metrics service.
+type otlpCollector struct {
+	collectormetrics.UnimplementedMetricsServiceServer
+
+	server   *grpc.Server
+	listener net.Listener
+
+	exports chan otlpExport
+}
+
+type otlpExport struct {
+	headers metadata.MD
+	request *collectormetrics.ExportMetricsServiceRequest
+}
+
+func startOTLPCollector() *otlpCollector {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+
+	collector := &otlpCollector{
+		listener: listener,
+		exports:  make(chan otlpExport, 10),
+	}
+
+	collector.server = grpc.NewServer()
+	collectormetrics.RegisterMetricsServiceServer(collector.server, collector)
+
+	go collector.server.Serve(listener)
+
+	return collector
+}
+
+func (collector *otlpCollector) Export(ctx context.Context, request *collectormetrics.ExportMetricsServiceRequest) (*collectormetrics.ExportMetricsServiceResponse, error) {
+	headers, _ := metadata.FromIncomingContext(ctx)
+
+	collector.exports <- otlpExport{
+		headers: headers,
+		request: request,
+	}
+
+	return &collectormetrics.ExportMetricsServiceResponse{}, nil
+}
+
+func (collector *otlpCollector) Addr() string {
+	return collector.listener.Addr().String()
+}
+
+func (collector *otlpCollector) Stop() {
+	collector.server.Stop()
+}
+
+// otlpMetricsByName returns the metrics of an ExportMetricsServiceRequest,
+// keyed by name.
+func otlpMetricsByName(request *collectormetrics.ExportMetricsServiceRequest) map[string]*metricspb.Metric {
+	byName := map[string]*metricspb.Metric{}
+	for _, resourceMetrics := range request.ResourceMetrics {
+		for _, library := range resourceMetrics.InstrumentationLibraryMetrics {
+			for _, m := range library.Metrics {
+				byName[m.Name] = m
+			}
+		}
+	}
+
+	return byName
+}
+
+func otlpAttributes(point interface {
+	GetAttributes() []*commonpb.KeyValue
+}) map[string]string {
+	attributes := map[string]string{}
+	for _, kv := range point.GetAttributes() {
+		attributes[kv.Key] = kv.Value.GetStringValue()
+	}
+
+	return attributes
+}
+
+var _ = Describe("OTLPEmitter", func() {
+	var (
+		testLogger *lagertest.TestLogger
+		now        time.Time
+	)
+
+	BeforeEach(func() {
+		testLogger = lagertest.NewTestLogger("otlp")
+		now = time.Now()
+	})
+
+	Describe("OTLPMetricName", func() {
+		It("namespaces and normalizes event names", func() {
+			Expect(emitter.OTLPMetricName("build finished")).To(Equal("concourse.build_finished"))
+			Expect(emitter.OTLPMetricName("gc: build collector duration (ms)")).To(Equal("concourse.gc.build_collector_duration"))
+			Expect(emitter.OTLPMetricName("GC container collector job dropped")).To(Equal("concourse.gc_container_collector_job_dropped"))
+		})
+	})
+
+	Describe("OTLPMetricsRequest", func() {
+		var metrics map[string]*metricspb.Metric
+
+		BeforeEach(func() {
+			events := []metric.Event{
+				{Name: "worker containers", Value: 3, Host: "some-host", Time: now, Attributes: map[string]string{"worker": "some-worker", "environment": "prod"}},
+				{Name: "worker containers", Value: 5, Host: "some-host", Time: now.Add(time.Second), Attributes: map[string]string{"worker": "some-worker", "environment": "prod"}},
+				{Name: "database queries", Kind: metric.KindCounter, Value: 10, Host: "some-host", Time: now},
+				{Name: "database queries", Kind: metric.KindCounter, Value: 4, Host: "some-host", Time: now.Add(time.Second)},
+				{Name: "build started", Kind: metric.KindOccurrence, Value: 1234, Host: "some-host", Time: now},
+				{Name: "build started", Kind: metric.KindOccurrence, Value: 1235, Host: "some-host", Time: now},
+				{Name: "build finished", Kind: metric.KindDuration, Unit: "ms", Value: 40, Host: "some-host", Time: now},
+				{Name: "build finished", Kind: metric.KindDuration, Unit: "ms", Value: 2000, Host: "some-host", Time: now},
+				{Name: "steps waiting duration", Kind: metric.KindDuration, Unit: "s", Value: 2, Host: "some-host", Time: now},
+			}
+
+			metrics = otlpMetricsByName(emitter.OTLPMetricsRequest(events))
+		})
+
+		It("keeps the latest value of gauges along with their attributes", func() {
+			gauge := metrics["concourse.worker_containers"].GetGauge()
+			Expect(gauge).ToNot(BeNil())
+			Expect(gauge.DataPoints).To(HaveLen(1))
+
+			point := gauge.DataPoints[0]
+			Expect(point.GetAsDouble()).To(Equal(5.0))
+			Expect(otlpAttributes(point)).To(HaveKeyWithValue("environment", "prod"))
+		})
+
+		It("sums counters", func() {
+			counter := metrics["concourse.database_queries"].GetSum()
+			Expect(counter).ToNot(BeNil())
+			Expect(counter.IsMonotonic).To(BeTrue())
+			Expect(counter.AggregationTemporality).To(Equal(metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA))
+			Expect(counter.DataPoints[0].GetAsDouble()).To(Equal(14.0))
+		})
+
+		It("counts occurrences regardless of their value", func() {
+			counter := metrics["concourse.build_started"].GetSum()
+			Expect(counter).ToNot(BeNil())
+			Expect(counter.DataPoints[0].GetAsDouble()).To(Equal(2.0))
+		})
+
+		It("collects durations into histograms", func() {
+			Expect(metrics["concourse.build_finished"].Unit).To(Equal("ms"))
+
+			histogram := metrics["concourse.build_finished"].GetHistogram()
+			Expect(histogram).ToNot(BeNil())
+
+			point := histogram.DataPoints[0]
+			Expect(point.Count).To(Equal(uint64(2)))
+			Expect(point.Sum).To(Equal(2040.0))
+			Expect(point.BucketCounts).To(HaveLen(len(point.ExplicitBounds) + 1))
+			Expect(point.BucketCounts[1]).To(Equal(uint64(1)))
+			Expect(point.BucketCounts[5]).To(Equal(uint64(1)))
+		})
+
+		It("buckets durations in seconds by their value in seconds", func() {
+			Expect(metrics["concourse.steps_waiting_duration"].Unit).To(Equal("s"))
+
+			point := metrics["concourse.steps_waiting_duration"].GetHistogram().DataPoints[0]
+			Expect(point.ExplicitBounds[4]).To(Equal(1.0))
+			Expect(point.BucketCounts[5]).To(Equal(uint64(1)))
+		})
+	})
+
+	Context("exporting over gRPC", func() {
+		var (
+			collector   *otlpCollector
+			otlpEmitter metric.Emitter
+		)
+
+		BeforeEach(func() {
+			collector = startOTLPCollector()
+
+			var err error
+			otlpEmitter, err = (&emitter.OTLPConfig{
+				Address:       collector.Addr(),
+				Protocol:      emitter.OTLPProtocolGRPC,
+				Headers:       map[string]string{"x-api-key": "some-key"},
+				BatchSize:     2,
+				BatchDuration: time.Hour,
+			}).NewEmitter()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			collector.Stop()
+		})
+
+		It("exports a batch once it is full", func() {
+			otlpEmitter.Emit(testLogger, metric.Event{Name: "builds running", Value: 1, Time: now})
+			Consistently(collector.exports).ShouldNot(Receive())
+
+			otlpEmitter.Emit(testLogger, metric.Event{Name: "jobs scheduled", Kind: metric.KindCounter, Value: 2, Time: now})
+
+			var export otlpExport
+			Eventually(collector.exports).Should(Receive(&export))
+
+			Expect(export.headers.Get("x-api-key")).To(ConsistOf("some-key"))
+			Expect(otlpMetricsByName(export.request)).To(HaveKey("concourse.builds_running"))
+			Expect(otlpMetricsByName(export.request)).To(HaveKey("concourse.jobs_scheduled"))
+		})
+	})
+
+	Context("exporting over HTTP", func() {
+		var (
+			server      *ghttp.Server
+			otlpEmitter metric.Emitter
+			received    chan *collectormetrics.ExportMetricsServiceRequest
+		)
+
+		BeforeEach(func() {
+			received = make(chan *collectormetrics.ExportMetricsServiceRequest, 1)
+
+			server = ghttp.NewServer()
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/metrics"),
+				ghttp.VerifyContentType("application/x-protobuf"),
+				ghttp.VerifyHeaderKV("X-Api-Key", "some-key"),
+				func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+
+					payload, err := ioutil.ReadAll(r.Body)
+					Expect(err).ToNot(HaveOccurred())
+
+					request := &collectormetrics.ExportMetricsServiceRequest{}
+					err = proto.Unmarshal(payload, request)
+					Expect(err).ToNot(HaveOccurred())
+
+					received <- request
+				},
+			))
+
+			var err error
+			otlpEmitter, err = (&emitter.OTLPConfig{
+				Address:       server.URL(),
+				Protocol:      emitter.OTLPProtocolHTTP,
+				Headers:       map[string]string{"X-Api-Key": "some-key"},
+				BatchSize:     1,
+				BatchDuration: time.Hour,
+			}).NewEmitter()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("posts the batch to the collector", func() {
+			otlpEmitter.Emit(testLogger, metric.Event{Name: "check build finished", Kind: metric.KindDuration, Unit: "ms", Value: 20, Time: now})
+
+			var request *collectormetrics.ExportMetricsServiceRequest
+			Eventually(received).Should(Receive(&request))
+			Expect(otlpMetricsByName(request)["concourse.check_build_finished"].GetHistogram()).ToNot(BeNil())
+		})
+	})
+})
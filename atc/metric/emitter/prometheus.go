@@ -71,6 +71,11 @@ type PrometheusEmitter struct {
 	workerTasks             *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
 
+	teamQuotaUsage *prometheus.GaugeVec
+	teamQuotaLimit *prometheus.GaugeVec
+
+	jobFlakiness *prometheus.GaugeVec
+
 	workerContainersLabels map[string]map[string]prometheus.Labels
 	workerVolumesLabels    map[string]map[string]prometheus.Labels
 	workerTasksLabels      map[string]map[string]prometheus.Labels
@@ -354,6 +359,39 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workerVolumes)
 
+	teamQuotaUsage := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "quota_usage",
+			Help:      "Current usage of each quota configured on a team",
+		},
+		[]string{"team", "quota"},
+	)
+	prometheus.MustRegister(teamQuotaUsage)
+
+	teamQuotaLimit := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "teams",
+			Name:      "quota_limit",
+			Help:      "Limit of each quota configured on a team",
+		},
+		[]string{"team", "quota"},
+	)
+	prometheus.MustRegister(teamQuotaLimit)
+
+	jobFlakiness := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "jobs",
+			Name:      "flakiness",
+			Help:      "Ratio of a job's recent builds which failed and then succeeded with the same inputs",
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(jobFlakiness)
+
 	workerUnknownVolumes := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "concourse",
@@ -522,6 +560,11 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		workerUnknownContainers: workerUnknownContainers,
 		workerUnknownVolumes:    workerUnknownVolumes,
 
+		teamQuotaUsage: teamQuotaUsage,
+		teamQuotaLimit: teamQuotaLimit,
+
+		jobFlakiness: jobFlakiness,
+
 		volumesStreamed: volumesStreamed,
 	}
 	go emitter.periodicMetricGC()
@@ -590,6 +633,12 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.workerUnknownVolumesMetric(logger, event)
 	case "worker tasks":
 		emitter.workerTasksMetric(logger, event)
+	case "team quota usage":
+		emitter.teamQuotaMetric(logger, emitter.teamQuotaUsage, event)
+	case "team quota limit":
+		emitter.teamQuotaMetric(logger, emitter.teamQuotaLimit, event)
+	case "job flakiness":
+		emitter.jobFlakinessMetric(logger, event)
 	case "worker state":
 		emitter.workersRegisteredMetric(logger, event)
 	case "http response time":
@@ -852,6 +901,41 @@ func (emitter *PrometheusEmitter) workerTasksMetric(logger lager.Logger, event m
 	emitter.workerTasks.With(emitter.workerTasksLabels[worker][key]).Set(event.Value)
 }
 
+func (emitter *PrometheusEmitter) teamQuotaMetric(logger lager.Logger, gauge *prometheus.GaugeVec, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+	quota, exists := event.Attributes["quota"]
+	if !exists {
+		logger.Error("failed-to-find-quota-in-event", fmt.Errorf("expected quota to exist in event.Attributes"))
+		return
+	}
+
+	gauge.WithLabelValues(team, quota).Set(event.Value)
+}
+
+func (emitter *PrometheusEmitter) jobFlakinessMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+	pipeline, exists := event.Attributes["pipeline"]
+	if !exists {
+		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
+		return
+	}
+	job, exists := event.Attributes["job"]
+	if !exists {
+		logger.Error("failed-to-find-job-in-event", fmt.Errorf("expected job to exist in event.Attributes"))
+		return
+	}
+
+	emitter.jobFlakiness.WithLabelValues(team, pipeline, job).Set(event.Value)
+}
+
 func (emitter *PrometheusEmitter) httpResponseTimeMetrics(logger lager.Logger, event metric.Event) {
 	route, exists := event.Attributes["route"]
 	if !exists {
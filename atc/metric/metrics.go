@@ -28,6 +28,8 @@ func (event StepsWaitingDuration) Emit(logger lager.Logger) {
 		logger.Session("steps-waiting-duration"),
 		Event{
 			Name:  "steps waiting duration",
+			Kind:  KindDuration,
+			Unit:  "s",
 			Value: event.Duration.Seconds(),
 			Attributes: map[string]string{
 				"platform":   event.Labels.Platform,
@@ -48,6 +50,8 @@ func (event BuildCollectorDuration) Emit(logger lager.Logger) {
 		logger.Session("gc-build-collector-duration"),
 		Event{
 			Name:  "gc: build collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -62,6 +66,8 @@ func (event WorkerCollectorDuration) Emit(logger lager.Logger) {
 		logger.Session("gc-worker-collector-duration"),
 		Event{
 			Name:  "gc: worker collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -76,6 +82,8 @@ func (event ResourceCacheUseCollectorDuration) Emit(logger lager.Logger) {
 		logger.Session("gc-resource-cache-use-collector-duration"),
 		Event{
 			Name:  "gc: resource cache use collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -90,6 +98,8 @@ func (event ResourceConfigCollectorDuration) Emit(logger lager.Logger) {
 		logger.Session("gc-resource-config-collector-duration"),
 		Event{
 			Name:  "gc: resource config collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -104,6 +114,8 @@ func (event ResourceCacheCollectorDuration) Emit(logger lager.Logger) {
 		logger.Session("gc-resource-cache-collector-duration"),
 		Event{
 			Name:  "gc: resource cache collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -118,6 +130,8 @@ func (event ResourceConfigCheckSessionCollectorDuration) Emit(logger lager.Logge
 		logger.Session("gc-resource-config-check-session-collector-duration"),
 		Event{
 			Name:  "gc: resource config check session collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -132,6 +146,8 @@ func (event ArtifactCollectorDuration) Emit(logger lager.Logger) {
 		logger.Session("gc-artifact-collector-duration"),
 		Event{
 			Name:  "gc: artifact collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -146,6 +162,8 @@ func (event ContainerCollectorDuration) Emit(logger lager.Logger) {
 		logger.Session("gc-container-collector-duration"),
 		Event{
 			Name:  "gc: container collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -160,6 +178,8 @@ func (event VolumeCollectorDuration) Emit(logger lager.Logger) {
 		logger.Session("gc-volume-collector-duration"),
 		Event{
 			Name:  "gc: volume collector duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 		},
 	)
@@ -177,6 +197,8 @@ func (event SchedulingJobDuration) Emit(logger lager.Logger) {
 		logger.Session("job-scheduling-duration"),
 		Event{
 			Name:  "scheduling: job duration (ms)",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 			Attributes: map[string]string{
 				"pipeline": event.PipelineName,
@@ -291,6 +313,60 @@ func (event WorkerTasks) Emit(logger lager.Logger) {
 	)
 }
 
+type TeamQuota struct {
+	TeamName string
+	Quota    string
+	Usage    float64
+	Limit    float64
+}
+
+func (event TeamQuota) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"team_name": event.TeamName,
+		"quota":     event.Quota,
+	}
+
+	Metrics.emit(
+		logger.Session("team-quota-usage"),
+		Event{
+			Name:       "team quota usage",
+			Value:      event.Usage,
+			Attributes: attributes,
+		},
+	)
+
+	Metrics.emit(
+		logger.Session("team-quota-limit"),
+		Event{
+			Name:       "team quota limit",
+			Value:      event.Limit,
+			Attributes: attributes,
+		},
+	)
+}
+
+type JobFlakiness struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	Score        float64
+}
+
+func (event JobFlakiness) Emit(logger lager.Logger) {
+	Metrics.emit(
+		logger.Session("job-flakiness"),
+		Event{
+			Name:  "job flakiness",
+			Value: event.Score,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+			},
+		},
+	)
+}
+
 type VolumesToBeGarbageCollected struct {
 	Volumes int
 }
@@ -420,6 +496,7 @@ func (event GarbageCollectionContainerCollectorJobDropped) Emit(logger lager.Log
 		logger.Session("gc-container-collector-dropped"),
 		Event{
 			Name:  "GC container collector job dropped",
+			Kind:  KindCounter,
 			Value: 1,
 			Attributes: map[string]string{
 				"worker": event.WorkerName,
@@ -437,6 +514,7 @@ func (event BuildStarted) Emit(logger lager.Logger) {
 		logger.Session("build-started"),
 		Event{
 			Name:       "build started",
+			Kind:       KindOccurrence,
 			Value:      float64(event.Build.ID()),
 			Attributes: event.Build.TracingAttrs(),
 		},
@@ -455,6 +533,8 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 		logger.Session("build-finished"),
 		Event{
 			Name:       "build finished",
+			Kind:       KindDuration,
+			Unit:       "ms",
 			Value:      ms(event.Build.EndTime().Sub(event.Build.StartTime())),
 			Attributes: attrs,
 		},
@@ -470,6 +550,7 @@ func (event CheckBuildStarted) Emit(logger lager.Logger) {
 		logger.Session("check-build-started"),
 		Event{
 			Name:       "check build started",
+			Kind:       KindOccurrence,
 			Value:      float64(event.Build.ID()),
 			Attributes: event.Build.TracingAttrs(),
 		},
@@ -488,6 +569,8 @@ func (event CheckBuildFinished) Emit(logger lager.Logger) {
 		logger.Session("check-build-finished"),
 		Event{
 			Name:       "check build finished",
+			Kind:       KindDuration,
+			Unit:       "ms",
 			Value:      ms(event.Build.EndTime().Sub(event.Build.StartTime())),
 			Attributes: attrs,
 		},
@@ -508,6 +591,7 @@ func (e ErrorLog) Emit(logger lager.Logger, m *Monitor) {
 		logger.Session("error-log"),
 		Event{
 			Name:  "error log",
+			Kind:  KindCounter,
 			Value: float64(e.Value),
 			Attributes: map[string]string{
 				"message": e.Message,
@@ -529,6 +613,8 @@ func (event HTTPResponseTime) Emit(logger lager.Logger, m *Monitor) {
 		logger.Session("http-response-time"),
 		Event{
 			Name:  "http response time",
+			Kind:  KindDuration,
+			Unit:  "ms",
 			Value: ms(event.Duration),
 			Attributes: map[string]string{
 				"route":  event.Route,
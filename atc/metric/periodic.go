@@ -32,6 +32,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("database-queries"),
 		Event{
 			Name:  "database queries",
+			Kind:  KindCounter,
 			Value: m.DatabaseQueries.Delta(),
 		},
 	)
@@ -55,6 +56,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("containers-deleted"),
 		Event{
 			Name:  "containers deleted",
+			Kind:  KindCounter,
 			Value: m.ContainersDeleted.Delta(),
 		},
 	)
@@ -63,6 +65,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("volumes-deleted"),
 		Event{
 			Name:  "volumes deleted",
+			Kind:  KindCounter,
 			Value: m.VolumesDeleted.Delta(),
 		},
 	)
@@ -71,6 +74,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("volumes-streamed"),
 		Event{
 			Name:  "volumes streamed",
+			Kind:  KindCounter,
 			Value: m.VolumesStreamed.Delta(),
 		},
 	)
@@ -79,6 +83,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("containers-created"),
 		Event{
 			Name:  "containers created",
+			Kind:  KindCounter,
 			Value: m.ContainersCreated.Delta(),
 		},
 	)
@@ -87,6 +92,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("volumes-created"),
 		Event{
 			Name:  "volumes created",
+			Kind:  KindCounter,
 			Value: m.VolumesCreated.Delta(),
 		},
 	)
@@ -95,6 +101,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("failed-containers"),
 		Event{
 			Name:  "failed containers",
+			Kind:  KindCounter,
 			Value: m.FailedContainers.Delta(),
 		},
 	)
@@ -103,6 +110,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("failed-volumes"),
 		Event{
 			Name:  "failed volumes",
+			Kind:  KindCounter,
 			Value: m.FailedVolumes.Delta(),
 		},
 	)
@@ -111,6 +119,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("jobs-scheduled"),
 		Event{
 			Name:  "jobs scheduled",
+			Kind:  KindCounter,
 			Value: m.JobsScheduled.Delta(),
 		},
 	)
@@ -127,6 +136,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("builds-started"),
 		Event{
 			Name:  "builds started",
+			Kind:  KindCounter,
 			Value: m.BuildsStarted.Delta(),
 		},
 	)
@@ -143,6 +153,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("check-builds-started"),
 		Event{
 			Name:  "check builds started",
+			Kind:  KindCounter,
 			Value: m.CheckBuildsStarted.Delta(),
 		},
 	)
@@ -173,6 +184,7 @@ func tick(logger lager.Logger, m *Monitor) {
 			logger.Session("concurrent-requests-limit-hit"),
 			Event{
 				Name:  "concurrent requests limit hit",
+				Kind:  KindCounter,
 				Value: counter.Delta(),
 				Attributes: map[string]string{
 					"action": action,
@@ -201,6 +213,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("checks-finished-with-error"),
 		Event{
 			Name:  "checks finished",
+			Kind:  KindCounter,
 			Value: m.ChecksFinishedWithError.Delta(),
 			Attributes: map[string]string{
 				"status": "error",
@@ -212,6 +225,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("checks-finished-with-success"),
 		Event{
 			Name:  "checks finished",
+			Kind:  KindCounter,
 			Value: m.ChecksFinishedWithSuccess.Delta(),
 			Attributes: map[string]string{
 				"status": "success",
@@ -224,6 +238,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("checks-started"),
 		Event{
 			Name:  "checks started",
+			Kind:  KindCounter,
 			Value: m.ChecksStarted.Delta(),
 		},
 	)
@@ -233,6 +248,7 @@ func tick(logger lager.Logger, m *Monitor) {
 		logger.Session("checks-enqueued"),
 		Event{
 			Name:  "checks enqueued",
+			Kind:  KindCounter,
 			Value: m.ChecksEnqueued.Delta(),
 		},
 	)
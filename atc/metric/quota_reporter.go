@@ -0,0 +1,77 @@
+package metric
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type quotaReporter struct {
+	teamFactory db.TeamFactory
+}
+
+// NewQuotaReporter returns a component which emits the usage and limit of
+// every quota configured on a team.
+func NewQuotaReporter(teamFactory db.TeamFactory) *quotaReporter {
+	return &quotaReporter{
+		teamFactory: teamFactory,
+	}
+}
+
+func (reporter *quotaReporter) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("quota-reporter")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	teams, err := reporter.teamFactory.GetTeams()
+	if err != nil {
+		logger.Error("failed-to-get-teams", err)
+		return err
+	}
+
+	for _, team := range teams {
+		quota := team.Quota()
+		if quota == nil {
+			continue
+		}
+
+		usage, err := team.Usage()
+		if err != nil {
+			logger.Error("failed-to-get-team-usage", err, lager.Data{"team": team.Name()})
+			continue
+		}
+
+		if quota.MaxConcurrentBuilds > 0 {
+			TeamQuota{
+				TeamName: team.Name(),
+				Quota:    atc.QuotaMaxConcurrentBuilds,
+				Usage:    float64(usage.ConcurrentBuilds),
+				Limit:    float64(quota.MaxConcurrentBuilds),
+			}.Emit(logger)
+		}
+
+		if quota.MaxContainers > 0 {
+			TeamQuota{
+				TeamName: team.Name(),
+				Quota:    atc.QuotaMaxContainers,
+				Usage:    float64(usage.Containers),
+				Limit:    float64(quota.MaxContainers),
+			}.Emit(logger)
+		}
+
+		if quota.MaxVolumeDisk > 0 {
+			TeamQuota{
+				TeamName: team.Name(),
+				Quota:    atc.QuotaMaxVolumeDisk,
+				Usage:    float64(usage.VolumeDisk),
+				Limit:    float64(quota.MaxVolumeDisk),
+			}.Emit(logger)
+		}
+	}
+
+	return nil
+}
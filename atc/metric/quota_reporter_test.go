@@ -0,0 +1,70 @@
+package metric_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/metric"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuotaReporter", func() {
+	var (
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		limitedTeam     *dbfakes.FakeTeam
+		unlimitedTeam   *dbfakes.FakeTeam
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+
+		limitedTeam = new(dbfakes.FakeTeam)
+		limitedTeam.NameReturns("limited-team")
+		limitedTeam.QuotaReturns(&atc.TeamQuota{MaxContainers: 10})
+		limitedTeam.UsageReturns(atc.TeamUsage{Containers: 3}, nil)
+
+		unlimitedTeam = new(dbfakes.FakeTeam)
+		unlimitedTeam.NameReturns("unlimited-team")
+
+		fakeTeamFactory.GetTeamsReturns([]db.Team{limitedTeam, unlimitedTeam}, nil)
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), testLogger)
+		runErr = metric.NewQuotaReporter(fakeTeamFactory).Run(ctx)
+	})
+
+	It("only looks up the usage of teams which have a quota", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(limitedTeam.UsageCallCount()).To(Equal(1))
+		Expect(unlimitedTeam.UsageCallCount()).To(BeZero())
+	})
+
+	Context("when looking up a team's usage fails", func() {
+		BeforeEach(func() {
+			limitedTeam.UsageReturns(atc.TeamUsage{}, errors.New("nope"))
+		})
+
+		It("carries on with the other teams", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("when getting the teams fails", func() {
+		BeforeEach(func() {
+			fakeTeamFactory.GetTeamsReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
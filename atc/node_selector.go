@@ -0,0 +1,158 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// WorkerLabels are arbitrary key/value pairs which a worker registers with,
+// e.g. arch=arm64 or zone=eu-1, to be matched against a NodeSelector.
+type WorkerLabels map[string]string
+
+// String renders the labels as a sorted list of key=value pairs.
+func (labels WorkerLabels) String() string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ", ")
+}
+
+type NodeSelectorOperator string
+
+const (
+	NodeSelectorOpIn     NodeSelectorOperator = "In"
+	NodeSelectorOpNotIn  NodeSelectorOperator = "NotIn"
+	NodeSelectorOpExists NodeSelectorOperator = "Exists"
+)
+
+// NodeSelector is a list of expressions matched against the labels of each
+// worker to decide where a step may run. A worker must match every hard
+// expression; soft expressions only express a preference, so that workers
+// matching more of them are tried first.
+type NodeSelector []NodeSelectorRequirement
+
+type NodeSelectorRequirement struct {
+	Key      string               `json:"key"`
+	Operator NodeSelectorOperator `json:"operator"`
+	Values   []string             `json:"values,omitempty"`
+	Soft     bool                 `json:"soft,omitempty"`
+}
+
+var ErrNodeSelectorMissingKey = errors.New("node selector key must not be empty")
+
+func (req NodeSelectorRequirement) Validate() error {
+	if req.Key == "" {
+		return ErrNodeSelectorMissingKey
+	}
+
+	switch req.Operator {
+	case NodeSelectorOpIn, NodeSelectorOpNotIn:
+		if len(req.Values) == 0 {
+			return fmt.Errorf("node selector for '%s' must specify values for operator '%s'", req.Key, req.Operator)
+		}
+	case NodeSelectorOpExists:
+		if len(req.Values) != 0 {
+			return fmt.Errorf("node selector for '%s' must not specify values for operator '%s'", req.Key, req.Operator)
+		}
+	default:
+		return fmt.Errorf("node selector for '%s' has unknown operator '%s' (must be one of %s, %s or %s)", req.Key, req.Operator, NodeSelectorOpIn, NodeSelectorOpNotIn, NodeSelectorOpExists)
+	}
+
+	return nil
+}
+
+// Matches returns whether the labels satisfy the expression. A worker without
+// the label never matches In, and always matches NotIn.
+func (req NodeSelectorRequirement) Matches(labels WorkerLabels) bool {
+	value, found := labels[req.Key]
+
+	switch req.Operator {
+	case NodeSelectorOpIn:
+		return found && req.hasValue(value)
+	case NodeSelectorOpNotIn:
+		return !found || !req.hasValue(value)
+	case NodeSelectorOpExists:
+		return found
+	}
+
+	return false
+}
+
+func (req NodeSelectorRequirement) hasValue(value string) bool {
+	for _, v := range req.Values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (req NodeSelectorRequirement) String() string {
+	var expr string
+	switch req.Operator {
+	case NodeSelectorOpExists:
+		expr = req.Key
+	case NodeSelectorOpNotIn:
+		expr = fmt.Sprintf("%s notin (%s)", req.Key, strings.Join(req.Values, ","))
+	default:
+		expr = fmt.Sprintf("%s in (%s)", req.Key, strings.Join(req.Values, ","))
+	}
+
+	if req.Soft {
+		expr += " (soft)"
+	}
+
+	return expr
+}
+
+func (selector NodeSelector) Validate() error {
+	for _, req := range selector {
+		err := req.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Satisfied returns whether the labels match every hard expression.
+func (selector NodeSelector) Satisfied(labels WorkerLabels) bool {
+	for _, req := range selector {
+		if !req.Soft && !req.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Affinity returns the number of soft expressions matched by the labels.
+func (selector NodeSelector) Affinity(labels WorkerLabels) int {
+	affinity := 0
+	for _, req := range selector {
+		if req.Soft && req.Matches(labels) {
+			affinity++
+		}
+	}
+
+	return affinity
+}
+
+// HasSoft returns whether any of the expressions are soft.
+func (selector NodeSelector) HasSoft() bool {
+	for _, req := range selector {
+		if req.Soft {
+			return true
+		}
+	}
+
+	return false
+}
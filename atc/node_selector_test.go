@@ -0,0 +1,106 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NodeSelector", func() {
+	labels := atc.WorkerLabels{"arch": "arm64", "zone": "eu-1"}
+
+	Describe("Validate", func() {
+		It("accepts valid expressions", func() {
+			Expect(atc.NodeSelector{
+				{Key: "arch", Operator: atc.NodeSelectorOpIn, Values: []string{"arm64"}},
+				{Key: "zone", Operator: atc.NodeSelectorOpNotIn, Values: []string{"us-1"}},
+				{Key: "gpu", Operator: atc.NodeSelectorOpExists, Soft: true},
+			}.Validate()).To(Succeed())
+		})
+
+		It("requires a key", func() {
+			Expect(atc.NodeSelector{
+				{Operator: atc.NodeSelectorOpExists},
+			}.Validate()).To(Equal(atc.ErrNodeSelectorMissingKey))
+		})
+
+		It("requires values for In and NotIn", func() {
+			Expect(atc.NodeSelector{
+				{Key: "arch", Operator: atc.NodeSelectorOpNotIn},
+			}.Validate()).To(MatchError("node selector for 'arch' must specify values for operator 'NotIn'"))
+		})
+
+		It("rejects values for Exists", func() {
+			Expect(atc.NodeSelector{
+				{Key: "arch", Operator: atc.NodeSelectorOpExists, Values: []string{"arm64"}},
+			}.Validate()).To(MatchError("node selector for 'arch' must not specify values for operator 'Exists'"))
+		})
+
+		It("rejects unknown operators", func() {
+			Expect(atc.NodeSelector{
+				{Key: "arch", Operator: "Like", Values: []string{"arm64"}},
+			}.Validate()).To(MatchError(ContainSubstring("unknown operator 'Like'")))
+		})
+	})
+
+	Describe("Satisfied", func() {
+		It("is satisfied when every hard expression matches", func() {
+			Expect(atc.NodeSelector{
+				{Key: "arch", Operator: atc.NodeSelectorOpIn, Values: []string{"amd64", "arm64"}},
+				{Key: "zone", Operator: atc.NodeSelectorOpNotIn, Values: []string{"us-1"}},
+				{Key: "zone", Operator: atc.NodeSelectorOpExists},
+			}.Satisfied(labels)).To(BeTrue())
+		})
+
+		It("is not satisfied when a hard expression does not match", func() {
+			Expect(atc.NodeSelector{
+				{Key: "arch", Operator: atc.NodeSelectorOpIn, Values: []string{"amd64"}},
+			}.Satisfied(labels)).To(BeFalse())
+
+			Expect(atc.NodeSelector{
+				{Key: "gpu", Operator: atc.NodeSelectorOpExists},
+			}.Satisfied(labels)).To(BeFalse())
+		})
+
+		It("matches NotIn when the label is missing", func() {
+			Expect(atc.NodeSelector{
+				{Key: "gpu", Operator: atc.NodeSelectorOpNotIn, Values: []string{"nvidia"}},
+			}.Satisfied(labels)).To(BeTrue())
+		})
+
+		It("ignores soft expressions", func() {
+			Expect(atc.NodeSelector{
+				{Key: "gpu", Operator: atc.NodeSelectorOpExists, Soft: true},
+			}.Satisfied(labels)).To(BeTrue())
+		})
+	})
+
+	Describe("Affinity", func() {
+		It("counts the matching soft expressions", func() {
+			selector := atc.NodeSelector{
+				{Key: "arch", Operator: atc.NodeSelectorOpIn, Values: []string{"arm64"}},
+				{Key: "zone", Operator: atc.NodeSelectorOpIn, Values: []string{"eu-1"}, Soft: true},
+				{Key: "gpu", Operator: atc.NodeSelectorOpExists, Soft: true},
+			}
+
+			Expect(selector.HasSoft()).To(BeTrue())
+			Expect(selector.Affinity(labels)).To(Equal(1))
+			Expect(selector.Affinity(atc.WorkerLabels{"zone": "eu-1", "gpu": "nvidia"})).To(Equal(2))
+		})
+	})
+
+	Describe("String", func() {
+		It("renders the expression", func() {
+			Expect(atc.NodeSelectorRequirement{Key: "arch", Operator: atc.NodeSelectorOpIn, Values: []string{"amd64", "arm64"}}.String()).To(Equal("arch in (amd64,arm64)"))
+			Expect(atc.NodeSelectorRequirement{Key: "zone", Operator: atc.NodeSelectorOpNotIn, Values: []string{"us-1"}, Soft: true}.String()).To(Equal("zone notin (us-1) (soft)"))
+			Expect(atc.NodeSelectorRequirement{Key: "gpu", Operator: atc.NodeSelectorOpExists}.String()).To(Equal("gpu"))
+		})
+	})
+})
+
+var _ = Describe("WorkerLabels", func() {
+	It("renders the labels sorted by key", func() {
+		Expect(atc.WorkerLabels{"zone": "eu-1", "arch": "arm64"}.String()).To(Equal("arch=arm64, zone=eu-1"))
+	})
+})
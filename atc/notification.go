@@ -0,0 +1,136 @@
+package atc
+
+import "github.com/gobwas/glob"
+
+const (
+	NotificationSinkTypeWebhook = "webhook"
+	NotificationSinkTypeSlack   = "slack"
+	NotificationSinkTypeSMTP    = "smtp"
+)
+
+var NotificationSinkTypes = []string{
+	NotificationSinkTypeWebhook,
+	NotificationSinkTypeSlack,
+	NotificationSinkTypeSMTP,
+}
+
+type NotificationsConfig struct {
+	Sinks NotificationSinkConfigs `json:"sinks,omitempty"`
+	Rules NotificationRuleConfigs `json:"rules,omitempty"`
+}
+
+type NotificationSinkConfig struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Source Source `json:"source"`
+}
+
+type NotificationSinkConfigs []NotificationSinkConfig
+
+func (sinks NotificationSinkConfigs) Lookup(name string) (NotificationSinkConfig, bool) {
+	for _, sink := range sinks {
+		if sink.Name == name {
+			return sink, true
+		}
+	}
+
+	return NotificationSinkConfig{}, false
+}
+
+// NotificationRuleConfig sends a notification to each of its sinks when a
+// build of a matching job finishes. Jobs may be glob expressions. From matches
+// the status of the job's previous build and To matches the status the build
+// finished with; leaving either empty matches any status.
+type NotificationRuleConfig struct {
+	Jobs  []string      `json:"jobs,omitempty"`
+	From  []BuildStatus `json:"from,omitempty"`
+	To    []BuildStatus `json:"to,omitempty"`
+	Sinks []string      `json:"sinks"`
+}
+
+func (rule NotificationRuleConfig) Matches(jobName string, from BuildStatus, to BuildStatus) bool {
+	if !matchesStatus(rule.From, from) || !matchesStatus(rule.To, to) {
+		return false
+	}
+
+	if len(rule.Jobs) == 0 {
+		return true
+	}
+
+	for _, jobGlob := range rule.Jobs {
+		g, err := glob.Compile(jobGlob)
+		if err != nil {
+			continue
+		}
+
+		if g.Match(jobName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesStatus(statuses []BuildStatus, status BuildStatus) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+type NotificationRuleConfigs []NotificationRuleConfig
+
+// Sinks returns the names of the sinks to notify for a build of the given
+// job, without duplicates and in the order they are first referenced.
+func (rules NotificationRuleConfigs) Sinks(jobName string, from BuildStatus, to BuildStatus) []string {
+	var sinks []string
+	seen := map[string]bool{}
+
+	for _, rule := range rules {
+		if !rule.Matches(jobName, from, to) {
+			continue
+		}
+
+		for _, sink := range rule.Sinks {
+			if !seen[sink] {
+				seen[sink] = true
+				sinks = append(sinks, sink)
+			}
+		}
+	}
+
+	return sinks
+}
+
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryStatus = "pending"
+	NotificationDeliverySucceeded NotificationDeliveryStatus = "succeeded"
+	NotificationDeliveryFailed    NotificationDeliveryStatus = "failed"
+)
+
+type NotificationDelivery struct {
+	ID                   int                        `json:"id"`
+	TeamName             string                     `json:"team_name"`
+	PipelineID           int                        `json:"pipeline_id"`
+	PipelineName         string                     `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars               `json:"pipeline_instance_vars,omitempty"`
+	JobName              string                     `json:"job_name"`
+	BuildID              int                        `json:"build_id"`
+	BuildName            string                     `json:"build_name"`
+	BuildStatus          BuildStatus                `json:"build_status"`
+	Sink                 string                     `json:"sink"`
+	Status               NotificationDeliveryStatus `json:"status"`
+	Attempts             int                        `json:"attempts"`
+	LastError            string                     `json:"last_error,omitempty"`
+	CreatedAt            int64                      `json:"created_at"`
+	UpdatedAt            int64                      `json:"updated_at"`
+}
@@ -0,0 +1,51 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationRuleConfigs", func() {
+	Describe("Matches", func() {
+		It("matches any job and status when nothing is specified", func() {
+			rule := atc.NotificationRuleConfig{Sinks: []string{"some-sink"}}
+
+			Expect(rule.Matches("some-job", atc.StatusSucceeded, atc.StatusFailed)).To(BeTrue())
+		})
+
+		It("matches job names against globs", func() {
+			rule := atc.NotificationRuleConfig{Jobs: []string{"deploy-*"}}
+
+			Expect(rule.Matches("deploy-prod", atc.StatusSucceeded, atc.StatusFailed)).To(BeTrue())
+			Expect(rule.Matches("unit", atc.StatusSucceeded, atc.StatusFailed)).To(BeFalse())
+		})
+
+		It("matches the previous and current build statuses", func() {
+			rule := atc.NotificationRuleConfig{
+				From: []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+				To:   []atc.BuildStatus{atc.StatusSucceeded},
+			}
+
+			Expect(rule.Matches("some-job", atc.StatusFailed, atc.StatusSucceeded)).To(BeTrue())
+			Expect(rule.Matches("some-job", atc.StatusErrored, atc.StatusSucceeded)).To(BeTrue())
+			Expect(rule.Matches("some-job", atc.StatusSucceeded, atc.StatusSucceeded)).To(BeFalse())
+			Expect(rule.Matches("some-job", atc.StatusFailed, atc.StatusFailed)).To(BeFalse())
+		})
+	})
+
+	Describe("Sinks", func() {
+		It("returns the sinks of every matching rule without duplicates", func() {
+			rules := atc.NotificationRuleConfigs{
+				{Jobs: []string{"deploy-*"}, Sinks: []string{"slack", "pager"}},
+				{To: []atc.BuildStatus{atc.StatusFailed}, Sinks: []string{"pager", "email"}},
+				{Jobs: []string{"unit"}, Sinks: []string{"webhook"}},
+			}
+
+			Expect(rules.Sinks("deploy-prod", atc.StatusSucceeded, atc.StatusFailed)).To(Equal([]string{"slack", "pager", "email"}))
+			Expect(rules.Sinks("deploy-prod", atc.StatusSucceeded, atc.StatusSucceeded)).To(Equal([]string{"slack", "pager"}))
+			Expect(rules.Sinks("other", atc.StatusSucceeded, atc.StatusSucceeded)).To(BeEmpty())
+		})
+	})
+})
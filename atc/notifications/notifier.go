@@ -0,0 +1,216 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const deliveryBatchSize = 100
+
+var ErrSinkNotConfigured = errors.New("notification sink is no longer configured")
+
+type notifier struct {
+	notificationFactory db.NotificationFactory
+	buildFactory        db.BuildFactory
+	sinkFactory         SinkFactory
+	secrets             creds.Secrets
+	varSourcePool       creds.VarSourcePool
+	externalURL         string
+	maxAttempts         int
+	retryInterval       time.Duration
+}
+
+// NewNotifier returns a component which matches finished builds against their
+// pipeline's notification rules and delivers the resulting notifications,
+// retrying failed deliveries with exponential backoff.
+func NewNotifier(
+	notificationFactory db.NotificationFactory,
+	buildFactory db.BuildFactory,
+	sinkFactory SinkFactory,
+	secrets creds.Secrets,
+	varSourcePool creds.VarSourcePool,
+	externalURL string,
+	maxAttempts int,
+	retryInterval time.Duration,
+) *notifier {
+	return &notifier{
+		notificationFactory: notificationFactory,
+		buildFactory:        buildFactory,
+		sinkFactory:         sinkFactory,
+		secrets:             secrets,
+		varSourcePool:       varSourcePool,
+		externalURL:         externalURL,
+		maxAttempts:         maxAttempts,
+		retryInterval:       retryInterval,
+	}
+}
+
+func (n *notifier) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("notifier")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	queued, err := n.notificationFactory.QueuedBuilds()
+	if err != nil {
+		logger.Error("failed-to-get-queued-builds", err)
+		return err
+	}
+
+	for _, notification := range queued {
+		err = n.scheduleDeliveries(notification)
+		if err != nil {
+			logger.Error("failed-to-schedule-deliveries", err, lager.Data{"build": notification.BuildID})
+			return err
+		}
+	}
+
+	deliveries, err := n.notificationFactory.DueDeliveries(deliveryBatchSize)
+	if err != nil {
+		logger.Error("failed-to-get-due-deliveries", err)
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		err = n.deliver(ctx, logger, delivery)
+		if err != nil {
+			logger.Error("failed-to-record-delivery", err, lager.Data{"delivery": delivery.ID()})
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *notifier) scheduleDeliveries(notification db.QueuedBuildNotification) error {
+	build, found, err := n.buildFactory.Build(notification.BuildID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return n.notificationFactory.ScheduleDeliveries(notification.BuildID, nil)
+	}
+
+	pipeline, found, err := build.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found || pipeline.Notifications() == nil {
+		return n.notificationFactory.ScheduleDeliveries(build.ID(), nil)
+	}
+
+	sinks := pipeline.Notifications().Rules.Sinks(
+		build.JobName(),
+		atc.BuildStatus(notification.PreviousStatus),
+		atc.BuildStatus(build.Status()),
+	)
+
+	return n.notificationFactory.ScheduleDeliveries(build.ID(), sinks)
+}
+
+// deliver attempts a single delivery. Failing to send the notification is
+// recorded on the delivery; only failing to record the outcome is returned.
+func (n *notifier) deliver(ctx context.Context, logger lager.Logger, delivery db.NotificationDelivery) error {
+	logger = logger.Session("deliver", lager.Data{
+		"delivery": delivery.ID(),
+		"build":    delivery.BuildID(),
+		"sink":     delivery.Sink(),
+	})
+
+	err := n.send(ctx, logger, delivery)
+	if err == nil {
+		return delivery.Delivered()
+	}
+
+	logger.Info("failed-to-deliver", lager.Data{"error": err.Error(), "attempt": delivery.Attempts() + 1})
+
+	if err == ErrSinkNotConfigured || delivery.Attempts()+1 >= n.maxAttempts {
+		return delivery.GiveUp(err)
+	}
+
+	return delivery.Retry(err, n.retryInterval*time.Duration(1<<uint(delivery.Attempts())))
+}
+
+func (n *notifier) send(ctx context.Context, logger lager.Logger, delivery db.NotificationDelivery) error {
+	build, found, err := n.buildFactory.Build(delivery.BuildID())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ErrSinkNotConfigured
+	}
+
+	pipeline, found, err := build.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found || pipeline.Notifications() == nil {
+		return ErrSinkNotConfigured
+	}
+
+	sinkConfig, found := pipeline.Notifications().Sinks.Lookup(delivery.Sink())
+	if !found {
+		return ErrSinkNotConfigured
+	}
+
+	variables, err := pipeline.Variables(logger, n.secrets, n.varSourcePool)
+	if err != nil {
+		return fmt.Errorf("failed to create variables: %w", err)
+	}
+
+	source, err := creds.NewSource(variables, sinkConfig.Source).Evaluate()
+	if err != nil {
+		return fmt.Errorf("failed to evaluate sink source: %w", err)
+	}
+
+	sink, err := n.sinkFactory.NewSink(sinkConfig.Type, source)
+	if err != nil {
+		return err
+	}
+
+	return sink.Send(ctx, n.message(delivery))
+}
+
+func (n *notifier) message(delivery db.NotificationDelivery) Message {
+	buildURL := fmt.Sprintf(
+		"%s/teams/%s/pipelines/%s/jobs/%s/builds/%s",
+		n.externalURL,
+		url.PathEscape(delivery.TeamName()),
+		url.PathEscape(delivery.PipelineName()),
+		url.PathEscape(delivery.JobName()),
+		url.PathEscape(delivery.BuildName()),
+	)
+
+	pipelineRef := atc.PipelineRef{
+		Name:         delivery.PipelineName(),
+		InstanceVars: delivery.PipelineInstanceVars(),
+	}
+
+	if params := pipelineRef.QueryParams(); params != nil {
+		buildURL += "?" + params.Encode()
+	}
+
+	return Message{
+		TeamName:             delivery.TeamName(),
+		PipelineName:         delivery.PipelineName(),
+		PipelineInstanceVars: delivery.PipelineInstanceVars(),
+		JobName:              delivery.JobName(),
+		BuildID:              delivery.BuildID(),
+		BuildName:            delivery.BuildName(),
+		Status:               atc.BuildStatus(delivery.BuildStatus()),
+		URL:                  buildURL,
+	}
+}
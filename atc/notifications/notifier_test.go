@@ -0,0 +1,256 @@
+package notifications_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/notifications"
+	"github.com/concourse/concourse/atc/notifications/notificationsfakes"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		fakeNotificationFactory *dbfakes.FakeNotificationFactory
+		fakeBuildFactory        *dbfakes.FakeBuildFactory
+		fakeSinkFactory         *notificationsfakes.FakeSinkFactory
+		fakeSink                *notificationsfakes.FakeSink
+		fakeBuild               *dbfakes.FakeBuild
+		fakePipeline            *dbfakes.FakePipeline
+
+		notifier interface{ Run(context.Context) error }
+		runErr   error
+	)
+
+	BeforeEach(func() {
+		fakeNotificationFactory = new(dbfakes.FakeNotificationFactory)
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeSinkFactory = new(notificationsfakes.FakeSinkFactory)
+		fakeSink = new(notificationsfakes.FakeSink)
+		fakeSinkFactory.NewSinkReturns(fakeSink, nil)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.NotificationsReturns(&atc.NotificationsConfig{
+			Sinks: atc.NotificationSinkConfigs{
+				{
+					Name:   "some-webhook",
+					Type:   "webhook",
+					Source: atc.Source{"url": "((webhook_url))"},
+				},
+				{
+					Name:   "some-slack",
+					Type:   "slack",
+					Source: atc.Source{"url": "https://hooks.example.com"},
+				},
+			},
+			Rules: atc.NotificationRuleConfigs{
+				{
+					Jobs:  []string{"deploy-*"},
+					To:    []atc.BuildStatus{atc.StatusFailed},
+					Sinks: []string{"some-webhook"},
+				},
+				{
+					From:  []atc.BuildStatus{atc.StatusFailed},
+					To:    []atc.BuildStatus{atc.StatusSucceeded},
+					Sinks: []string{"some-slack", "some-webhook"},
+				},
+			},
+		})
+		fakePipeline.VariablesReturns(vars.StaticVariables{"webhook_url": "https://example.com/hook"}, nil)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.JobNameReturns("deploy-prod")
+		fakeBuild.PipelineReturns(fakePipeline, true, nil)
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+	})
+
+	JustBeforeEach(func() {
+		notifier = notifications.NewNotifier(
+			fakeNotificationFactory,
+			fakeBuildFactory,
+			fakeSinkFactory,
+			nil,
+			nil,
+			"https://ci.example.com",
+			3,
+			time.Minute,
+		)
+
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = notifier.Run(ctx)
+	})
+
+	Describe("scheduling deliveries for finished builds", func() {
+		BeforeEach(func() {
+			fakeNotificationFactory.QueuedBuildsReturns([]db.QueuedBuildNotification{
+				{BuildID: 42, PreviousStatus: db.BuildStatusFailed},
+			}, nil)
+		})
+
+		Context("when the build matches a rule on its status", func() {
+			BeforeEach(func() {
+				fakeBuild.StatusReturns(db.BuildStatusFailed)
+			})
+
+			It("schedules a delivery to the rule's sinks", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeBuildFactory.BuildArgsForCall(0)).To(Equal(42))
+
+				Expect(fakeNotificationFactory.ScheduleDeliveriesCallCount()).To(Equal(1))
+				buildID, sinks := fakeNotificationFactory.ScheduleDeliveriesArgsForCall(0)
+				Expect(buildID).To(Equal(42))
+				Expect(sinks).To(Equal([]string{"some-webhook"}))
+			})
+		})
+
+		Context("when the build matches a rule on its transition", func() {
+			BeforeEach(func() {
+				fakeBuild.StatusReturns(db.BuildStatusSucceeded)
+			})
+
+			It("schedules a delivery to each sink once", func() {
+				_, sinks := fakeNotificationFactory.ScheduleDeliveriesArgsForCall(0)
+				Expect(sinks).To(Equal([]string{"some-slack", "some-webhook"}))
+			})
+		})
+
+		Context("when the build matches no rules", func() {
+			BeforeEach(func() {
+				fakeBuild.StatusReturns(db.BuildStatusErrored)
+			})
+
+			It("dequeues the build without scheduling any deliveries", func() {
+				Expect(fakeNotificationFactory.ScheduleDeliveriesCallCount()).To(Equal(1))
+				_, sinks := fakeNotificationFactory.ScheduleDeliveriesArgsForCall(0)
+				Expect(sinks).To(BeEmpty())
+			})
+		})
+
+		Context("when the build no longer exists", func() {
+			BeforeEach(func() {
+				fakeBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("dequeues the build", func() {
+				Expect(fakeNotificationFactory.ScheduleDeliveriesCallCount()).To(Equal(1))
+				buildID, sinks := fakeNotificationFactory.ScheduleDeliveriesArgsForCall(0)
+				Expect(buildID).To(Equal(42))
+				Expect(sinks).To(BeEmpty())
+			})
+		})
+
+		Context("when scheduling fails", func() {
+			BeforeEach(func() {
+				fakeNotificationFactory.ScheduleDeliveriesReturns(errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("nope"))
+			})
+		})
+	})
+
+	Describe("delivering notifications", func() {
+		var fakeDelivery *dbfakes.FakeNotificationDelivery
+
+		BeforeEach(func() {
+			fakeDelivery = new(dbfakes.FakeNotificationDelivery)
+			fakeDelivery.IDReturns(1)
+			fakeDelivery.BuildIDReturns(42)
+			fakeDelivery.SinkReturns("some-webhook")
+			fakeDelivery.TeamNameReturns("some-team")
+			fakeDelivery.PipelineNameReturns("some-pipeline")
+			fakeDelivery.PipelineInstanceVarsReturns(atc.InstanceVars{"branch": "main"})
+			fakeDelivery.JobNameReturns("deploy-prod")
+			fakeDelivery.BuildNameReturns("7")
+			fakeDelivery.BuildStatusReturns(db.BuildStatusFailed)
+
+			fakeNotificationFactory.DueDeliveriesReturns([]db.NotificationDelivery{fakeDelivery}, nil)
+		})
+
+		It("sends the message to the sink with its source interpolated", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+
+			Expect(fakeSinkFactory.NewSinkCallCount()).To(Equal(1))
+			sinkType, source := fakeSinkFactory.NewSinkArgsForCall(0)
+			Expect(sinkType).To(Equal("webhook"))
+			Expect(source).To(Equal(atc.Source{"url": "https://example.com/hook"}))
+
+			Expect(fakeSink.SendCallCount()).To(Equal(1))
+			_, message := fakeSink.SendArgsForCall(0)
+			Expect(message).To(Equal(notifications.Message{
+				TeamName:             "some-team",
+				PipelineName:         "some-pipeline",
+				PipelineInstanceVars: atc.InstanceVars{"branch": "main"},
+				JobName:              "deploy-prod",
+				BuildID:              42,
+				BuildName:            "7",
+				Status:               atc.StatusFailed,
+				URL:                  `https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/deploy-prod/builds/7?vars.branch=%22main%22`,
+			}))
+
+			Expect(fakeDelivery.DeliveredCallCount()).To(Equal(1))
+		})
+
+		Context("when sending fails", func() {
+			BeforeEach(func() {
+				fakeSink.SendReturns(errors.New("connection refused"))
+				fakeDelivery.AttemptsReturns(1)
+			})
+
+			It("schedules a retry with backoff", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeDelivery.DeliveredCallCount()).To(BeZero())
+
+				Expect(fakeDelivery.RetryCallCount()).To(Equal(1))
+				cause, after := fakeDelivery.RetryArgsForCall(0)
+				Expect(cause).To(MatchError("connection refused"))
+				Expect(after).To(Equal(2 * time.Minute))
+			})
+
+			Context("on the last attempt", func() {
+				BeforeEach(func() {
+					fakeDelivery.AttemptsReturns(2)
+				})
+
+				It("gives up", func() {
+					Expect(fakeDelivery.RetryCallCount()).To(BeZero())
+					Expect(fakeDelivery.GiveUpCallCount()).To(Equal(1))
+					Expect(fakeDelivery.GiveUpArgsForCall(0)).To(MatchError("connection refused"))
+				})
+			})
+		})
+
+		Context("when the sink has been removed from the pipeline", func() {
+			BeforeEach(func() {
+				fakeDelivery.SinkReturns("some-removed-sink")
+			})
+
+			It("gives up without sending", func() {
+				Expect(fakeSink.SendCallCount()).To(BeZero())
+				Expect(fakeDelivery.GiveUpCallCount()).To(Equal(1))
+				Expect(fakeDelivery.GiveUpArgsForCall(0)).To(Equal(notifications.ErrSinkNotConfigured))
+			})
+		})
+
+		Context("when recording the outcome fails", func() {
+			BeforeEach(func() {
+				fakeDelivery.DeliveredReturns(errors.New("db down"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("db down"))
+			})
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// Message describes a finished build for delivery to a sink.
+type Message struct {
+	TeamName             string           `json:"team_name"`
+	PipelineName         string           `json:"pipeline_name"`
+	PipelineInstanceVars atc.InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string           `json:"job_name"`
+	BuildID              int              `json:"build_id"`
+	BuildName            string           `json:"build_name"`
+	Status               atc.BuildStatus  `json:"status"`
+	URL                  string           `json:"url"`
+}
+
+// Summary renders the message as a single line of text.
+func (m Message) Summary() string {
+	return fmt.Sprintf("%s/%s/%s #%s %s", m.TeamName, m.PipelineName, m.JobName, m.BuildName, m.Status)
+}
+
+//go:generate counterfeiter . Sink
+
+type Sink interface {
+	Send(context.Context, Message) error
+}
+
+//go:generate counterfeiter . SinkFactory
+
+type SinkFactory interface {
+	NewSink(sinkType string, source atc.Source) (Sink, error)
+}
+
+type sinkFactory struct {
+	httpClient  *http.Client
+	smtpTimeout time.Duration
+}
+
+// NewSinkFactory returns a SinkFactory whose webhook and Slack sinks deliver
+// through httpClient, and whose SMTP sinks give up on a server after
+// smtpTimeout.
+func NewSinkFactory(httpClient *http.Client, smtpTimeout time.Duration) SinkFactory {
+	return sinkFactory{
+		httpClient:  httpClient,
+		smtpTimeout: smtpTimeout,
+	}
+}
+
+func (factory sinkFactory) NewSink(sinkType string, source atc.Source) (Sink, error) {
+	switch sinkType {
+	case atc.NotificationSinkTypeWebhook:
+		var config WebhookConfig
+		err := decodeSource(source, &config)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewWebhookSink(factory.httpClient, config), nil
+
+	case atc.NotificationSinkTypeSlack:
+		var config SlackConfig
+		err := decodeSource(source, &config)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewSlackSink(factory.httpClient, config), nil
+
+	case atc.NotificationSinkTypeSMTP:
+		var config SMTPConfig
+		err := decodeSource(source, &config)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewSMTPSink(config, factory.smtpTimeout), nil
+	}
+
+	return nil, fmt.Errorf("unknown notification sink type '%s'", sinkType)
+}
+
+func decodeSource(source atc.Source, config interface{}) error {
+	payload, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(payload, config)
+	if err != nil {
+		return fmt.Errorf("invalid sink source: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package notifications_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/notifications"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Sinks", func() {
+	var (
+		server      *Server
+		sinkFactory notifications.SinkFactory
+		message     notifications.Message
+	)
+
+	BeforeEach(func() {
+		server = NewServer()
+		sinkFactory = notifications.NewSinkFactory(http.DefaultClient, time.Second)
+
+		message = notifications.Message{
+			TeamName:     "some-team",
+			PipelineName: "some-pipeline",
+			JobName:      "some-job",
+			BuildID:      42,
+			BuildName:    "7",
+			Status:       atc.StatusFailed,
+			URL:          "https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/7",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("webhook", func() {
+		var sink notifications.Sink
+
+		BeforeEach(func() {
+			var err error
+			sink, err = sinkFactory.NewSink("webhook", atc.Source{
+				"url":     server.URL() + "/hook",
+				"headers": map[string]interface{}{"Authorization": "Bearer some-token"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("posts the message as JSON", func() {
+			server.AppendHandlers(CombineHandlers(
+				VerifyRequest("POST", "/hook"),
+				VerifyHeaderKV("Authorization", "Bearer some-token"),
+				VerifyJSON(`{
+					"team_name": "some-team",
+					"pipeline_name": "some-pipeline",
+					"job_name": "some-job",
+					"build_id": 42,
+					"build_name": "7",
+					"status": "failed",
+					"url": "https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/7"
+				}`),
+				RespondWith(http.StatusNoContent, nil),
+			))
+
+			err := sink.Send(context.TODO(), message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("returns an error when the response is not successful", func() {
+			server.AppendHandlers(RespondWith(http.StatusInternalServerError, "oh no"))
+
+			err := sink.Send(context.TODO(), message)
+			Expect(err).To(MatchError("unexpected response 500: oh no"))
+		})
+	})
+
+	Describe("slack", func() {
+		It("posts the summary to the incoming webhook", func() {
+			sink, err := sinkFactory.NewSink("slack", atc.Source{
+				"url":     server.URL() + "/services/some-hook",
+				"channel": "#ci",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			server.AppendHandlers(CombineHandlers(
+				VerifyRequest("POST", "/services/some-hook"),
+				VerifyJSON(`{
+					"text": "<https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/7|some-team/some-pipeline/some-job #7 failed>",
+					"channel": "#ci"
+				}`),
+				RespondWith(http.StatusOK, "ok"),
+			))
+
+			err = sink.Send(context.TODO(), message)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("smtp", func() {
+		var (
+			listener net.Listener
+			accepted chan net.Conn
+		)
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+
+			accepted = make(chan net.Conn, 10)
+
+			// accept connections but never greet the client
+			go func() {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						close(accepted)
+						return
+					}
+
+					accepted <- conn
+				}
+			}()
+		})
+
+		AfterEach(func() {
+			listener.Close()
+
+			for conn := range accepted {
+				conn.Close()
+			}
+		})
+
+		It("gives up on a server which never answers", func() {
+			host, port, err := net.SplitHostPort(listener.Addr().String())
+			Expect(err).ToNot(HaveOccurred())
+
+			sink := notifications.NewSMTPSink(notifications.SMTPConfig{
+				Host: host,
+				Port: mustAtoi(port),
+				From: "ci@example.com",
+				To:   []string{"team@example.com"},
+			}, 100*time.Millisecond)
+
+			errs := make(chan error, 1)
+			go func() {
+				errs <- sink.Send(context.TODO(), message)
+			}()
+
+			var sendErr error
+			Eventually(errs, 5*time.Second).Should(Receive(&sendErr))
+			Expect(sendErr).To(MatchError(ContainSubstring("deadline exceeded")))
+		})
+
+		It("speaks TLS from the start when implicit TLS is configured", func() {
+			host, port, err := net.SplitHostPort(listener.Addr().String())
+			Expect(err).ToNot(HaveOccurred())
+
+			sink := notifications.NewSMTPSink(notifications.SMTPConfig{
+				Host: host,
+				Port: mustAtoi(port),
+				TLS:  true,
+				From: "ci@example.com",
+				To:   []string{"team@example.com"},
+			}, time.Second)
+
+			go sink.Send(context.TODO(), message)
+
+			var conn net.Conn
+			Eventually(accepted, 5*time.Second).Should(Receive(&conn))
+			defer conn.Close()
+
+			// a plain SMTP client waits for the server's greeting, whereas a
+			// TLS client opens with a handshake record
+			record := make([]byte, 1)
+			_, err = io.ReadFull(conn, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record[0]).To(Equal(byte(0x16)))
+		})
+
+		It("gives up when the context is cancelled", func() {
+			host, port, err := net.SplitHostPort(listener.Addr().String())
+			Expect(err).ToNot(HaveOccurred())
+
+			sink := notifications.NewSMTPSink(notifications.SMTPConfig{
+				Host: host,
+				Port: mustAtoi(port),
+				From: "ci@example.com",
+				To:   []string{"team@example.com"},
+			}, time.Minute)
+
+			ctx, cancel := context.WithCancel(context.Background())
+
+			errs := make(chan error, 1)
+			go func() {
+				errs <- sink.Send(ctx, message)
+			}()
+
+			Consistently(errs, 100*time.Millisecond).ShouldNot(Receive())
+			cancel()
+
+			var sendErr error
+			Eventually(errs, 5*time.Second).Should(Receive(&sendErr))
+			Expect(sendErr).To(MatchError(ContainSubstring("context canceled")))
+		})
+	})
+
+	It("rejects unknown sink types", func() {
+		_, err := sinkFactory.NewSink("pigeon", atc.Source{})
+		Expect(err).To(MatchError("unknown notification sink type 'pigeon'"))
+	})
+
+	It("rejects sources that do not match the sink's config", func() {
+		_, err := sinkFactory.NewSink("smtp", atc.Source{"to": "not-a-list"})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func mustAtoi(s string) int {
+	i, err := strconv.Atoi(s)
+	Expect(err).ToNot(HaveOccurred())
+	return i
+}
@@ -0,0 +1,50 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type SlackConfig struct {
+	URL      string `json:"url"`
+	Channel  string `json:"channel,omitempty"`
+	Username string `json:"username,omitempty"`
+	IconURL  string `json:"icon_url,omitempty"`
+}
+
+type slackSink struct {
+	httpClient *http.Client
+	config     SlackConfig
+}
+
+// NewSlackSink returns a Sink which posts each message to a Slack-compatible
+// incoming webhook.
+func NewSlackSink(httpClient *http.Client, config SlackConfig) Sink {
+	return slackSink{
+		httpClient: httpClient,
+		config:     config,
+	}
+}
+
+type slackPayload struct {
+	Text     string `json:"text"`
+	Channel  string `json:"channel,omitempty"`
+	Username string `json:"username,omitempty"`
+	IconURL  string `json:"icon_url,omitempty"`
+}
+
+func (sink slackSink) Send(ctx context.Context, message Message) error {
+	payload, err := json.Marshal(slackPayload{
+		Text:     fmt.Sprintf("<%s|%s>", message.URL, message.Summary()),
+		Channel:  sink.config.Channel,
+		Username: sink.config.Username,
+		IconURL:  sink.config.IconURL,
+	})
+	if err != nil {
+		return err
+	}
+
+	return postJSON(ctx, sink.httpClient, sink.config.URL, nil, payload)
+}
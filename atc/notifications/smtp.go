@@ -0,0 +1,171 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host string `json:"host"`
+	Port int    `json:"port,omitempty"`
+
+	// TLS makes the connection use TLS from the start (implicit TLS), rather
+	// than upgrading it with STARTTLS. It is implied by port 465.
+	TLS bool `json:"tls,omitempty"`
+
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+}
+
+type smtpSink struct {
+	config  SMTPConfig
+	timeout time.Duration
+}
+
+// NewSMTPSink returns a Sink which emails each message to a list of
+// recipients. A delivery which has not finished within timeout, or by the
+// deadline of the context it is sent with, is abandoned.
+func NewSMTPSink(config SMTPConfig, timeout time.Duration) Sink {
+	return smtpSink{
+		config:  config,
+		timeout: timeout,
+	}
+}
+
+func (sink smtpSink) Send(ctx context.Context, message Message) error {
+	if sink.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, sink.timeout)
+		defer cancel()
+	}
+
+	port := sink.config.Port
+	if port == 0 {
+		port = 587
+	}
+
+	addr := net.JoinHostPort(sink.config.Host, strconv.Itoa(port))
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the deadline covers timeouts; closing the connection also unblocks the
+	// exchange if the context is cancelled first
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	var smtpConn net.Conn = conn
+	if sink.config.TLS || port == 465 {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: sink.config.Host})
+
+		err = tlsConn.Handshake()
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("smtp delivery to %s: %w", addr, ctx.Err())
+			}
+
+			return fmt.Errorf("smtp delivery to %s: tls handshake: %w", addr, err)
+		}
+
+		smtpConn = tlsConn
+	}
+
+	err = sink.deliver(smtpConn, message)
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("smtp delivery to %s: %w", addr, ctx.Err())
+	}
+
+	return err
+}
+
+func (sink smtpSink) deliver(conn net.Conn, message Message) error {
+	client, err := smtp.NewClient(conn, sink.config.Host)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	_, implicitTLS := conn.(*tls.Conn)
+	if ok, _ := client.Extension("STARTTLS"); ok && !implicitTLS {
+		err = client.StartTLS(&tls.Config{ServerName: sink.config.Host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if sink.config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); ok {
+			auth := smtp.PlainAuth("", sink.config.Username, sink.config.Password, sink.config.Host)
+
+			err = client.Auth(auth)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = client.Mail(sink.config.From)
+	if err != nil {
+		return err
+	}
+
+	for _, to := range sink.config.To {
+		err = client.Rcpt(to)
+		if err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "From: %s\r\n", sink.config.From)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(sink.config.To, ", "))
+	fmt.Fprintf(&body, "Subject: %s\r\n", message.Summary())
+	fmt.Fprintf(&body, "Content-Type: text/plain; charset=utf-8\r\n")
+	fmt.Fprintf(&body, "\r\n")
+	fmt.Fprintf(&body, "%s\r\n\r\n%s\r\n", message.Summary(), message.URL)
+
+	_, err = w.Write(body.Bytes())
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
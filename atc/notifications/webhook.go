@@ -0,0 +1,64 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+type WebhookConfig struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type webhookSink struct {
+	httpClient *http.Client
+	config     WebhookConfig
+}
+
+// NewWebhookSink returns a Sink which POSTs each message as JSON to a URL.
+func NewWebhookSink(httpClient *http.Client, config WebhookConfig) Sink {
+	return webhookSink{
+		httpClient: httpClient,
+		config:     config,
+	}
+}
+
+func (sink webhookSink) Send(ctx context.Context, message Message) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return postJSON(ctx, sink.httpClient, sink.config.URL, sink.config.Headers, payload)
+}
+
+func postJSON(ctx context.Context, httpClient *http.Client, url string, headers map[string]string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected response %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	return nil
+}
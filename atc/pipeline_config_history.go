@@ -0,0 +1,13 @@
+package atc
+
+// PipelineConfigRevision is a config which was saved for a pipeline. Revisions
+// are numbered from 1 for each pipeline.
+type PipelineConfigRevision struct {
+	Revision int    `json:"revision"`
+	Author   string `json:"author,omitempty"`
+	Message  string `json:"message,omitempty"`
+	SavedAt  int64  `json:"saved_at"`
+
+	// Config is only included when a single revision is requested.
+	Config *Config `json:"config,omitempty"`
+}
@@ -194,6 +194,10 @@ type GetPlan struct {
 	// Worker tags to influence placement of the container.
 	Tags Tags `json:"tags,omitempty"`
 
+	// Expressions matched against worker labels to influence placement of the
+	// container.
+	NodeSelector NodeSelector `json:"node_selector,omitempty"`
+
 	// A timeout to enforce on the resource `get` process. Note that fetching the
 	// resource's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
@@ -220,6 +224,10 @@ type PutPlan struct {
 	// Worker tags to influence placement of the container.
 	Tags Tags `json:"tags,omitempty"`
 
+	// Expressions matched against worker labels to influence placement of the
+	// container.
+	NodeSelector NodeSelector `json:"node_selector,omitempty"`
+
 	// A timeout to enforce on the resource `put` process. Note that fetching the
 	// resource's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
@@ -254,8 +262,16 @@ type CheckPlan struct {
 	// the resource's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
 
+	// Whether the check was triggered through the resource's webhook. Any new
+	// versions it finds are recorded as such.
+	Webhook bool `json:"webhook,omitempty"`
+
 	// Worker tags to influence placement of the container.
 	Tags Tags `json:"tags,omitempty"`
+
+	// Expressions matched against worker labels to influence placement of the
+	// container.
+	NodeSelector NodeSelector `json:"node_selector,omitempty"`
 }
 
 type TaskPlan struct {
@@ -270,6 +286,10 @@ type TaskPlan struct {
 	// Worker tags to influence placement of the container.
 	Tags Tags `json:"tags,omitempty"`
 
+	// Expressions matched against worker labels to influence placement of the
+	// container.
+	NodeSelector NodeSelector `json:"node_selector,omitempty"`
+
 	// The task config to execute - either fetched from a path at runtime, or
 	// provided statically.
 	ConfigPath string      `json:"config_path,omitempty"`
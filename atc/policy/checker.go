@@ -8,7 +8,12 @@ import (
 	"github.com/jessevdk/go-flags"
 )
 
-const ActionUseImage = "UseImage"
+const (
+	ActionUseImage    = "UseImage"
+	ActionRunTask     = "RunTask"
+	ActionPutResource = "PutResource"
+	ActionSetPipeline = "SetPipeline"
+)
 
 type PolicyCheckNotPass struct {
 	Reasons []string
@@ -22,6 +27,7 @@ type Filter struct {
 	HttpMethods   []string `long:"policy-check-filter-http-method" description:"API http method to go through policy check"`
 	Actions       []string `long:"policy-check-filter-action" description:"Actions in the list will go through policy check"`
 	ActionsToSkip []string `long:"policy-check-filter-action-skip" description:"Actions the list will not go through policy check"`
+	ActionsToWarn []string `long:"policy-check-warn-action" description:"Actions in the list will go through policy check, but violations are only warned about rather than denied"`
 }
 
 type PolicyCheckInput struct {
@@ -40,6 +46,10 @@ type PolicyCheckInput struct {
 type PolicyCheckOutput struct {
 	Allowed bool
 	Reasons []string
+
+	// Warned is set when the check did not pass, but the action is in warn
+	// mode and so was allowed anyway.
+	Warned bool
 }
 
 // FailedPolicyCheck creates a generic failed check
@@ -152,7 +162,7 @@ func (c *AgentChecker) ShouldCheckHttpMethod(method string) bool {
 }
 
 func (c *AgentChecker) ShouldCheckAction(action string) bool {
-	return inArray(c.filter.Actions, action)
+	return inArray(c.filter.Actions, action) || inArray(c.filter.ActionsToWarn, action)
 }
 
 func (c *AgentChecker) ShouldSkipAction(action string) bool {
@@ -174,7 +184,18 @@ func (c *AgentChecker) Check(input PolicyCheckInput) (PolicyCheckOutput, error)
 	input.Service = "concourse"
 	input.ClusterName = clusterName
 	input.ClusterVersion = clusterVersion
-	return c.agent.Check(input)
+
+	result, err := c.agent.Check(input)
+	if err != nil {
+		return result, err
+	}
+
+	if !result.Allowed && inArray(c.filter.ActionsToWarn, input.Action) {
+		result.Allowed = true
+		result.Warned = true
+	}
+
+	return result, nil
 }
 
 type NoopChecker struct{}
@@ -22,6 +22,7 @@ var _ = Describe("Policy checker", func() {
 			HttpMethods:   []string{"POST", "PUT"},
 			Actions:       []string{"do_1", "do_2"},
 			ActionsToSkip: []string{"skip_1", "skip_2"},
+			ActionsToWarn: []string{"warn_1"},
 		}
 
 		fakeAgent = new(policyfakes.FakeAgent)
@@ -60,6 +61,10 @@ var _ = Describe("Policy checker", func() {
 					Expect(checker.ShouldCheckAction("do_1")).To(BeTrue())
 					Expect(checker.ShouldCheckAction("do_2")).To(BeTrue())
 				})
+
+				It("should check actions in warn mode", func() {
+					Expect(checker.ShouldCheckAction("warn_1")).To(BeTrue())
+				})
 			})
 
 			Context("ShouldSkipAction", func() {
@@ -135,6 +140,39 @@ var _ = Describe("Policy checker", func() {
 					})
 				})
 
+				Context("when the action is in warn mode", func() {
+					BeforeEach(func() {
+						input.Action = "warn_1"
+					})
+
+					Context("when agent says not-pass", func() {
+						BeforeEach(func() {
+							fakeAgent.CheckReturns(policy.PolicyCheckOutput{
+								Allowed: false,
+								Reasons: []string{"a policy says you can't do that"},
+							}, nil)
+						})
+
+						It("should pass with a warning", func() {
+							Expect(checkErr).ToNot(HaveOccurred())
+							Expect(output.Allowed).To(BeTrue())
+							Expect(output.Warned).To(BeTrue())
+							Expect(output.Reasons).To(ConsistOf("a policy says you can't do that"))
+						})
+					})
+
+					Context("when agent says pass", func() {
+						BeforeEach(func() {
+							fakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+						})
+
+						It("should pass without a warning", func() {
+							Expect(output.Allowed).To(BeTrue())
+							Expect(output.Warned).To(BeFalse())
+						})
+					})
+				})
+
 				Context("when agent says error", func() {
 					BeforeEach(func() {
 						fakeAgent.CheckReturns(policy.FailedPolicyCheck(), errors.New("some-error"))
@@ -0,0 +1,90 @@
+package policy
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . DecisionLog
+
+// DecisionLog persists the outcome of policy checks.
+type DecisionLog interface {
+	SaveDecision(atc.PolicyDecision) error
+}
+
+// NewDecisionLoggingChecker records the checks performed by the checker which
+// were denied or warned about in the decision log, along with those which
+// were allowed if recordAllowed is set. Failing to record a decision does not
+// fail the check.
+func NewDecisionLoggingChecker(logger lager.Logger, checker Checker, log DecisionLog, recordAllowed bool) Checker {
+	return &decisionLoggingChecker{
+		Checker:       checker,
+		logger:        logger,
+		log:           log,
+		recordAllowed: recordAllowed,
+	}
+}
+
+type decisionLoggingChecker struct {
+	Checker
+
+	logger        lager.Logger
+	log           DecisionLog
+	recordAllowed bool
+}
+
+func (c *decisionLoggingChecker) Check(input PolicyCheckInput) (PolicyCheckOutput, error) {
+	result, err := c.Checker.Check(input)
+	if err != nil {
+		return result, err
+	}
+
+	if result.Allowed && !result.Warned && !c.recordAllowed {
+		return result, nil
+	}
+
+	hash, err := inputHash(input)
+	if err != nil {
+		c.logger.Error("failed-to-hash-policy-check-input", err)
+	}
+
+	decision := atc.PolicyDecision{
+		InputHash: hash,
+		Action:    input.Action,
+		Team:      input.Team,
+		Pipeline:  input.Pipeline,
+		Reasons:   result.Reasons,
+	}
+
+	switch {
+	case result.Warned:
+		decision.Result = atc.PolicyDecisionWarned
+	case result.Allowed:
+		decision.Result = atc.PolicyDecisionAllowed
+	default:
+		decision.Result = atc.PolicyDecisionDenied
+	}
+
+	err = c.log.SaveDecision(decision)
+	if err != nil {
+		c.logger.Error("failed-to-save-policy-decision", err, lager.Data{"action": input.Action})
+	}
+
+	return result, nil
+}
+
+// inputHash identifies the input, so that repeated decisions about the same
+// input can be told apart from decisions about different ones without
+// persisting the input, which may be large or sensitive.
+func inputHash(input PolicyCheckInput) (string, error) {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), nil
+}
@@ -0,0 +1,147 @@
+package policy_test
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Decision logging checker", func() {
+	var (
+		fakeChecker     *policyfakes.FakeChecker
+		fakeDecisionLog *policyfakes.FakeDecisionLog
+		recordAllowed   bool
+		checker         policy.Checker
+
+		input    policy.PolicyCheckInput
+		output   policy.PolicyCheckOutput
+		checkErr error
+	)
+
+	BeforeEach(func() {
+		fakeChecker = new(policyfakes.FakeChecker)
+		fakeDecisionLog = new(policyfakes.FakeDecisionLog)
+		recordAllowed = false
+
+		input = policy.PolicyCheckInput{
+			Action:   "RunTask",
+			Team:     "some-team",
+			Pipeline: "some-pipeline",
+			Data:     map[string]interface{}{"privileged": true},
+		}
+	})
+
+	JustBeforeEach(func() {
+		checker = policy.NewDecisionLoggingChecker(testLogger, fakeChecker, fakeDecisionLog, recordAllowed)
+		output, checkErr = checker.Check(input)
+	})
+
+	It("delegates the filters to the checker", func() {
+		fakeChecker.ShouldCheckActionReturns(true)
+		Expect(checker.ShouldCheckAction("RunTask")).To(BeTrue())
+		Expect(fakeChecker.ShouldCheckActionArgsForCall(0)).To(Equal("RunTask"))
+	})
+
+	Context("when the check passes", func() {
+		BeforeEach(func() {
+			fakeChecker.CheckReturns(policy.PassedPolicyCheck(), nil)
+		})
+
+		It("returns the result", func() {
+			Expect(checkErr).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+
+		It("does not record the decision", func() {
+			Expect(fakeDecisionLog.SaveDecisionCallCount()).To(BeZero())
+		})
+
+		Context("when allowed decisions are recorded", func() {
+			BeforeEach(func() {
+				recordAllowed = true
+			})
+
+			It("records the decision", func() {
+				Expect(fakeDecisionLog.SaveDecisionCallCount()).To(Equal(1))
+				decision := fakeDecisionLog.SaveDecisionArgsForCall(0)
+				Expect(decision.Action).To(Equal("RunTask"))
+				Expect(decision.Team).To(Equal("some-team"))
+				Expect(decision.Pipeline).To(Equal("some-pipeline"))
+				Expect(decision.Result).To(Equal(atc.PolicyDecisionAllowed))
+				Expect(decision.InputHash).To(HaveLen(64))
+			})
+
+			It("hashes equal inputs equally", func() {
+				_, err := checker.Check(input)
+				Expect(err).ToNot(HaveOccurred())
+
+				input.Data = map[string]interface{}{"privileged": false}
+				_, err = checker.Check(input)
+				Expect(err).ToNot(HaveOccurred())
+
+				first := fakeDecisionLog.SaveDecisionArgsForCall(0)
+				second := fakeDecisionLog.SaveDecisionArgsForCall(1)
+				third := fakeDecisionLog.SaveDecisionArgsForCall(2)
+				Expect(second.InputHash).To(Equal(first.InputHash))
+				Expect(third.InputHash).ToNot(Equal(first.InputHash))
+			})
+
+			Context("when recording the decision fails", func() {
+				BeforeEach(func() {
+					fakeDecisionLog.SaveDecisionReturns(errors.New("nope"))
+				})
+
+				It("still returns the result", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(output.Allowed).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Context("when the check does not pass", func() {
+		BeforeEach(func() {
+			fakeChecker.CheckReturns(policy.PolicyCheckOutput{
+				Allowed: false,
+				Reasons: []string{"no privileged tasks"},
+			}, nil)
+		})
+
+		It("records the decision as denied with the reasons", func() {
+			decision := fakeDecisionLog.SaveDecisionArgsForCall(0)
+			Expect(decision.Result).To(Equal(atc.PolicyDecisionDenied))
+			Expect(decision.Reasons).To(Equal([]string{"no privileged tasks"}))
+		})
+	})
+
+	Context("when the check is only warned about", func() {
+		BeforeEach(func() {
+			fakeChecker.CheckReturns(policy.PolicyCheckOutput{
+				Allowed: true,
+				Warned:  true,
+				Reasons: []string{"no privileged tasks"},
+			}, nil)
+		})
+
+		It("records the decision as warned", func() {
+			decision := fakeDecisionLog.SaveDecisionArgsForCall(0)
+			Expect(decision.Result).To(Equal(atc.PolicyDecisionWarned))
+		})
+	})
+
+	Context("when the check errors", func() {
+		BeforeEach(func() {
+			fakeChecker.CheckReturns(policy.PolicyCheckOutput{}, errors.New("nope"))
+		})
+
+		It("returns the error without recording a decision", func() {
+			Expect(checkErr).To(MatchError("nope"))
+			Expect(fakeDecisionLog.SaveDecisionCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package opa_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"time"
@@ -36,6 +37,44 @@ var _ = Describe("Policy checker", func() {
 		Expect(agent).ToNot(BeNil())
 	})
 
+	Context("when checking a step's action", func() {
+		var body []byte
+
+		BeforeEach(func() {
+			fakeOpa = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ = ioutil.ReadAll(r.Body)
+				fmt.Fprint(w, `{ "result": { "allowed": true }}`)
+			}))
+		})
+
+		It("sends the input unchanged", func() {
+			_, err := agent.Check(policy.PolicyCheckInput{
+				Action:   policy.ActionRunTask,
+				Team:     "some-team",
+				Pipeline: "some-pipeline",
+				Data: map[string]interface{}{
+					"privileged": true,
+					"limits":     map[string]interface{}{"cpu": 512},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{
+				"input": {
+					"service": "",
+					"cluster_name": "",
+					"cluster_version": "",
+					"action": "RunTask",
+					"team": "some-team",
+					"pipeline": "some-pipeline",
+					"data": {
+						"privileged": true,
+						"limits": {"cpu": 512}
+					}
+				}
+			}`))
+		})
+	})
+
 	Context("when OPA returns no result", func() {
 		BeforeEach(func() {
 			fakeOpa = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
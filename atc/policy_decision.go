@@ -0,0 +1,24 @@
+package atc
+
+// PolicyCheckWarningHeader carries the reasons of a policy check which was
+// violated by an API request, but only warned about rather than denied.
+const PolicyCheckWarningHeader = "X-Concourse-Policy-Check-Warning"
+
+const (
+	PolicyDecisionAllowed = "allowed"
+	PolicyDecisionDenied  = "denied"
+	PolicyDecisionWarned  = "warned"
+)
+
+// PolicyDecision is the outcome of a policy check, recorded so that rules can
+// be audited and rolled out in warn mode before they are enforced.
+type PolicyDecision struct {
+	ID        int      `json:"id"`
+	InputHash string   `json:"input_hash"`
+	Action    string   `json:"action"`
+	Team      string   `json:"team,omitempty"`
+	Pipeline  string   `json:"pipeline,omitempty"`
+	Result    string   `json:"result"`
+	Reasons   []string `json:"reasons,omitempty"`
+	Time      int64    `json:"time"`
+}
@@ -176,6 +176,7 @@ func (runner *Runner) openConn(dbName string) db.Conn {
 		runner.dataSourceName(dbName),
 		nil,
 		nil,
+		nil,
 		"postgresrunner",
 		nil,
 	)
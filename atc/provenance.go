@@ -0,0 +1,87 @@
+package atc
+
+import "time"
+
+const (
+	// ProvenanceStatementType is the in-toto statement type of build
+	// provenance.
+	ProvenanceStatementType = "https://in-toto.io/Statement/v0.1"
+
+	// ProvenancePredicateType is the SLSA provenance predicate type.
+	ProvenancePredicateType = "https://slsa.dev/provenance/v0.2"
+
+	// ProvenancePayloadType is the DSSE payload type of the signed statement.
+	ProvenancePayloadType = "application/vnd.in-toto+json"
+
+	// ProvenanceBuildType identifies the structure of Concourse builds
+	// recorded in provenance.
+	ProvenanceBuildType = "https://concourse-ci.org/provenance/build@v1"
+)
+
+// ProvenanceStatement is an in-toto statement attesting how the versions
+// produced by a build's put steps came to be.
+type ProvenanceStatement struct {
+	Type          string              `json:"_type"`
+	PredicateType string              `json:"predicateType"`
+	Subject       []ProvenanceSubject `json:"subject"`
+	Predicate     ProvenancePredicate `json:"predicate"`
+}
+
+type ProvenanceSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type ProvenancePredicate struct {
+	Builder     ProvenanceBuilder    `json:"builder"`
+	BuildType   string               `json:"buildType"`
+	Invocation  ProvenanceInvocation `json:"invocation"`
+	BuildConfig interface{}          `json:"buildConfig,omitempty"`
+	Metadata    ProvenanceMetadata   `json:"metadata"`
+	Materials   []ProvenanceMaterial `json:"materials,omitempty"`
+}
+
+type ProvenanceBuilder struct {
+	ID string `json:"id"`
+}
+
+type ProvenanceInvocation struct {
+	ConfigSource ProvenanceConfigSource `json:"configSource"`
+}
+
+type ProvenanceConfigSource struct {
+	URI        string `json:"uri"`
+	EntryPoint string `json:"entryPoint"`
+}
+
+type ProvenanceMetadata struct {
+	BuildInvocationID string                 `json:"buildInvocationId"`
+	BuildStartedOn    *time.Time             `json:"buildStartedOn,omitempty"`
+	BuildFinishedOn   *time.Time             `json:"buildFinishedOn,omitempty"`
+	Completeness      ProvenanceCompleteness `json:"completeness"`
+	Reproducible      bool                   `json:"reproducible"`
+}
+
+type ProvenanceCompleteness struct {
+	Parameters  bool `json:"parameters"`
+	Environment bool `json:"environment"`
+	Materials   bool `json:"materials"`
+}
+
+type ProvenanceMaterial struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest"`
+}
+
+// ProvenanceEnvelope is a DSSE envelope carrying a signed, base64 encoded
+// ProvenanceStatement.
+type ProvenanceEnvelope struct {
+	PayloadType string                `json:"payloadType"`
+	Payload     string                `json:"payload"`
+	Signatures  []ProvenanceSignature `json:"signatures"`
+}
+
+type ProvenanceSignature struct {
+	KeyID string `json:"keyid"`
+	Sig   string `json:"sig"`
+}
@@ -0,0 +1,225 @@
+package provenance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . Recorder
+
+// Recorder records signed provenance for the versions a build has put.
+type Recorder interface {
+	Record(lager.Logger, db.Build) error
+}
+
+type recorder struct {
+	externalURL string
+	signer      Signer
+}
+
+func NewRecorder(externalURL string, signer Signer) Recorder {
+	return &recorder{
+		externalURL: externalURL,
+		signer:      signer,
+	}
+}
+
+// Record generates, signs and saves the provenance of a finished build. Only
+// succeeded job builds which put any versions have provenance recorded.
+//
+// The image versions used by the build are only kept until the next build of
+// the job succeeds, so this should be called as soon as the build finishes.
+func (r *recorder) Record(logger lager.Logger, build db.Build) error {
+	if build.JobID() == 0 {
+		return nil
+	}
+
+	found, err := build.Reload()
+	if err != nil {
+		return err
+	}
+
+	if !found || build.Status() != db.BuildStatusSucceeded {
+		return nil
+	}
+
+	inputs, outputs, err := build.Resources()
+	if err != nil {
+		return err
+	}
+
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	images, err := build.ImageResourceVersions()
+	if err != nil {
+		return err
+	}
+
+	pipeline, found, err := build.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		logger.Info("pipeline-not-found")
+		return nil
+	}
+
+	statement, err := r.statement(build, pipeline, inputs, outputs, images)
+	if err != nil {
+		return err
+	}
+
+	envelope, err := Envelope(r.signer, statement)
+	if err != nil {
+		return err
+	}
+
+	err = build.SaveProvenance(envelope)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("recorded-provenance", lager.Data{"subjects": len(statement.Subject)})
+
+	return nil
+}
+
+func (r *recorder) statement(
+	build db.Build,
+	pipeline db.Pipeline,
+	inputs []db.BuildInput,
+	outputs []db.BuildOutput,
+	images []db.BuildImageResourceVersion,
+) (atc.ProvenanceStatement, error) {
+	pipelineURL := fmt.Sprintf(
+		"%s/teams/%s/pipelines/%s",
+		r.externalURL,
+		url.PathEscape(build.TeamName()),
+		url.PathEscape(build.PipelineName()),
+	)
+
+	var query string
+	if params := build.PipelineRef().QueryParams(); params != nil {
+		query = "?" + params.Encode()
+	}
+
+	buildURL := fmt.Sprintf(
+		"%s/jobs/%s/builds/%s%s",
+		pipelineURL,
+		url.PathEscape(build.JobName()),
+		url.PathEscape(build.Name()),
+		query,
+	)
+
+	statement := atc.ProvenanceStatement{
+		Type:          atc.ProvenanceStatementType,
+		PredicateType: atc.ProvenancePredicateType,
+		Predicate: atc.ProvenancePredicate{
+			Builder:   atc.ProvenanceBuilder{ID: r.externalURL},
+			BuildType: atc.ProvenanceBuildType,
+			Invocation: atc.ProvenanceInvocation{
+				ConfigSource: atc.ProvenanceConfigSource{
+					URI:        pipelineURL + query,
+					EntryPoint: build.JobName(),
+				},
+			},
+			Metadata: atc.ProvenanceMetadata{
+				BuildInvocationID: buildURL,
+				BuildStartedOn:    timePtr(build.StartTime()),
+				BuildFinishedOn:   timePtr(build.EndTime()),
+				// the materials are not complete: images are only identified
+				// by the type of resource they were fetched with, not the
+				// repository they came from
+				Completeness: atc.ProvenanceCompleteness{
+					Materials: false,
+				},
+			},
+		},
+	}
+
+	if build.PublicPlan() != nil {
+		statement.Predicate.BuildConfig = build.PublicPlan()
+	}
+
+	for _, output := range outputs {
+		statement.Subject = append(statement.Subject, atc.ProvenanceSubject{
+			Name:   output.Name,
+			Digest: versionDigest(output.Version),
+		})
+	}
+
+	for _, input := range inputs {
+		resource, found, err := pipeline.ResourceByID(input.ResourceID)
+		if err != nil {
+			return atc.ProvenanceStatement{}, err
+		}
+
+		name := input.Name
+		if found {
+			name = resource.Name()
+		}
+
+		statement.Predicate.Materials = append(statement.Predicate.Materials, atc.ProvenanceMaterial{
+			URI:    fmt.Sprintf("%s/resources/%s%s", pipelineURL, url.PathEscape(name), query),
+			Digest: versionDigest(input.Version),
+		})
+	}
+
+	// the image's source is not known at this point, only the type of the
+	// resource it was fetched with
+	for _, image := range images {
+		statement.Predicate.Materials = append(statement.Predicate.Materials, atc.ProvenanceMaterial{
+			URI:    image.Type,
+			Digest: versionDigest(image.Version),
+		})
+	}
+
+	return statement, nil
+}
+
+var gitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// versionDigest returns the digest identifying a resource version. Versions
+// which are already content addressed, i.e. image digests and git commits,
+// are used as-is; any other version is identified by the digest of the
+// version itself.
+func versionDigest(version atc.Version) map[string]string {
+	if digest, found := version["digest"]; found {
+		parts := strings.SplitN(digest, ":", 2)
+		if len(parts) == 2 {
+			return map[string]string{parts[0]: parts[1]}
+		}
+	}
+
+	if ref, found := version["ref"]; found && gitSHA.MatchString(ref) {
+		return map[string]string{"sha1": ref}
+	}
+
+	// json.Marshal sorts map keys, so the payload is stable
+	payload, _ := json.Marshal(version)
+	sum := sha256.Sum256(payload)
+
+	return map[string]string{"sha256": hex.EncodeToString(sum[:])}
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() || t.Unix() == 0 {
+		return nil
+	}
+
+	t = t.UTC()
+	return &t
+}
@@ -0,0 +1,198 @@
+package provenance_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/provenance"
+	"github.com/concourse/concourse/atc/provenance/provenancefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		fakeSigner   *provenancefakes.FakeSigner
+		fakeBuild    *dbfakes.FakeBuild
+		fakePipeline *dbfakes.FakePipeline
+		fakeResource *dbfakes.FakeResource
+
+		startTime time.Time
+		endTime   time.Time
+
+		recorder  provenance.Recorder
+		recordErr error
+	)
+
+	BeforeEach(func() {
+		fakeSigner = new(provenancefakes.FakeSigner)
+		fakeSigner.KeyIDReturns("some-key")
+		fakeSigner.SignReturns([]byte("some-sig"), nil)
+
+		startTime = time.Date(2021, 5, 8, 10, 0, 0, 0, time.UTC)
+		endTime = startTime.Add(time.Minute)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.JobIDReturns(1)
+		fakeBuild.JobNameReturns("some-job")
+		fakeBuild.NameReturns("42")
+		fakeBuild.TeamNameReturns("some-team")
+		fakeBuild.PipelineNameReturns("some-pipeline")
+		fakeBuild.PipelineRefReturns(atc.PipelineRef{Name: "some-pipeline"})
+		fakeBuild.StartTimeReturns(startTime)
+		fakeBuild.EndTimeReturns(endTime)
+		fakeBuild.ReloadReturns(true, nil)
+		fakeBuild.StatusReturns(db.BuildStatusSucceeded)
+
+		fakeBuild.ResourcesReturns(
+			[]db.BuildInput{
+				{Name: "some-input", ResourceID: 7, Version: atc.Version{"ref": "0123456789abcdef0123456789abcdef01234567"}},
+			},
+			[]db.BuildOutput{
+				{Name: "some-image", Version: atc.Version{"digest": "sha256:feedface"}},
+			},
+			nil,
+		)
+
+		fakeBuild.ImageResourceVersionsReturns([]db.BuildImageResourceVersion{
+			{Type: "registry-image", Version: atc.Version{"digest": "sha256:deadbeef"}},
+		}, nil)
+
+		fakeResource = new(dbfakes.FakeResource)
+		fakeResource.NameReturns("some-repo")
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.ResourceByIDReturns(fakeResource, true, nil)
+		fakeBuild.PipelineReturns(fakePipeline, true, nil)
+
+		recorder = provenance.NewRecorder("https://ci.example.com", fakeSigner)
+	})
+
+	JustBeforeEach(func() {
+		recordErr = recorder.Record(lagertest.NewTestLogger("test"), fakeBuild)
+	})
+
+	It("saves a signed statement of the build's outputs", func() {
+		Expect(recordErr).NotTo(HaveOccurred())
+		Expect(fakeBuild.SaveProvenanceCallCount()).To(Equal(1))
+
+		envelope := fakeBuild.SaveProvenanceArgsForCall(0)
+		Expect(envelope.PayloadType).To(Equal(atc.ProvenancePayloadType))
+		Expect(envelope.Signatures).To(Equal([]atc.ProvenanceSignature{
+			{KeyID: "some-key", Sig: base64.StdEncoding.EncodeToString([]byte("some-sig"))},
+		}))
+
+		payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeSigner.SignArgsForCall(0)).To(Equal(provenance.PAE(atc.ProvenancePayloadType, payload)))
+
+		var statement atc.ProvenanceStatement
+		Expect(json.Unmarshal(payload, &statement)).To(Succeed())
+
+		Expect(statement.Type).To(Equal(atc.ProvenanceStatementType))
+		Expect(statement.PredicateType).To(Equal(atc.ProvenancePredicateType))
+		Expect(statement.Subject).To(Equal([]atc.ProvenanceSubject{
+			{Name: "some-image", Digest: map[string]string{"sha256": "feedface"}},
+		}))
+
+		predicate := statement.Predicate
+		Expect(predicate.Builder.ID).To(Equal("https://ci.example.com"))
+		Expect(predicate.Invocation.ConfigSource).To(Equal(atc.ProvenanceConfigSource{
+			URI:        "https://ci.example.com/teams/some-team/pipelines/some-pipeline",
+			EntryPoint: "some-job",
+		}))
+		Expect(predicate.Metadata.BuildInvocationID).To(Equal("https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/42"))
+		Expect(*predicate.Metadata.BuildStartedOn).To(BeTemporally("==", startTime))
+		Expect(*predicate.Metadata.BuildFinishedOn).To(BeTemporally("==", endTime))
+		Expect(predicate.Metadata.Completeness.Materials).To(BeFalse())
+		Expect(predicate.Materials).To(Equal([]atc.ProvenanceMaterial{
+			{
+				URI:    "https://ci.example.com/teams/some-team/pipelines/some-pipeline/resources/some-repo",
+				Digest: map[string]string{"sha1": "0123456789abcdef0123456789abcdef01234567"},
+			},
+			{
+				URI:    "registry-image",
+				Digest: map[string]string{"sha256": "deadbeef"},
+			},
+		}))
+
+		Expect(fakePipeline.ResourceByIDArgsForCall(0)).To(Equal(7))
+	})
+
+	Context("when a version is not content addressed", func() {
+		BeforeEach(func() {
+			fakeBuild.ResourcesReturns(nil, []db.BuildOutput{
+				{Name: "some-release", Version: atc.Version{"version": "1.2.3"}},
+			}, nil)
+		})
+
+		It("uses the digest of the version itself", func() {
+			Expect(recordErr).NotTo(HaveOccurred())
+
+			payload, err := base64.StdEncoding.DecodeString(fakeBuild.SaveProvenanceArgsForCall(0).Payload)
+			Expect(err).NotTo(HaveOccurred())
+
+			var statement atc.ProvenanceStatement
+			Expect(json.Unmarshal(payload, &statement)).To(Succeed())
+			Expect(statement.Subject).To(Equal([]atc.ProvenanceSubject{
+				{
+					Name: "some-release",
+					// sha256 of {"version":"1.2.3"}
+					Digest: map[string]string{"sha256": "6752cea788aba4230b054a6bef39be6d6bc8f806f28763eff8ced8b28b917fa2"},
+				},
+			}))
+		})
+	})
+
+	Context("when the build did not put anything", func() {
+		BeforeEach(func() {
+			fakeBuild.ResourcesReturns(nil, nil, nil)
+		})
+
+		It("does not record provenance", func() {
+			Expect(recordErr).NotTo(HaveOccurred())
+			Expect(fakeBuild.SaveProvenanceCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the build is not a job build", func() {
+		BeforeEach(func() {
+			fakeBuild.JobIDReturns(0)
+		})
+
+		It("does not record provenance", func() {
+			Expect(recordErr).NotTo(HaveOccurred())
+			Expect(fakeBuild.ResourcesCallCount()).To(BeZero())
+			Expect(fakeBuild.SaveProvenanceCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the build did not succeed", func() {
+		BeforeEach(func() {
+			fakeBuild.StatusReturns(db.BuildStatusFailed)
+		})
+
+		It("does not record provenance", func() {
+			Expect(recordErr).NotTo(HaveOccurred())
+			Expect(fakeBuild.SaveProvenanceCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when signing fails", func() {
+		BeforeEach(func() {
+			fakeSigner.SignReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(recordErr).To(MatchError("nope"))
+			Expect(fakeBuild.SaveProvenanceCallCount()).To(BeZero())
+		})
+	})
+})
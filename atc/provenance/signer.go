@@ -0,0 +1,103 @@
+package provenance
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . Signer
+
+// Signer signs provenance statements. Only ed25519 keys read from a file are
+// supported for now.
+type Signer interface {
+	KeyID() string
+	Sign(payload []byte) ([]byte, error)
+}
+
+type ed25519Signer struct {
+	key   ed25519.PrivateKey
+	keyID string
+}
+
+func NewEd25519Signer(key ed25519.PrivateKey) Signer {
+	fingerprint := sha256.Sum256(key.Public().(ed25519.PublicKey))
+
+	return &ed25519Signer{
+		key:   key,
+		keyID: "SHA256:" + hex.EncodeToString(fingerprint[:]),
+	}
+}
+
+// LoadEd25519Signer reads a PEM encoded PKCS #8 ed25519 private key, as
+// generated by `openssl genpkey -algorithm ed25519`.
+func LoadEd25519Signer(path string) (Signer, error) {
+	keyPEM, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	ed25519Key, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T, must be ed25519", key)
+	}
+
+	return NewEd25519Signer(ed25519Key), nil
+}
+
+func (s *ed25519Signer) KeyID() string {
+	return s.keyID
+}
+
+func (s *ed25519Signer) Sign(payload []byte) ([]byte, error) {
+	return ed25519.Sign(s.key, payload), nil
+}
+
+// Envelope signs the statement, wrapping it in a DSSE envelope.
+func Envelope(signer Signer, statement atc.ProvenanceStatement) (atc.ProvenanceEnvelope, error) {
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, err
+	}
+
+	sig, err := signer.Sign(PAE(atc.ProvenancePayloadType, payload))
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, err
+	}
+
+	return atc.ProvenanceEnvelope{
+		PayloadType: atc.ProvenancePayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures: []atc.ProvenanceSignature{
+			{
+				KeyID: signer.KeyID(),
+				Sig:   base64.StdEncoding.EncodeToString(sig),
+			},
+		},
+	}, nil
+}
+
+// PAE is the DSSE pre-authentication encoding of the payload, which is what
+// gets signed rather than the payload itself.
+func PAE(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
@@ -0,0 +1,102 @@
+package provenance_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/provenance"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signer", func() {
+	var (
+		publicKey  ed25519.PublicKey
+		privateKey ed25519.PrivateKey
+	)
+
+	BeforeEach(func() {
+		var err error
+		publicKey, privateKey, err = ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("LoadEd25519Signer", func() {
+		var (
+			tmpdir  string
+			keyPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "provenance")
+			Expect(err).NotTo(HaveOccurred())
+
+			keyPath = filepath.Join(tmpdir, "key.pem")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		It("loads a PKCS #8 ed25519 key", func() {
+			der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			signer, err := provenance.LoadEd25519Signer(keyPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.KeyID()).To(Equal(provenance.NewEd25519Signer(privateKey).KeyID()))
+		})
+
+		It("errors when the file does not contain PEM data", func() {
+			err := ioutil.WriteFile(keyPath, []byte("nope"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = provenance.LoadEd25519Signer(keyPath)
+			Expect(err).To(MatchError("no PEM data found"))
+		})
+	})
+
+	Describe("Envelope", func() {
+		It("signs the pre-authentication encoding of the statement", func() {
+			signer := provenance.NewEd25519Signer(privateKey)
+
+			statement := atc.ProvenanceStatement{
+				Type:          atc.ProvenanceStatementType,
+				PredicateType: atc.ProvenancePredicateType,
+				Subject: []atc.ProvenanceSubject{
+					{Name: "some-output", Digest: map[string]string{"sha256": "abc"}},
+				},
+			}
+
+			envelope, err := provenance.Envelope(signer, statement)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(envelope.PayloadType).To(Equal(atc.ProvenancePayloadType))
+			Expect(envelope.Signatures).To(HaveLen(1))
+			Expect(envelope.Signatures[0].KeyID).To(Equal(signer.KeyID()))
+
+			payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+			Expect(err).NotTo(HaveOccurred())
+
+			var decoded atc.ProvenanceStatement
+			Expect(json.Unmarshal(payload, &decoded)).To(Succeed())
+			Expect(decoded).To(Equal(statement))
+
+			sig, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ed25519.Verify(publicKey, provenance.PAE(envelope.PayloadType, payload), sig)).To(BeTrue())
+		})
+	})
+})
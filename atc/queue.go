@@ -0,0 +1,77 @@
+package atc
+
+// JobPriority is the class a job's builds are queued with. When workers are
+// scarce, builds of higher priority jobs are scheduled and placed first.
+type JobPriority string
+
+const (
+	JobPriorityHigh   JobPriority = "high"
+	JobPriorityNormal JobPriority = "normal"
+	JobPriorityLow    JobPriority = "low"
+)
+
+var jobPriorityRanks = map[JobPriority]int{
+	JobPriorityHigh:   1,
+	JobPriorityNormal: 0,
+	JobPriorityLow:    -1,
+}
+
+func JobPriorityNames() []string {
+	return []string{string(JobPriorityHigh), string(JobPriorityNormal), string(JobPriorityLow)}
+}
+
+// Valid returns whether the priority is a known class. An empty priority is
+// valid and means normal.
+func (priority JobPriority) Valid() bool {
+	if priority == "" {
+		return true
+	}
+
+	_, found := jobPriorityRanks[priority]
+	return found
+}
+
+// Rank orders priorities; a higher rank is scheduled first.
+func (priority JobPriority) Rank() int {
+	return jobPriorityRanks[priority]
+}
+
+// JobPriorityForRank returns the class with the given rank, defaulting to
+// normal.
+func JobPriorityForRank(rank int) JobPriority {
+	for priority, r := range jobPriorityRanks {
+		if r == rank {
+			return priority
+		}
+	}
+
+	return JobPriorityNormal
+}
+
+// QueueReason explains why a build has not started running yet.
+type QueueReason string
+
+const (
+	QueueReasonPaused      QueueReason = "paused"
+	QueueReasonInputs      QueueReason = "inputs"
+	QueueReasonMaxInFlight QueueReason = "max-in-flight"
+	QueueReasonSerialGroup QueueReason = "serial-group"
+	QueueReasonNoWorker    QueueReason = "no-worker"
+	QueueReasonQuota       QueueReason = "quota"
+)
+
+// QueuedBuild is a build which is pending, or which has started but is
+// waiting for a worker.
+type QueuedBuild struct {
+	ID                   int          `json:"id"`
+	Name                 string       `json:"name"`
+	Status               BuildStatus  `json:"status"`
+	TeamName             string       `json:"team_name"`
+	PipelineID           int          `json:"pipeline_id,omitempty"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name,omitempty"`
+	Priority             JobPriority  `json:"priority"`
+	Reason               QueueReason  `json:"reason,omitempty"`
+	CreateTime           int64        `json:"create_time,omitempty"`
+}
@@ -0,0 +1,31 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobPriority", func() {
+	DescribeTable("Rank and JobPriorityForRank",
+		func(priority atc.JobPriority, rank int, roundTrip atc.JobPriority) {
+			Expect(priority.Valid()).To(BeTrue())
+			Expect(priority.Rank()).To(Equal(rank))
+			Expect(atc.JobPriorityForRank(rank)).To(Equal(roundTrip))
+		},
+		Entry("high", atc.JobPriorityHigh, 1, atc.JobPriorityHigh),
+		Entry("normal", atc.JobPriorityNormal, 0, atc.JobPriorityNormal),
+		Entry("low", atc.JobPriorityLow, -1, atc.JobPriorityLow),
+		Entry("unset", atc.JobPriority(""), 0, atc.JobPriorityNormal),
+	)
+
+	It("is not valid for an unknown class", func() {
+		Expect(atc.JobPriority("urgent").Valid()).To(BeFalse())
+	})
+
+	It("treats an unknown rank as normal", func() {
+		Expect(atc.JobPriorityForRank(5)).To(Equal(atc.JobPriorityNormal))
+	})
+})
@@ -8,8 +8,10 @@ const (
 
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
+	GetBuildProvenance  = "GetBuildProvenance"
 	CreateBuild         = "CreateBuild"
 	ListBuilds          = "ListBuilds"
+	ListBuildQueue      = "ListBuildQueue"
 	BuildEvents         = "BuildEvents"
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
@@ -68,6 +70,11 @@ const (
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
 
+	ListPipelineNotifications = "ListPipelineNotifications"
+
+	ListPipelineConfigHistory = "ListPipelineConfigHistory"
+	GetPipelineConfigRevision = "GetPipelineConfigRevision"
+
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
 	RetireWorker    = "RetireWorker"
@@ -92,13 +99,21 @@ const (
 	ListVolumes           = "ListVolumes"
 	ListDestroyingVolumes = "ListDestroyingVolumes"
 	ReportWorkerVolumes   = "ReportWorkerVolumes"
-
-	ListTeams      = "ListTeams"
-	GetTeam        = "GetTeam"
-	SetTeam        = "SetTeam"
-	RenameTeam     = "RenameTeam"
-	DestroyTeam    = "DestroyTeam"
-	ListTeamBuilds = "ListTeamBuilds"
+	ReportVolumeSizes     = "ReportVolumeSizes"
+
+	ListTeams       = "ListTeams"
+	GetTeam         = "GetTeam"
+	SetTeam         = "SetTeam"
+	RenameTeam      = "RenameTeam"
+	DestroyTeam     = "DestroyTeam"
+	ListTeamBuilds  = "ListTeamBuilds"
+	SearchBuildLogs = "SearchBuildLogs"
+
+	ListServiceAccounts       = "ListServiceAccounts"
+	CreateServiceAccount      = "CreateServiceAccount"
+	DeleteServiceAccount      = "DeleteServiceAccount"
+	CreateServiceAccountToken = "CreateServiceAccountToken"
+	RevokeServiceAccountToken = "RevokeServiceAccountToken"
 
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
@@ -110,11 +125,17 @@ const (
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
+
+	ListPolicyDecisions = "ListPolicyDecisions"
+	ListAuditEvents     = "ListAuditEvents"
+
+	GetEncryptionProgress = "GetEncryptionProgress"
 )
 
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+	SaveConfigMessage       = "message"
 )
 
 var Routes = rata.Routes([]rata.Route{
@@ -124,8 +145,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
 
 	{Path: "/api/v1/builds", Method: "GET", Name: ListBuilds},
+	{Path: "/api/v1/queue", Method: "GET", Name: ListBuildQueue},
 	{Path: "/api/v1/builds/:build_id", Method: "GET", Name: GetBuild},
 	{Path: "/api/v1/builds/:build_id/plan", Method: "GET", Name: GetBuildPlan},
+	{Path: "/api/v1/builds/:build_id/provenance", Method: "GET", Name: GetBuildProvenance},
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
@@ -163,6 +186,9 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/notifications", Method: "GET", Name: ListPipelineNotifications},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/history", Method: "GET", Name: ListPipelineConfigHistory},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/history/:revision", Method: "GET", Name: GetPipelineConfigRevision},
 
 	{Path: "/api/v1/resources", Method: "GET", Name: ListAllResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources", Method: "GET", Name: ListResources},
@@ -203,6 +229,11 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/user", Method: "GET", Name: GetUser},
 	{Path: "/api/v1/users", Method: "GET", Name: ListActiveUsersSince},
 
+	{Path: "/api/v1/policy/decisions", Method: "GET", Name: ListPolicyDecisions},
+	{Path: "/api/v1/audit", Method: "GET", Name: ListAuditEvents},
+
+	{Path: "/api/v1/encryption", Method: "GET", Name: GetEncryptionProgress},
+
 	{Path: "/api/v1/containers/destroying", Method: "GET", Name: ListDestroyingContainers},
 	{Path: "/api/v1/containers/report", Method: "PUT", Name: ReportWorkerContainers},
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
@@ -212,6 +243,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/volumes", Method: "GET", Name: ListVolumes},
 	{Path: "/api/v1/volumes/destroying", Method: "GET", Name: ListDestroyingVolumes},
 	{Path: "/api/v1/volumes/report", Method: "PUT", Name: ReportWorkerVolumes},
+	{Path: "/api/v1/volumes/sizes", Method: "PUT", Name: ReportVolumeSizes},
 
 	{Path: "/api/v1/teams", Method: "GET", Name: ListTeams},
 	{Path: "/api/v1/teams/:team_name", Method: "GET", Name: GetTeam},
@@ -219,6 +251,13 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/builds/search", Method: "GET", Name: SearchBuildLogs},
+
+	{Path: "/api/v1/teams/:team_name/service-accounts", Method: "GET", Name: ListServiceAccounts},
+	{Path: "/api/v1/teams/:team_name/service-accounts/:service_account_name", Method: "PUT", Name: CreateServiceAccount},
+	{Path: "/api/v1/teams/:team_name/service-accounts/:service_account_name", Method: "DELETE", Name: DeleteServiceAccount},
+	{Path: "/api/v1/teams/:team_name/service-accounts/:service_account_name/tokens", Method: "POST", Name: CreateServiceAccountToken},
+	{Path: "/api/v1/teams/:team_name/service-accounts/:service_account_name/tokens/:token_name", Method: "DELETE", Name: RevokeServiceAccountToken},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
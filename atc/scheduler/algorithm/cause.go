@@ -0,0 +1,55 @@
+package algorithm
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Cause describes why a build of the job is created with the given next
+// build inputs: the inputs whose versions have not been used by any of the
+// job's builds yet, along with the upstream builds, as determined in the
+// input mapping, which those versions passed through.
+func Cause(
+	job db.SchedulerJob,
+	inputConfigs db.InputConfigs,
+	buildInputs []db.BuildInput,
+	mapping db.InputMapping,
+) (atc.BuildCause, error) {
+	jobInputs, err := job.Inputs()
+	if err != nil {
+		return atc.BuildCause{}, err
+	}
+
+	resourceNames := map[string]string{}
+	for _, input := range jobInputs {
+		resourceNames[input.Name] = input.Resource
+	}
+
+	triggers := map[string]bool{}
+	for _, inputConfig := range inputConfigs {
+		triggers[inputConfig.Name] = inputConfig.Trigger
+	}
+
+	cause := atc.BuildCause{Type: atc.BuildCauseInputs}
+	for _, buildInput := range buildInputs {
+		if !buildInput.FirstOccurrence {
+			continue
+		}
+
+		input := atc.BuildCauseInput{
+			Name:     buildInput.Name,
+			Resource: resourceNames[buildInput.Name],
+			Version:  buildInput.Version,
+			Trigger:  triggers[buildInput.Name],
+			Webhook:  buildInput.Webhook,
+		}
+
+		for _, buildID := range mapping[buildInput.Name].PassedBuildIDs {
+			input.Passed = append(input.Passed, atc.BuildCauseBuild{ID: buildID})
+		}
+
+		cause.Inputs = append(cause.Inputs, input)
+	}
+
+	return cause, nil
+}
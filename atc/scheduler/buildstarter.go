@@ -170,6 +170,11 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	}
 
 	if !readyToDetermineInputs {
+		err = nextPendingBuild.SetQueueReason(atc.QueueReasonInputs)
+		if err != nil {
+			return startResults{}, fmt.Errorf("set queue reason: %w", err)
+		}
+
 		return startResults{
 			scheduled:              scheduled,
 			readyToDetermineInputs: readyToDetermineInputs,
@@ -184,6 +189,11 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	if !inputsDetermined {
 		logger.Debug("build-inputs-not-found")
 
+		err = nextPendingBuild.SetQueueReason(atc.QueueReasonInputs)
+		if err != nil {
+			return startResults{}, fmt.Errorf("set queue reason: %w", err)
+		}
+
 		// don't retry when build inputs are not found because this is due to the
 		// inputs being unsatisfiable
 		return startResults{
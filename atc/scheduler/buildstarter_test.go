@@ -253,6 +253,11 @@ var _ = Describe("BuildStarter", func() {
 						It("retries to schedule", func() {
 							Expect(needsReschedule).To(BeTrue())
 						})
+
+						It("records that the build is waiting on its inputs", func() {
+							Expect(createdBuild.SetQueueReasonCallCount()).To(Equal(1))
+							Expect(createdBuild.SetQueueReasonArgsForCall(0)).To(Equal(atc.QueueReasonInputs))
+						})
 					})
 
 					Context("when all resources are checked after build create time or pinned", func() {
@@ -536,6 +541,21 @@ var _ = Describe("BuildStarter", func() {
 							Expect(tryStartErr).ToNot(HaveOccurred())
 							Expect(needsReschedule).To(BeFalse())
 						})
+
+						It("records that the build is waiting on its inputs", func() {
+							Expect(pendingBuild1.SetQueueReasonCallCount()).To(Equal(1))
+							Expect(pendingBuild1.SetQueueReasonArgsForCall(0)).To(Equal(atc.QueueReasonInputs))
+						})
+
+						Context("when recording the queue reason fails", func() {
+							BeforeEach(func() {
+								pendingBuild1.SetQueueReasonReturns(errors.New("disaster"))
+							})
+
+							It("returns the error", func() {
+								Expect(tryStartErr).To(MatchError(ContainSubstring("set queue reason: disaster")))
+							})
+						})
 					})
 
 					Context("when there are several pending builds consisting of both retrigger and normal scheduler builds", func() {
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	"github.com/concourse/concourse/tracing"
 )
 
@@ -52,7 +53,7 @@ func (s *Scheduler) Schedule(
 		return false, fmt.Errorf("save next input mapping: %w", err)
 	}
 
-	err = s.ensurePendingBuildExists(ctx, logger, job, jobInputs)
+	err = s.ensurePendingBuildExists(ctx, logger, job, jobInputs, inputMapping)
 	if err != nil {
 		return false, err
 	}
@@ -65,6 +66,7 @@ func (s *Scheduler) ensurePendingBuildExists(
 	logger lager.Logger,
 	job db.SchedulerJob,
 	jobInputs db.InputConfigs,
+	inputMapping db.InputMapping,
 ) error {
 	buildInputs, satisfiableInputs, err := job.GetFullNextBuildInputs()
 	if err != nil {
@@ -76,14 +78,14 @@ func (s *Scheduler) ensurePendingBuildExists(
 		return nil
 	}
 
-	inputMapping := map[string]db.BuildInput{}
+	buildInputsByName := map[string]db.BuildInput{}
 	for _, input := range buildInputs {
-		inputMapping[input.Name] = input
+		buildInputsByName[input.Name] = input
 	}
 
 	var hasNewInputs bool
 	for _, inputConfig := range jobInputs {
-		inputSource, ok := inputMapping[inputConfig.Name]
+		inputSource, ok := buildInputsByName[inputConfig.Name]
 
 		//trigger: true, and the version has not been used
 		if ok && inputSource.FirstOccurrence {
@@ -102,7 +104,12 @@ func (s *Scheduler) ensurePendingBuildExists(
 						"version":  string(version),
 					},
 				)
-				err := job.EnsurePendingBuildExists(spanCtx)
+				cause, err := algorithm.Cause(job, jobInputs, buildInputs, inputMapping)
+				if err != nil {
+					return fmt.Errorf("determine build cause: %w", err)
+				}
+
+				err = job.EnsurePendingBuildExists(spanCtx, cause)
 				if err != nil {
 					return fmt.Errorf("ensure pending build exists: %w", err)
 				}
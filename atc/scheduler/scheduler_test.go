@@ -365,6 +365,68 @@ var _ = Describe("Scheduler", func() {
 						Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
 						Expect(scheduleErr).NotTo(HaveOccurred())
 					})
+
+					Context("when the input versions passed upstream jobs", func() {
+						BeforeEach(func() {
+							fakeJob.InputsReturns([]atc.JobInput{
+								{Name: "a", Resource: "some-resource"},
+								{Name: "b", Resource: "other-resource"},
+							}, nil)
+
+							fakeAlgorithm.ComputeReturns(db.InputMapping{
+								"a": db.InputResult{PassedBuildIDs: []int{42}},
+								"b": db.InputResult{},
+							}, true, false, nil)
+						})
+
+						It("records the new versions as the cause of the build", func() {
+							Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(Equal(1))
+							_, cause := fakeJob.EnsurePendingBuildExistsArgsForCall(0)
+							Expect(cause).To(Equal(atc.BuildCause{
+								Type: atc.BuildCauseInputs,
+								Inputs: []atc.BuildCauseInput{
+									{
+										Name:     "a",
+										Resource: "some-resource",
+										Version:  atc.Version{"ref": "v1"},
+										Trigger:  true,
+										Passed:   []atc.BuildCauseBuild{{ID: 42}},
+									},
+								},
+							}))
+						})
+					})
+
+					Context("when a new version was found through the resource's webhook", func() {
+						BeforeEach(func() {
+							fakeJob.GetFullNextBuildInputsReturns([]db.BuildInput{
+								{
+									Name:            "a",
+									Version:         atc.Version{"ref": "v1"},
+									ResourceID:      11,
+									FirstOccurrence: true,
+									Webhook:         true,
+								},
+							}, true, nil)
+						})
+
+						It("records it in the cause of the build", func() {
+							Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(Equal(1))
+							_, cause := fakeJob.EnsurePendingBuildExistsArgsForCall(0)
+							Expect(cause.Inputs).To(HaveLen(1))
+							Expect(cause.Inputs[0].Webhook).To(BeTrue())
+						})
+					})
+
+					Context("when fetching the job's inputs fails", func() {
+						BeforeEach(func() {
+							fakeJob.InputsReturns(nil, disaster)
+						})
+
+						It("returns the error", func() {
+							Expect(scheduleErr).To(Equal(fmt.Errorf("determine build cause: %w", disaster)))
+						})
+					})
 				})
 			})
 
@@ -456,7 +518,7 @@ var _ = Describe("Scheduler", func() {
 			})
 
 			It("starts a linked span", func() {
-				pendingBuildCtx := fakeJob.EnsurePendingBuildExistsArgsForCall(0)
+				pendingBuildCtx, _ := fakeJob.EnsurePendingBuildExistsArgsForCall(0)
 				span := tracing.FromContext(pendingBuildCtx).(*tracetest.Span)
 				Expect(span.Links()).To(HaveLen(1))
 				Expect(span.Links()).To(HaveKey(tracing.FromContext(ctx).SpanContext()))
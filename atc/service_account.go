@@ -0,0 +1,23 @@
+package atc
+
+// ServiceAccount is a team-owned identity for automation, which
+// authenticates with long-lived API tokens rather than by logging in.
+type ServiceAccount struct {
+	Name      string                `json:"name"`
+	TeamName  string                `json:"team_name"`
+	CreatedAt int64                 `json:"created_at"`
+	Tokens    []ServiceAccountToken `json:"tokens"`
+}
+
+// ServiceAccountToken is a named API token of a service account. It grants
+// Role on the account's team until it is revoked or ExpiresAt passes.
+type ServiceAccountToken struct {
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+	ExpiresAt int64  `json:"expires_at,omitempty"`
+
+	// Token is only ever returned when the token is created; afterwards
+	// only its hash is kept.
+	Token string `json:"token,omitempty"`
+}
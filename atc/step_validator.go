@@ -103,6 +103,8 @@ func (validator *StepValidator) VisitTask(plan *TaskStep) error {
 		validator.popContext()
 	}
 
+	validator.validateNodeSelector(plan.NodeSelector)
+
 	return nil
 }
 
@@ -164,6 +166,8 @@ func (validator *StepValidator) VisitGet(step *GetStep) error {
 
 	validator.popContext()
 
+	validator.validateNodeSelector(step.NodeSelector)
+
 	return nil
 }
 
@@ -186,6 +190,8 @@ func (validator *StepValidator) VisitPut(step *PutStep) error {
 		validator.recordError("unknown resource '%s'", resourceName)
 	}
 
+	validator.validateNodeSelector(step.NodeSelector)
+
 	return nil
 }
 
@@ -400,6 +406,19 @@ func (validator *StepValidator) recordWarning(warning ConfigWarning) {
 	validator.Warnings = append(validator.Warnings, warning)
 }
 
+func (validator *StepValidator) validateNodeSelector(selector NodeSelector) {
+	for i, req := range selector {
+		validator.pushContext(".node_selector[%d]", i)
+
+		err := req.Validate()
+		if err != nil {
+			validator.recordError(err.Error())
+		}
+
+		validator.popContext()
+	}
+}
+
 func (validator *StepValidator) recordError(message string, args ...interface{}) {
 	validator.Errors = append(validator.Errors, validator.annotate(fmt.Sprintf(message, args...)))
 }
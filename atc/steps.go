@@ -296,6 +296,8 @@ type GetStep struct {
 	Trigger  bool           `json:"trigger,omitempty"`
 	Tags     Tags           `json:"tags,omitempty"`
 	Timeout  string         `json:"timeout,omitempty"`
+
+	NodeSelector NodeSelector `json:"node_selector,omitempty"`
 }
 
 func (step *GetStep) ResourceName() string {
@@ -318,6 +320,8 @@ type PutStep struct {
 	Tags      Tags          `json:"tags,omitempty"`
 	GetParams Params        `json:"get_params,omitempty"`
 	Timeout   string        `json:"timeout,omitempty"`
+
+	NodeSelector NodeSelector `json:"node_selector,omitempty"`
 }
 
 func (step *PutStep) ResourceName() string {
@@ -344,6 +348,8 @@ type TaskStep struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 	Timeout           string            `json:"timeout,omitempty"`
+
+	NodeSelector NodeSelector `json:"node_selector,omitempty"`
 }
 
 func (step *TaskStep) Visit(v StepVisitor) error {
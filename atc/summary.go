@@ -21,6 +21,10 @@ type JobSummary struct {
 
 	Inputs  []JobInputSummary  `json:"inputs,omitempty"`
 	Outputs []JobOutputSummary `json:"outputs,omitempty"`
+
+	Flakiness *JobFlakiness `json:"flakiness,omitempty"`
+
+	NextFireTime int64 `json:"next_fire_time,omitempty"`
 }
 
 type BuildSummary struct {
@@ -3,14 +3,23 @@ package syslog
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 )
 
+// drainBatchSize is the number of build events read before the entries are
+// written to the sinks and their cursors are saved.
+const drainBatchSize = 500
+
+// auditTag is the syslog tag of the entries for audit events.
+const auditTag = "audit"
+
 //go:generate counterfeiter . Drainer
 
 type Drainer interface {
@@ -19,19 +28,19 @@ type Drainer interface {
 
 type drainer struct {
 	hostname     string
-	transport    string
-	address      string
-	caCerts      []string
+	sinks        []Sink
 	buildFactory db.BuildFactory
+	auditEvents  db.AuditEventRepository
 }
 
-func NewDrainer(transport string, address string, hostname string, caCerts []string, buildFactory db.BuildFactory) Drainer {
+// NewDrainer returns a Drainer which sends the logs of finished builds to the
+// sinks. If auditEvents is not nil, the audit events are sent along too.
+func NewDrainer(hostname string, sinks []Sink, buildFactory db.BuildFactory, auditEvents db.AuditEventRepository) Drainer {
 	return &drainer{
 		hostname:     hostname,
-		transport:    transport,
-		address:      address,
+		sinks:        sinks,
 		buildFactory: buildFactory,
-		caCerts:      caCerts,
+		auditEvents:  auditEvents,
 	}
 }
 
@@ -44,30 +53,172 @@ func (d *drainer) Run(ctx context.Context) error {
 		return err
 	}
 
-	if len(builds) > 0 {
-		syslog, err := Dial(d.transport, d.address, d.caCerts)
+	auditPending, err := d.auditEventsPending()
+	if err != nil {
+		logger.Error("failed-to-get-audit-events", err)
+		return err
+	}
+
+	if len(builds) == 0 && !auditPending {
+		return nil
+	}
+
+	// a sink which cannot be opened is skipped so that the others can carry
+	// on; the builds will not be marked as drained until it catches up
+	writers := map[string]SinkWriter{}
+	var openErr error
+	for _, sink := range d.sinks {
+		writer, err := sink.Open()
+		if err != nil {
+			logger.Error("failed-to-open-sink", err, lager.Data{"sink": sink.Name()})
+			openErr = err
+			continue
+		}
+
+		// ignore any errors coming from writer.Close()
+		defer db.Close(writer)
+
+		writers[sink.Name()] = writer
+	}
+
+	if len(writers) == 0 {
+		return openErr
+	}
+
+	for _, build := range builds {
+		err := d.drainBuild(ctx, logger, build, writers)
+		if err != nil {
+			return err
+		}
+	}
+
+	if auditPending {
+		return d.drainAuditEvents(ctx, logger, writers)
+	}
+
+	return nil
+}
+
+// auditEventsPending returns whether any sink has yet to be sent some of the
+// audit events.
+func (d *drainer) auditEventsPending() (bool, error) {
+	if d.auditEvents == nil {
+		return false, nil
+	}
+
+	cursors, err := d.auditEvents.DrainCursors()
+	if err != nil {
+		return false, err
+	}
+
+	for _, sink := range d.sinks {
+		events, err := d.auditEvents.AuditEventsAfter(cursors[sink.Name()], 1)
+		if err != nil {
+			return false, err
+		}
+
+		if len(events) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// drainAuditEvents writes the audit events to each of the writers in batches,
+// starting from where each sink's cursor left off. Audit events are committed
+// in the order of their IDs, so no event can turn up behind a cursor. As with
+// builds, a writer that fails is removed from the map.
+func (d *drainer) drainAuditEvents(ctx context.Context, logger lager.Logger, writers map[string]SinkWriter) error {
+	logger = logger.Session("drain-audit-events")
+
+	cursors, err := d.auditEvents.DrainCursors()
+	if err != nil {
+		logger.Error("failed-to-get-drain-cursors", err)
+		return err
+	}
+
+	for len(writers) > 0 {
+		from := -1
+		for name := range writers {
+			if from == -1 || cursors[name] < from {
+				from = cursors[name]
+			}
+		}
+
+		events, err := d.auditEvents.AuditEventsAfter(from, drainBatchSize)
 		if err != nil {
-			logger.Error("failed-to-connect", err)
+			logger.Error("failed-to-get-audit-events", err)
 			return err
 		}
 
-		// ignore any errors coming from syslog.Close()
-		defer db.Close(syslog)
+		if len(events) == 0 {
+			return nil
+		}
+
+		last := events[len(events)-1].ID
+
+		for name, writer := range writers {
+			var entries []Entry
+			for _, auditEvent := range events {
+				if auditEvent.ID > cursors[name] {
+					entries = append(entries, d.auditEntry(auditEvent))
+				}
+			}
+
+			if len(entries) == 0 {
+				continue
+			}
+
+			err := writer.Write(ctx, entries)
+			if err != nil {
+				logger.Error("failed-to-write-to-sink", err, lager.Data{"sink": name})
+				delete(writers, name)
+				continue
+			}
 
-		for _, build := range builds {
-			err := d.drainBuild(logger, build, syslog)
+			err = d.auditEvents.SetDrainCursor(name, last)
 			if err != nil {
+				logger.Error("failed-to-save-drain-cursor", err, lager.Data{"sink": name})
 				return err
 			}
+
+			cursors[name] = last
+		}
+
+		if len(events) < drainBatchSize {
+			return nil
 		}
 	}
+
 	return nil
 }
 
-func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog) error {
+type pendingEntry struct {
+	position uint
+	entry    Entry
+}
+
+// drainBuild writes the build's logs to each of the writers, starting from
+// where each sink's cursor left off. A writer that fails is removed from the
+// map so that it is not retried until the next drain.
+func (d *drainer) drainBuild(ctx context.Context, logger lager.Logger, build db.Build, writers map[string]SinkWriter) error {
 	logger = logger.Session("drain-build", build.LagerData())
 
-	events, err := build.Events(0)
+	cursors, err := build.DrainCursors()
+	if err != nil {
+		logger.Error("failed-to-get-drain-cursors", err)
+		return err
+	}
+
+	from := ^uint(0)
+	for name := range writers {
+		if cursors[name] < from {
+			from = cursors[name]
+		}
+	}
+
+	events, err := build.Events(from)
 	if err != nil {
 		return err
 	}
@@ -75,6 +226,44 @@ func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog
 	// ignore any errors coming from events.Close()
 	defer db.Close(events)
 
+	position := from
+	var pending []pendingEntry
+
+	flush := func() error {
+		for name, writer := range writers {
+			cursor := cursors[name]
+			if cursor >= position {
+				continue
+			}
+
+			var entries []Entry
+			for _, p := range pending {
+				if p.position >= cursor {
+					entries = append(entries, p.entry)
+				}
+			}
+
+			err := writer.Write(ctx, entries)
+			if err != nil {
+				logger.Error("failed-to-write-to-sink", err, lager.Data{"sink": name})
+				delete(writers, name)
+				continue
+			}
+
+			err = build.SetDrainCursor(name, position)
+			if err != nil {
+				logger.Error("failed-to-save-drain-cursor", err, lager.Data{"sink": name})
+				return err
+			}
+
+			cursors[name] = position
+		}
+
+		pending = nil
+
+		return nil
+	}
+
 	for {
 		ev, err := events.Next()
 		if err != nil {
@@ -94,19 +283,34 @@ func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog
 				return err
 			}
 
-			err = syslog.Write(
-				d.hostname,
-				build.SyslogTag(log.Origin.ID),
-				time.Unix(log.Time, 0),
-				log.Payload,
-			)
+			pending = append(pending, pendingEntry{
+				position: position,
+				entry:    d.entry(build, log),
+			})
+		}
+
+		position++
+
+		if (position-from)%drainBatchSize == 0 {
+			err := flush()
 			if err != nil {
-				logger.Error("failed-to-write-to-server", err)
 				return err
 			}
 		}
 	}
 
+	err = flush()
+	if err != nil {
+		return err
+	}
+
+	for _, sink := range d.sinks {
+		if _, ok := writers[sink.Name()]; !ok {
+			logger.Info("sink-not-drained", lager.Data{"sink": sink.Name()})
+			return nil
+		}
+	}
+
 	err = build.SetDrained(true)
 	if err != nil {
 		logger.Error("failed-to-update-status", err)
@@ -115,3 +319,34 @@ func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog
 
 	return nil
 }
+
+func (d *drainer) entry(build db.Build, log event.Log) Entry {
+	return Entry{
+		Hostname: d.hostname,
+		Tag:      build.SyslogTag(log.Origin.ID),
+		Time:     time.Unix(log.Time, 0),
+		Message:  log.Payload,
+
+		TeamName:     build.TeamName(),
+		PipelineName: build.PipelineName(),
+		JobName:      build.JobName(),
+		BuildID:      build.ID(),
+		BuildName:    build.Name(),
+		Origin:       string(log.Origin.ID),
+		Source:       string(log.Origin.Source),
+		Status:       string(build.Status()),
+	}
+}
+
+func (d *drainer) auditEntry(auditEvent atc.AuditEvent) Entry {
+	return Entry{
+		Hostname: d.hostname,
+		Tag:      auditTag,
+		Time:     time.Unix(auditEvent.Time, 0),
+		Message:  fmt.Sprintf("%s %s %d", auditEvent.Action, auditEvent.Target, auditEvent.Status),
+
+		TeamName: auditEvent.Team,
+		User:     auditEvent.User,
+		Action:   auditEvent.Action,
+	}
+}
@@ -3,20 +3,24 @@ package syslog_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strconv"
+	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/syslog/syslogfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
-func newFakeBuild(id int) db.Build {
+func newFakeEventSource(id int) *dbfakes.FakeEventSource {
 	fakeEventSource := new(dbfakes.FakeEventSource)
 
-	msg1 := json.RawMessage(`{"time":1533744538,"payload":"build ` + strconv.Itoa(id) + ` log"}`)
+	msg1 := json.RawMessage(`{"time":1533744538,"origin":{"id":"some-origin","source":"stdout"},"payload":"build ` + strconv.Itoa(id) + ` log"}`)
 
 	fakeEventSource.NextReturnsOnCall(0, event.Envelope{
 		Data:  &msg1,
@@ -34,34 +38,56 @@ func newFakeBuild(id int) db.Build {
 
 	fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
 
+	return fakeEventSource
+}
+
+func newFakeBuild(id int) *dbfakes.FakeBuild {
 	fakeBuild := new(dbfakes.FakeBuild)
-	fakeBuild.EventsReturns(fakeEventSource, nil)
+	fakeBuild.EventsReturns(newFakeEventSource(id), nil)
 	fakeBuild.IDReturns(id)
+	fakeBuild.NameReturns(strconv.Itoa(id))
+	fakeBuild.TeamNameReturns("some-team")
+	fakeBuild.PipelineNameReturns("some-pipeline")
+	fakeBuild.JobNameReturns("some-job")
+	fakeBuild.StatusReturns(db.BuildStatusSucceeded)
+	fakeBuild.DrainCursorsReturns(map[string]uint{}, nil)
 
 	return fakeBuild
 }
 
+func newFakeSink(name string) (*syslogfakes.FakeSink, *syslogfakes.FakeSinkWriter) {
+	fakeWriter := new(syslogfakes.FakeSinkWriter)
+
+	fakeSink := new(syslogfakes.FakeSink)
+	fakeSink.NameReturns(name)
+	fakeSink.OpenReturns(fakeWriter, nil)
+
+	return fakeSink, fakeWriter
+}
+
 var _ = Describe("Drainer", func() {
 	var fakeBuildFactory *dbfakes.FakeBuildFactory
-	var server *testServer
 
 	BeforeEach(func() {
 		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
 		fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakeBuild(123), newFakeBuild(345)}, nil)
 	})
 
-	AfterEach(func() {
-		server.Close()
-	})
-
 	Context("when there are builds that have not been drained", func() {
 		Context("when tls is not set", func() {
+			var server *testServer
+
 			BeforeEach(func() {
 				server = newTestServer(nil)
 			})
 
+			AfterEach(func() {
+				server.Close()
+			})
+
 			It("drains all build events by tcp", func() {
-				testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", []string{}, fakeBuildFactory)
+				sink := syslog.NewSyslogSink("tcp", server.Addr, []string{})
+				testDrainer := syslog.NewDrainer("test", []syslog.Sink{sink}, fakeBuildFactory, nil)
 				err := testDrainer.Run(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
@@ -70,8 +96,196 @@ var _ = Describe("Drainer", func() {
 				Expect(got).To(ContainSubstring("build 345 log"))
 				Expect(got).NotTo(ContainSubstring("build 123 status"))
 				Expect(got).NotTo(ContainSubstring("build 345 status"))
+				Expect(got).To(ContainSubstring(`[concourse@32473 team="some-team" pipeline="some-pipeline" job="some-job" build="123" build_id="123" origin="some-origin" source="stdout" status="succeeded"]`))
 			}, 0.2)
 		})
 
+		Context("with multiple sinks", func() {
+			var (
+				build *dbfakes.FakeBuild
+
+				healthySink   *syslogfakes.FakeSink
+				healthyWriter *syslogfakes.FakeSinkWriter
+				failingSink   *syslogfakes.FakeSink
+				failingWriter *syslogfakes.FakeSinkWriter
+
+				runErr error
+			)
+
+			BeforeEach(func() {
+				build = newFakeBuild(123)
+				fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{build}, nil)
+
+				healthySink, healthyWriter = newFakeSink("healthy")
+				failingSink, failingWriter = newFakeSink("failing")
+			})
+
+			JustBeforeEach(func() {
+				testDrainer := syslog.NewDrainer("test", []syslog.Sink{failingSink, healthySink}, fakeBuildFactory, nil)
+				runErr = testDrainer.Run(context.TODO())
+			})
+
+			Context("when all sinks accept the logs", func() {
+				It("advances each sink's cursor and marks the build as drained", func() {
+					Expect(runErr).NotTo(HaveOccurred())
+
+					Expect(healthyWriter.WriteCallCount()).To(Equal(1))
+					_, entries := healthyWriter.WriteArgsForCall(0)
+					Expect(entries).To(HaveLen(1))
+					Expect(entries[0].Message).To(Equal("build 123 log"))
+					Expect(entries[0].Tag).To(Equal(build.SyslogTag("some-origin")))
+					Expect(entries[0].Hostname).To(Equal("test"))
+
+					Expect(build.SetDrainCursorCallCount()).To(Equal(2))
+					Expect(build.SetDrainedCallCount()).To(Equal(1))
+					Expect(build.SetDrainedArgsForCall(0)).To(BeTrue())
+				})
+			})
+
+			Context("when a sink fails to write", func() {
+				BeforeEach(func() {
+					failingWriter.WriteReturns(errors.New("nope"))
+				})
+
+				It("still delivers to the other sinks", func() {
+					Expect(runErr).NotTo(HaveOccurred())
+					Expect(healthyWriter.WriteCallCount()).To(Equal(1))
+
+					Expect(build.SetDrainCursorCallCount()).To(Equal(1))
+					name, cursor := build.SetDrainCursorArgsForCall(0)
+					Expect(name).To(Equal("healthy"))
+					Expect(cursor).To(Equal(uint(2)))
+				})
+
+				It("does not mark the build as drained", func() {
+					Expect(build.SetDrainedCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a sink cannot be opened", func() {
+				BeforeEach(func() {
+					failingSink.OpenReturns(nil, errors.New("nope"))
+				})
+
+				It("still delivers to the other sinks without marking the build as drained", func() {
+					Expect(runErr).NotTo(HaveOccurred())
+					Expect(healthyWriter.WriteCallCount()).To(Equal(1))
+					Expect(build.SetDrainedCallCount()).To(BeZero())
+				})
+
+				Context("when no sink can be opened", func() {
+					BeforeEach(func() {
+						healthySink.OpenReturns(nil, errors.New("also nope"))
+					})
+
+					It("returns the error", func() {
+						Expect(runErr).To(HaveOccurred())
+					})
+				})
+			})
+
+			Context("when a sink is behind the others", func() {
+				BeforeEach(func() {
+					build.DrainCursorsReturns(map[string]uint{"healthy": 2}, nil)
+				})
+
+				It("only reads the events from the earliest cursor", func() {
+					Expect(build.EventsArgsForCall(0)).To(Equal(uint(0)))
+				})
+
+				It("only writes to the sink which is behind", func() {
+					Expect(failingWriter.WriteCallCount()).To(Equal(1))
+					Expect(healthyWriter.WriteCallCount()).To(BeZero())
+
+					Expect(build.SetDrainedCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Context("when audit events are drained", func() {
+		var (
+			fakeAuditEvents *dbfakes.FakeAuditEventRepository
+
+			sink   *syslogfakes.FakeSink
+			writer *syslogfakes.FakeSinkWriter
+
+			runErr error
+		)
+
+		BeforeEach(func() {
+			fakeBuildFactory.GetDrainableBuildsReturns(nil, nil)
+
+			fakeAuditEvents = new(dbfakes.FakeAuditEventRepository)
+			fakeAuditEvents.DrainCursorsReturns(map[string]int{"some-sink": 1}, nil)
+			fakeAuditEvents.AuditEventsAfterReturns([]atc.AuditEvent{
+				{
+					ID:     2,
+					Time:   1620945615,
+					User:   "some-user",
+					Team:   "some-team",
+					Action: atc.SaveConfig,
+					Target: "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+					Status: 200,
+				},
+			}, nil)
+
+			sink, writer = newFakeSink("some-sink")
+		})
+
+		JustBeforeEach(func() {
+			testDrainer := syslog.NewDrainer("test", []syslog.Sink{sink}, fakeBuildFactory, fakeAuditEvents)
+			runErr = testDrainer.Run(context.TODO())
+		})
+
+		It("writes the events after the sink's cursor", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+
+			id, _ := fakeAuditEvents.AuditEventsAfterArgsForCall(0)
+			Expect(id).To(Equal(1))
+
+			Expect(writer.WriteCallCount()).To(Equal(1))
+			_, entries := writer.WriteArgsForCall(0)
+			Expect(entries).To(Equal([]syslog.Entry{
+				{
+					Hostname: "test",
+					Tag:      "audit",
+					Time:     time.Unix(1620945615, 0),
+					Message:  "SaveConfig /api/v1/teams/some-team/pipelines/some-pipeline/config 200",
+					TeamName: "some-team",
+					User:     "some-user",
+					Action:   atc.SaveConfig,
+				},
+			}))
+		})
+
+		It("advances the sink's cursor", func() {
+			Expect(fakeAuditEvents.SetDrainCursorCallCount()).To(Equal(1))
+			name, cursor := fakeAuditEvents.SetDrainCursorArgsForCall(0)
+			Expect(name).To(Equal("some-sink"))
+			Expect(cursor).To(Equal(2))
+		})
+
+		Context("when there are no new events", func() {
+			BeforeEach(func() {
+				fakeAuditEvents.AuditEventsAfterReturns(nil, nil)
+			})
+
+			It("does not open the sinks", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+				Expect(sink.OpenCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the sink fails to write", func() {
+			BeforeEach(func() {
+				writer.WriteReturns(errors.New("nope"))
+			})
+
+			It("does not advance the sink's cursor", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+				Expect(fakeAuditEvents.SetDrainCursorCallCount()).To(BeZero())
+			})
+		})
 	})
 })
@@ -0,0 +1,79 @@
+package syslog
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// structuredDataID identifies the structured data element carrying the build
+// metadata. Custom SD-IDs have to be qualified with a private enterprise
+// number; 32473 is the one reserved for documentation (RFC 5612).
+const structuredDataID = "concourse@32473"
+
+// Entry is a single line of build output along with the build it belongs to,
+// or an audit event along with the user who caused it.
+type Entry struct {
+	Hostname string    `json:"hostname"`
+	Tag      string    `json:"tag"`
+	Time     time.Time `json:"time"`
+	Message  string    `json:"message"`
+
+	TeamName     string `json:"team"`
+	PipelineName string `json:"pipeline,omitempty"`
+	JobName      string `json:"job,omitempty"`
+	BuildID      int    `json:"build_id,omitempty"`
+	BuildName    string `json:"build_name,omitempty"`
+	Origin       string `json:"origin,omitempty"`
+	Source       string `json:"source,omitempty"`
+	Status       string `json:"status,omitempty"`
+
+	User   string `json:"user,omitempty"`
+	Action string `json:"action,omitempty"`
+}
+
+// StructuredData renders the build metadata as an RFC 5424 structured data
+// element. Empty parameters are left out.
+func (e Entry) StructuredData() string {
+	var buildID string
+	if e.BuildID != 0 {
+		buildID = strconv.Itoa(e.BuildID)
+	}
+
+	params := []struct {
+		name  string
+		value string
+	}{
+		{"team", e.TeamName},
+		{"pipeline", e.PipelineName},
+		{"job", e.JobName},
+		{"build", e.BuildName},
+		{"build_id", buildID},
+		{"origin", e.Origin},
+		{"source", e.Source},
+		{"status", e.Status},
+		{"user", e.User},
+		{"action", e.Action},
+	}
+
+	var sd strings.Builder
+	sd.WriteString("[" + structuredDataID)
+
+	for _, param := range params {
+		if param.value == "" {
+			continue
+		}
+
+		sd.WriteString(" " + param.name + `="` + escapeParamValue(param.value) + `"`)
+	}
+
+	sd.WriteString("]")
+
+	return sd.String()
+}
+
+// escapeParamValue escapes the characters which RFC 5424 does not allow
+// unescaped within a PARAM-VALUE.
+func escapeParamValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
@@ -0,0 +1,42 @@
+package syslog_test
+
+import (
+	"github.com/concourse/concourse/atc/syslog"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Entry", func() {
+	Describe("StructuredData", func() {
+		It("leaves out empty parameters", func() {
+			entry := syslog.Entry{
+				TeamName:  "main",
+				BuildID:   42,
+				BuildName: "42",
+				Status:    "failed",
+			}
+
+			Expect(entry.StructuredData()).To(Equal(`[concourse@32473 team="main" build="42" build_id="42" status="failed"]`))
+		})
+
+		It("escapes quotes, backslashes and closing brackets", func() {
+			entry := syslog.Entry{
+				TeamName:     "main",
+				PipelineName: `some "pipeline" \with] chars`,
+				BuildID:      1,
+			}
+
+			Expect(entry.StructuredData()).To(Equal(`[concourse@32473 team="main" pipeline="some \"pipeline\" \\with\] chars" build_id="1"]`))
+		})
+
+		It("leaves out the build id of audit entries", func() {
+			entry := syslog.Entry{
+				TeamName: "main",
+				User:     "some-user",
+				Action:   "SaveConfig",
+			}
+
+			Expect(entry.StructuredData()).To(Equal(`[concourse@32473 team="main" user="some-user" action="SaveConfig"]`))
+		})
+	})
+})
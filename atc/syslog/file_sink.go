@@ -0,0 +1,125 @@
+package syslog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const SinkNameFile = "file"
+
+type fileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+}
+
+// NewFileSink returns a Sink which appends entries to a local file as JSON
+// lines. Once the file would grow past maxSize bytes it is rotated to
+// path.1, path.1 to path.2 and so on, keeping at most maxBackups old files.
+// A maxSize of 0 disables rotation.
+func NewFileSink(path string, maxSize int64, maxBackups int) Sink {
+	return fileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+}
+
+func (sink fileSink) Name() string { return SinkNameFile }
+
+func (sink fileSink) Open() (SinkWriter, error) {
+	writer := &fileWriter{sink: sink}
+
+	err := writer.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+type fileWriter struct {
+	sink fileSink
+
+	file *os.File
+	size int64
+}
+
+func (writer *fileWriter) open() error {
+	file, err := os.OpenFile(writer.sink.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	writer.file = file
+	writer.size = info.Size()
+
+	return nil
+}
+
+func (writer *fileWriter) Write(_ context.Context, entries []Entry) error {
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+
+		line = append(line, '\n')
+
+		if writer.sink.maxSize > 0 && writer.size > 0 && writer.size+int64(len(line)) > writer.sink.maxSize {
+			err := writer.rotate()
+			if err != nil {
+				return fmt.Errorf("rotate: %w", err)
+			}
+		}
+
+		n, err := writer.file.Write(line)
+		writer.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.file.Sync()
+}
+
+func (writer *fileWriter) rotate() error {
+	err := writer.file.Close()
+	if err != nil {
+		return err
+	}
+
+	if writer.sink.maxBackups > 0 {
+		for i := writer.sink.maxBackups - 1; i > 0; i-- {
+			err := os.Rename(writer.backupPath(i), writer.backupPath(i+1))
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		err = os.Rename(writer.sink.path, writer.backupPath(1))
+	} else {
+		err = os.Remove(writer.sink.path)
+	}
+	if err != nil {
+		return err
+	}
+
+	return writer.open()
+}
+
+func (writer *fileWriter) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", writer.sink.path, i)
+}
+
+func (writer *fileWriter) Close() error {
+	return writer.file.Close()
+}
@@ -0,0 +1,239 @@
+package syslog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+const SinkNameHTTP = "http"
+
+// HTTPFormat is the payload format the HTTP sink encodes each batch of
+// entries in.
+type HTTPFormat string
+
+const (
+	// HTTPFormatNDJSON posts one JSON encoded Entry per line.
+	HTTPFormatNDJSON HTTPFormat = "ndjson"
+
+	// HTTPFormatLoki posts a push request for Loki's /loki/api/v1/push,
+	// with the entries grouped into streams by team, pipeline and job.
+	HTTPFormatLoki HTTPFormat = "loki"
+
+	// HTTPFormatElasticBulk posts an index action followed by the document
+	// for each entry, for Elasticsearch's _bulk endpoint.
+	HTTPFormatElasticBulk HTTPFormat = "elastic-bulk"
+)
+
+type httpSink struct {
+	httpClient *http.Client
+	url        string
+	format     HTTPFormat
+	headers    map[string]string
+}
+
+// NewHTTPSink returns a Sink which POSTs each batch of entries to a URL,
+// encoded in the given format.
+func NewHTTPSink(httpClient *http.Client, url string, format HTTPFormat, headers map[string]string) Sink {
+	return httpSink{
+		httpClient: httpClient,
+		url:        url,
+		format:     format,
+		headers:    headers,
+	}
+}
+
+func (sink httpSink) Name() string { return SinkNameHTTP }
+
+func (sink httpSink) Open() (SinkWriter, error) {
+	switch sink.format {
+	case HTTPFormatNDJSON, HTTPFormatLoki, HTTPFormatElasticBulk:
+		return sink, nil
+	}
+
+	return nil, fmt.Errorf("unknown HTTP sink format '%s'", sink.format)
+}
+
+func (sink httpSink) Write(ctx context.Context, entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var (
+		payload     []byte
+		contentType string
+		err         error
+	)
+
+	switch sink.format {
+	case HTTPFormatLoki:
+		payload, err = encodeLokiPush(entries)
+		contentType = "application/json"
+	case HTTPFormatElasticBulk:
+		payload, err = encodeElasticBulk(entries)
+		contentType = "application/x-ndjson"
+	default:
+		payload, err = encodeJSONLines(entries)
+		contentType = "application/x-ndjson"
+	}
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	for name, value := range sink.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := sink.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected response %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	if sink.format == HTTPFormatElasticBulk {
+		return checkElasticBulkResponse(resp.Body)
+	}
+
+	return nil
+}
+
+func (sink httpSink) Close() error {
+	return nil
+}
+
+func encodeJSONLines(entries []Entry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	for _, entry := range entries {
+		err := encoder.Encode(entry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+type lokiPush struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// encodeLokiPush groups the entries into one stream per team, pipeline and
+// job, keeping the order in which each stream first appears. Builds are left
+// out of the labels to keep their cardinality down; each line is the whole
+// entry as JSON so that they can still be extracted at query time.
+func encodeLokiPush(entries []Entry) ([]byte, error) {
+	var push lokiPush
+
+	streams := map[[4]string]int{}
+	for _, entry := range entries {
+		key := [4]string{entry.Hostname, entry.TeamName, entry.PipelineName, entry.JobName}
+
+		i, found := streams[key]
+		if !found {
+			labels := map[string]string{"hostname": entry.Hostname, "team": entry.TeamName}
+			if entry.PipelineName != "" {
+				labels["pipeline"] = entry.PipelineName
+			}
+			if entry.JobName != "" {
+				labels["job"] = entry.JobName
+			}
+
+			i = len(push.Streams)
+			streams[key] = i
+			push.Streams = append(push.Streams, lokiStream{Stream: labels})
+		}
+
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		push.Streams[i].Values = append(push.Streams[i].Values, [2]string{
+			strconv.FormatInt(entry.Time.UnixNano(), 10),
+			string(line),
+		})
+	}
+
+	return json.Marshal(push)
+}
+
+// encodeElasticBulk precedes each entry with an index action. The action
+// names no index, so the index has to be given in the URL, e.g.
+// https://elastic:9200/concourse-builds/_bulk.
+func encodeElasticBulk(entries []Entry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	for _, entry := range entries {
+		err := encoder.Encode(map[string]interface{}{"index": struct{}{}})
+		if err != nil {
+			return nil, err
+		}
+
+		err = encoder.Encode(entry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+type elasticBulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+// checkElasticBulkResponse fails when any of the documents in a bulk request
+// was rejected, which Elasticsearch reports with a successful status code.
+func checkElasticBulkResponse(body io.Reader) error {
+	var resp elasticBulkResponse
+	err := json.NewDecoder(body).Decode(&resp)
+	if err != nil {
+		return fmt.Errorf("decode bulk response: %w", err)
+	}
+
+	if !resp.Errors {
+		return nil
+	}
+
+	for _, item := range resp.Items {
+		for action, result := range item {
+			if result.Error != nil {
+				return fmt.Errorf("bulk %s failed with %d: %s: %s", action, result.Status, result.Error.Type, result.Error.Reason)
+			}
+		}
+	}
+
+	return fmt.Errorf("bulk request failed")
+}
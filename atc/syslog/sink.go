@@ -0,0 +1,79 @@
+package syslog
+
+import (
+	"context"
+)
+
+//go:generate counterfeiter . Sink
+
+// Sink is a destination for drained build logs. Each sink keeps its own
+// cursor into every build, so it is identified by a name which must not
+// change between restarts.
+type Sink interface {
+	Name() string
+	Open() (SinkWriter, error)
+}
+
+//go:generate counterfeiter . SinkWriter
+
+// SinkWriter is an open connection to a Sink. Write either delivers all of
+// the given entries or returns an error, in which case they will all be
+// written again on the next drain.
+type SinkWriter interface {
+	Write(context.Context, []Entry) error
+	Close() error
+}
+
+const SinkNameSyslog = "syslog"
+
+type syslogSink struct {
+	transport string
+	address   string
+	caCerts   []string
+}
+
+// NewSyslogSink returns a Sink which sends each entry to a syslog server,
+// with the build metadata as RFC 5424 structured data.
+func NewSyslogSink(transport string, address string, caCerts []string) Sink {
+	return syslogSink{
+		transport: transport,
+		address:   address,
+		caCerts:   caCerts,
+	}
+}
+
+func (sink syslogSink) Name() string { return SinkNameSyslog }
+
+func (sink syslogSink) Open() (SinkWriter, error) {
+	syslog, err := Dial(sink.transport, sink.address, sink.caCerts)
+	if err != nil {
+		return nil, err
+	}
+
+	return syslogWriter{syslog}, nil
+}
+
+type syslogWriter struct {
+	syslog *Syslog
+}
+
+func (writer syslogWriter) Write(_ context.Context, entries []Entry) error {
+	for _, entry := range entries {
+		err := writer.syslog.WriteStructured(
+			entry.Hostname,
+			entry.Tag,
+			entry.Time,
+			entry.StructuredData(),
+			entry.Message,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (writer syslogWriter) Close() error {
+	return writer.syslog.Close()
+}
@@ -0,0 +1,220 @@
+package syslog_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/syslog"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Sinks", func() {
+	var entries []syslog.Entry
+
+	BeforeEach(func() {
+		entries = []syslog.Entry{
+			{Hostname: "test", Tag: "some-tag", Time: time.Unix(1533744538, 0).UTC(), Message: "hello", TeamName: "main", BuildID: 1, BuildName: "1", Status: "succeeded"},
+			{Hostname: "test", Tag: "some-tag", Time: time.Unix(1533744539, 0).UTC(), Message: "world", TeamName: "main", BuildID: 1, BuildName: "1", Status: "succeeded"},
+		}
+	})
+
+	Describe("HTTP", func() {
+		var server *ghttp.Server
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("posts the entries as JSON lines", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/push"),
+				ghttp.VerifyContentType("application/x-ndjson"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				func(w http.ResponseWriter, r *http.Request) {
+					body, err := ioutil.ReadAll(r.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+					Expect(lines).To(HaveLen(2))
+
+					var entry syslog.Entry
+					Expect(json.Unmarshal([]byte(lines[1]), &entry)).To(Succeed())
+					Expect(entry).To(Equal(entries[1]))
+				},
+			))
+
+			sink := syslog.NewHTTPSink(http.DefaultClient, server.URL()+"/push", syslog.HTTPFormatNDJSON, map[string]string{"Authorization": "Bearer some-token"})
+			Expect(sink.Name()).To(Equal("http"))
+
+			writer, err := sink.Open()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(writer.Write(context.TODO(), entries)).To(Succeed())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("fails when the endpoint does not accept the entries", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusServiceUnavailable, "try later"))
+
+			writer, err := syslog.NewHTTPSink(http.DefaultClient, server.URL(), syslog.HTTPFormatNDJSON, nil).Open()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(writer.Write(context.TODO(), entries)).To(MatchError("unexpected response 503: try later"))
+		})
+
+		It("pushes the entries to Loki as streams", func() {
+			entries = append(entries, syslog.Entry{
+				Hostname: "test", Tag: "some-tag", Time: time.Unix(1533744540, 0).UTC(), Message: "other", TeamName: "main", PipelineName: "some-pipeline", JobName: "some-job", BuildID: 2, BuildName: "1",
+			})
+
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/loki/api/v1/push"),
+				ghttp.VerifyContentType("application/json"),
+				ghttp.VerifyJSON(`{
+					"streams": [
+						{
+							"stream": {"hostname": "test", "team": "main"},
+							"values": [
+								["1533744538000000000", "{\"hostname\":\"test\",\"tag\":\"some-tag\",\"time\":\"2018-08-08T16:08:58Z\",\"message\":\"hello\",\"team\":\"main\",\"build_id\":1,\"build_name\":\"1\",\"status\":\"succeeded\"}"],
+								["1533744539000000000", "{\"hostname\":\"test\",\"tag\":\"some-tag\",\"time\":\"2018-08-08T16:08:59Z\",\"message\":\"world\",\"team\":\"main\",\"build_id\":1,\"build_name\":\"1\",\"status\":\"succeeded\"}"]
+							]
+						},
+						{
+							"stream": {"hostname": "test", "team": "main", "pipeline": "some-pipeline", "job": "some-job"},
+							"values": [
+								["1533744540000000000", "{\"hostname\":\"test\",\"tag\":\"some-tag\",\"time\":\"2018-08-08T16:09:00Z\",\"message\":\"other\",\"team\":\"main\",\"pipeline\":\"some-pipeline\",\"job\":\"some-job\",\"build_id\":2,\"build_name\":\"1\"}"]
+							]
+						}
+					]
+				}`),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			))
+
+			writer, err := syslog.NewHTTPSink(http.DefaultClient, server.URL()+"/loki/api/v1/push", syslog.HTTPFormatLoki, nil).Open()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(writer.Write(context.TODO(), entries)).To(Succeed())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("with the elastic-bulk format", func() {
+			var writer syslog.SinkWriter
+
+			BeforeEach(func() {
+				var err error
+				writer, err = syslog.NewHTTPSink(http.DefaultClient, server.URL()+"/builds/_bulk", syslog.HTTPFormatElasticBulk, nil).Open()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("indexes each entry with a bulk action", func() {
+				server.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/builds/_bulk"),
+					ghttp.VerifyContentType("application/x-ndjson"),
+					func(w http.ResponseWriter, r *http.Request) {
+						body, err := ioutil.ReadAll(r.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+						Expect(lines).To(HaveLen(4))
+
+						for i, entry := range entries {
+							Expect(lines[2*i]).To(MatchJSON(`{"index":{}}`))
+
+							var doc syslog.Entry
+							Expect(json.Unmarshal([]byte(lines[2*i+1]), &doc)).To(Succeed())
+							Expect(doc).To(Equal(entry))
+						}
+					},
+					ghttp.RespondWith(http.StatusOK, `{"errors":false,"items":[{"index":{"status":201}},{"index":{"status":201}}]}`),
+				))
+
+				Expect(writer.Write(context.TODO(), entries)).To(Succeed())
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+
+			It("fails when a document is rejected", func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{
+					"errors": true,
+					"items": [
+						{"index": {"status": 201}},
+						{"index": {"status": 400, "error": {"type": "mapper_parsing_exception", "reason": "failed to parse field [time]"}}}
+					]
+				}`))
+
+				Expect(writer.Write(context.TODO(), entries)).To(MatchError("bulk index failed with 400: mapper_parsing_exception: failed to parse field [time]"))
+			})
+		})
+
+		It("rejects unknown formats", func() {
+			_, err := syslog.NewHTTPSink(http.DefaultClient, server.URL(), "xml", nil).Open()
+			Expect(err).To(MatchError("unknown HTTP sink format 'xml'"))
+		})
+	})
+
+	Describe("File", func() {
+		var dir, path string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "syslog-file-sink")
+			Expect(err).NotTo(HaveOccurred())
+
+			path = filepath.Join(dir, "builds.log")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("appends the entries as JSON lines", func() {
+			writer, err := syslog.NewFileSink(path, 0, 0).Open()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(writer.Write(context.TODO(), entries[:1])).To(Succeed())
+			Expect(writer.Write(context.TODO(), entries[1:])).To(Succeed())
+			Expect(writer.Close()).To(Succeed())
+
+			content, err := ioutil.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(ContainSubstring(`"message":"hello"`))
+			Expect(lines[1]).To(ContainSubstring(`"message":"world"`))
+		})
+
+		It("rotates the file once it grows past the max size", func() {
+			line, err := json.Marshal(entries[0])
+			Expect(err).NotTo(HaveOccurred())
+
+			writer, err := syslog.NewFileSink(path, int64(len(line)+1), 1).Open()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(writer.Write(context.TODO(), entries)).To(Succeed())
+			Expect(writer.Write(context.TODO(), entries[:1])).To(Succeed())
+			Expect(writer.Close()).To(Succeed())
+
+			current, err := ioutil.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(current)).To(ContainSubstring(`"message":"hello"`))
+
+			backup, err := ioutil.ReadFile(path + ".1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(backup)).To(ContainSubstring(`"message":"world"`))
+
+			Expect(path + ".2").ToNot(BeAnExistingFile())
+		})
+	})
+})
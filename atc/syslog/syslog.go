@@ -14,6 +14,7 @@ import (
 )
 
 const rfc5424time = "2006-01-02T15:04:05.999999Z07:00"
+const nilValue = "-"
 const priority = sl.LOG_USER | sl.LOG_INFO
 
 type Syslog struct {
@@ -66,13 +67,19 @@ func Dial(transport, address string, caCerts []string) (*Syslog, error) {
 }
 
 func (s *Syslog) Write(hostname, tag string, ts time.Time, msg string) error {
+	return s.WriteStructured(hostname, tag, ts, nilValue, msg)
+}
+
+// WriteStructured writes a message along with RFC 5424 structured data, which
+// must already be formatted.
+func (s *Syslog) WriteStructured(hostname, tag string, ts time.Time, structuredData string, msg string) error {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	if s.writer == nil {
 		return errors.New("connection already closed")
 	}
 
-	s.writer.SetFormatter(getSyslogFormatter(hostname, ts, tag))
+	s.writer.SetFormatter(getSyslogFormatter(hostname, ts, tag, structuredData))
 	_, err := s.writer.Write([]byte(msg))
 	return err
 }
@@ -93,16 +100,16 @@ func (s *Syslog) Close() error {
 	return err
 }
 
-// generate custom formatter based on hostname and tag
-func getSyslogFormatter(hostname string, ts time.Time, tag string) sl.Formatter {
+// generate custom formatter based on hostname, tag and structured data
+func getSyslogFormatter(hostname string, ts time.Time, tag string, structuredData string) sl.Formatter {
 	return func(priority sl.Priority, _, _, content string) string {
 		// strip whitespaces
 		s := strings.Replace(content, "\n", " ", -1)
 		s = strings.Replace(s, "\r", " ", -1)
 		s = strings.Replace(s, "\x00", " ", -1)
 
-		msg := fmt.Sprintf("<%d>1 %s %s %s - - - %s\n",
-			priority, ts.Format(rfc5424time), hostname, tag, s)
+		msg := fmt.Sprintf("<%d>1 %s %s %s - - %s %s\n",
+			priority, ts.Format(rfc5424time), hostname, tag, structuredData, s)
 		return msg
 	}
 }
@@ -0,0 +1,202 @@
+package taskcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// Key identifies a task cache; the same cache is shared by every build of
+// the job.
+type Key struct {
+	TeamID   int
+	JobID    int
+	StepName string
+	Path     string
+}
+
+// blobKey is where the cache is kept in the store. The step name and path
+// are hashed as they may contain anything.
+func (key Key) blobKey() string {
+	sum := sha256.Sum256([]byte(key.StepName + "\x00" + key.Path))
+	return fmt.Sprintf("teams/%d/jobs/%d/%x.tar", key.TeamID, key.JobID, sum)
+}
+
+//go:generate counterfeiter . RemoteCache
+
+// RemoteCache is a cluster-wide tier for task caches, so that a worker which
+// has not run a task before starts from the cache last uploaded by another
+// worker rather than from an empty cache.
+type RemoteCache interface {
+	// Hydrate creates the task cache on the worker from the remote tier, if
+	// the worker does not have the cache yet. It returns whether it did.
+	Hydrate(ctx context.Context, logger lager.Logger, worker worker.Worker, key Key, privileged bool) (bool, error)
+
+	// Upload snapshots the worker's task cache to the remote tier.
+	Upload(ctx context.Context, logger lager.Logger, worker worker.Worker, key Key) error
+}
+
+type remoteCache struct {
+	store       blobstore.Store
+	repository  db.RemoteTaskCacheRepository
+	compression compression.Compression
+}
+
+func NewRemoteCache(store blobstore.Store, repository db.RemoteTaskCacheRepository, compression compression.Compression) RemoteCache {
+	return &remoteCache{
+		store:       store,
+		repository:  repository,
+		compression: compression,
+	}
+}
+
+func (cache *remoteCache) Hydrate(ctx context.Context, logger lager.Logger, w worker.Worker, key Key, privileged bool) (bool, error) {
+	logger = logger.Session("hydrate-task-cache", lager.Data{
+		"step-name": key.StepName,
+		"path":      key.Path,
+		"worker":    w.Name(),
+	})
+
+	_, found, err := w.FindVolumeForTaskCache(logger, key.TeamID, key.JobID, key.StepName, key.Path)
+	if err != nil {
+		return false, err
+	}
+
+	if found {
+		return false, nil
+	}
+
+	remote, found, err := cache.repository.Find(key.JobID, key.StepName, key.Path)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	blob, err := cache.store.Get(ctx, remote.Key)
+	if err != nil {
+		if err == blobstore.ErrNotFound {
+			// the blob was removed from the store behind our back; forget it
+			// so that the next upload starts over
+			logger.Info("blob-not-found", lager.Data{"key": remote.Key})
+			return false, cache.repository.Delete(remote.ID)
+		}
+
+		return false, err
+	}
+
+	defer blob.Close()
+
+	volume, err := w.CreateVolumeForTaskCache(
+		logger,
+		worker.VolumeSpec{
+			Strategy:   baggageclaim.EmptyStrategy{},
+			Privileged: privileged,
+		},
+		key.TeamID,
+		key.JobID,
+		key.StepName,
+		key.Path,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	err = volume.StreamIn(ctx, ".", baggageclaim.Encoding(remote.Encoding), blob)
+	if err != nil {
+		// a partially hydrated cache must not be used by the task
+		destroyErr := volume.Destroy()
+		if destroyErr != nil {
+			logger.Error("failed-to-destroy-volume", destroyErr)
+		}
+
+		return false, err
+	}
+
+	err = volume.InitializeTaskCache(logger, key.JobID, key.StepName, key.Path, privileged)
+	if err != nil {
+		return false, err
+	}
+
+	err = cache.repository.MarkUsed(remote.ID)
+	if err != nil {
+		return false, err
+	}
+
+	logger.Info("hydrated", lager.Data{"size": remote.Size})
+
+	return true, nil
+}
+
+func (cache *remoteCache) Upload(ctx context.Context, logger lager.Logger, w worker.Worker, key Key) error {
+	logger = logger.Session("upload-task-cache", lager.Data{
+		"step-name": key.StepName,
+		"path":      key.Path,
+		"worker":    w.Name(),
+	})
+
+	volume, found, err := w.FindVolumeForTaskCache(logger, key.TeamID, key.JobID, key.StepName, key.Path)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		logger.Info("volume-not-found")
+		return nil
+	}
+
+	encoding := cache.compression.Encoding()
+
+	out, err := volume.StreamOut(ctx, ".", encoding)
+	if err != nil {
+		return err
+	}
+
+	defer out.Close()
+
+	blobKey := key.blobKey()
+	counter := &countingReader{reader: out}
+
+	err = cache.store.Put(ctx, blobKey, counter)
+	if err != nil {
+		return err
+	}
+
+	err = cache.repository.Save(db.RemoteTaskCache{
+		TeamID:   key.TeamID,
+		JobID:    key.JobID,
+		StepName: key.StepName,
+		Path:     key.Path,
+		Key:      blobKey,
+		Encoding: string(encoding),
+		Size:     counter.count,
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("uploaded", lager.Data{"size": counter.count})
+
+	return nil
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
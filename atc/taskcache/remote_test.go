@@ -0,0 +1,232 @@
+package taskcache_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/taskcache"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RemoteCache", func() {
+	var (
+		ctx    context.Context
+		logger *lagertest.TestLogger
+
+		dir            string
+		store          blobstore.Store
+		fakeRepository *dbfakes.FakeRemoteTaskCacheRepository
+		fakeWorker     *workerfakes.FakeWorker
+		fakeVolume     *workerfakes.FakeVolume
+
+		remoteCache taskcache.RemoteCache
+
+		key = taskcache.Key{
+			TeamID:   1,
+			JobID:    2,
+			StepName: "some-task",
+			Path:     "some/path",
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger = lagertest.NewTestLogger("test")
+
+		var err error
+		dir, err = ioutil.TempDir("", "taskcache")
+		Expect(err).ToNot(HaveOccurred())
+
+		store, err = blobstore.Local{Dir: dir}.Store()
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeRepository = new(dbfakes.FakeRemoteTaskCacheRepository)
+		fakeWorker = new(workerfakes.FakeWorker)
+		fakeWorker.NameReturns("some-worker")
+		fakeVolume = new(workerfakes.FakeVolume)
+
+		remoteCache = taskcache.NewRemoteCache(store, fakeRepository, compression.NewGzipCompression())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("Upload", func() {
+		var uploadErr error
+
+		JustBeforeEach(func() {
+			uploadErr = remoteCache.Upload(ctx, logger, fakeWorker, key)
+		})
+
+		Context("when the worker has the cache", func() {
+			BeforeEach(func() {
+				fakeWorker.FindVolumeForTaskCacheReturns(fakeVolume, true, nil)
+				fakeVolume.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-tarball")), nil)
+			})
+
+			It("streams the volume out compressed", func() {
+				Expect(fakeVolume.StreamOutCallCount()).To(Equal(1))
+				_, path, encoding := fakeVolume.StreamOutArgsForCall(0)
+				Expect(path).To(Equal("."))
+				Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+			})
+
+			It("saves the blob and records it", func() {
+				Expect(uploadErr).ToNot(HaveOccurred())
+
+				Expect(fakeRepository.SaveCallCount()).To(Equal(1))
+				saved := fakeRepository.SaveArgsForCall(0)
+				Expect(saved.TeamID).To(Equal(1))
+				Expect(saved.JobID).To(Equal(2))
+				Expect(saved.StepName).To(Equal("some-task"))
+				Expect(saved.Path).To(Equal("some/path"))
+				Expect(saved.Encoding).To(Equal(string(baggageclaim.GzipEncoding)))
+				Expect(saved.Size).To(Equal(int64(len("some-tarball"))))
+
+				blob, err := store.Get(ctx, saved.Key)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.ReadAll(blob)).To(Equal([]byte("some-tarball")))
+				blob.Close()
+			})
+
+			Context("when streaming out fails", func() {
+				BeforeEach(func() {
+					fakeVolume.StreamOutReturns(nil, errors.New("nope"))
+				})
+
+				It("does not record the cache", func() {
+					Expect(uploadErr).To(HaveOccurred())
+					Expect(fakeRepository.SaveCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the worker does not have the cache", func() {
+			It("does nothing", func() {
+				Expect(uploadErr).ToNot(HaveOccurred())
+				Expect(fakeRepository.SaveCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("Hydrate", func() {
+		var (
+			hydrated   bool
+			hydrateErr error
+		)
+
+		JustBeforeEach(func() {
+			hydrated, hydrateErr = remoteCache.Hydrate(ctx, logger, fakeWorker, key, true)
+		})
+
+		Context("when the worker already has the cache", func() {
+			BeforeEach(func() {
+				fakeWorker.FindVolumeForTaskCacheReturns(fakeVolume, true, nil)
+			})
+
+			It("leaves it alone", func() {
+				Expect(hydrateErr).ToNot(HaveOccurred())
+				Expect(hydrated).To(BeFalse())
+				Expect(fakeRepository.FindCallCount()).To(BeZero())
+				Expect(fakeWorker.CreateVolumeForTaskCacheCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the cache has never been uploaded", func() {
+			It("does nothing", func() {
+				Expect(hydrateErr).ToNot(HaveOccurred())
+				Expect(hydrated).To(BeFalse())
+				Expect(fakeWorker.CreateVolumeForTaskCacheCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the cache has been uploaded", func() {
+			var streamedIn []byte
+
+			BeforeEach(func() {
+				Expect(store.Put(ctx, "some-key", bytes.NewBufferString("some-tarball"))).To(Succeed())
+
+				fakeRepository.FindReturns(db.RemoteTaskCache{
+					ID:       42,
+					Key:      "some-key",
+					Encoding: string(baggageclaim.ZstdEncoding),
+				}, true, nil)
+
+				fakeWorker.CreateVolumeForTaskCacheReturns(fakeVolume, nil)
+
+				streamedIn = nil
+				fakeVolume.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, tarStream io.Reader) error {
+					var err error
+					streamedIn, err = ioutil.ReadAll(tarStream)
+					return err
+				}
+			})
+
+			It("creates the cache on the worker from the blob", func() {
+				Expect(hydrateErr).ToNot(HaveOccurred())
+				Expect(hydrated).To(BeTrue())
+
+				Expect(fakeWorker.CreateVolumeForTaskCacheCallCount()).To(Equal(1))
+				_, spec, teamID, jobID, stepName, path := fakeWorker.CreateVolumeForTaskCacheArgsForCall(0)
+				Expect(spec).To(Equal(worker.VolumeSpec{
+					Strategy:   baggageclaim.EmptyStrategy{},
+					Privileged: true,
+				}))
+				Expect(teamID).To(Equal(1))
+				Expect(jobID).To(Equal(2))
+				Expect(stepName).To(Equal("some-task"))
+				Expect(path).To(Equal("some/path"))
+
+				Expect(fakeVolume.StreamInCallCount()).To(Equal(1))
+				_, destPath, encoding, _ := fakeVolume.StreamInArgsForCall(0)
+				Expect(destPath).To(Equal("."))
+				Expect(encoding).To(Equal(baggageclaim.ZstdEncoding))
+				Expect(streamedIn).To(Equal([]byte("some-tarball")))
+
+				Expect(fakeVolume.InitializeTaskCacheCallCount()).To(Equal(1))
+				Expect(fakeRepository.MarkUsedCallCount()).To(Equal(1))
+				Expect(fakeRepository.MarkUsedArgsForCall(0)).To(Equal(42))
+			})
+
+			Context("when streaming in fails", func() {
+				BeforeEach(func() {
+					fakeVolume.StreamInReturns(errors.New("nope"))
+				})
+
+				It("destroys the partial cache", func() {
+					Expect(hydrateErr).To(HaveOccurred())
+					Expect(fakeVolume.DestroyCallCount()).To(Equal(1))
+					Expect(fakeVolume.InitializeTaskCacheCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the blob is missing from the store", func() {
+				BeforeEach(func() {
+					Expect(store.Delete(ctx, "some-key")).To(Succeed())
+				})
+
+				It("forgets the cache", func() {
+					Expect(hydrateErr).ToNot(HaveOccurred())
+					Expect(hydrated).To(BeFalse())
+					Expect(fakeRepository.DeleteCallCount()).To(Equal(1))
+					Expect(fakeRepository.DeleteArgsForCall(0)).To(Equal(42))
+				})
+			})
+		})
+	})
+})
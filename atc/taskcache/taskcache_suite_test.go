@@ -0,0 +1,13 @@
+package taskcache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTaskCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Task Cache Suite")
+}
@@ -2,21 +2,151 @@ package atc
 
 import (
 	"errors"
+	"fmt"
+	"time"
 )
 
 var (
 	ErrAuthConfigEmpty   = errors.New("auth config for the team must not be empty")
 	ErrAuthConfigInvalid = errors.New("auth config for the team does not have users and groups configured")
+	ErrQuotaInvalid      = errors.New("quota for the team must not have negative limits")
 )
 
 type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	// Quota is left untouched on an existing team when it is omitted.
+	Quota *TeamQuota `json:"quota,omitempty"`
+
+	// CustomRoles are granted to users and groups through Auth, just like
+	// the built-in roles.
+	CustomRoles CustomRoles `json:"custom_roles,omitempty"`
 }
 
 func (team Team) Validate() error {
-	return team.Auth.Validate()
+	err := team.Auth.Validate()
+	if err != nil {
+		return err
+	}
+
+	if team.Quota != nil {
+		err = team.Quota.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return team.CustomRoles.Validate()
+}
+
+// CustomRoles maps the name of each role defined by a team to the API
+// actions, e.g. CreateJobBuild, which it permits on the team.
+type CustomRoles map[string][]string
+
+func (roles CustomRoles) Validate() error {
+	for role, actions := range roles {
+		if role == "" {
+			return errors.New("custom role must have a name")
+		}
+
+		if len(actions) == 0 {
+			return fmt.Errorf("custom role '%s' must permit at least one action", role)
+		}
+	}
+
+	return nil
+}
+
+// Permits returns whether the named custom role permits the given action.
+func (roles CustomRoles) Permits(role string, action string) bool {
+	for _, permitted := range roles[role] {
+		if permitted == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TeamQuota limits how much of the worker fleet a team may use at once. A
+// limit of zero means unlimited. The limits are checked before a build is
+// started, so a running build may take the team past them.
+type TeamQuota struct {
+	MaxConcurrentBuilds int    `json:"max_concurrent_builds,omitempty"`
+	MaxContainers       int    `json:"max_containers,omitempty"`
+	MaxVolumeDisk       uint64 `json:"max_volume_disk,omitempty"`
+
+	// MaxTaskCacheSize and TaskCacheMaxAge bound the team's task caches in
+	// the remote cache tier. The least recently used caches are evicted
+	// first.
+	MaxTaskCacheSize uint64 `json:"max_task_cache_size,omitempty"`
+	TaskCacheMaxAge  string `json:"task_cache_max_age,omitempty"`
+}
+
+const (
+	QuotaMaxConcurrentBuilds = "max_concurrent_builds"
+	QuotaMaxContainers       = "max_containers"
+	QuotaMaxVolumeDisk       = "max_volume_disk"
+)
+
+func (quota TeamQuota) Validate() error {
+	if quota.MaxConcurrentBuilds < 0 || quota.MaxContainers < 0 {
+		return ErrQuotaInvalid
+	}
+
+	maxAge, err := quota.TaskCacheMaxAgeDuration()
+	if err != nil {
+		return err
+	}
+
+	if maxAge < 0 {
+		return ErrQuotaInvalid
+	}
+
+	return nil
+}
+
+// TaskCacheMaxAgeDuration returns how long a remote task cache may go unused
+// before it is evicted, or zero if it may be kept forever.
+func (quota TeamQuota) TaskCacheMaxAgeDuration() (time.Duration, error) {
+	if quota.TaskCacheMaxAge == "" {
+		return 0, nil
+	}
+
+	maxAge, err := time.ParseDuration(quota.TaskCacheMaxAge)
+	if err != nil {
+		return 0, fmt.Errorf("invalid task cache max age '%s': %w", quota.TaskCacheMaxAge, err)
+	}
+
+	return maxAge, nil
+}
+
+// BuildsReached returns whether the team may not schedule any more builds.
+func (quota TeamQuota) BuildsReached(usage TeamUsage) bool {
+	return quota.MaxConcurrentBuilds > 0 && usage.ConcurrentBuilds >= quota.MaxConcurrentBuilds
+}
+
+// WorkerQuotaReached returns the name of the quota which stops the team from
+// starting any more builds because of its use of the workers, if any.
+func (quota TeamQuota) WorkerQuotaReached(usage TeamUsage) (string, bool) {
+	if quota.MaxContainers > 0 && usage.Containers >= quota.MaxContainers {
+		return QuotaMaxContainers, true
+	}
+
+	if quota.MaxVolumeDisk > 0 && usage.VolumeDisk >= quota.MaxVolumeDisk {
+		return QuotaMaxVolumeDisk, true
+	}
+
+	return "", false
+}
+
+// TeamUsage is how much of the worker fleet a team is currently using.
+type TeamUsage struct {
+	ConcurrentBuilds int    `json:"concurrent_builds"`
+	Containers       int    `json:"containers"`
+	VolumeDisk       uint64 `json:"volume_disk"`
 }
 
 type TeamAuth map[string]map[string][]string
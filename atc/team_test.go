@@ -0,0 +1,84 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamQuota", func() {
+	Describe("Validate", func() {
+		It("allows an empty quota", func() {
+			Expect(atc.TeamQuota{}.Validate()).To(Succeed())
+		})
+
+		It("rejects negative limits", func() {
+			Expect(atc.TeamQuota{MaxConcurrentBuilds: -1}.Validate()).To(Equal(atc.ErrQuotaInvalid))
+			Expect(atc.TeamQuota{MaxContainers: -1}.Validate()).To(Equal(atc.ErrQuotaInvalid))
+			Expect(atc.TeamQuota{TaskCacheMaxAge: "-1h"}.Validate()).To(Equal(atc.ErrQuotaInvalid))
+		})
+
+		It("rejects an invalid task cache max age", func() {
+			Expect(atc.TeamQuota{TaskCacheMaxAge: "a week"}.Validate()).To(MatchError(ContainSubstring("invalid task cache max age")))
+		})
+	})
+
+	DescribeTable("BuildsReached",
+		func(quota atc.TeamQuota, usage atc.TeamUsage, reached bool) {
+			Expect(quota.BuildsReached(usage)).To(Equal(reached))
+		},
+		Entry("unlimited", atc.TeamQuota{}, atc.TeamUsage{ConcurrentBuilds: 100}, false),
+		Entry("below the limit", atc.TeamQuota{MaxConcurrentBuilds: 2}, atc.TeamUsage{ConcurrentBuilds: 1}, false),
+		Entry("at the limit", atc.TeamQuota{MaxConcurrentBuilds: 2}, atc.TeamUsage{ConcurrentBuilds: 2}, true),
+	)
+
+	DescribeTable("WorkerQuotaReached",
+		func(quota atc.TeamQuota, usage atc.TeamUsage, name string, reached bool) {
+			actualName, actualReached := quota.WorkerQuotaReached(usage)
+			Expect(actualName).To(Equal(name))
+			Expect(actualReached).To(Equal(reached))
+		},
+		Entry("unlimited", atc.TeamQuota{}, atc.TeamUsage{Containers: 100, VolumeDisk: 1024}, "", false),
+		Entry("below the limits", atc.TeamQuota{MaxContainers: 2, MaxVolumeDisk: 1024}, atc.TeamUsage{Containers: 1, VolumeDisk: 512}, "", false),
+		Entry("at the container limit", atc.TeamQuota{MaxContainers: 2}, atc.TeamUsage{Containers: 2}, atc.QuotaMaxContainers, true),
+		Entry("over the volume disk limit", atc.TeamQuota{MaxVolumeDisk: 1024}, atc.TeamUsage{VolumeDisk: 2048}, atc.QuotaMaxVolumeDisk, true),
+		Entry("ignores concurrent builds", atc.TeamQuota{MaxConcurrentBuilds: 1}, atc.TeamUsage{ConcurrentBuilds: 5}, "", false),
+	)
+})
+
+var _ = Describe("CustomRoles", func() {
+	Describe("Validate", func() {
+		It("allows roles which permit actions", func() {
+			roles := atc.CustomRoles{"releaser": {atc.CreateJobBuild, atc.PinResourceVersion}}
+			Expect(roles.Validate()).To(Succeed())
+		})
+
+		It("rejects roles without actions", func() {
+			roles := atc.CustomRoles{"releaser": {}}
+			Expect(roles.Validate()).To(MatchError("custom role 'releaser' must permit at least one action"))
+		})
+
+		It("rejects roles without a name", func() {
+			roles := atc.CustomRoles{"": {atc.CreateJobBuild}}
+			Expect(roles.Validate()).To(MatchError("custom role must have a name"))
+		})
+	})
+
+	Describe("Permits", func() {
+		roles := atc.CustomRoles{"releaser": {atc.CreateJobBuild}}
+
+		It("permits the role's actions", func() {
+			Expect(roles.Permits("releaser", atc.CreateJobBuild)).To(BeTrue())
+		})
+
+		It("does not permit other actions", func() {
+			Expect(roles.Permits("releaser", atc.SaveConfig)).To(BeFalse())
+		})
+
+		It("does not permit anything for unknown roles", func() {
+			Expect(roles.Permits("owner", atc.CreateJobBuild)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,120 @@
+package timer
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type trigger struct {
+	jobFactory db.JobFactory
+	clock      clock.Clock
+}
+
+// NewTrigger returns a component which creates a build of each job with a
+// schedule once its next fire time has passed.
+func NewTrigger(jobFactory db.JobFactory, clock clock.Clock) *trigger {
+	return &trigger{
+		jobFactory: jobFactory,
+		clock:      clock,
+	}
+}
+
+func (t *trigger) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("schedule-trigger")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	jobs, err := t.jobFactory.ScheduledJobs()
+	if err != nil {
+		logger.Error("failed-to-get-scheduled-jobs", err)
+		return err
+	}
+
+	now := t.clock.Now()
+
+	for _, job := range jobs {
+		jobLogger := logger.WithData(lager.Data{
+			"team":     job.TeamName(),
+			"pipeline": job.PipelineName(),
+			"job":      job.Name(),
+		})
+
+		err := t.trigger(jobLogger, job, now)
+		if err != nil {
+			jobLogger.Error("failed-to-trigger-job", err)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (t *trigger) trigger(logger lager.Logger, job db.Job, now time.Time) error {
+	config, err := job.Config()
+	if err != nil {
+		return err
+	}
+
+	if config.Schedule == nil {
+		return nil
+	}
+
+	schedule := *config.Schedule
+
+	// the next fire time is only unknown when the schedule was just configured
+	// or changed, in which case it is computed without triggering a build
+	due := !job.NextScheduledTime().IsZero()
+	if due && now.Before(job.NextFireTime()) {
+		return nil
+	}
+
+	// fire times missed while the job was paused or the web nodes were down
+	// only result in the one build below, rather than one for each of them
+	next, err := schedule.Next(now)
+	if err != nil {
+		return err
+	}
+
+	jitter, err := schedule.JitterDuration()
+	if err != nil {
+		return err
+	}
+
+	fireTime := next
+	if jitter > 0 {
+		fireTime = next.Add(time.Duration(rand.Int63n(int64(jitter))))
+	}
+
+	if !due {
+		return job.SaveNextSchedule(next, fireTime)
+	}
+
+	build, created, err := job.CreateScheduledBuild(atc.BuildCause{
+		Type: atc.BuildCauseSchedule,
+		Schedule: &atc.ScheduleCause{
+			Cron:          schedule.Cron,
+			Location:      schedule.Location,
+			ScheduledTime: job.NextScheduledTime().Unix(),
+		},
+	}, next, fireTime)
+	if err != nil {
+		return err
+	}
+
+	if !created {
+		logger.Debug("already-triggered")
+		return nil
+	}
+
+	logger.Info("triggered-build", lager.Data{"build": build.Name()})
+
+	return nil
+}
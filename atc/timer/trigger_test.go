@@ -0,0 +1,174 @@
+package timer_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/timer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trigger", func() {
+	var (
+		fakeJobFactory *dbfakes.FakeJobFactory
+		fakeJob        *dbfakes.FakeJob
+		fakeClock      *fakeclock.FakeClock
+
+		schedule *atc.JobSchedule
+		now      time.Time
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeJobFactory = new(dbfakes.FakeJobFactory)
+
+		now = time.Date(2021, 5, 3, 9, 30, 0, 0, time.UTC)
+		fakeClock = fakeclock.NewFakeClock(now)
+
+		schedule = &atc.JobSchedule{Cron: "0 * * * *"}
+
+		fakeJob = new(dbfakes.FakeJob)
+		fakeJob.NameReturns("some-job")
+		fakeJob.CreateScheduledBuildReturns(new(dbfakes.FakeBuild), true, nil)
+
+		fakeJobFactory.ScheduledJobsReturns(db.Jobs{fakeJob}, nil)
+	})
+
+	JustBeforeEach(func() {
+		fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job", Schedule: schedule}, nil)
+
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = timer.NewTrigger(fakeJobFactory, fakeClock).Run(ctx)
+	})
+
+	Context("when the next fire time is not known yet", func() {
+		It("saves the next fire time without triggering a build", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+
+			Expect(fakeJob.SaveNextScheduleCallCount()).To(Equal(1))
+			scheduledTime, fireTime := fakeJob.SaveNextScheduleArgsForCall(0)
+			Expect(scheduledTime).To(BeTemporally("==", time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)))
+			Expect(fireTime).To(BeTemporally("==", scheduledTime))
+		})
+
+		Context("when the schedule has a location", func() {
+			BeforeEach(func() {
+				schedule = &atc.JobSchedule{Cron: "0 9 * * *", Location: "America/New_York"}
+			})
+
+			It("evaluates the cron expression in that location", func() {
+				scheduledTime, _ := fakeJob.SaveNextScheduleArgsForCall(0)
+				Expect(scheduledTime).To(BeTemporally("==", time.Date(2021, 5, 3, 13, 0, 0, 0, time.UTC)))
+			})
+		})
+
+		Context("when the schedule has jitter", func() {
+			BeforeEach(func() {
+				schedule = &atc.JobSchedule{Cron: "0 * * * *", Jitter: "10m"}
+			})
+
+			It("delays the fire time by up to the jitter", func() {
+				scheduledTime, fireTime := fakeJob.SaveNextScheduleArgsForCall(0)
+				Expect(fireTime).To(BeTemporally(">=", scheduledTime))
+				Expect(fireTime).To(BeTemporally("<", scheduledTime.Add(10*time.Minute)))
+			})
+		})
+	})
+
+	Context("when the next fire time has not passed yet", func() {
+		BeforeEach(func() {
+			fakeJob.NextScheduledTimeReturns(now.Add(30 * time.Minute))
+			fakeJob.NextFireTimeReturns(now.Add(30 * time.Minute))
+		})
+
+		It("does nothing", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+			Expect(fakeJob.SaveNextScheduleCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the next fire time has passed", func() {
+		var scheduledTime time.Time
+
+		BeforeEach(func() {
+			scheduledTime = now.Add(-30 * time.Minute)
+
+			fakeJob.NextScheduledTimeReturns(scheduledTime)
+			fakeJob.NextFireTimeReturns(scheduledTime.Add(time.Minute))
+		})
+
+		It("creates a build recording the schedule as its cause", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+			cause, _, _ := fakeJob.CreateScheduledBuildArgsForCall(0)
+			Expect(cause).To(Equal(atc.BuildCause{
+				Type: atc.BuildCauseSchedule,
+				Schedule: &atc.ScheduleCause{
+					Cron:          "0 * * * *",
+					ScheduledTime: scheduledTime.Unix(),
+				},
+			}))
+		})
+
+		It("moves on to the following fire time along with the build", func() {
+			_, nextScheduledTime, nextFireTime := fakeJob.CreateScheduledBuildArgsForCall(0)
+			Expect(nextScheduledTime).To(BeTemporally("==", time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)))
+			Expect(nextFireTime).To(BeTemporally("==", nextScheduledTime))
+
+			Expect(fakeJob.SaveNextScheduleCallCount()).To(BeZero())
+		})
+
+		Context("when another web node already triggered the build", func() {
+			BeforeEach(func() {
+				fakeJob.CreateScheduledBuildReturns(nil, false, nil)
+			})
+
+			It("does not fail", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeJob.SaveNextScheduleCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when creating the build fails", func() {
+			BeforeEach(func() {
+				fakeJob.CreateScheduledBuildReturns(nil, false, errors.New("nope"))
+			})
+
+			It("does not move on to the following fire time", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeJob.SaveNextScheduleCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the job no longer has a schedule", func() {
+		BeforeEach(func() {
+			schedule = nil
+		})
+
+		It("does nothing", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+			Expect(fakeJob.SaveNextScheduleCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when getting the jobs fails", func() {
+		BeforeEach(func() {
+			fakeJobFactory.ScheduledJobsReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
@@ -17,6 +17,7 @@ type UserInfo struct {
 	IsAdmin       bool                `json:"is_admin"`
 	IsSystem      bool                `json:"is_system"`
 	Teams         map[string][]string `json:"teams"`
+	TeamActions   map[string][]string `json:"team_actions,omitempty"`
 	Connector     string              `json:"connector"`
 	DisplayUserId string              `json:"display_user_id"`
 }
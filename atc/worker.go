@@ -20,16 +20,22 @@ type Worker struct {
 	ActiveVolumes    int `json:"active_volumes"`
 	ActiveTasks      int `json:"active_tasks"`
 
+	AllocatableCPU    uint64 `json:"allocatable_cpu,omitempty"`
+	AllocatableMemory uint64 `json:"allocatable_memory,omitempty"`
+	ReservedCPU       uint64 `json:"reserved_cpu,omitempty"`
+	ReservedMemory    uint64 `json:"reserved_memory,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
-	Platform  string   `json:"platform"`
-	Tags      []string `json:"tags"`
-	Team      string   `json:"team"`
-	Name      string   `json:"name"`
-	Version   string   `json:"version"`
-	StartTime int64    `json:"start_time"`
-	Ephemeral bool     `json:"ephemeral"`
-	State     string   `json:"state"`
+	Platform  string       `json:"platform"`
+	Tags      []string     `json:"tags"`
+	Labels    WorkerLabels `json:"labels,omitempty"`
+	Team      string       `json:"team"`
+	Name      string       `json:"name"`
+	Version   string       `json:"version"`
+	StartTime int64        `json:"start_time"`
+	Ephemeral bool         `json:"ephemeral"`
+	State     string       `json:"state"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
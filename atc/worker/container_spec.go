@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -13,6 +14,15 @@ type WorkerSpec struct {
 	ResourceType string
 	Tags         []string
 	TeamID       int
+
+	// NodeSelector is matched against the labels of each worker. Workers must
+	// match its hard expressions, and are preferred when matching its soft
+	// expressions.
+	NodeSelector atc.NodeSelector
+
+	// Priority is only used to order steps waiting for a worker; it does not
+	// affect which workers are compatible.
+	Priority atc.JobPriority
 }
 
 type ContainerSpec struct {
@@ -140,5 +150,11 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	for _, req := range spec.NodeSelector {
+		if !req.Soft {
+			attrs = append(attrs, fmt.Sprintf("label '%s'", req))
+		}
+	}
+
 	return strings.Join(attrs, ", ")
 }
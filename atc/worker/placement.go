@@ -12,7 +12,7 @@ import (
 )
 
 type ContainerPlacementStrategyOptions struct {
-	ContainerPlacementStrategy   []string `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they will be applied in order. Random strategy should only be used alone."`
+	ContainerPlacementStrategy   []string `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" choice:"available-resources" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they will be applied in order. Random strategy should only be used alone."`
 	MaxActiveTasksPerWorker      int      `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
 	MaxActiveContainersPerWorker int      `long:"max-active-containers-per-worker" default:"0" description:"Maximum allowed number of active containers per worker. Has effect only when used with limit-active-containers placement strategy. 0 means no limit."`
 	MaxActiveVolumesPerWorker    int      `long:"max-active-volumes-per-worker" default:"0" description:"Maximum allowed number of active volumes per worker. Has effect only when used with limit-active-volumes placement strategy. 0 means no limit."`
@@ -22,6 +22,8 @@ var (
 	ErrTooManyActiveTasks = errors.New("worker has too many active tasks")
 	ErrTooManyContainers  = errors.New("worker has too many containers")
 	ErrTooManyVolumes     = errors.New("worker has too many volumes")
+
+	ErrInsufficientResources = errors.New("worker has insufficient cpu or memory available")
 )
 
 type NoWorkerFitContainerPlacementStrategyError struct {
@@ -44,11 +46,11 @@ type ContainerPlacementStrategy interface {
 
 	// Attempts to pick the given worker to run the specified container, checking the worker abides
 	// by the conditions of the specific strategy.
-	Pick(lager.Logger, Worker, ContainerSpec) error
+	Pick(lager.Logger, Worker, db.ContainerOwner, ContainerSpec) error
 
 	// Releases any resources acquired by any configured strategies as part of
-	// picking the candidate worker.
-	Release(lager.Logger, Worker, ContainerSpec)
+	// picking the candidate worker for the container owner.
+	Release(lager.Logger, Worker, db.ContainerOwner, ContainerSpec)
 }
 
 type ChainPlacementStrategy struct {
@@ -92,6 +94,9 @@ func NewChainPlacementStrategy(opts ContainerPlacementStrategyOptions) (*ChainPl
 			}
 			cps.nodes = append(cps.nodes, newLimitActiveVolumesPlacementStrategy(strategy, opts.MaxActiveVolumesPerWorker))
 
+		case "available-resources":
+			cps.nodes = append(cps.nodes, newAvailableResourcesStrategy(strategy))
+
 		case "volume-locality":
 			cps.nodes = append(cps.nodes, newVolumeLocalityStrategy(strategy))
 
@@ -148,7 +153,7 @@ func (strategy *ChainPlacementStrategy) Order(logger lager.Logger, workers []Wor
 	return candidates, nil
 }
 
-func (strategy *ChainPlacementStrategy) Pick(logger lager.Logger, worker Worker, spec ContainerSpec) error {
+func (strategy *ChainPlacementStrategy) Pick(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) error {
 	var err error
 	var i int
 
@@ -156,7 +161,7 @@ func (strategy *ChainPlacementStrategy) Pick(logger lager.Logger, worker Worker,
 	// Release on the relevant nodes when an error occurs.
 	for i = 0; i < len(strategy.nodes); i++ {
 		node := strategy.nodes[i]
-		err = node.Pick(logger, worker, spec)
+		err = node.Pick(logger, worker, owner, spec)
 
 		if err != nil {
 			break
@@ -168,17 +173,17 @@ func (strategy *ChainPlacementStrategy) Pick(logger lager.Logger, worker Worker,
 		// Pick. Decrement "i" initially to skip stage which failed Pick.
 		for i--; i >= 0; i-- {
 			node := strategy.nodes[i]
-			node.Release(logger, worker, spec)
+			node.Release(logger, worker, owner, spec)
 		}
 	}
 
 	return err
 }
 
-func (strategy *ChainPlacementStrategy) Release(logger lager.Logger, worker Worker, spec ContainerSpec) {
+func (strategy *ChainPlacementStrategy) Release(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) {
 	for i := len(strategy.nodes) - 1; i >= 0; i-- {
 		node := strategy.nodes[i]
-		node.Release(logger, worker, spec)
+		node.Release(logger, worker, owner, spec)
 	}
 }
 
@@ -231,13 +236,13 @@ func (strategy *VolumeLocalityStrategy) Order(logger lager.Logger, workers []Wor
 	return candidates, nil
 }
 
-func (strategy *VolumeLocalityStrategy) Pick(logger lager.Logger, worker Worker, spec ContainerSpec) error {
+func (strategy *VolumeLocalityStrategy) Pick(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) error {
 	// This strategy doesn't have any requirements on the number of volumes which must exist
 	// on a worker for the container to be scheduled on it
 	return nil
 }
 
-func (strategy *VolumeLocalityStrategy) Release(logger lager.Logger, worker Worker, spec ContainerSpec) {
+func (strategy *VolumeLocalityStrategy) Release(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) {
 }
 
 // Strategy which orders candidate workers based off the number of build containers which
@@ -267,11 +272,11 @@ func (strategy *FewestBuildContainersStrategy) Order(logger lager.Logger, worker
 	return candidates, nil
 }
 
-func (strategy *FewestBuildContainersStrategy) Pick(logger lager.Logger, worker Worker, spec ContainerSpec) error {
+func (strategy *FewestBuildContainersStrategy) Pick(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) error {
 	return nil
 }
 
-func (strategy *FewestBuildContainersStrategy) Release(logger lager.Logger, worker Worker, spec ContainerSpec) {
+func (strategy *FewestBuildContainersStrategy) Release(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) {
 }
 
 type LimitActiveTasksStrategy struct {
@@ -313,7 +318,7 @@ func (strategy *LimitActiveTasksStrategy) Order(logger lager.Logger, workers []W
 	return candidates, nil
 }
 
-func (strategy *LimitActiveTasksStrategy) Pick(logger lager.Logger, worker Worker, spec ContainerSpec) error {
+func (strategy *LimitActiveTasksStrategy) Pick(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) error {
 	if spec.Type != db.ContainerTypeTask {
 		return nil
 	}
@@ -336,7 +341,7 @@ func (strategy *LimitActiveTasksStrategy) Pick(logger lager.Logger, worker Worke
 	return nil
 }
 
-func (strategy *LimitActiveTasksStrategy) Release(logger lager.Logger, worker Worker, spec ContainerSpec) {
+func (strategy *LimitActiveTasksStrategy) Release(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) {
 	if spec.Type != db.ContainerTypeTask {
 		return
 	}
@@ -363,7 +368,7 @@ func (strategy *LimitActiveContainersStrategy) Order(logger lager.Logger, worker
 	return workers, nil
 }
 
-func (strategy *LimitActiveContainersStrategy) Pick(logger lager.Logger, worker Worker, spec ContainerSpec) error {
+func (strategy *LimitActiveContainersStrategy) Pick(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) error {
 	if strategy.maxContainers > 0 && worker.ActiveContainers() > strategy.maxContainers {
 		return ErrTooManyContainers
 	}
@@ -371,7 +376,7 @@ func (strategy *LimitActiveContainersStrategy) Pick(logger lager.Logger, worker
 	return nil
 }
 
-func (strategy *LimitActiveContainersStrategy) Release(logger lager.Logger, worker Worker, spec ContainerSpec) {
+func (strategy *LimitActiveContainersStrategy) Release(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) {
 }
 
 type LimitActiveVolumesStrategy struct {
@@ -390,7 +395,7 @@ func (strategy *LimitActiveVolumesStrategy) Order(logger lager.Logger, workers [
 	return workers, nil
 }
 
-func (strategy *LimitActiveVolumesStrategy) Pick(logger lager.Logger, worker Worker, spec ContainerSpec) error {
+func (strategy *LimitActiveVolumesStrategy) Pick(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) error {
 	if strategy.maxVolumes > 0 && worker.ActiveVolumes() > strategy.maxVolumes {
 		return ErrTooManyVolumes
 	}
@@ -398,5 +403,129 @@ func (strategy *LimitActiveVolumesStrategy) Pick(logger lager.Logger, worker Wor
 	return nil
 }
 
-func (strategy *LimitActiveVolumesStrategy) Release(logger lager.Logger, worker Worker, spec ContainerSpec) {
+func (strategy *LimitActiveVolumesStrategy) Release(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) {
+}
+
+// Strategy which orders candidate workers by the CPU and memory they have left
+// after subtracting the limits of the containers already placed on them, and
+// reserves the container's limits on the picked worker until it is released.
+// Reservations are held by the container's owner, so they can be released by
+// whichever ATC runs the owner's step to completion.
+//
+// Workers which don't advertise their allocatable CPU or memory are never
+// considered full, and are ordered after the workers that do.
+type AvailableResourcesStrategy struct {
+	NamedPlacementStrategy
+}
+
+func newAvailableResourcesStrategy(name string) ContainerPlacementStrategy {
+	return &AvailableResourcesStrategy{
+		NamedPlacementStrategy{name},
+	}
+}
+
+type availableResources struct {
+	advertised bool
+	cpu        uint64
+	memory     uint64
+}
+
+func (strategy *AvailableResourcesStrategy) Order(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	cpu, memory := requestedResources(spec)
+	if cpu == 0 && memory == 0 {
+		return workers, nil
+	}
+
+	candidates := []Worker{}
+	available := map[Worker]availableResources{}
+
+	for _, worker := range workers {
+		allocatableCPU := worker.AllocatableCPU()
+		allocatableMemory := worker.AllocatableMemory()
+
+		if (allocatableCPU != 0 && allocatableCPU < cpu) || (allocatableMemory != 0 && allocatableMemory < memory) {
+			// the container would never fit on this worker, even if it were idle
+			continue
+		}
+
+		reservedCPU, reservedMemory, err := worker.ReservedResources()
+		if err != nil {
+			logger.Error("Cannot retrieve reserved resources on worker. Skipping.", err)
+			continue
+		}
+
+		candidates = append(candidates, worker)
+		available[worker] = availableResources{
+			advertised: allocatableCPU != 0 || allocatableMemory != 0,
+			cpu:        remaining(allocatableCPU, reservedCPU),
+			memory:     remaining(allocatableMemory, reservedMemory),
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := available[candidates[i]], available[candidates[j]]
+		if a.advertised != b.advertised {
+			return a.advertised
+		}
+
+		if a.memory != b.memory {
+			return a.memory > b.memory
+		}
+
+		return a.cpu > b.cpu
+	})
+
+	return candidates, nil
+}
+
+func (strategy *AvailableResourcesStrategy) Pick(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) error {
+	cpu, memory := requestedResources(spec)
+	if cpu == 0 && memory == 0 {
+		return nil
+	}
+
+	reserved, err := worker.ReserveResources(owner, cpu, memory)
+	if err != nil {
+		return err
+	}
+
+	if !reserved {
+		return ErrInsufficientResources
+	}
+
+	return nil
+}
+
+func (strategy *AvailableResourcesStrategy) Release(logger lager.Logger, worker Worker, owner db.ContainerOwner, spec ContainerSpec) {
+	cpu, memory := requestedResources(spec)
+	if cpu == 0 && memory == 0 {
+		return
+	}
+
+	err := worker.ReleaseResources(owner)
+	if err != nil {
+		logger.Error("failed-to-release-resources", err)
+	}
+}
+
+func requestedResources(spec ContainerSpec) (uint64, uint64) {
+	var cpu, memory uint64
+
+	if spec.Limits.CPU != nil {
+		cpu = *spec.Limits.CPU
+	}
+
+	if spec.Limits.Memory != nil {
+		memory = *spec.Limits.Memory
+	}
+
+	return cpu, memory
+}
+
+func remaining(allocatable uint64, reserved uint64) uint64 {
+	if reserved >= allocatable {
+		return 0
+	}
+
+	return allocatable - reserved
 }
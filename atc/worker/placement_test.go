@@ -7,6 +7,8 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 
@@ -22,9 +24,10 @@ var _ = Describe("ContainerPlacementStrategy", func() {
 		strategy    ContainerPlacementStrategy
 		strategyErr error
 
-		containerSpec ContainerSpec
-		workerFakes   []*workerfakes.FakeWorker
-		workers       []Worker
+		containerOwner db.ContainerOwner
+		containerSpec  ContainerSpec
+		workerFakes    []*workerfakes.FakeWorker
+		workers        []Worker
 
 		orderedWorkers []Worker
 		orderErr       error
@@ -83,7 +86,7 @@ var _ = Describe("ContainerPlacementStrategy", func() {
 		pickedWorker = nil
 
 		for _, worker := range orderedWorkers {
-			pickErr = strategy.Pick(logger, worker, containerSpec)
+			pickErr = strategy.Pick(logger, worker, containerOwner, containerSpec)
 
 			if pickErr == nil {
 				pickedWorker = worker
@@ -93,7 +96,7 @@ var _ = Describe("ContainerPlacementStrategy", func() {
 
 		if pickedWorker != nil {
 			fmt.Fprintln(GinkgoWriter, fmt.Sprintf("picked worker: %s", pickedWorker.Name()))
-			strategy.Release(logger, pickedWorker, containerSpec)
+			strategy.Release(logger, pickedWorker, containerOwner, containerSpec)
 		}
 
 		return pickedWorker
@@ -102,6 +105,8 @@ var _ = Describe("ContainerPlacementStrategy", func() {
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("placement-tests")
 
+		containerOwner = new(dbfakes.FakeContainerOwner)
+
 		containerSpec = ContainerSpec{
 			ImageSpec: ImageSpec{ResourceType: "some-type"},
 			TeamID:    4567,
@@ -676,6 +681,162 @@ var _ = Describe("ContainerPlacementStrategy", func() {
 		})
 	})
 
+	Describe("available-resources", func() {
+		var cpu, memory uint64
+
+		JustBeforeEach(func() {
+			strategy, strategyErr = NewChainPlacementStrategy(ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"available-resources"},
+			})
+			Expect(strategyErr).ToNot(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			cpu = 512
+			memory = 4 * 1024 * 1024 * 1024
+
+			containerSpec.Type = "task"
+			containerSpec.Limits = ContainerLimits{CPU: &cpu, Memory: &memory}
+
+			for _, fake := range workerFakes {
+				fake.AllocatableCPUReturns(1024)
+				fake.AllocatableMemoryReturns(16 * 1024 * 1024 * 1024)
+				fake.ReserveResourcesReturns(true, nil)
+			}
+		})
+
+		Describe("strategy.Order", func() {
+			JustBeforeEach(func() {
+				order(true)
+			})
+
+			Context("with multiple workers", func() {
+				BeforeEach(func() {
+					workerFakes[0].ReservedResourcesReturns(0, 8*1024*1024*1024, nil)
+					workerFakes[1].ReservedResourcesReturns(0, 2*1024*1024*1024, nil)
+					workerFakes[2].ReservedResourcesReturns(0, 4*1024*1024*1024, nil)
+				})
+
+				It("orders workers by available memory", func() {
+					Expect(orderedWorkers).To(Equal([]Worker{workers[1], workers[2], workers[0]}))
+				})
+
+				Context("when multiple have the same available memory", func() {
+					BeforeEach(func() {
+						workerFakes[0].ReservedResourcesReturns(256, 2*1024*1024*1024, nil)
+					})
+
+					It("orders them by available cpu", func() {
+						Expect(orderedWorkers).To(Equal([]Worker{workers[1], workers[0], workers[2]}))
+					})
+				})
+
+				Context("when a worker does not advertise its capacity", func() {
+					BeforeEach(func() {
+						workerFakes[1].AllocatableCPUReturns(0)
+						workerFakes[1].AllocatableMemoryReturns(0)
+					})
+
+					It("returns that worker last", func() {
+						Expect(orderedWorkers).To(Equal([]Worker{workers[2], workers[0], workers[1]}))
+					})
+				})
+
+				Context("when a worker could never fit the container", func() {
+					BeforeEach(func() {
+						workerFakes[1].AllocatableMemoryReturns(1024 * 1024 * 1024)
+					})
+
+					It("removes that worker", func() {
+						Expect(orderedWorkers).To(Equal([]Worker{workers[2], workers[0]}))
+					})
+				})
+
+				Context("when there is an error getting the reserved resources", func() {
+					BeforeEach(func() {
+						workerFakes[1].ReservedResourcesReturns(0, 0, errors.New("nope"))
+					})
+
+					It("ignores the failed worker", func() {
+						Expect(orderedWorkers).To(Equal([]Worker{workers[2], workers[0]}))
+					})
+				})
+			})
+
+			Context("when the container does not declare any limits", func() {
+				BeforeEach(func() {
+					containerSpec.Limits = ContainerLimits{}
+				})
+
+				It("returns all workers without looking up reservations", func() {
+					Expect(orderedWorkers).To(ConsistOf(workers))
+					for _, fake := range workerFakes {
+						Expect(fake.ReservedResourcesCallCount()).To(BeZero())
+					}
+				})
+			})
+		})
+
+		Describe("strategy.Pick and strategy.Release", func() {
+			JustBeforeEach(func() {
+				pickAndRelease()
+			})
+
+			BeforeEach(func() {
+				orderedWorkers = workers
+			})
+
+			It("reserves and releases the container's limits on the picked worker", func() {
+				Expect(pickedWorker).To(Equal(workers[0]))
+
+				Expect(workerFakes[0].ReserveResourcesCallCount()).To(Equal(1))
+				reservedOwner, reservedCPU, reservedMemory := workerFakes[0].ReserveResourcesArgsForCall(0)
+				Expect(reservedOwner).To(Equal(containerOwner))
+				Expect(reservedCPU).To(Equal(cpu))
+				Expect(reservedMemory).To(Equal(memory))
+
+				Expect(workerFakes[0].ReleaseResourcesCallCount()).To(Equal(1))
+				Expect(workerFakes[0].ReleaseResourcesArgsForCall(0)).To(Equal(containerOwner))
+			})
+
+			Context("when the worker does not have enough resources available", func() {
+				BeforeEach(func() {
+					workerFakes[0].ReserveResourcesReturns(false, nil)
+				})
+
+				It("picks the next worker", func() {
+					Expect(pickedWorker).To(Equal(workers[1]))
+					Expect(workerFakes[0].ReleaseResourcesCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when no worker has enough resources available", func() {
+				BeforeEach(func() {
+					for _, fake := range workerFakes {
+						fake.ReserveResourcesReturns(false, nil)
+					}
+				})
+
+				It("fails to pick a worker", func() {
+					Expect(pickedWorker).To(BeNil())
+					Expect(pickErr).To(Equal(ErrInsufficientResources))
+				})
+			})
+
+			Context("when the container does not declare any limits", func() {
+				BeforeEach(func() {
+					containerSpec.Limits = ContainerLimits{}
+				})
+
+				It("does not reserve anything", func() {
+					Expect(pickedWorker).To(Equal(workers[0]))
+					Expect(workerFakes[0].ReserveResourcesCallCount()).To(BeZero())
+					Expect(workerFakes[0].ReleaseResourcesCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
 	Describe("Chained placement strategy", func() {
 		Describe("strategy.Order", func() {
 			Context("fewest-build-containers,volume-locality", func() {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -44,6 +46,7 @@ type Pool interface {
 
 	ReleaseWorker(
 		context.Context,
+		db.ContainerOwner,
 		ContainerSpec,
 		Client,
 		ContainerPlacementStrategy,
@@ -64,13 +67,25 @@ type VolumeFinder interface {
 
 type pool struct {
 	provider WorkerProvider
-	waker    chan bool
+
+	waitersL sync.Mutex
+	waiters  map[*waiter]struct{}
+	sequence uint64
+}
+
+// waiter is a step waiting for a worker to become available.
+type waiter struct {
+	key      string
+	spec     WorkerSpec
+	priority int
+	sequence uint64
+	wake     chan struct{}
 }
 
 func NewPool(provider WorkerProvider) Pool {
 	return &pool{
 		provider: provider,
-		waker:    make(chan bool),
+		waiters:  map[*waiter]struct{}{},
 	}
 }
 
@@ -100,10 +115,24 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 	if len(compatibleTeamWorkers) != 0 {
 		// XXX(aoldershaw): if there is a team worker that is compatible but is
 		// rejected by the strategy, shouldn't we fallback to general workers?
-		return compatibleTeamWorkers, nil
+		return preferAffinity(compatibleTeamWorkers, spec), nil
 	}
 
-	return compatibleGeneralWorkers, nil
+	return preferAffinity(compatibleGeneralWorkers, spec), nil
+}
+
+// preferAffinity orders the workers matching more of the spec's soft node
+// selector expressions first, otherwise keeping their order.
+func preferAffinity(workers []Worker, spec WorkerSpec) []Worker {
+	if !spec.NodeSelector.HasSoft() {
+		return workers
+	}
+
+	sort.SliceStable(workers, func(i, j int) bool {
+		return spec.NodeSelector.Affinity(workers[i].Labels()) > spec.NodeSelector.Affinity(workers[j].Labels())
+	})
+
+	return workers
 }
 
 func (pool *pool) findWorkerWithContainer(
@@ -134,7 +163,9 @@ func (pool *pool) findWorkerWithContainer(
 func (pool *pool) findWorkerFromStrategy(
 	logger lager.Logger,
 	compatible []Worker,
+	containerOwner db.ContainerOwner,
 	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
 	strategy ContainerPlacementStrategy,
 ) (Worker, error) {
 	orderedWorkers, err := strategy.Order(logger, compatible, containerSpec)
@@ -143,9 +174,13 @@ func (pool *pool) findWorkerFromStrategy(
 		return nil, err
 	}
 
+	// soft node affinity takes precedence over the configured strategies, which
+	// only break ties between workers matching as many soft expressions
+	orderedWorkers = preferAffinity(orderedWorkers, workerSpec)
+
 	var strategyError error
 	for _, candidate := range orderedWorkers {
-		err := strategy.Pick(logger, candidate, containerSpec)
+		err := strategy.Pick(logger, candidate, containerOwner, containerSpec)
 
 		if err == nil {
 			return candidate, nil
@@ -188,16 +223,20 @@ func (pool *pool) findWorker(
 		return nil, err
 	}
 
-	if worker == nil {
-		worker, err = pool.findWorkerFromStrategy(
-			logger,
-			compatibleWorkers,
-			containerSpec,
-			strategy,
-		)
-		if err != nil {
-			return nil, err
-		}
+	if worker != nil {
+		return NewClient(worker), nil
+	}
+
+	worker, err = pool.findWorkerFromStrategy(
+		logger,
+		compatibleWorkers,
+		containerOwner,
+		containerSpec,
+		workerSpec,
+		strategy,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	if worker == nil {
@@ -268,24 +307,38 @@ func (pool *pool) SelectWorker(
 		WorkerTags: strings.Join(workerSpec.Tags, "_"),
 	}
 
+	self := &waiter{
+		key:      fmt.Sprintf("%d:%s", workerSpec.TeamID, workerSpec.Description()),
+		spec:     workerSpec,
+		priority: workerSpec.Priority.Rank(),
+		wake:     make(chan struct{}, 1),
+	}
+
 	var worker Client
 	var pollingTicker *time.Ticker
 	for {
-		var err error
-		worker, err = pool.findWorker(ctx, owner, containerSpec, workerSpec, strategy)
-
-		if err != nil {
-			return nil, 0, err
-		}
+		// while higher priority steps are waiting for the same kind of worker,
+		// leave any capacity that frees up to them
+		if !pool.outranked(self) {
+			var err error
+			worker, err = pool.findWorker(ctx, owner, containerSpec, workerSpec, strategy)
+
+			if err != nil {
+				return nil, 0, err
+			}
 
-		if worker != nil {
-			break
+			if worker != nil {
+				break
+			}
 		}
 
 		if pollingTicker == nil {
 			pollingTicker = time.NewTicker(WorkerPollingInterval)
 			defer pollingTicker.Stop()
 
+			pool.addWaiter(self)
+			defer pool.removeWaiter(self)
+
 			logger.Debug("waiting-for-available-worker")
 
 			_, ok := metric.Metrics.StepsWaiting[labels]
@@ -306,7 +359,7 @@ func (pool *pool) SelectWorker(
 			logger.Info("aborted-waiting-for-worker")
 			return nil, 0, ctx.Err()
 		case <-pollingTicker.C:
-		case <-pool.waker:
+		case <-self.wake:
 		}
 	}
 
@@ -321,22 +374,86 @@ func (pool *pool) SelectWorker(
 
 func (pool *pool) ReleaseWorker(
 	ctx context.Context,
+	containerOwner db.ContainerOwner,
 	containerSpec ContainerSpec,
 	client Client,
 	strategy ContainerPlacementStrategy,
 ) {
 	logger := lagerctx.FromContext(ctx)
-	strategy.Release(logger, client.Worker(), containerSpec)
-
-	// Attempt to wake a random waiting step to see if it can be
-	// scheduled on the recently released worker.
-	select {
-	case pool.waker <- true:
-		logger.Debug("attempted-to-wake-waiting-step")
-	default:
+
+	// the worker may have been picked by another ATC before this one resumed
+	// the step, so release it even if it already had the container
+	strategy.Release(logger, client.Worker(), containerOwner, containerSpec)
+
+	// Attempt to wake the waiting steps which could be scheduled on the
+	// recently released worker.
+	if woken := pool.wakeWaitersFor(logger, client.Worker()); woken > 0 {
+		logger.Debug("attempted-to-wake-waiting-steps", lager.Data{"steps": woken})
 	}
 }
 
+func (pool *pool) addWaiter(w *waiter) {
+	pool.waitersL.Lock()
+	defer pool.waitersL.Unlock()
+
+	pool.sequence++
+	w.sequence = pool.sequence
+	pool.waiters[w] = struct{}{}
+}
+
+func (pool *pool) removeWaiter(w *waiter) {
+	pool.waitersL.Lock()
+	delete(pool.waiters, w)
+	pool.waitersL.Unlock()
+}
+
+// outranked returns whether a step with a higher priority is waiting for the
+// same kind of worker.
+func (pool *pool) outranked(w *waiter) bool {
+	pool.waitersL.Lock()
+	defer pool.waitersL.Unlock()
+
+	for other := range pool.waiters {
+		if other.key == w.key && other.priority > w.priority {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wakeWaitersFor wakes the steps which are waiting for a kind of worker that
+// the given worker satisfies. Of the steps waiting for the same kind of
+// worker, only the highest priority step that has been waiting the longest is
+// woken, as the others would leave the worker to it anyway.
+func (pool *pool) wakeWaitersFor(logger lager.Logger, worker Worker) int {
+	pool.waitersL.Lock()
+	defer pool.waitersL.Unlock()
+
+	next := map[string]*waiter{}
+	for w := range pool.waiters {
+		if !worker.Satisfies(logger, w.spec) {
+			continue
+		}
+
+		current, found := next[w.key]
+		if !found ||
+			w.priority > current.priority ||
+			(w.priority == current.priority && w.sequence < current.sequence) {
+			next[w.key] = w
+		}
+	}
+
+	for _, w := range next {
+		select {
+		case w.wake <- struct{}{}:
+		default:
+		}
+	}
+
+	return len(next)
+}
+
 func (pool *pool) chooseRandomWorkerForVolume(
 	logger lager.Logger,
 	workerSpec WorkerSpec,
@@ -350,5 +467,18 @@ func (pool *pool) chooseRandomWorkerForVolume(
 		return nil, NoCompatibleWorkersError{Spec: workerSpec}
 	}
 
-	return workers[rand.Intn(len(workers))], nil
+	// workers are ordered by affinity, so only choose amongst those matching
+	// as many soft node selector expressions as the first
+	preferred := len(workers)
+	if workerSpec.NodeSelector.HasSoft() {
+		affinity := workerSpec.NodeSelector.Affinity(workers[0].Labels())
+		for i, worker := range workers {
+			if workerSpec.NodeSelector.Affinity(worker.Labels()) < affinity {
+				preferred = i
+				break
+			}
+		}
+	}
+
+	return workers[rand.Intn(preferred)], nil
 }
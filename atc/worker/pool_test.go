@@ -9,6 +9,7 @@ import (
 
 	"context"
 	"errors"
+	"time"
 
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
@@ -331,6 +332,15 @@ var _ = Describe("Pool", func() {
 						Expect(selectErr).NotTo(HaveOccurred())
 						Expect(selectedWorker.Name()).To(Equal(workers[0].Name()))
 					})
+
+					It("releases the owner's reservation from the strategy when the worker is released", func() {
+						pool.ReleaseWorker(selectCtx, fakeOwner, containerSpec, selectedWorker, fakeStrategy)
+						Expect(fakeStrategy.ReleaseCallCount()).To(Equal(1))
+
+						_, releasedWorker, releasedOwner, _ := fakeStrategy.ReleaseArgsForCall(0)
+						Expect(releasedWorker.Name()).To(Equal(workers[0].Name()))
+						Expect(releasedOwner).To(Equal(fakeOwner))
+					})
 				})
 
 				Context("when multiple workers satisfy the spec", func() {
@@ -362,7 +372,7 @@ var _ = Describe("Pool", func() {
 						Expect(fakeStrategy.OrderCallCount()).To(Equal(1))
 						Expect(fakeStrategy.PickCallCount()).To(Equal(1))
 
-						_, pickedWorker, _ := fakeStrategy.PickArgsForCall(0)
+						_, pickedWorker, _, _ := fakeStrategy.PickArgsForCall(0)
 						Expect(pickedWorker.Name()).To(Equal(workers[1].Name()))
 
 						Expect(selectErr).NotTo(HaveOccurred())
@@ -403,6 +413,29 @@ var _ = Describe("Pool", func() {
 						_, satisfyingWorkers, _ := fakeStrategy.OrderArgsForCall(0)
 						Expect(satisfyingWorkers).To(ConsistOf(workers[0], workers[1]))
 					})
+
+					Context("when the spec has a soft node selector", func() {
+						BeforeEach(func() {
+							workerSpec.NodeSelector = atc.NodeSelector{
+								{Key: "zone", Operator: atc.NodeSelectorOpIn, Values: []string{"eu-1"}, Soft: true},
+							}
+
+							workerFakes[0].LabelsReturns(atc.WorkerLabels{"zone": "us-1"})
+							workerFakes[1].LabelsReturns(atc.WorkerLabels{"zone": "eu-1"})
+						})
+
+						It("picks the worker matching the node selector first", func() {
+							_, satisfyingWorkers, _ := fakeStrategy.OrderArgsForCall(0)
+							Expect(satisfyingWorkers).To(Equal([]Worker{workers[1], workers[0]}))
+
+							Expect(fakeStrategy.PickCallCount()).To(Equal(1))
+							_, pickedWorker, _, _ := fakeStrategy.PickArgsForCall(0)
+							Expect(pickedWorker.Name()).To(Equal(workers[1].Name()))
+
+							Expect(selectErr).NotTo(HaveOccurred())
+							Expect(selectedWorker.Name()).To(Equal(workers[1].Name()))
+						})
+					})
 				})
 
 				Context("when team workers and general workers satisfy the spec", func() {
@@ -483,6 +516,19 @@ var _ = Describe("Pool", func() {
 							Expect(fakeStrategy.OrderCallCount()).To(Equal(1))
 							Expect(selectedWorker.Name()).To(Equal(workers[0].Name()))
 						})
+
+						It("releases what the strategy picked when the worker is released", func() {
+							pool.ReleaseWorker(selectCtx, fakeOwner, containerSpec, selectedWorker, fakeStrategy)
+							Expect(fakeStrategy.ReleaseCallCount()).To(Equal(1))
+
+							_, releasedWorker, releasedOwner, _ := fakeStrategy.ReleaseArgsForCall(0)
+							Expect(releasedWorker.Name()).To(Equal(workers[0].Name()))
+							Expect(releasedOwner).To(Equal(fakeOwner))
+
+							_, pickedWorker, pickedOwner, _ := fakeStrategy.PickArgsForCall(0)
+							Expect(pickedWorker.Name()).To(Equal(workers[0].Name()))
+							Expect(pickedOwner).To(Equal(fakeOwner))
+						})
 					})
 
 					Context("when strategy returns multiple workers", func() {
@@ -502,7 +548,7 @@ var _ = Describe("Pool", func() {
 						It("chooses first worker", func() {
 							Expect(fakeStrategy.OrderCallCount()).To(Equal(1))
 
-							_, pickedWorker, _ := fakeStrategy.PickArgsForCall(0)
+							_, pickedWorker, _, _ := fakeStrategy.PickArgsForCall(0)
 							Expect(pickedWorker.Name()).To(Equal(workers[2].Name()))
 
 							Expect(selectErr).ToNot(HaveOccurred())
@@ -518,10 +564,10 @@ var _ = Describe("Pool", func() {
 						It("succeeds and picks the next worker", func() {
 							Expect(fakeStrategy.PickCallCount()).To(Equal(2))
 
-							_, pickedWorkerA, _ := fakeStrategy.PickArgsForCall(0)
+							_, pickedWorkerA, _, _ := fakeStrategy.PickArgsForCall(0)
 							Expect(pickedWorkerA.Name()).To(Equal(workers[0].Name()))
 
-							_, pickedWorkerB, _ := fakeStrategy.PickArgsForCall(1)
+							_, pickedWorkerB, _, _ := fakeStrategy.PickArgsForCall(1)
 							Expect(pickedWorkerB.Name()).To(Equal(workers[1].Name()))
 
 							Expect(selectErr).NotTo(HaveOccurred())
@@ -579,5 +625,86 @@ var _ = Describe("Pool", func() {
 				})
 			})
 		})
+
+		Context("when a higher priority step is waiting for the same kind of worker", func() {
+			var (
+				highCtx    context.Context
+				cancelHigh context.CancelFunc
+
+				highWorker chan Client
+			)
+
+			BeforeEach(func() {
+				fakeProvider.RunningWorkersReturns([]Worker{}, nil)
+				for _, worker := range workerFakes {
+					worker.SatisfiesReturns(true)
+				}
+
+				highCtx, cancelHigh = context.WithCancel(lagerctx.NewContext(context.Background(), logger))
+
+				highSpec := workerSpec
+				highSpec.Priority = atc.JobPriorityHigh
+
+				highWorker = make(chan Client, 1)
+				go func() {
+					defer GinkgoRecover()
+
+					worker, _, err := pool.SelectWorker(highCtx, fakeOwner, containerSpec, highSpec, fakeStrategy, nil)
+					if err == nil {
+						highWorker <- worker
+					}
+				}()
+
+				Eventually(fakeProvider.RunningWorkersCallCount).Should(Equal(1))
+
+				fakeProvider.RunningWorkersReturns(workers, nil)
+			})
+
+			AfterEach(func() {
+				cancelHigh()
+			})
+
+			It("does not take a worker from it", func() {
+				ctx, cancel := context.WithTimeout(lagerctx.NewContext(context.Background(), logger), 100*time.Millisecond)
+				defer cancel()
+
+				_, _, err := pool.SelectWorker(ctx, fakeOwner, containerSpec, workerSpec, fakeStrategy, nil)
+				Expect(err).To(Equal(context.DeadlineExceeded))
+				Expect(fakeProvider.RunningWorkersCallCount()).To(Equal(1))
+			})
+
+			It("does not hold back steps waiting for other kinds of workers", func() {
+				otherSpec := workerSpec
+				otherSpec.Tags = atc.Tags{"other-tag"}
+
+				worker, _, err := pool.SelectWorker(lagerctx.NewContext(context.Background(), logger), fakeOwner, containerSpec, otherSpec, fakeStrategy, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(worker).ToNot(BeNil())
+			})
+
+			It("is woken up first when a worker is released", func() {
+				fakeClient := new(workerfakes.FakeClient)
+				fakeClient.WorkerReturns(workerFakes[0])
+
+				pool.ReleaseWorker(lagerctx.NewContext(context.Background(), logger), fakeOwner, containerSpec, fakeClient, fakeStrategy)
+
+				var worker Client
+				Eventually(highWorker).Should(Receive(&worker))
+				Expect(worker.Name()).To(Equal(workers[0].Name()))
+			})
+
+			It("is not woken up when a worker it cannot use is released", func() {
+				otherWorker := new(workerfakes.FakeWorker)
+				otherWorker.SatisfiesReturns(false)
+
+				fakeClient := new(workerfakes.FakeClient)
+				fakeClient.WorkerReturns(otherWorker)
+
+				pool.ReleaseWorker(lagerctx.NewContext(context.Background(), logger), fakeOwner, containerSpec, fakeClient, fakeStrategy)
+
+				Consistently(highWorker, 500*time.Millisecond).ShouldNot(Receive())
+				Expect(fakeProvider.RunningWorkersCallCount()).To(Equal(1))
+			})
+		})
 	})
 })
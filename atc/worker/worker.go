@@ -41,6 +41,7 @@ type Worker interface {
 	Name() string
 	ResourceTypes() []atc.WorkerResourceType
 	Tags() atc.Tags
+	Labels() atc.WorkerLabels
 	Uptime() time.Duration
 	IsOwnedByTeam() bool
 	Ephemeral() bool
@@ -59,6 +60,7 @@ type Worker interface {
 	FindVolumeForResourceCache(logger lager.Logger, resourceCache db.UsedResourceCache) (Volume, bool, error)
 	FindResourceCacheForVolume(volume Volume) (db.UsedResourceCache, bool, error)
 	FindVolumeForTaskCache(lager.Logger, int, int, string, string) (Volume, bool, error)
+	CreateVolumeForTaskCache(lager.Logger, VolumeSpec, int, int, string, string) (Volume, error)
 	Fetch(
 		context.Context,
 		lager.Logger,
@@ -82,6 +84,12 @@ type Worker interface {
 	IncreaseActiveTasks() (int, error)
 	DecreaseActiveTasks() (int, error)
 
+	AllocatableCPU() uint64
+	AllocatableMemory() uint64
+	ReservedResources() (uint64, uint64, error)
+	ReserveResources(owner db.ContainerOwner, cpu uint64, memory uint64) (bool, error)
+	ReleaseResources(owner db.ContainerOwner) error
+
 	ActiveContainers() int
 	ActiveVolumes() int
 }
@@ -197,6 +205,10 @@ func (worker *gardenWorker) FindVolumeForTaskCache(logger lager.Logger, teamID i
 	return worker.volumeClient.FindVolumeForTaskCache(logger, teamID, jobID, stepName, path)
 }
 
+func (worker *gardenWorker) CreateVolumeForTaskCache(logger lager.Logger, spec VolumeSpec, teamID int, jobID int, stepName string, path string) (Volume, error) {
+	return worker.volumeClient.CreateVolumeForTaskCache(logger, spec, teamID, jobID, stepName, path)
+}
+
 func (worker *gardenWorker) CertsVolume(logger lager.Logger) (Volume, bool, error) {
 	return worker.volumeClient.FindOrCreateVolumeForResourceCerts(logger.Session("find-or-create"))
 }
@@ -699,6 +711,10 @@ func (worker *gardenWorker) Tags() atc.Tags {
 	return worker.dbWorker.Tags()
 }
 
+func (worker *gardenWorker) Labels() atc.WorkerLabels {
+	return worker.dbWorker.Labels()
+}
+
 func (worker *gardenWorker) Ephemeral() bool {
 	return worker.dbWorker.Ephemeral()
 }
@@ -739,6 +755,10 @@ func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool
 		return false
 	}
 
+	if !spec.NodeSelector.Satisfied(worker.dbWorker.Labels()) {
+		return false
+	}
+
 	return true
 }
 
@@ -751,6 +771,10 @@ func (worker *gardenWorker) Description() string {
 		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if labels := worker.dbWorker.Labels(); len(labels) > 0 {
+		messages = append(messages, fmt.Sprintf("labels '%s'", labels))
+	}
+
 	return strings.Join(messages, ", ")
 }
 
@@ -793,6 +817,26 @@ func (worker *gardenWorker) DecreaseActiveTasks() (int, error) {
 	return worker.dbWorker.DecreaseActiveTasks()
 }
 
+func (worker *gardenWorker) AllocatableCPU() uint64 {
+	return worker.dbWorker.AllocatableCPU()
+}
+
+func (worker *gardenWorker) AllocatableMemory() uint64 {
+	return worker.dbWorker.AllocatableMemory()
+}
+
+func (worker *gardenWorker) ReservedResources() (uint64, uint64, error) {
+	return worker.dbWorker.ReservedResources()
+}
+
+func (worker *gardenWorker) ReserveResources(owner db.ContainerOwner, cpu uint64, memory uint64) (bool, error) {
+	return worker.dbWorker.ReserveResources(owner, cpu, memory)
+}
+
+func (worker *gardenWorker) ReleaseResources(owner db.ContainerOwner) error {
+	return worker.dbWorker.ReleaseResources(owner)
+}
+
 func (worker *gardenWorker) ActiveContainers() int {
 	return worker.dbWorker.ActiveContainers()
 }
@@ -665,6 +665,50 @@ var _ = Describe("Worker", func() {
 			})
 		})
 
+		Context("when the spec has a node selector", func() {
+			BeforeEach(func() {
+				fakeDBWorker.LabelsReturns(atc.WorkerLabels{"arch": "arm64", "zone": "eu-1"})
+			})
+
+			Context("when the worker matches every expression", func() {
+				BeforeEach(func() {
+					spec.NodeSelector = atc.NodeSelector{
+						{Key: "arch", Operator: atc.NodeSelectorOpIn, Values: []string{"amd64", "arm64"}},
+						{Key: "gpu", Operator: atc.NodeSelectorOpNotIn, Values: []string{"nvidia"}},
+						{Key: "zone", Operator: atc.NodeSelectorOpExists},
+					}
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+
+			Context("when the worker does not match a hard expression", func() {
+				BeforeEach(func() {
+					spec.NodeSelector = atc.NodeSelector{
+						{Key: "arch", Operator: atc.NodeSelectorOpIn, Values: []string{"amd64"}},
+					}
+				})
+
+				It("returns false", func() {
+					Expect(satisfies).To(BeFalse())
+				})
+			})
+
+			Context("when the worker only fails to match a soft expression", func() {
+				BeforeEach(func() {
+					spec.NodeSelector = atc.NodeSelector{
+						{Key: "zone", Operator: atc.NodeSelectorOpIn, Values: []string{"us-1"}, Soft: true},
+					}
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+		})
+
 		Context("when spec specifies team", func() {
 			BeforeEach(func() {
 				teamID = 123
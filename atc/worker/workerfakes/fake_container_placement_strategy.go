@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -34,12 +35,13 @@ type FakeContainerPlacementStrategy struct {
 		result1 []worker.Worker
 		result2 error
 	}
-	PickStub        func(lager.Logger, worker.Worker, worker.ContainerSpec) error
+	PickStub        func(lager.Logger, worker.Worker, db.ContainerOwner, worker.ContainerSpec) error
 	pickMutex       sync.RWMutex
 	pickArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 worker.Worker
-		arg3 worker.ContainerSpec
+		arg3 db.ContainerOwner
+		arg4 worker.ContainerSpec
 	}
 	pickReturns struct {
 		result1 error
@@ -47,12 +49,13 @@ type FakeContainerPlacementStrategy struct {
 	pickReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ReleaseStub        func(lager.Logger, worker.Worker, worker.ContainerSpec)
+	ReleaseStub        func(lager.Logger, worker.Worker, db.ContainerOwner, worker.ContainerSpec)
 	releaseMutex       sync.RWMutex
 	releaseArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 worker.Worker
-		arg3 worker.ContainerSpec
+		arg3 db.ContainerOwner
+		arg4 worker.ContainerSpec
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -182,20 +185,21 @@ func (fake *FakeContainerPlacementStrategy) OrderReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
-func (fake *FakeContainerPlacementStrategy) Pick(arg1 lager.Logger, arg2 worker.Worker, arg3 worker.ContainerSpec) error {
+func (fake *FakeContainerPlacementStrategy) Pick(arg1 lager.Logger, arg2 worker.Worker, arg3 db.ContainerOwner, arg4 worker.ContainerSpec) error {
 	fake.pickMutex.Lock()
 	ret, specificReturn := fake.pickReturnsOnCall[len(fake.pickArgsForCall)]
 	fake.pickArgsForCall = append(fake.pickArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 worker.Worker
-		arg3 worker.ContainerSpec
-	}{arg1, arg2, arg3})
+		arg3 db.ContainerOwner
+		arg4 worker.ContainerSpec
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.PickStub
 	fakeReturns := fake.pickReturns
-	fake.recordInvocation("Pick", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("Pick", []interface{}{arg1, arg2, arg3, arg4})
 	fake.pickMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -209,17 +213,17 @@ func (fake *FakeContainerPlacementStrategy) PickCallCount() int {
 	return len(fake.pickArgsForCall)
 }
 
-func (fake *FakeContainerPlacementStrategy) PickCalls(stub func(lager.Logger, worker.Worker, worker.ContainerSpec) error) {
+func (fake *FakeContainerPlacementStrategy) PickCalls(stub func(lager.Logger, worker.Worker, db.ContainerOwner, worker.ContainerSpec) error) {
 	fake.pickMutex.Lock()
 	defer fake.pickMutex.Unlock()
 	fake.PickStub = stub
 }
 
-func (fake *FakeContainerPlacementStrategy) PickArgsForCall(i int) (lager.Logger, worker.Worker, worker.ContainerSpec) {
+func (fake *FakeContainerPlacementStrategy) PickArgsForCall(i int) (lager.Logger, worker.Worker, db.ContainerOwner, worker.ContainerSpec) {
 	fake.pickMutex.RLock()
 	defer fake.pickMutex.RUnlock()
 	argsForCall := fake.pickArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeContainerPlacementStrategy) PickReturns(result1 error) {
@@ -245,18 +249,19 @@ func (fake *FakeContainerPlacementStrategy) PickReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
-func (fake *FakeContainerPlacementStrategy) Release(arg1 lager.Logger, arg2 worker.Worker, arg3 worker.ContainerSpec) {
+func (fake *FakeContainerPlacementStrategy) Release(arg1 lager.Logger, arg2 worker.Worker, arg3 db.ContainerOwner, arg4 worker.ContainerSpec) {
 	fake.releaseMutex.Lock()
 	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 worker.Worker
-		arg3 worker.ContainerSpec
-	}{arg1, arg2, arg3})
+		arg3 db.ContainerOwner
+		arg4 worker.ContainerSpec
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.ReleaseStub
-	fake.recordInvocation("Release", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("Release", []interface{}{arg1, arg2, arg3, arg4})
 	fake.releaseMutex.Unlock()
 	if stub != nil {
-		fake.ReleaseStub(arg1, arg2, arg3)
+		fake.ReleaseStub(arg1, arg2, arg3, arg4)
 	}
 }
 
@@ -266,17 +271,17 @@ func (fake *FakeContainerPlacementStrategy) ReleaseCallCount() int {
 	return len(fake.releaseArgsForCall)
 }
 
-func (fake *FakeContainerPlacementStrategy) ReleaseCalls(stub func(lager.Logger, worker.Worker, worker.ContainerSpec)) {
+func (fake *FakeContainerPlacementStrategy) ReleaseCalls(stub func(lager.Logger, worker.Worker, db.ContainerOwner, worker.ContainerSpec)) {
 	fake.releaseMutex.Lock()
 	defer fake.releaseMutex.Unlock()
 	fake.ReleaseStub = stub
 }
 
-func (fake *FakeContainerPlacementStrategy) ReleaseArgsForCall(i int) (lager.Logger, worker.Worker, worker.ContainerSpec) {
+func (fake *FakeContainerPlacementStrategy) ReleaseArgsForCall(i int) (lager.Logger, worker.Worker, db.ContainerOwner, worker.ContainerSpec) {
 	fake.releaseMutex.RLock()
 	defer fake.releaseMutex.RUnlock()
 	argsForCall := fake.releaseArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeContainerPlacementStrategy) Invocations() map[string][][]interface{} {
@@ -62,13 +62,14 @@ type FakePool struct {
 		result2 bool
 		result3 error
 	}
-	ReleaseWorkerStub        func(context.Context, worker.ContainerSpec, worker.Client, worker.ContainerPlacementStrategy)
+	ReleaseWorkerStub        func(context.Context, db.ContainerOwner, worker.ContainerSpec, worker.Client, worker.ContainerPlacementStrategy)
 	releaseWorkerMutex       sync.RWMutex
 	releaseWorkerArgsForCall []struct {
 		arg1 context.Context
-		arg2 worker.ContainerSpec
-		arg3 worker.Client
-		arg4 worker.ContainerPlacementStrategy
+		arg2 db.ContainerOwner
+		arg3 worker.ContainerSpec
+		arg4 worker.Client
+		arg5 worker.ContainerPlacementStrategy
 	}
 	SelectWorkerStub        func(context.Context, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, worker.PoolCallbacks) (worker.Client, time.Duration, error)
 	selectWorkerMutex       sync.RWMutex
@@ -299,19 +300,20 @@ func (fake *FakePool) FindVolumeReturnsOnCall(i int, result1 worker.Volume, resu
 	}{result1, result2, result3}
 }
 
-func (fake *FakePool) ReleaseWorker(arg1 context.Context, arg2 worker.ContainerSpec, arg3 worker.Client, arg4 worker.ContainerPlacementStrategy) {
+func (fake *FakePool) ReleaseWorker(arg1 context.Context, arg2 db.ContainerOwner, arg3 worker.ContainerSpec, arg4 worker.Client, arg5 worker.ContainerPlacementStrategy) {
 	fake.releaseWorkerMutex.Lock()
 	fake.releaseWorkerArgsForCall = append(fake.releaseWorkerArgsForCall, struct {
 		arg1 context.Context
-		arg2 worker.ContainerSpec
-		arg3 worker.Client
-		arg4 worker.ContainerPlacementStrategy
-	}{arg1, arg2, arg3, arg4})
+		arg2 db.ContainerOwner
+		arg3 worker.ContainerSpec
+		arg4 worker.Client
+		arg5 worker.ContainerPlacementStrategy
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.ReleaseWorkerStub
-	fake.recordInvocation("ReleaseWorker", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ReleaseWorker", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.releaseWorkerMutex.Unlock()
 	if stub != nil {
-		fake.ReleaseWorkerStub(arg1, arg2, arg3, arg4)
+		fake.ReleaseWorkerStub(arg1, arg2, arg3, arg4, arg5)
 	}
 }
 
@@ -321,17 +323,17 @@ func (fake *FakePool) ReleaseWorkerCallCount() int {
 	return len(fake.releaseWorkerArgsForCall)
 }
 
-func (fake *FakePool) ReleaseWorkerCalls(stub func(context.Context, worker.ContainerSpec, worker.Client, worker.ContainerPlacementStrategy)) {
+func (fake *FakePool) ReleaseWorkerCalls(stub func(context.Context, db.ContainerOwner, worker.ContainerSpec, worker.Client, worker.ContainerPlacementStrategy)) {
 	fake.releaseWorkerMutex.Lock()
 	defer fake.releaseWorkerMutex.Unlock()
 	fake.ReleaseWorkerStub = stub
 }
 
-func (fake *FakePool) ReleaseWorkerArgsForCall(i int) (context.Context, worker.ContainerSpec, worker.Client, worker.ContainerPlacementStrategy) {
+func (fake *FakePool) ReleaseWorkerArgsForCall(i int) (context.Context, db.ContainerOwner, worker.ContainerSpec, worker.Client, worker.ContainerPlacementStrategy) {
 	fake.releaseWorkerMutex.RLock()
 	defer fake.releaseWorkerMutex.RUnlock()
 	argsForCall := fake.releaseWorkerArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakePool) SelectWorker(arg1 context.Context, arg2 db.ContainerOwner, arg3 worker.ContainerSpec, arg4 worker.WorkerSpec, arg5 worker.ContainerPlacementStrategy, arg6 worker.PoolCallbacks) (worker.Client, time.Duration, error) {
@@ -49,6 +49,26 @@ type FakeWorker struct {
 	activeVolumesReturnsOnCall map[int]struct {
 		result1 int
 	}
+	AllocatableCPUStub        func() uint64
+	allocatableCPUMutex       sync.RWMutex
+	allocatableCPUArgsForCall []struct {
+	}
+	allocatableCPUReturns struct {
+		result1 uint64
+	}
+	allocatableCPUReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	AllocatableMemoryStub        func() uint64
+	allocatableMemoryMutex       sync.RWMutex
+	allocatableMemoryArgsForCall []struct {
+	}
+	allocatableMemoryReturns struct {
+		result1 uint64
+	}
+	allocatableMemoryReturnsOnCall map[int]struct {
+		result1 uint64
+	}
 	BuildContainersStub        func() int
 	buildContainersMutex       sync.RWMutex
 	buildContainersArgsForCall []struct {
@@ -90,6 +110,24 @@ type FakeWorker struct {
 		result1 worker.Volume
 		result2 error
 	}
+	CreateVolumeForTaskCacheStub        func(lager.Logger, worker.VolumeSpec, int, int, string, string) (worker.Volume, error)
+	createVolumeForTaskCacheMutex       sync.RWMutex
+	createVolumeForTaskCacheArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.VolumeSpec
+		arg3 int
+		arg4 int
+		arg5 string
+		arg6 string
+	}
+	createVolumeForTaskCacheReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	createVolumeForTaskCacheReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	DecreaseActiveTasksStub        func() (int, error)
 	decreaseActiveTasksMutex       sync.RWMutex
 	decreaseActiveTasksArgsForCall []struct {
@@ -274,6 +312,16 @@ type FakeWorker struct {
 	isVersionCompatibleReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	LabelsStub        func() atc.WorkerLabels
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 atc.WorkerLabels
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 atc.WorkerLabels
+	}
 	LookupVolumeStub        func(lager.Logger, string) (worker.Volume, bool, error)
 	lookupVolumeMutex       sync.RWMutex
 	lookupVolumeArgsForCall []struct {
@@ -300,6 +348,46 @@ type FakeWorker struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ReleaseResourcesStub        func(db.ContainerOwner) error
+	releaseResourcesMutex       sync.RWMutex
+	releaseResourcesArgsForCall []struct {
+		arg1 db.ContainerOwner
+	}
+	releaseResourcesReturns struct {
+		result1 error
+	}
+	releaseResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReserveResourcesStub        func(db.ContainerOwner, uint64, uint64) (bool, error)
+	reserveResourcesMutex       sync.RWMutex
+	reserveResourcesArgsForCall []struct {
+		arg1 db.ContainerOwner
+		arg2 uint64
+		arg3 uint64
+	}
+	reserveResourcesReturns struct {
+		result1 bool
+		result2 error
+	}
+	reserveResourcesReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ReservedResourcesStub        func() (uint64, uint64, error)
+	reservedResourcesMutex       sync.RWMutex
+	reservedResourcesArgsForCall []struct {
+	}
+	reservedResourcesReturns struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
+	reservedResourcesReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
 	ResourceTypesStub        func() []atc.WorkerResourceType
 	resourceTypesMutex       sync.RWMutex
 	resourceTypesArgsForCall []struct {
@@ -508,6 +596,112 @@ func (fake *FakeWorker) ActiveVolumesReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorker) AllocatableCPU() uint64 {
+	fake.allocatableCPUMutex.Lock()
+	ret, specificReturn := fake.allocatableCPUReturnsOnCall[len(fake.allocatableCPUArgsForCall)]
+	fake.allocatableCPUArgsForCall = append(fake.allocatableCPUArgsForCall, struct {
+	}{})
+	stub := fake.AllocatableCPUStub
+	fakeReturns := fake.allocatableCPUReturns
+	fake.recordInvocation("AllocatableCPU", []interface{}{})
+	fake.allocatableCPUMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableCPUCallCount() int {
+	fake.allocatableCPUMutex.RLock()
+	defer fake.allocatableCPUMutex.RUnlock()
+	return len(fake.allocatableCPUArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableCPUCalls(stub func() uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = stub
+}
+
+func (fake *FakeWorker) AllocatableCPUReturns(result1 uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = nil
+	fake.allocatableCPUReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableCPUReturnsOnCall(i int, result1 uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = nil
+	if fake.allocatableCPUReturnsOnCall == nil {
+		fake.allocatableCPUReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.allocatableCPUReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableMemory() uint64 {
+	fake.allocatableMemoryMutex.Lock()
+	ret, specificReturn := fake.allocatableMemoryReturnsOnCall[len(fake.allocatableMemoryArgsForCall)]
+	fake.allocatableMemoryArgsForCall = append(fake.allocatableMemoryArgsForCall, struct {
+	}{})
+	stub := fake.AllocatableMemoryStub
+	fakeReturns := fake.allocatableMemoryReturns
+	fake.recordInvocation("AllocatableMemory", []interface{}{})
+	fake.allocatableMemoryMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableMemoryCallCount() int {
+	fake.allocatableMemoryMutex.RLock()
+	defer fake.allocatableMemoryMutex.RUnlock()
+	return len(fake.allocatableMemoryArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableMemoryCalls(stub func() uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = stub
+}
+
+func (fake *FakeWorker) AllocatableMemoryReturns(result1 uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = nil
+	fake.allocatableMemoryReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableMemoryReturnsOnCall(i int, result1 uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = nil
+	if fake.allocatableMemoryReturnsOnCall == nil {
+		fake.allocatableMemoryReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.allocatableMemoryReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
 func (fake *FakeWorker) BuildContainers() int {
 	fake.buildContainersMutex.Lock()
 	ret, specificReturn := fake.buildContainersReturnsOnCall[len(fake.buildContainersArgsForCall)]
@@ -695,6 +889,75 @@ func (fake *FakeWorker) CreateVolumeReturnsOnCall(i int, result1 worker.Volume,
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) CreateVolumeForTaskCache(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 int, arg5 string, arg6 string) (worker.Volume, error) {
+	fake.createVolumeForTaskCacheMutex.Lock()
+	ret, specificReturn := fake.createVolumeForTaskCacheReturnsOnCall[len(fake.createVolumeForTaskCacheArgsForCall)]
+	fake.createVolumeForTaskCacheArgsForCall = append(fake.createVolumeForTaskCacheArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.VolumeSpec
+		arg3 int
+		arg4 int
+		arg5 string
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.CreateVolumeForTaskCacheStub
+	fakeReturns := fake.createVolumeForTaskCacheReturns
+	fake.recordInvocation("CreateVolumeForTaskCache", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.createVolumeForTaskCacheMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) CreateVolumeForTaskCacheCallCount() int {
+	fake.createVolumeForTaskCacheMutex.RLock()
+	defer fake.createVolumeForTaskCacheMutex.RUnlock()
+	return len(fake.createVolumeForTaskCacheArgsForCall)
+}
+
+func (fake *FakeWorker) CreateVolumeForTaskCacheCalls(stub func(lager.Logger, worker.VolumeSpec, int, int, string, string) (worker.Volume, error)) {
+	fake.createVolumeForTaskCacheMutex.Lock()
+	defer fake.createVolumeForTaskCacheMutex.Unlock()
+	fake.CreateVolumeForTaskCacheStub = stub
+}
+
+func (fake *FakeWorker) CreateVolumeForTaskCacheArgsForCall(i int) (lager.Logger, worker.VolumeSpec, int, int, string, string) {
+	fake.createVolumeForTaskCacheMutex.RLock()
+	defer fake.createVolumeForTaskCacheMutex.RUnlock()
+	argsForCall := fake.createVolumeForTaskCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeWorker) CreateVolumeForTaskCacheReturns(result1 worker.Volume, result2 error) {
+	fake.createVolumeForTaskCacheMutex.Lock()
+	defer fake.createVolumeForTaskCacheMutex.Unlock()
+	fake.CreateVolumeForTaskCacheStub = nil
+	fake.createVolumeForTaskCacheReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CreateVolumeForTaskCacheReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.createVolumeForTaskCacheMutex.Lock()
+	defer fake.createVolumeForTaskCacheMutex.Unlock()
+	fake.CreateVolumeForTaskCacheStub = nil
+	if fake.createVolumeForTaskCacheReturnsOnCall == nil {
+		fake.createVolumeForTaskCacheReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.createVolumeForTaskCacheReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) DecreaseActiveTasks() (int, error) {
 	fake.decreaseActiveTasksMutex.Lock()
 	ret, specificReturn := fake.decreaseActiveTasksReturnsOnCall[len(fake.decreaseActiveTasksArgsForCall)]
@@ -1500,6 +1763,59 @@ func (fake *FakeWorker) IsVersionCompatibleReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Labels() atc.WorkerLabels {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	stub := fake.LabelsStub
+	fakeReturns := fake.labelsReturns
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsCalls(stub func() atc.WorkerLabels) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = stub
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 atc.WorkerLabels) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 atc.WorkerLabels
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 atc.WorkerLabels) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerLabels
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 atc.WorkerLabels
+	}{result1}
+}
+
 func (fake *FakeWorker) LookupVolume(arg1 lager.Logger, arg2 string) (worker.Volume, bool, error) {
 	fake.lookupVolumeMutex.Lock()
 	ret, specificReturn := fake.lookupVolumeReturnsOnCall[len(fake.lookupVolumeArgsForCall)]
@@ -1621,6 +1937,192 @@ func (fake *FakeWorker) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ReleaseResources(arg1 db.ContainerOwner) error {
+	fake.releaseResourcesMutex.Lock()
+	ret, specificReturn := fake.releaseResourcesReturnsOnCall[len(fake.releaseResourcesArgsForCall)]
+	fake.releaseResourcesArgsForCall = append(fake.releaseResourcesArgsForCall, struct {
+		arg1 db.ContainerOwner
+	}{arg1})
+	stub := fake.ReleaseResourcesStub
+	fakeReturns := fake.releaseResourcesReturns
+	fake.recordInvocation("ReleaseResources", []interface{}{arg1})
+	fake.releaseResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ReleaseResourcesCallCount() int {
+	fake.releaseResourcesMutex.RLock()
+	defer fake.releaseResourcesMutex.RUnlock()
+	return len(fake.releaseResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ReleaseResourcesCalls(stub func(db.ContainerOwner) error) {
+	fake.releaseResourcesMutex.Lock()
+	defer fake.releaseResourcesMutex.Unlock()
+	fake.ReleaseResourcesStub = stub
+}
+
+func (fake *FakeWorker) ReleaseResourcesArgsForCall(i int) db.ContainerOwner {
+	fake.releaseResourcesMutex.RLock()
+	defer fake.releaseResourcesMutex.RUnlock()
+	argsForCall := fake.releaseResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) ReleaseResourcesReturns(result1 error) {
+	fake.releaseResourcesMutex.Lock()
+	defer fake.releaseResourcesMutex.Unlock()
+	fake.ReleaseResourcesStub = nil
+	fake.releaseResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) ReleaseResourcesReturnsOnCall(i int, result1 error) {
+	fake.releaseResourcesMutex.Lock()
+	defer fake.releaseResourcesMutex.Unlock()
+	fake.ReleaseResourcesStub = nil
+	if fake.releaseResourcesReturnsOnCall == nil {
+		fake.releaseResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) ReserveResources(arg1 db.ContainerOwner, arg2 uint64, arg3 uint64) (bool, error) {
+	fake.reserveResourcesMutex.Lock()
+	ret, specificReturn := fake.reserveResourcesReturnsOnCall[len(fake.reserveResourcesArgsForCall)]
+	fake.reserveResourcesArgsForCall = append(fake.reserveResourcesArgsForCall, struct {
+		arg1 db.ContainerOwner
+		arg2 uint64
+		arg3 uint64
+	}{arg1, arg2, arg3})
+	stub := fake.ReserveResourcesStub
+	fakeReturns := fake.reserveResourcesReturns
+	fake.recordInvocation("ReserveResources", []interface{}{arg1, arg2, arg3})
+	fake.reserveResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) ReserveResourcesCallCount() int {
+	fake.reserveResourcesMutex.RLock()
+	defer fake.reserveResourcesMutex.RUnlock()
+	return len(fake.reserveResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ReserveResourcesCalls(stub func(db.ContainerOwner, uint64, uint64) (bool, error)) {
+	fake.reserveResourcesMutex.Lock()
+	defer fake.reserveResourcesMutex.Unlock()
+	fake.ReserveResourcesStub = stub
+}
+
+func (fake *FakeWorker) ReserveResourcesArgsForCall(i int) (db.ContainerOwner, uint64, uint64) {
+	fake.reserveResourcesMutex.RLock()
+	defer fake.reserveResourcesMutex.RUnlock()
+	argsForCall := fake.reserveResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeWorker) ReserveResourcesReturns(result1 bool, result2 error) {
+	fake.reserveResourcesMutex.Lock()
+	defer fake.reserveResourcesMutex.Unlock()
+	fake.ReserveResourcesStub = nil
+	fake.reserveResourcesReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) ReserveResourcesReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.reserveResourcesMutex.Lock()
+	defer fake.reserveResourcesMutex.Unlock()
+	fake.ReserveResourcesStub = nil
+	if fake.reserveResourcesReturnsOnCall == nil {
+		fake.reserveResourcesReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.reserveResourcesReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) ReservedResources() (uint64, uint64, error) {
+	fake.reservedResourcesMutex.Lock()
+	ret, specificReturn := fake.reservedResourcesReturnsOnCall[len(fake.reservedResourcesArgsForCall)]
+	fake.reservedResourcesArgsForCall = append(fake.reservedResourcesArgsForCall, struct {
+	}{})
+	stub := fake.ReservedResourcesStub
+	fakeReturns := fake.reservedResourcesReturns
+	fake.recordInvocation("ReservedResources", []interface{}{})
+	fake.reservedResourcesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) ReservedResourcesCallCount() int {
+	fake.reservedResourcesMutex.RLock()
+	defer fake.reservedResourcesMutex.RUnlock()
+	return len(fake.reservedResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ReservedResourcesCalls(stub func() (uint64, uint64, error)) {
+	fake.reservedResourcesMutex.Lock()
+	defer fake.reservedResourcesMutex.Unlock()
+	fake.ReservedResourcesStub = stub
+}
+
+func (fake *FakeWorker) ReservedResourcesReturns(result1 uint64, result2 uint64, result3 error) {
+	fake.reservedResourcesMutex.Lock()
+	defer fake.reservedResourcesMutex.Unlock()
+	fake.ReservedResourcesStub = nil
+	fake.reservedResourcesReturns = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) ReservedResourcesReturnsOnCall(i int, result1 uint64, result2 uint64, result3 error) {
+	fake.reservedResourcesMutex.Lock()
+	defer fake.reservedResourcesMutex.Unlock()
+	fake.ReservedResourcesStub = nil
+	if fake.reservedResourcesReturnsOnCall == nil {
+		fake.reservedResourcesReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.reservedResourcesReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) ResourceTypes() []atc.WorkerResourceType {
 	fake.resourceTypesMutex.Lock()
 	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
@@ -1851,12 +2353,18 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.activeTasksMutex.RUnlock()
 	fake.activeVolumesMutex.RLock()
 	defer fake.activeVolumesMutex.RUnlock()
+	fake.allocatableCPUMutex.RLock()
+	defer fake.allocatableCPUMutex.RUnlock()
+	fake.allocatableMemoryMutex.RLock()
+	defer fake.allocatableMemoryMutex.RUnlock()
 	fake.buildContainersMutex.RLock()
 	defer fake.buildContainersMutex.RUnlock()
 	fake.certsVolumeMutex.RLock()
 	defer fake.certsVolumeMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
+	fake.createVolumeForTaskCacheMutex.RLock()
+	defer fake.createVolumeForTaskCacheMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
 	defer fake.decreaseActiveTasksMutex.RUnlock()
 	fake.descriptionMutex.RLock()
@@ -1883,10 +2391,18 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.isOwnedByTeamMutex.RUnlock()
 	fake.isVersionCompatibleMutex.RLock()
 	defer fake.isVersionCompatibleMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.lookupVolumeMutex.RLock()
 	defer fake.lookupVolumeMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.releaseResourcesMutex.RLock()
+	defer fake.releaseResourcesMutex.RUnlock()
+	fake.reserveResourcesMutex.RLock()
+	defer fake.reserveResourcesMutex.RUnlock()
+	fake.reservedResourcesMutex.RLock()
+	defer fake.reservedResourcesMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.satisfiesMutex.RLock()
@@ -11,13 +11,11 @@ func NewAccessorWrappa(
 	logger lager.Logger,
 	accessFactory accessor.AccessFactory,
 	auditor auditor.Auditor,
-	customRoles map[string]string,
 ) *AccessorWrappa {
 	return &AccessorWrappa{
 		logger:        logger,
 		accessFactory: accessFactory,
 		auditor:       auditor,
-		customRoles:   customRoles,
 	}
 }
 
@@ -25,7 +23,6 @@ type AccessorWrappa struct {
 	logger        lager.Logger
 	accessFactory accessor.AccessFactory
 	auditor       auditor.Auditor
-	customRoles   map[string]string
 }
 
 func (w *AccessorWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
@@ -38,7 +35,6 @@ func (w *AccessorWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			handler,
 			w.accessFactory,
 			w.auditor,
-			w.customRoles,
 		)
 	}
 
@@ -47,6 +47,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
+			atc.GetBuildProvenance,
 			atc.ListBuildArtifacts:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
@@ -61,7 +62,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListDestroyingVolumes,
 			atc.ListDestroyingContainers,
 			atc.ReportWorkerContainers,
-			atc.ReportWorkerVolumes:
+			atc.ReportWorkerVolumes,
+			atc.ReportVolumeSizes:
 			newHandler = wrappa.checkWorkerTeamAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// pipeline is public or authorized
@@ -102,6 +104,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListAllJobs,
 			atc.ListAllResources,
 			atc.ListBuilds,
+			atc.ListBuildQueue,
 			atc.MainJobBadge,
 			atc.GetWall:
 			newHandler = auth.CheckAuthenticationIfProvidedHandler(handler, rejector)
@@ -110,6 +113,9 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetLogLevel,
 			atc.DestroyTeam,
 			atc.ListActiveUsersSince,
+			atc.ListPolicyDecisions,
+			atc.ListAuditEvents,
+			atc.GetEncryptionProgress,
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
@@ -120,6 +126,12 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetTeam,
 			atc.SetTeam,
 			atc.RenameTeam,
+			atc.SearchBuildLogs,
+			atc.ListServiceAccounts,
+			atc.CreateServiceAccount,
+			atc.DeleteServiceAccount,
+			atc.CreateServiceAccountToken,
+			atc.RevokeServiceAccountToken,
 			atc.ListContainers,
 			atc.GetContainer,
 			atc.HijackContainer,
@@ -139,6 +151,9 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetConfig,
 			atc.GetCC,
 			atc.GetVersionsDB,
+			atc.ListPipelineNotifications,
+			atc.ListPipelineConfigHistory,
+			atc.GetPipelineConfigRevision,
 			atc.ListJobInputs,
 			atc.OrderPipelines,
 			atc.PauseJob,
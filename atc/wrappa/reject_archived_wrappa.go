@@ -48,11 +48,13 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListBuildArtifacts,
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
+			atc.GetBuildProvenance,
 			atc.AbortBuild,
 			atc.PruneWorker,
 			atc.LandWorker,
 			atc.ReportWorkerContainers,
 			atc.ReportWorkerVolumes,
+			atc.ReportVolumeSizes,
 			atc.RetireWorker,
 			atc.ListDestroyingContainers,
 			atc.ListDestroyingVolumes,
@@ -78,6 +80,12 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListContainers,
 			atc.ListVolumes,
 			atc.ListTeamBuilds,
+			atc.SearchBuildLogs,
+			atc.ListServiceAccounts,
+			atc.CreateServiceAccount,
+			atc.DeleteServiceAccount,
+			atc.CreateServiceAccountToken,
+			atc.RevokeServiceAccountToken,
 			atc.ListWorkers,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
@@ -92,6 +100,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.CheckResourceWebHook,
 			atc.ListAllPipelines,
 			atc.ListBuilds,
+			atc.ListBuildQueue,
 			atc.ListPipelines,
 			atc.ListAllJobs,
 			atc.ListAllResources,
@@ -102,11 +111,17 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.ListActiveUsersSince,
+			atc.ListPolicyDecisions,
+			atc.ListAuditEvents,
+			atc.GetEncryptionProgress,
 			atc.SetWall,
 			atc.ClearWall,
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
+			atc.ListPipelineNotifications,
+			atc.ListPipelineConfigHistory,
+			atc.GetPipelineConfigRevision,
 			atc.ListJobInputs,
 			atc.OrderPipelines,
 			atc.PauseJob,
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/applyhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/vito/go-interact/interact"
+)
+
+// applyExitDriftDetected is the exit code of fly apply --check when the
+// cluster has drifted from the file.
+const applyExitDriftDetected = 2
+
+type ApplyCommand struct {
+	File            atc.PathFlag `short:"f" long:"file" required:"true" description:"Cluster configuration file declaring teams and their pipelines"`
+	DryRun          bool         `long:"dry-run" description:"Show what would change without applying it"`
+	Check           bool         `long:"check" description:"Show what would change without applying it, exiting 2 if anything has drifted"`
+	Prune           bool         `long:"prune" description:"Destroy teams and pipelines which are not declared in the file (the main team is never destroyed)"`
+	SkipInteractive bool         `short:"n" long:"non-interactive" description:"Apply changes without confirmation"`
+}
+
+// Execute exits 0 once the cluster matches the file, and 1 on failure. With
+// --check it only exits 0 if the cluster already matched, and 2 if it has
+// drifted.
+func (command *ApplyCommand) Execute([]string) error {
+	cluster, err := applyhelpers.LoadCluster(string(command.File))
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cluster.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+
+	planner := applyhelpers.Planner{
+		Client: target.Client(),
+		Prune:  command.Prune,
+		Out:    stdout,
+	}
+
+	plan, err := planner.Plan(cluster)
+	if err != nil {
+		return err
+	}
+
+	warnings = append(warnings, plan.Warnings...)
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	if len(plan.Changes) == 0 {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("changes:")
+	for _, change := range plan.Changes {
+		fmt.Printf("  - %s\n", change.Description)
+	}
+
+	if command.Check {
+		fmt.Println()
+		fmt.Println("drift detected")
+		os.Exit(applyExitDriftDetected)
+	}
+
+	if command.DryRun {
+		return nil
+	}
+
+	confirm := true
+	if !command.SkipInteractive {
+		confirm = false
+		err = interact.NewInteraction("\napply changes?").Resolve(&confirm)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !confirm {
+		displayhelpers.Failf("bailing out")
+	}
+
+	fmt.Println()
+
+	warnings, err = plan.Apply(os.Stdout)
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("changes applied")
+
+	return nil
+}
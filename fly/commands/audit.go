@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type AuditCommand struct {
+	Team   string `short:"n" long:"team" description:"Show the events of this team"`
+	User   string `short:"u" long:"user" description:"Show the events caused by this user"`
+	Action string `short:"a" long:"action" description:"Show the events for this API action, e.g. SaveConfig"`
+	Since  string `long:"since" description:"Show the events from this date on (yyyy-mm-dd)"`
+	Until  string `long:"until" description:"Show the events before this date (yyyy-mm-dd)"`
+	Count  int    `short:"c" long:"count" default:"50" description:"Number of events to show"`
+	Json   bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *AuditCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	filter := concourse.AuditEventFilter{
+		Team:   command.Team,
+		User:   command.User,
+		Action: command.Action,
+		Limit:  command.Count,
+	}
+
+	if command.Since != "" {
+		filter.Since, err = time.ParseInLocation(inputDateLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return errors.New("since time should be in the format: yyyy-mm-dd")
+		}
+	}
+
+	if command.Until != "" {
+		filter.Until, err = time.ParseInLocation(inputDateLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return errors.New("until time should be in the format: yyyy-mm-dd")
+		}
+	}
+
+	events, err := target.Client().ListAuditEvents(filter)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(events)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "time", Color: color.New(color.Bold)},
+			{Contents: "user", Color: color.New(color.Bold)},
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "action", Color: color.New(color.Bold)},
+			{Contents: "target", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, event := range events {
+		userCell := ui.TableCell{Contents: event.User}
+		if event.User == "" {
+			userCell.Contents = "anonymous"
+			userCell.Color = color.New(color.Faint)
+		}
+
+		teamCell := ui.TableCell{Contents: event.Team}
+		if event.Team == "" {
+			teamCell.Contents = "none"
+			teamCell.Color = color.New(color.Faint)
+		}
+
+		statusCell := ui.TableCell{Contents: strconv.Itoa(event.Status)}
+		if event.Status >= 400 {
+			statusCell.Color = color.New(color.FgRed)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: time.Unix(event.Time, 0).Local().Format(timeDateLayout)},
+			userCell,
+			teamCell,
+			{Contents: event.Action},
+			{Contents: event.Target},
+			statusCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -22,6 +22,7 @@ const inputTimeLayout = "2006-01-02 15:04:05"
 
 type BuildsCommand struct {
 	AllTeams    bool                      `short:"a" long:"all-teams" description:"Show builds for the all teams that user has access to"`
+	Cause       bool                      `long:"cause" description:"Show why each build was created"`
 	Count       int                       `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
 	CurrentTeam bool                      `long:"current-team" description:"Show builds for the currently targeted team"`
 	Job         flaghelpers.JobFlag       `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
@@ -196,6 +197,10 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 		},
 	}
 
+	if command.Cause {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: "cause", Color: color.New(color.Bold)})
+	}
+
 	buildCap := command.buildCap(builds)
 	for _, b := range builds[:buildCap] {
 		startTimeCell, endTimeCell, durationCell := populateTimeCells(time.Unix(b.StartTime, 0), time.Unix(b.EndTime, 0))
@@ -228,7 +233,7 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 		if b.CreatedBy != nil {
 			createdBy = *b.CreatedBy
 		}
-		table.Data = append(table.Data, []ui.TableCell{
+		row := ui.TableRow{
 			{Contents: strconv.Itoa(b.ID)},
 			nameCell,
 			ui.BuildStatusCell(b.Status),
@@ -237,7 +242,18 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			durationCell,
 			{Contents: b.TeamName},
 			{Contents: createdBy},
-		})
+		}
+
+		if command.Cause {
+			causeCell := ui.TableCell{Contents: "n/a"}
+			if b.Cause != nil {
+				causeCell = ui.TableCell{Contents: b.Cause.String()}
+			}
+
+			row = append(row, causeCell)
+		}
+
+		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type DiffPipelineCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline whose config revisions to compare"`
+	From     int                      `long:"from" required:"true" description:"Revision to compare from"`
+	To       int                      `long:"to" description:"Revision to compare to, defaults to the current config"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *DiffPipelineCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	fromConfig, err := configRevision(team, pipelineRef, command.From)
+	if err != nil {
+		return err
+	}
+
+	var toConfig atc.Config
+	if command.To != 0 {
+		toConfig, err = configRevision(team, pipelineRef, command.To)
+		if err != nil {
+			return err
+		}
+	} else {
+		var found bool
+		toConfig, _, found, err = team.PipelineConfig(pipelineRef)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
+		}
+	}
+
+	if !fromConfig.Diff(os.Stdout, toConfig) {
+		fmt.Println("no changes")
+	}
+
+	return nil
+}
+
+func configRevision(team concourse.Team, pipelineRef atc.PipelineRef, number int) (atc.Config, error) {
+	revision, found, err := team.PipelineConfigRevision(pipelineRef, number)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	if !found || revision.Config == nil {
+		return atc.Config{}, fmt.Errorf("revision %d of pipeline '%s' not found", number, pipelineRef.String())
+	}
+
+	return *revision.Config, nil
+}
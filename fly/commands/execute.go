@@ -1,10 +1,15 @@
 package commands
 
 import (
+	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"syscall"
 	"time"
@@ -13,6 +18,7 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/executehelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"github.com/concourse/concourse/fly/config"
 	"github.com/concourse/concourse/fly/eventstream"
@@ -36,9 +42,28 @@ type ExecuteCommand struct {
 	Var            []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  unquote:"false"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar        []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    unquote:"false"  description:"Specify a YAML value to set for a variable in the pipeline"`
 	VarsFrom       []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+	Local          LocalExecuteFlags                  `group:"Local Execution"`
+}
+
+type LocalExecuteFlags struct {
+	Enabled       bool   `long:"local"                 description:"Run the task on this machine with containerd instead of on the targeted Concourse"`
+	Rootfs        string `long:"local-rootfs"          value-name:"PATH" description:"Directory to use as the task's root filesystem instead of fetching its image_resource"`
+	Containerd    string `long:"local-containerd"      value-name:"SOCKET" default:"/run/containerd/containerd.sock" description:"Address of the containerd daemon to run the task with"`
+	InitBin       string `long:"local-init-bin"        value-name:"PATH" default:"/usr/local/concourse/bin/init" description:"Path to the init executable to run in the task container"`
+	CNIPluginsDir string `long:"local-cni-plugins-dir" value-name:"PATH" default:"/usr/local/concourse/bin" description:"Path to CNI network plugins"`
+	CacheDir      string `long:"local-cache-dir"       value-name:"PATH" description:"Directory to keep fetched images and task caches in (default: the user's cache directory)"`
 }
 
 func (command *ExecuteCommand) Execute(args []string) error {
+	if command.Local.Enabled {
+		exitCode, err := command.executeLocally(args)
+		if err != nil {
+			return err
+		}
+
+		os.Exit(exitCode)
+	}
+
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -201,6 +226,113 @@ func (command *ExecuteCommand) CreateTaskConfig(args []string) (atc.TaskConfig,
 	return config.OverrideTaskParams(taskTemplateEvaluated, args)
 }
 
+func (command *ExecuteCommand) executeLocally(args []string) (int, error) {
+	switch {
+	case command.InputsFrom.PipelineRef.Name != "":
+		return 0, errors.New("--inputs-from cannot be used with --local")
+	case len(command.InputMappings) > 0:
+		return 0, errors.New("--input-mapping cannot be used with --local")
+	case command.Image != "":
+		return 0, errors.New("--image cannot be used with --local")
+	case len(command.Tags) > 0:
+		return 0, errors.New("--tag cannot be used with --local")
+	}
+
+	taskConfig, err := command.CreateTaskConfig(args)
+	if err != nil {
+		return 0, err
+	}
+
+	inputs, err := executehelpers.DetermineLocalInputs(taskConfig.Inputs, command.Inputs)
+	if err != nil {
+		return 0, err
+	}
+
+	outputs, err := executehelpers.DetermineOutputs(
+		atc.NewPlanFactory(time.Now().Unix()),
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	outputPaths := map[string]string{}
+	for _, output := range outputs {
+		outputPaths[output.Name] = output.Path
+	}
+
+	cacheDir := command.Local.CacheDir
+	if cacheDir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return 0, err
+		}
+
+		cacheDir = filepath.Join(userCacheDir, "concourse", "fly")
+	}
+
+	// caches are shared between runs of the same task config, the same way
+	// they are shared between builds of the same job on a worker
+	configPath, err := filepath.Abs(string(command.TaskConfig))
+	if err != nil {
+		return 0, err
+	}
+
+	taskCacheDir := filepath.Join(cacheDir, "task-caches", fmt.Sprintf("%x", sha256.Sum256([]byte(configPath))))
+
+	workDir, err := ioutil.TempDir("", "fly-execute-")
+	if err != nil {
+		return 0, err
+	}
+
+	defer os.RemoveAll(workDir)
+
+	containers, stop, err := localexec.NewContainerdRuntime(localexec.ContainerdConfig{
+		Address:       command.Local.Containerd,
+		InitBin:       command.Local.InitBin,
+		CNIPluginsDir: command.Local.CNIPluginsDir,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	defer stop()
+
+	runner := localexec.NewRunner(
+		containers,
+		localexec.NewRegistryImageFetcher(filepath.Join(cacheDir, "images")),
+		workDir,
+		taskCacheDir,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	terminate := make(chan os.Signal, 1)
+
+	go func() {
+		<-terminate
+		fmt.Fprintf(ui.Stderr, "\naborting...\n")
+		cancel()
+
+		// if told to terminate again, exit immediately
+		<-terminate
+		fmt.Fprintln(ui.Stderr, "exiting immediately")
+		os.Exit(2)
+	}()
+
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	return runner.Run(ctx, localexec.Task{
+		Config:     taskConfig,
+		Privileged: command.Privileged,
+		Inputs:     inputs,
+		Outputs:    outputPaths,
+		Rootfs:     command.Local.Rootfs,
+	}, os.Stdout, os.Stderr)
+}
+
 func abortOnSignal(
 	client concourse.Client,
 	terminate <-chan os.Signal,
@@ -25,6 +25,10 @@ type FlyCommand struct {
 
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
+	Audit       AuditCommand       `command:"audit" description:"List the audit trail of API requests"`
+
+	ServiceAccounts ServiceAccountsCommand `command:"service-accounts" alias:"sas" description:"Manage the service accounts of a team"`
+	Tokens          TokensCommand          `command:"tokens" description:"Manage the API tokens of a team's service accounts"`
 
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	GetTeam     GetTeamCommand     `command:"get-team"  alias:"gt" description:"Show team configuration"`
@@ -59,6 +63,12 @@ type FlyCommand struct {
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
 
+	PipelineHistory  PipelineHistoryCommand  `command:"pipeline-history"    alias:"ph"   description:"List the saved config revisions of a pipeline"`
+	DiffPipeline     DiffPipelineCommand     `command:"diff-pipeline"       alias:"dfp"  description:"Show the changes between two config revisions of a pipeline"`
+	RollbackPipeline RollbackPipelineCommand `command:"rollback-pipeline"   alias:"rbp"  description:"Restore the config of a pipeline from an earlier revision"`
+
+	Apply ApplyCommand `command:"apply" description:"Reconcile teams and pipelines with a cluster configuration file"`
+
 	Resources              ResourcesCommand              `command:"resources"                  alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"          alias:"rvs"  description:"List the versions of a resource"`
 	CheckResource          CheckResourceCommand          `command:"check-resource"             alias:"cr"   description:"Check a resource"`
@@ -74,9 +84,16 @@ type FlyCommand struct {
 	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
 	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
 	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Provenance ProvenanceCommand `command:"provenance"  alias:"pv" description:"Print the signed provenance of a build"`
+
+	Queue QueueCommand `command:"queue" alias:"q" description:"List pending builds in the order they will be scheduled"`
+
+	SearchLogs SearchLogsCommand `command:"search-logs" alias:"sl" description:"Search the build logs of a team"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
+	Notifications NotificationsCommand `command:"notifications" alias:"ns" description:"List the notification deliveries of a pipeline"`
+
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
 	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
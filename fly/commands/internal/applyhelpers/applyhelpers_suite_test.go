@@ -0,0 +1,13 @@
+package applyhelpers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestApplyhelpers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Apply Helpers Suite")
+}
@@ -0,0 +1,155 @@
+// Package applyhelpers reconciles a single document declaring teams and their
+// pipelines against a Concourse.
+package applyhelpers
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"sigs.k8s.io/yaml"
+)
+
+type Cluster struct {
+	Teams []TeamConfig `json:"teams"`
+}
+
+type TeamConfig struct {
+	Name string `json:"name"`
+
+	// Auth is left untouched on an existing team when it is omitted.
+	Auth atc.TeamAuth `json:"auth,omitempty"`
+
+	// Pipelines are ordered on the team in the order they are declared.
+	Pipelines []PipelineConfig `json:"pipelines,omitempty"`
+}
+
+type PipelineConfig struct {
+	Name         string                 `json:"name"`
+	File         string                 `json:"file"`
+	Vars         map[string]interface{} `json:"vars,omitempty"`
+	VarsFiles    []string               `json:"vars_files,omitempty"`
+	InstanceVars atc.InstanceVars       `json:"instance_vars,omitempty"`
+
+	// Paused and Exposed are left untouched when they are omitted.
+	Paused  *bool `json:"paused,omitempty"`
+	Exposed *bool `json:"exposed,omitempty"`
+}
+
+func (pipeline PipelineConfig) Ref() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         pipeline.Name,
+		InstanceVars: pipeline.InstanceVars,
+	}
+}
+
+// LoadCluster reads a cluster document. Pipeline files and vars files are
+// resolved relative to the document.
+func LoadCluster(path string) (Cluster, error) {
+	payload, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Cluster{}, fmt.Errorf("could not read file: %s", err.Error())
+	}
+
+	var cluster Cluster
+	err = yaml.UnmarshalStrict(payload, &cluster)
+	if err != nil {
+		return Cluster{}, fmt.Errorf("malformed cluster config: %s", err.Error())
+	}
+
+	dir := filepath.Dir(path)
+	for i, team := range cluster.Teams {
+		for j, pipeline := range team.Pipelines {
+			cluster.Teams[i].Pipelines[j].File = resolvePath(dir, pipeline.File)
+
+			for k, varsFile := range pipeline.VarsFiles {
+				cluster.Teams[i].Pipelines[j].VarsFiles[k] = resolvePath(dir, varsFile)
+			}
+		}
+	}
+
+	return cluster, nil
+}
+
+func resolvePath(dir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
+
+func (cluster Cluster) Validate() ([]concourse.ConfigWarning, error) {
+	var warnings []concourse.ConfigWarning
+	var errorMessages []string
+
+	if len(cluster.Teams) == 0 {
+		return nil, errors.New("cluster config must declare at least one team")
+	}
+
+	teams := map[string]bool{}
+	for i, team := range cluster.Teams {
+		identifier := fmt.Sprintf("teams[%d]", i)
+		if team.Name != "" {
+			identifier = fmt.Sprintf("teams.%s", team.Name)
+		}
+
+		warning, err := atc.ValidateIdentifier(team.Name, "team")
+		if err != nil {
+			errorMessages = append(errorMessages, identifier+": "+err.Error())
+			continue
+		}
+		if warning != nil {
+			warnings = append(warnings, concourse.ConfigWarning{
+				Type:    warning.Type,
+				Message: warning.Message,
+			})
+		}
+
+		if teams[team.Name] {
+			errorMessages = append(errorMessages, identifier+": team is declared more than once")
+		}
+		teams[team.Name] = true
+
+		if team.Auth != nil {
+			err := team.Auth.Validate()
+			if err != nil {
+				errorMessages = append(errorMessages, identifier+".auth: "+err.Error())
+			}
+		}
+
+		pipelines := map[string]bool{}
+		for j, pipeline := range team.Pipelines {
+			pipelineIdentifier := fmt.Sprintf("%s.pipelines[%d]", identifier, j)
+			if pipeline.Name != "" {
+				pipelineIdentifier = fmt.Sprintf("%s.pipelines.%s", identifier, pipeline.Ref())
+			}
+
+			if pipeline.Name == "" {
+				errorMessages = append(errorMessages, pipelineIdentifier+": pipeline has no name")
+			} else if strings.Contains(pipeline.Name, "/") {
+				errorMessages = append(errorMessages, pipelineIdentifier+": pipeline name cannot contain '/'")
+			}
+
+			if pipeline.File == "" {
+				errorMessages = append(errorMessages, pipelineIdentifier+": pipeline has no file")
+			}
+
+			ref := pipeline.Ref().String()
+			if pipelines[ref] {
+				errorMessages = append(errorMessages, pipelineIdentifier+": pipeline is declared more than once")
+			}
+			pipelines[ref] = true
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return warnings, fmt.Errorf("invalid cluster config:\n  %s", strings.Join(errorMessages, "\n  "))
+	}
+
+	return warnings, nil
+}
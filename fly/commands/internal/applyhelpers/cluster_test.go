@@ -0,0 +1,134 @@
+package applyhelpers_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/applyhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster", func() {
+	Describe("LoadCluster", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "fly-apply")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("resolves pipeline files relative to the document", func() {
+			path := filepath.Join(dir, "cluster.yml")
+			err := ioutil.WriteFile(path, []byte(`
+teams:
+- name: some-team
+  auth:
+    owner:
+      users: [local:admin]
+  pipelines:
+  - name: some-pipeline
+    file: pipelines/some-pipeline.yml
+    vars_files: [/abs/vars.yml, vars.yml]
+    instance_vars: {branch: main}
+    paused: false
+`), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			cluster, err := LoadCluster(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			paused := false
+			Expect(cluster).To(Equal(Cluster{
+				Teams: []TeamConfig{
+					{
+						Name: "some-team",
+						Auth: atc.TeamAuth{"owner": {"users": {"local:admin"}}},
+						Pipelines: []PipelineConfig{
+							{
+								Name:         "some-pipeline",
+								File:         filepath.Join(dir, "pipelines", "some-pipeline.yml"),
+								VarsFiles:    []string{"/abs/vars.yml", filepath.Join(dir, "vars.yml")},
+								InstanceVars: atc.InstanceVars{"branch": "main"},
+								Paused:       &paused,
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("rejects unknown fields", func() {
+			path := filepath.Join(dir, "cluster.yml")
+			err := ioutil.WriteFile(path, []byte(`
+teams:
+- name: some-team
+  pipelnes: []
+`), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = LoadCluster(path)
+			Expect(err).To(MatchError(ContainSubstring("malformed cluster config")))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid cluster", func() {
+			warnings, err := Cluster{
+				Teams: []TeamConfig{
+					{
+						Name:      "some-team",
+						Pipelines: []PipelineConfig{{Name: "some-pipeline", File: "some-pipeline.yml"}},
+					},
+				},
+			}.Validate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("warns about invalid identifiers", func() {
+			warnings, err := Cluster{Teams: []TeamConfig{{Name: "Some_Team"}}}.Validate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Type).To(Equal("invalid_identifier"))
+		})
+
+		It("rejects an empty cluster", func() {
+			_, err := Cluster{}.Validate()
+			Expect(err).To(MatchError("cluster config must declare at least one team"))
+		})
+
+		It("reports every problem", func() {
+			_, err := Cluster{
+				Teams: []TeamConfig{
+					{Name: "some-team", Auth: atc.TeamAuth{}},
+					{
+						Name: "some-team",
+						Pipelines: []PipelineConfig{
+							{Name: "some/pipeline", File: "some-pipeline.yml"},
+							{Name: "other-pipeline"},
+							{Name: "dup", File: "dup.yml", InstanceVars: atc.InstanceVars{"branch": "main"}},
+							{Name: "dup", File: "dup.yml", InstanceVars: atc.InstanceVars{"branch": "main"}},
+							{Name: "dup", File: "dup.yml", InstanceVars: atc.InstanceVars{"branch": "other"}},
+						},
+					},
+				},
+			}.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("teams.some-team.auth: " + atc.ErrAuthConfigEmpty.Error()))
+			Expect(err.Error()).To(ContainSubstring("teams.some-team: team is declared more than once"))
+			Expect(err.Error()).To(ContainSubstring("teams.some-team.pipelines.some/pipeline: pipeline name cannot contain '/'"))
+			Expect(err.Error()).To(ContainSubstring("teams.some-team.pipelines.other-pipeline: pipeline has no file"))
+			Expect(err.Error()).To(ContainSubstring("teams.some-team.pipelines.dup/branch:main: pipeline is declared more than once"))
+			Expect(err.Error()).ToNot(ContainSubstring("dup/branch:other"))
+		})
+	})
+})
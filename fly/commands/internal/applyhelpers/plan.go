@@ -0,0 +1,393 @@
+package applyhelpers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/vars"
+	"github.com/mgutz/ansi"
+	"github.com/onsi/gomega/gexec"
+	"sigs.k8s.io/yaml"
+)
+
+// Change is a single step of bringing the cluster in line with the document.
+type Change struct {
+	Description string
+
+	apply func() ([]concourse.ConfigWarning, error)
+}
+
+type Plan struct {
+	Changes  []Change
+	Warnings []concourse.ConfigWarning
+}
+
+// Apply makes each change in order, stopping at the first failure.
+func (plan Plan) Apply(out io.Writer) ([]concourse.ConfigWarning, error) {
+	var warnings []concourse.ConfigWarning
+	for _, change := range plan.Changes {
+		fmt.Fprintln(out, change.Description)
+
+		changeWarnings, err := change.apply()
+		warnings = append(warnings, changeWarnings...)
+		if err != nil {
+			return warnings, fmt.Errorf("failed to %s: %w", change.Description, err)
+		}
+	}
+
+	return warnings, nil
+}
+
+type Planner struct {
+	Client concourse.Client
+
+	// Prune destroys teams and pipelines which are not in the document. The
+	// main team is never destroyed.
+	Prune bool
+
+	// Out receives the diff of each team and pipeline which has drifted.
+	Out io.Writer
+}
+
+// Plan compares the document with the cluster and returns the changes needed
+// to reconcile them, rendering diffs along the way.
+func (planner Planner) Plan(cluster Cluster) (Plan, error) {
+	existingTeams, err := planner.Client.ListTeams()
+	if err != nil {
+		return Plan{}, err
+	}
+
+	teamsByName := map[string]atc.Team{}
+	for _, team := range existingTeams {
+		teamsByName[team.Name] = team
+	}
+
+	var plan Plan
+	declared := map[string]bool{}
+	for _, teamConfig := range cluster.Teams {
+		declared[teamConfig.Name] = true
+
+		existing, found := teamsByName[teamConfig.Name]
+
+		err := planner.planTeam(&plan, teamConfig, existing, found)
+		if err != nil {
+			return Plan{}, err
+		}
+	}
+
+	if planner.Prune {
+		for _, team := range existingTeams {
+			if declared[team.Name] || team.Name == atc.DefaultTeamName {
+				continue
+			}
+
+			fmt.Fprintln(planner.Out, ansi.Color(fmt.Sprintf("team %s will be destroyed", team.Name), "yellow"))
+
+			teamName := team.Name
+			plan.Changes = append(plan.Changes, Change{
+				Description: fmt.Sprintf("destroy team %s", teamName),
+				apply: func() ([]concourse.ConfigWarning, error) {
+					return nil, planner.Client.Team(teamName).DestroyTeam(teamName)
+				},
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+func (planner Planner) planTeam(plan *Plan, teamConfig TeamConfig, existing atc.Team, found bool) error {
+	team := planner.Client.Team(teamConfig.Name)
+
+	out := &bytes.Buffer{}
+	indent := gexec.NewPrefixedWriter("  ", out)
+
+	if !found {
+		if teamConfig.Auth == nil {
+			return fmt.Errorf("team '%s' does not exist and no auth is declared for it", teamConfig.Name)
+		}
+
+		atc.TeamAuth{}.Diff(indent, teamConfig.Auth)
+
+		plan.Changes = append(plan.Changes, Change{
+			Description: fmt.Sprintf("create team %s", teamConfig.Name),
+			apply: func() ([]concourse.ConfigWarning, error) {
+				_, _, _, warnings, err := team.CreateOrUpdate(atc.Team{Auth: teamConfig.Auth})
+				return warnings, err
+			},
+		})
+	} else if teamConfig.Auth != nil && existing.Auth.Diff(indent, teamConfig.Auth) {
+		plan.Changes = append(plan.Changes, Change{
+			Description: fmt.Sprintf("update team %s", teamConfig.Name),
+			apply: func() ([]concourse.ConfigWarning, error) {
+				_, _, _, warnings, err := team.CreateOrUpdate(atc.Team{Auth: teamConfig.Auth})
+				return warnings, err
+			},
+		})
+	}
+
+	var existingPipelines []atc.Pipeline
+	if found {
+		var err error
+		existingPipelines, err = team.ListPipelines()
+		if err != nil {
+			return err
+		}
+	}
+
+	pipelinesByRef := map[string]atc.Pipeline{}
+	for _, pipeline := range existingPipelines {
+		pipelinesByRef[pipeline.Ref().String()] = pipeline
+	}
+
+	declared := map[string]bool{}
+	for _, pipelineConfig := range teamConfig.Pipelines {
+		ref := pipelineConfig.Ref()
+		declared[ref.String()] = true
+
+		existing, exists := pipelinesByRef[ref.String()]
+
+		err := planner.planPipeline(plan, indent, team, pipelineConfig, existing, exists)
+		if err != nil {
+			return err
+		}
+	}
+
+	if planner.Prune {
+		for _, pipeline := range existingPipelines {
+			ref := pipeline.Ref()
+			if declared[ref.String()] {
+				continue
+			}
+
+			fmt.Fprintln(indent, ansi.Color(fmt.Sprintf("pipeline %s will be destroyed", ref), "yellow"))
+
+			plan.Changes = append(plan.Changes, Change{
+				Description: fmt.Sprintf("destroy pipeline %s/%s", teamConfig.Name, ref),
+				apply: func() ([]concourse.ConfigWarning, error) {
+					found, err := team.DeletePipeline(ref)
+					if err == nil && !found {
+						err = fmt.Errorf("pipeline '%s' not found", ref)
+					}
+					return nil, err
+				},
+			})
+		}
+	}
+
+	orderedNames, reordered := pipelineOrder(teamConfig.Pipelines, existingPipelines)
+	if reordered {
+		fmt.Fprintln(indent, ansi.Color("pipelines will be reordered", "yellow"))
+
+		plan.Changes = append(plan.Changes, Change{
+			Description: fmt.Sprintf("order pipelines of team %s", teamConfig.Name),
+			apply: func() ([]concourse.ConfigWarning, error) {
+				return nil, team.OrderingPipelines(orderedNames)
+			},
+		})
+	}
+
+	if out.Len() > 0 {
+		if found {
+			fmt.Fprintf(planner.Out, ansi.Color("team %s has changed:", "yellow")+"\n", teamConfig.Name)
+		} else {
+			fmt.Fprintf(planner.Out, ansi.Color("team %s has been added:", "yellow")+"\n", teamConfig.Name)
+		}
+
+		_, err := out.WriteTo(planner.Out)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (planner Planner) planPipeline(plan *Plan, out io.Writer, team concourse.Team, pipelineConfig PipelineConfig, existing atc.Pipeline, exists bool) error {
+	ref := pipelineConfig.Ref()
+	description := fmt.Sprintf("%s/%s", team.Name(), ref)
+
+	evaluatedTemplate, err := evaluate(pipelineConfig)
+	if err != nil {
+		return fmt.Errorf("pipeline %s: %w", description, err)
+	}
+
+	var newConfig atc.Config
+	err = yaml.Unmarshal(evaluatedTemplate, &newConfig)
+	if err != nil {
+		return fmt.Errorf("pipeline %s: %w", description, err)
+	}
+
+	err = atc.KeepMatrixValueText(evaluatedTemplate, &newConfig)
+	if err != nil {
+		return fmt.Errorf("pipeline %s: %w", description, err)
+	}
+
+	// fail the plan rather than the apply, which the user would only get to
+	// after confirming the plan
+	configWarnings, errorMessages := configvalidate.Validate(newConfig)
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("pipeline %s: invalid configuration:\n%s", description, strings.Join(errorMessages, "\n"))
+	}
+
+	for _, w := range configWarnings {
+		plan.Warnings = append(plan.Warnings, concourse.ConfigWarning{
+			Type:    w.Type,
+			Message: fmt.Sprintf("pipeline %s: %s", description, w.Message),
+		})
+	}
+
+	// the server stores each expansion of a job's matrix as a job of its own
+	newConfig, err = newConfig.ExpandJobMatrices()
+	if err != nil {
+		return err
+	}
+
+	var existingConfig atc.Config
+	var existingConfigVersion string
+	if exists {
+		existingConfig, existingConfigVersion, _, err = team.PipelineConfig(ref)
+		if err != nil {
+			return err
+		}
+	}
+
+	diff := &bytes.Buffer{}
+	indent := gexec.NewPrefixedWriter("  ", diff)
+
+	// setting the config of an archived pipeline is what unarchives it
+	configChanged := existingConfig.Diff(indent, newConfig) || existing.Archived
+	if configChanged {
+		switch {
+		case !exists:
+			fmt.Fprintf(out, ansi.Color("pipeline %s has been added:", "yellow")+"\n", ref)
+		case existing.Archived:
+			fmt.Fprintf(out, ansi.Color("pipeline %s will be unarchived:", "yellow")+"\n", ref)
+		default:
+			fmt.Fprintf(out, ansi.Color("pipeline %s has changed:", "yellow")+"\n", ref)
+		}
+
+		_, err := diff.WriteTo(out)
+		if err != nil {
+			return err
+		}
+
+		plan.Changes = append(plan.Changes, Change{
+			Description: fmt.Sprintf("set pipeline %s", description),
+			apply: func() ([]concourse.ConfigWarning, error) {
+				_, _, warnings, err := team.CreateOrUpdatePipelineConfig(ref, existingConfigVersion, evaluatedTemplate, false)
+				return warnings, err
+			},
+		})
+	}
+
+	// pipelines are paused when they are created or unarchived
+	paused := !exists || existing.Paused || existing.Archived
+	if pipelineConfig.Paused != nil && *pipelineConfig.Paused != paused {
+		if *pipelineConfig.Paused {
+			fmt.Fprintln(out, ansi.Color(fmt.Sprintf("pipeline %s will be paused", ref), "yellow"))
+			plan.Changes = append(plan.Changes, pipelineChange("pause", description, ref, team.PausePipeline))
+		} else {
+			fmt.Fprintln(out, ansi.Color(fmt.Sprintf("pipeline %s will be unpaused", ref), "yellow"))
+			plan.Changes = append(plan.Changes, pipelineChange("unpause", description, ref, team.UnpausePipeline))
+		}
+	}
+
+	exposed := exists && existing.Public
+	if pipelineConfig.Exposed != nil && *pipelineConfig.Exposed != exposed {
+		if *pipelineConfig.Exposed {
+			fmt.Fprintln(out, ansi.Color(fmt.Sprintf("pipeline %s will be exposed", ref), "yellow"))
+			plan.Changes = append(plan.Changes, pipelineChange("expose", description, ref, team.ExposePipeline))
+		} else {
+			fmt.Fprintln(out, ansi.Color(fmt.Sprintf("pipeline %s will be hidden", ref), "yellow"))
+			plan.Changes = append(plan.Changes, pipelineChange("hide", description, ref, team.HidePipeline))
+		}
+	}
+
+	return nil
+}
+
+func pipelineChange(verb string, description string, ref atc.PipelineRef, action func(atc.PipelineRef) (bool, error)) Change {
+	return Change{
+		Description: fmt.Sprintf("%s pipeline %s", verb, description),
+		apply: func() ([]concourse.ConfigWarning, error) {
+			found, err := action(ref)
+			if err == nil && !found {
+				err = fmt.Errorf("pipeline '%s' not found", ref)
+			}
+			return nil, err
+		},
+	}
+}
+
+func evaluate(pipelineConfig PipelineConfig) ([]byte, error) {
+	var varsFiles []atc.PathFlag
+	for _, path := range pipelineConfig.VarsFiles {
+		varsFiles = append(varsFiles, atc.PathFlag(path))
+	}
+
+	var yamlVars []flaghelpers.YAMLVariablePairFlag
+	for name, value := range pipelineConfig.Vars {
+		ref, err := vars.ParseReference(name)
+		if err != nil {
+			return nil, err
+		}
+
+		yamlVars = append(yamlVars, flaghelpers.YAMLVariablePairFlag{
+			Ref:   ref,
+			Value: value,
+		})
+	}
+
+	return templatehelpers.NewYamlTemplateWithParams(
+		atc.PathFlag(pipelineConfig.File),
+		varsFiles,
+		nil,
+		yamlVars,
+		pipelineConfig.InstanceVars,
+	).Evaluate(false, false)
+}
+
+// pipelineOrder returns the declared order of pipeline names and whether it
+// differs from the order the pipelines will have once any new ones have been
+// created at the end. Undeclared pipelines are ignored.
+func pipelineOrder(pipelineConfigs []PipelineConfig, existingPipelines []atc.Pipeline) ([]string, bool) {
+	var declaredNames []string
+	declared := map[string]bool{}
+	for _, pipelineConfig := range pipelineConfigs {
+		if !declared[pipelineConfig.Name] {
+			declared[pipelineConfig.Name] = true
+			declaredNames = append(declaredNames, pipelineConfig.Name)
+		}
+	}
+
+	var resultingNames []string
+	seen := map[string]bool{}
+	for _, pipeline := range existingPipelines {
+		if declared[pipeline.Name] && !seen[pipeline.Name] {
+			seen[pipeline.Name] = true
+			resultingNames = append(resultingNames, pipeline.Name)
+		}
+	}
+
+	for _, name := range declaredNames {
+		if !seen[name] {
+			resultingNames = append(resultingNames, name)
+		}
+	}
+
+	for i := range declaredNames {
+		if declaredNames[i] != resultingNames[i] {
+			return declaredNames, true
+		}
+	}
+
+	return declaredNames, false
+}
@@ -0,0 +1,348 @@
+package applyhelpers_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/applyhelpers"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/go-concourse/concourse/concoursefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Planner", func() {
+	var (
+		dir        string
+		fakeClient *concoursefakes.FakeClient
+		fakeTeams  map[string]*concoursefakes.FakeTeam
+		out        *gbytes.Buffer
+
+		planner Planner
+		cluster Cluster
+
+		plan    Plan
+		planErr error
+	)
+
+	pipelineFile := func(name string, contents string) string {
+		path := filepath.Join(dir, name)
+		err := ioutil.WriteFile(path, []byte(contents), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		return path
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "fly-apply")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeClient = new(concoursefakes.FakeClient)
+		fakeTeams = map[string]*concoursefakes.FakeTeam{}
+		fakeClient.TeamStub = func(name string) concourse.Team {
+			team, found := fakeTeams[name]
+			if !found {
+				team = new(concoursefakes.FakeTeam)
+				team.NameReturns(name)
+				fakeTeams[name] = team
+			}
+			return team
+		}
+
+		out = gbytes.NewBuffer()
+		planner = Planner{Client: fakeClient, Out: out}
+
+		cluster = Cluster{
+			Teams: []TeamConfig{
+				{
+					Name: "some-team",
+					Auth: atc.TeamAuth{"owner": {"users": {"local:admin"}}},
+					Pipelines: []PipelineConfig{
+						{
+							Name: "some-pipeline",
+							File: pipelineFile("some-pipeline.yml", `
+jobs:
+- name: ((job_name))
+  plan: []
+`),
+							Vars: map[string]interface{}{"job_name": "some-job"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		plan, planErr = planner.Plan(cluster)
+	})
+
+	Context("when the team does not exist", func() {
+		It("creates the team and its pipeline", func() {
+			Expect(planErr).ToNot(HaveOccurred())
+			Expect(descriptions(plan)).To(Equal([]string{
+				"create team some-team",
+				"set pipeline some-team/some-pipeline",
+			}))
+
+			Expect(out).To(gbytes.Say("team some-team has been added:"))
+			Expect(out).To(gbytes.Say(`\+.*local:admin`))
+			Expect(out).To(gbytes.Say("pipeline some-pipeline has been added:"))
+			Expect(out).To(gbytes.Say(`\+.*name: some-job`))
+
+			_, err := plan.Apply(ioutil.Discard)
+			Expect(err).ToNot(HaveOccurred())
+
+			team := fakeTeams["some-team"]
+			Expect(team.CreateOrUpdateCallCount()).To(Equal(1))
+			Expect(team.CreateOrUpdateArgsForCall(0).Auth).To(Equal(cluster.Teams[0].Auth))
+
+			Expect(team.CreateOrUpdatePipelineConfigCallCount()).To(Equal(1))
+			ref, version, config, checkCredentials := team.CreateOrUpdatePipelineConfigArgsForCall(0)
+			Expect(ref).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+			Expect(version).To(BeEmpty())
+			Expect(string(config)).To(ContainSubstring("name: some-job"))
+			Expect(checkCredentials).To(BeFalse())
+
+			Expect(team.ListPipelinesCallCount()).To(BeZero())
+		})
+
+		Context("when no auth is declared", func() {
+			BeforeEach(func() {
+				cluster.Teams[0].Auth = nil
+			})
+
+			It("errors", func() {
+				Expect(planErr).To(MatchError("team 'some-team' does not exist and no auth is declared for it"))
+			})
+		})
+
+		Context("when the pipeline config is invalid", func() {
+			BeforeEach(func() {
+				cluster.Teams[0].Pipelines[0].Vars["job_name"] = ""
+			})
+
+			It("fails the plan", func() {
+				Expect(planErr).To(MatchError(ContainSubstring("pipeline some-team/some-pipeline: invalid configuration:")))
+				Expect(planErr).To(MatchError(ContainSubstring("jobs[0] has no name")))
+			})
+		})
+
+		Context("when the pipeline should be unpaused", func() {
+			BeforeEach(func() {
+				paused := false
+				cluster.Teams[0].Pipelines[0].Paused = &paused
+			})
+
+			It("unpauses it once it is created", func() {
+				Expect(planErr).ToNot(HaveOccurred())
+				Expect(descriptions(plan)).To(Equal([]string{
+					"create team some-team",
+					"set pipeline some-team/some-pipeline",
+					"unpause pipeline some-team/some-pipeline",
+				}))
+			})
+		})
+	})
+
+	Context("when the team exists", func() {
+		var team *concoursefakes.FakeTeam
+
+		BeforeEach(func() {
+			fakeClient.ListTeamsReturns([]atc.Team{
+				{Name: "main", Auth: atc.TeamAuth{"owner": {"users": {"local:admin"}}}},
+				{Name: "some-team", Auth: atc.TeamAuth{"owner": {"users": {"local:admin"}, "groups": {}}}},
+				{Name: "other-team"},
+			}, nil)
+
+			team = fakeClient.Team("some-team").(*concoursefakes.FakeTeam)
+			team.ListPipelinesReturns([]atc.Pipeline{
+				{Name: "some-pipeline", Paused: true},
+				{Name: "undeclared-pipeline"},
+			}, nil)
+			team.PipelineConfigReturns(atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job", PlanSequence: []atc.Step{}}},
+			}, "42", true, nil)
+		})
+
+		It("has nothing to change", func() {
+			Expect(planErr).ToNot(HaveOccurred())
+			Expect(plan.Changes).To(BeEmpty())
+			Expect(out.Contents()).To(BeEmpty())
+			Expect(team.PipelineConfigArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		})
+
+		Context("when the config has drifted", func() {
+			BeforeEach(func() {
+				cluster.Teams[0].Pipelines[0].Vars["job_name"] = "other-job"
+			})
+
+			It("updates the pipeline from the current version", func() {
+				Expect(planErr).ToNot(HaveOccurred())
+				Expect(descriptions(plan)).To(Equal([]string{"set pipeline some-team/some-pipeline"}))
+
+				Expect(out).To(gbytes.Say("team some-team has changed:"))
+				Expect(out).To(gbytes.Say("pipeline some-pipeline has changed:"))
+				Expect(out).To(gbytes.Say("job some-job has been removed:"))
+				Expect(out).To(gbytes.Say("job other-job has been added:"))
+
+				_, err := plan.Apply(ioutil.Discard)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, version, _, _ := team.CreateOrUpdatePipelineConfigArgsForCall(0)
+				Expect(version).To(Equal("42"))
+			})
+		})
+
+		Context("when the auth has drifted", func() {
+			BeforeEach(func() {
+				cluster.Teams[0].Auth = atc.TeamAuth{"owner": {"users": {"local:someone-else"}}}
+			})
+
+			It("updates the team", func() {
+				Expect(planErr).ToNot(HaveOccurred())
+				Expect(descriptions(plan)).To(Equal([]string{"update team some-team"}))
+				Expect(out).To(gbytes.Say("auth has changed:"))
+			})
+		})
+
+		Context("when no auth is declared", func() {
+			BeforeEach(func() {
+				cluster.Teams[0].Auth = nil
+			})
+
+			It("leaves the team's auth alone", func() {
+				Expect(planErr).ToNot(HaveOccurred())
+				Expect(plan.Changes).To(BeEmpty())
+			})
+		})
+
+		Context("when the pipeline state has drifted", func() {
+			BeforeEach(func() {
+				paused := false
+				exposed := true
+				cluster.Teams[0].Pipelines[0].Paused = &paused
+				cluster.Teams[0].Pipelines[0].Exposed = &exposed
+			})
+
+			It("unpauses and exposes it", func() {
+				Expect(planErr).ToNot(HaveOccurred())
+				Expect(descriptions(plan)).To(Equal([]string{
+					"unpause pipeline some-team/some-pipeline",
+					"expose pipeline some-team/some-pipeline",
+				}))
+
+				team.UnpausePipelineReturns(true, nil)
+				team.ExposePipelineReturns(false, nil)
+
+				_, err := plan.Apply(ioutil.Discard)
+				Expect(err).To(MatchError("failed to expose pipeline some-team/some-pipeline: pipeline 'some-pipeline' not found"))
+				Expect(team.UnpausePipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+			})
+		})
+
+		Context("when the pipeline is archived", func() {
+			BeforeEach(func() {
+				team.ListPipelinesReturns([]atc.Pipeline{
+					{Name: "some-pipeline", Archived: true, Paused: true},
+				}, nil)
+			})
+
+			It("sets it again to unarchive it", func() {
+				Expect(planErr).ToNot(HaveOccurred())
+				Expect(descriptions(plan)).To(Equal([]string{"set pipeline some-team/some-pipeline"}))
+				Expect(out).To(gbytes.Say("pipeline some-pipeline will be unarchived:"))
+			})
+		})
+
+		Context("when pipelines are declared in a different order", func() {
+			BeforeEach(func() {
+				team.ListPipelinesReturns([]atc.Pipeline{
+					{Name: "some-pipeline", Paused: true},
+					{Name: "other-pipeline", Paused: true},
+				}, nil)
+				team.PipelineConfigStub = func(ref atc.PipelineRef) (atc.Config, string, bool, error) {
+					return atc.Config{
+						Jobs: atc.JobConfigs{{Name: "some-job", PlanSequence: []atc.Step{}}},
+					}, "42", true, nil
+				}
+
+				cluster.Teams[0].Pipelines = append([]PipelineConfig{{
+					Name: "other-pipeline",
+					File: cluster.Teams[0].Pipelines[0].File,
+					Vars: map[string]interface{}{"job_name": "some-job"},
+				}}, cluster.Teams[0].Pipelines...)
+			})
+
+			It("reorders them", func() {
+				Expect(planErr).ToNot(HaveOccurred())
+				Expect(descriptions(plan)).To(Equal([]string{"order pipelines of team some-team"}))
+
+				_, err := plan.Apply(ioutil.Discard)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(team.OrderingPipelinesArgsForCall(0)).To(Equal([]string{"other-pipeline", "some-pipeline"}))
+			})
+		})
+
+		Context("when pruning", func() {
+			BeforeEach(func() {
+				planner.Prune = true
+			})
+
+			It("destroys undeclared pipelines and teams other than main", func() {
+				Expect(planErr).ToNot(HaveOccurred())
+				Expect(descriptions(plan)).To(Equal([]string{
+					"destroy pipeline some-team/undeclared-pipeline",
+					"destroy team other-team",
+				}))
+				Expect(out).To(gbytes.Say("pipeline undeclared-pipeline will be destroyed"))
+				Expect(out).To(gbytes.Say("team other-team will be destroyed"))
+
+				team.DeletePipelineReturns(true, nil)
+
+				_, err := plan.Apply(ioutil.Discard)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.DeletePipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "undeclared-pipeline"}))
+				Expect(fakeTeams["other-team"].DestroyTeamArgsForCall(0)).To(Equal("other-team"))
+			})
+		})
+
+		Context("when listing pipelines fails", func() {
+			BeforeEach(func() {
+				team.ListPipelinesReturns(nil, errors.New("nope"))
+			})
+
+			It("errors", func() {
+				Expect(planErr).To(MatchError("nope"))
+			})
+		})
+	})
+
+	Context("when listing teams fails", func() {
+		BeforeEach(func() {
+			fakeClient.ListTeamsReturns(nil, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(planErr).To(MatchError("nope"))
+		})
+	})
+})
+
+func descriptions(plan Plan) []string {
+	var descriptions []string
+	for _, change := range plan.Changes {
+		descriptions = append(descriptions, change.Description)
+	}
+	return descriptions
+}
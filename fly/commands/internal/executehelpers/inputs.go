@@ -46,33 +46,10 @@ func DetermineInputs(
 	}
 
 	if inputsFrom.PipelineRef.Name == "" && inputsFrom.JobName == "" {
-		wd, err := os.Getwd()
+		localInputMappings, err = withWorkingDirectoryInput(taskInputs, localInputMappings)
 		if err != nil {
 			return nil, nil, nil, nil, err
 		}
-
-		required := false
-		for _, input := range taskInputs {
-			if input.Name == filepath.Base(wd) {
-				required = true
-				break
-			}
-		}
-
-		provided := false
-		for _, input := range localInputMappings {
-			if input.Name == filepath.Base(wd) {
-				provided = true
-				break
-			}
-		}
-
-		if required && !provided {
-			localInputMappings = append(localInputMappings, flaghelpers.InputPairFlag{
-				Name: filepath.Base(wd),
-				Path: ".",
-			})
-		}
 	}
 
 	inputsFromLocal, err := GenerateLocalInputs(fact, team, localInputMappings, includeIgnored, platform, tags)
@@ -111,6 +88,75 @@ func DetermineInputs(
 	return inputs, inputMappings, imageResourceFromJob, resourceTypes, nil
 }
 
+// DetermineLocalInputs maps each of the task's inputs to a directory on this
+// machine, for running the task locally rather than uploading its inputs.
+func DetermineLocalInputs(
+	taskInputs []atc.TaskInputConfig,
+	localInputMappings []flaghelpers.InputPairFlag,
+) (map[string]string, error) {
+	err := CheckForUnknownInputMappings(localInputMappings, taskInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckForInputType(localInputMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	localInputMappings, err = withWorkingDirectoryInput(taskInputs, localInputMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := map[string]string{}
+	for _, mapping := range localInputMappings {
+		inputs[mapping.Name] = mapping.Path
+	}
+
+	for _, taskInput := range taskInputs {
+		if _, found := inputs[taskInput.Name]; !found && !taskInput.Optional {
+			return nil, fmt.Errorf("missing required input `%s`", taskInput.Name)
+		}
+	}
+
+	return inputs, nil
+}
+
+// withWorkingDirectoryInput provides the current directory as the input named
+// after it, unless that input was given explicitly.
+func withWorkingDirectoryInput(taskInputs []atc.TaskInputConfig, localInputMappings []flaghelpers.InputPairFlag) ([]flaghelpers.InputPairFlag, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	required := false
+	for _, input := range taskInputs {
+		if input.Name == filepath.Base(wd) {
+			required = true
+			break
+		}
+	}
+
+	provided := false
+	for _, input := range localInputMappings {
+		if input.Name == filepath.Base(wd) {
+			provided = true
+			break
+		}
+	}
+
+	if required && !provided {
+		localInputMappings = append(localInputMappings, flaghelpers.InputPairFlag{
+			Name: filepath.Base(wd),
+			Path: ".",
+		})
+	}
+
+	return localInputMappings, nil
+}
+
 func ConvertInputMappings(variables []flaghelpers.InputMappingPairFlag) map[string]string {
 	inputMappings := map[string]string{}
 	for _, flag := range variables {
@@ -0,0 +1,9 @@
+package localexec
+
+// ContainerdConfig configures how to reach the local containerd daemon and the
+// binaries needed to set up task containers.
+type ContainerdConfig struct {
+	Address       string
+	InitBin       string
+	CNIPluginsDir string
+}
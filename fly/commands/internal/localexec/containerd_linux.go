@@ -0,0 +1,50 @@
+// +build linux
+
+package localexec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/worker/runtime"
+	"github.com/concourse/concourse/worker/runtime/libcontainerd"
+)
+
+const containerdNamespace = "fly"
+
+// localNetworkConfig keeps task containers off of the network used by a
+// worker that may be running on the same machine.
+var localNetworkConfig = runtime.CNINetworkConfig{
+	BridgeName:  "flylocal0",
+	NetworkName: "fly-local",
+	Subnet:      "10.81.0.0/16",
+}
+
+// NewContainerdRuntime connects to the containerd daemon listening on
+// config.Address and runs containers through the same Garden backend that
+// workers use. The returned function disconnects from containerd.
+func NewContainerdRuntime(config ContainerdConfig) (Containers, func(), error) {
+	network, err := runtime.NewCNINetwork(
+		runtime.WithCNIBinariesDir(config.CNIPluginsDir),
+		runtime.WithCNINetworkConfig(localNetworkConfig),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("new cni network: %w", err)
+	}
+
+	backend, err := runtime.NewGardenBackend(
+		libcontainerd.New(config.Address, containerdNamespace, time.Minute),
+		runtime.WithNetwork(network),
+		runtime.WithInitBinPath(config.InitBin),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("containerd backend init: %w", err)
+	}
+
+	err = backend.Start()
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to containerd at %s: %w", config.Address, err)
+	}
+
+	return &backend, backend.Stop, nil
+}
@@ -0,0 +1,12 @@
+// +build !linux
+
+package localexec
+
+import (
+	"fmt"
+	goruntime "runtime"
+)
+
+func NewContainerdRuntime(ContainerdConfig) (Containers, func(), error) {
+	return nil, nil, fmt.Errorf("running tasks locally is not supported on %s", goruntime.GOOS)
+}
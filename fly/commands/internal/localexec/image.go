@@ -0,0 +1,22 @@
+package localexec
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// ImageMetadata is the part of an image's config that affects how the task
+// process is run.
+type ImageMetadata struct {
+	Env  []string `json:"env,omitempty"`
+	User string   `json:"user,omitempty"`
+}
+
+//go:generate counterfeiter . ImageFetcher
+
+// ImageFetcher fetches a task's image_resource and returns the path to its
+// unpacked rootfs.
+type ImageFetcher interface {
+	Fetch(context.Context, atc.ImageResource) (string, ImageMetadata, error)
+}
@@ -0,0 +1,221 @@
+// +build linux
+
+package localexec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/archive/compression"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// RegistryImageSource is the subset of the registry-image resource's source
+// configuration understood when running locally.
+type RegistryImageSource struct {
+	Repository string `json:"repository"`
+	Tag        string `json:"tag,omitempty"`
+	Username   string `json:"username,omitempty"`
+	Password   string `json:"password,omitempty"`
+	Insecure   bool   `json:"insecure,omitempty"`
+}
+
+type registryImageFetcher struct {
+	dir string
+}
+
+// NewRegistryImageFetcher returns an ImageFetcher that pulls registry-image
+// (and docker-image) resources straight from their registry. Blobs and
+// unpacked rootfses are kept under dir and reused across runs.
+func NewRegistryImageFetcher(dir string) ImageFetcher {
+	return registryImageFetcher{dir: dir}
+}
+
+func (fetcher registryImageFetcher) Fetch(ctx context.Context, imageResource atc.ImageResource) (string, ImageMetadata, error) {
+	if imageResource.Type != "registry-image" && imageResource.Type != "docker-image" {
+		return "", ImageMetadata{}, fmt.Errorf("unsupported image resource type '%s'; use --local-rootfs to run it with a local rootfs", imageResource.Type)
+	}
+
+	var source RegistryImageSource
+	err := decode(imageResource.Source, &source)
+	if err != nil {
+		return "", ImageMetadata{}, fmt.Errorf("invalid image source: %w", err)
+	}
+
+	if source.Repository == "" {
+		return "", ImageMetadata{}, fmt.Errorf("image source is missing 'repository'")
+	}
+
+	ref := normalizeReference(source.Repository)
+	if digest, ok := imageResource.Version["digest"]; ok {
+		ref += "@" + digest
+	} else if source.Tag != "" {
+		ref += ":" + source.Tag
+	} else {
+		ref += ":latest"
+	}
+
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		Hosts: docker.ConfigureDefaultRegistries(
+			docker.WithAuthorizer(docker.NewDockerAuthorizer(
+				docker.WithAuthCreds(func(string) (string, string, error) {
+					return source.Username, source.Password, nil
+				}),
+			)),
+			docker.WithPlainHTTP(func(string) (bool, error) {
+				return source.Insecure, nil
+			}),
+		),
+	})
+
+	name, desc, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return "", ImageMetadata{}, fmt.Errorf("resolve %s: %w", ref, err)
+	}
+
+	remoteFetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return "", ImageMetadata{}, err
+	}
+
+	store, err := local.NewStore(filepath.Join(fetcher.dir, "content"))
+	if err != nil {
+		return "", ImageMetadata{}, err
+	}
+
+	platform := platforms.Default()
+
+	err = images.Dispatch(ctx, images.Handlers(
+		remotes.FetchHandler(store, remoteFetcher),
+		images.LimitManifests(images.FilterPlatforms(images.ChildrenHandler(store), platform), platform, 1),
+	), nil, desc)
+	if err != nil {
+		return "", ImageMetadata{}, fmt.Errorf("fetch %s: %w", ref, err)
+	}
+
+	manifest, err := images.Manifest(ctx, store, desc, platform)
+	if err != nil {
+		return "", ImageMetadata{}, err
+	}
+
+	rootfs := filepath.Join(fetcher.dir, "rootfs", manifest.Config.Digest.Encoded())
+	metadataPath := rootfs + ".json"
+
+	// the metadata is only written once the rootfs is fully unpacked
+	var metadata ImageMetadata
+	payload, err := ioutil.ReadFile(metadataPath)
+	if err == nil {
+		err = json.Unmarshal(payload, &metadata)
+		if err == nil {
+			return rootfs, metadata, nil
+		}
+	}
+
+	metadata, err = unpack(ctx, store, manifest, rootfs)
+	if err != nil {
+		return "", ImageMetadata{}, fmt.Errorf("unpack %s: %w", ref, err)
+	}
+
+	payload, err = json.Marshal(metadata)
+	if err != nil {
+		return "", ImageMetadata{}, err
+	}
+
+	err = ioutil.WriteFile(metadataPath, payload, 0644)
+	if err != nil {
+		return "", ImageMetadata{}, err
+	}
+
+	return rootfs, metadata, nil
+}
+
+func unpack(ctx context.Context, store content.Store, manifest ocispec.Manifest, rootfs string) (ImageMetadata, error) {
+	configBlob, err := content.ReadBlob(ctx, store, manifest.Config)
+	if err != nil {
+		return ImageMetadata{}, err
+	}
+
+	var config ocispec.Image
+	err = json.Unmarshal(configBlob, &config)
+	if err != nil {
+		return ImageMetadata{}, err
+	}
+
+	err = os.RemoveAll(rootfs)
+	if err != nil {
+		return ImageMetadata{}, err
+	}
+
+	err = os.MkdirAll(rootfs, 0755)
+	if err != nil {
+		return ImageMetadata{}, err
+	}
+
+	for _, layer := range manifest.Layers {
+		err := applyLayer(ctx, store, layer, rootfs)
+		if err != nil {
+			return ImageMetadata{}, fmt.Errorf("apply layer %s: %w", layer.Digest, err)
+		}
+	}
+
+	return ImageMetadata{
+		Env:  config.Config.Env,
+		User: config.Config.User,
+	}, nil
+}
+
+func applyLayer(ctx context.Context, store content.Store, layer ocispec.Descriptor, rootfs string) error {
+	ra, err := store.ReaderAt(ctx, layer)
+	if err != nil {
+		return err
+	}
+
+	defer ra.Close()
+
+	stream, err := compression.DecompressStream(content.NewReader(ra))
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	_, err = archive.Apply(ctx, rootfs, stream)
+	return err
+}
+
+// normalizeReference qualifies a repository the same way the docker CLI does,
+// e.g. "busybox" becomes "docker.io/library/busybox".
+func normalizeReference(repository string) string {
+	parts := strings.SplitN(repository, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return repository
+	}
+
+	if len(parts) == 1 {
+		return "docker.io/library/" + repository
+	}
+
+	return "docker.io/" + repository
+}
+
+func decode(source atc.Source, dest interface{}) error {
+	payload, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(payload, dest)
+}
@@ -0,0 +1,21 @@
+// +build !linux
+
+package localexec
+
+import (
+	"context"
+	"fmt"
+	goruntime "runtime"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type registryImageFetcher struct{}
+
+func NewRegistryImageFetcher(string) ImageFetcher {
+	return registryImageFetcher{}
+}
+
+func (registryImageFetcher) Fetch(context.Context, atc.ImageResource) (string, ImageMetadata, error) {
+	return "", ImageMetadata{}, fmt.Errorf("fetching images locally is not supported on %s", goruntime.GOOS)
+}
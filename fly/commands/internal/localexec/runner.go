@@ -0,0 +1,264 @@
+// Package localexec runs a task config on the local machine, without a
+// Concourse cluster, by driving a Garden backend directly.
+package localexec
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . Containers
+
+// Containers is the subset of a Garden backend needed to run a task.
+type Containers interface {
+	Create(garden.ContainerSpec) (garden.Container, error)
+	Destroy(handle string) error
+}
+
+// Task is a task config along with the local directories to run it against.
+type Task struct {
+	Config     atc.TaskConfig
+	Privileged bool
+
+	// Inputs maps input names to directories on the local machine.
+	Inputs map[string]string
+
+	// Outputs maps output names to directories on the local machine. Outputs
+	// that are not mapped are discarded once the task finishes.
+	Outputs map[string]string
+
+	// Rootfs overrides the task's image with a local directory.
+	Rootfs string
+}
+
+type MissingInputsError struct {
+	Inputs []string
+}
+
+func (err MissingInputsError) Error() string {
+	return fmt.Sprintf("missing inputs: %s", strings.Join(err.Inputs, ", "))
+}
+
+type Runner struct {
+	containers   Containers
+	imageFetcher ImageFetcher
+
+	// workDir holds the per-run copies of inputs and scratch outputs.
+	workDir string
+
+	// cacheDir holds task caches, which persist between runs of the same task.
+	cacheDir string
+}
+
+func NewRunner(containers Containers, imageFetcher ImageFetcher, workDir string, cacheDir string) *Runner {
+	return &Runner{
+		containers:   containers,
+		imageFetcher: imageFetcher,
+		workDir:      workDir,
+		cacheDir:     cacheDir,
+	}
+}
+
+// Run runs the task to completion and returns its exit status. Inputs are
+// copied before the task runs so that it cannot modify the originals, the
+// same way inputs are copy-on-write on a worker.
+func (runner *Runner) Run(ctx context.Context, task Task, stdout io.Writer, stderr io.Writer) (int, error) {
+	id, err := randomID()
+	if err != nil {
+		return 0, err
+	}
+
+	artifactsRoot := "/tmp/build/" + id
+
+	scratch := filepath.Join(runner.workDir, id)
+	err = os.MkdirAll(scratch, 0755)
+	if err != nil {
+		return 0, err
+	}
+
+	defer os.RemoveAll(scratch)
+
+	rootfs, metadata, err := runner.rootfs(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+
+	bindMounts, err := runner.bindMounts(task, scratch, artifactsRoot)
+	if err != nil {
+		return 0, err
+	}
+
+	spec := garden.ContainerSpec{
+		Handle:     "fly-local-" + id,
+		RootFSPath: "raw://" + rootfs,
+		Privileged: task.Privileged,
+		Env:        append(metadata.Env, task.Config.Params.Env()...),
+		BindMounts: bindMounts,
+	}
+
+	if task.Config.Limits != nil {
+		spec.Limits = gardenLimits(*task.Config.Limits)
+	}
+
+	container, err := runner.containers.Create(spec)
+	if err != nil {
+		return 0, fmt.Errorf("create container: %w", err)
+	}
+
+	defer runner.containers.Destroy(container.Handle())
+
+	user := task.Config.Run.User
+	if user == "" {
+		user = metadata.User
+	}
+
+	process, err := container.Run(garden.ProcessSpec{
+		Path: task.Config.Run.Path,
+		Args: task.Config.Run.Args,
+		Dir:  path.Join(artifactsRoot, task.Config.Run.Dir),
+		User: user,
+	}, garden.ProcessIO{
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("run task: %w", err)
+	}
+
+	exited := make(chan struct{})
+	defer close(exited)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = process.Signal(garden.SignalTerminate)
+		case <-exited:
+		}
+	}()
+
+	return process.Wait()
+}
+
+func (runner *Runner) rootfs(ctx context.Context, task Task) (string, ImageMetadata, error) {
+	if task.Rootfs != "" {
+		rootfs, err := filepath.Abs(task.Rootfs)
+		return rootfs, ImageMetadata{}, err
+	}
+
+	if task.Config.ImageResource != nil {
+		return runner.imageFetcher.Fetch(ctx, *task.Config.ImageResource)
+	}
+
+	if strings.HasPrefix(task.Config.RootfsURI, "raw://") {
+		return strings.TrimPrefix(task.Config.RootfsURI, "raw://"), ImageMetadata{}, nil
+	}
+
+	return "", ImageMetadata{}, fmt.Errorf("task has no image_resource; use --local-rootfs to run it with a local rootfs")
+}
+
+func (runner *Runner) bindMounts(task Task, scratch string, artifactsRoot string) ([]garden.BindMount, error) {
+	var bindMounts []garden.BindMount
+	var missing []string
+
+	for _, input := range task.Config.Inputs {
+		src, found := task.Inputs[input.Name]
+		if !found {
+			if !input.Optional {
+				missing = append(missing, input.Name)
+			}
+			continue
+		}
+
+		dest := filepath.Join(scratch, "inputs", input.Name)
+		err := copyDir(src, dest)
+		if err != nil {
+			return nil, fmt.Errorf("copy input '%s': %w", input.Name, err)
+		}
+
+		bindMounts = append(bindMounts, bindMount(dest, artifactPath(artifactsRoot, input.Path, input.Name)))
+	}
+
+	if len(missing) > 0 {
+		return nil, MissingInputsError{missing}
+	}
+
+	for _, output := range task.Config.Outputs {
+		dest, found := task.Outputs[output.Name]
+		if !found {
+			dest = filepath.Join(scratch, "outputs", output.Name)
+		}
+
+		dest, err := filepath.Abs(dest)
+		if err != nil {
+			return nil, err
+		}
+
+		err = os.MkdirAll(dest, 0755)
+		if err != nil {
+			return nil, fmt.Errorf("create output '%s': %w", output.Name, err)
+		}
+
+		bindMounts = append(bindMounts, bindMount(dest, artifactPath(artifactsRoot, output.Path, output.Name)))
+	}
+
+	for _, cache := range task.Config.Caches {
+		dest := filepath.Join(runner.cacheDir, filepath.FromSlash(path.Clean("/"+cache.Path)))
+
+		err := os.MkdirAll(dest, 0755)
+		if err != nil {
+			return nil, fmt.Errorf("create cache '%s': %w", cache.Path, err)
+		}
+
+		bindMounts = append(bindMounts, bindMount(dest, path.Join(artifactsRoot, cache.Path)))
+	}
+
+	return bindMounts, nil
+}
+
+func bindMount(src string, dst string) garden.BindMount {
+	return garden.BindMount{
+		SrcPath: src,
+		DstPath: dst,
+		Mode:    garden.BindMountModeRW,
+		Origin:  garden.BindMountOriginHost,
+	}
+}
+
+func artifactPath(artifactsRoot string, configuredPath string, name string) string {
+	if configuredPath == "" {
+		configuredPath = name
+	}
+
+	return path.Join(artifactsRoot, configuredPath)
+}
+
+func gardenLimits(limits atc.ContainerLimits) garden.Limits {
+	var gardenLimits garden.Limits
+	if limits.CPU != nil {
+		gardenLimits.CPU = garden.CPULimits{LimitInShares: uint64(*limits.CPU)}
+	}
+	if limits.Memory != nil {
+		gardenLimits.Memory = garden.MemoryLimits{LimitInBytes: uint64(*limits.Memory)}
+	}
+	return gardenLimits
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 8)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,275 @@
+package localexec_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
+	"github.com/concourse/concourse/fly/commands/internal/localexec/localexecfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Runner", func() {
+	var (
+		fakeContainers   *localexecfakes.FakeContainers
+		fakeImageFetcher *localexecfakes.FakeImageFetcher
+		fakeContainer    *gardenfakes.FakeContainer
+		fakeProcess      *gardenfakes.FakeProcess
+
+		tmpDir   string
+		inputDir string
+		cacheDir string
+		task     localexec.Task
+
+		stdout *gbytes.Buffer
+		stderr *gbytes.Buffer
+
+		ctx      context.Context
+		exitCode int
+		runErr   error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "localexec")
+		Expect(err).ToNot(HaveOccurred())
+
+		inputDir = filepath.Join(tmpDir, "some-input")
+		Expect(os.MkdirAll(inputDir, 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(inputDir, "file"), []byte("contents"), 0644)).To(Succeed())
+
+		cacheDir = filepath.Join(tmpDir, "caches")
+
+		fakeProcess = new(gardenfakes.FakeProcess)
+		fakeProcess.WaitReturns(3, nil)
+
+		fakeContainer = new(gardenfakes.FakeContainer)
+		fakeContainer.HandleReturns("some-handle")
+		fakeContainer.RunStub = func(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+			_, _ = io.Stdout.Write([]byte("hello"))
+			return fakeProcess, nil
+		}
+
+		fakeContainers = new(localexecfakes.FakeContainers)
+		fakeContainers.CreateReturns(fakeContainer, nil)
+
+		fakeImageFetcher = new(localexecfakes.FakeImageFetcher)
+		fakeImageFetcher.FetchReturns("/some/rootfs", localexec.ImageMetadata{
+			Env:  []string{"PATH=/usr/bin"},
+			User: "image-user",
+		}, nil)
+
+		cpu := atc.CPULimit(512)
+		memory := atc.MemoryLimit(1024)
+
+		task = localexec.Task{
+			Config: atc.TaskConfig{
+				Platform: "linux",
+				ImageResource: &atc.ImageResource{
+					Type:   "registry-image",
+					Source: atc.Source{"repository": "busybox"},
+				},
+				Limits: &atc.ContainerLimits{CPU: &cpu, Memory: &memory},
+				Params: atc.TaskEnv{"FOO": "bar"},
+				Run: atc.TaskRunConfig{
+					Path: "sh",
+					Args: []string{"-c", "echo hello"},
+					Dir:  "some-input",
+				},
+				Inputs: []atc.TaskInputConfig{
+					{Name: "some-input"},
+					{Name: "optional-input", Optional: true},
+				},
+				Outputs: []atc.TaskOutputConfig{
+					{Name: "some-output", Path: "out"},
+					{Name: "unmapped-output"},
+				},
+				Caches: []atc.TaskCacheConfig{
+					{Path: "some-cache"},
+				},
+			},
+			Inputs: map[string]string{
+				"some-input": inputDir,
+			},
+			Outputs: map[string]string{
+				"some-output": filepath.Join(tmpDir, "output"),
+			},
+		}
+
+		stdout = gbytes.NewBuffer()
+		stderr = gbytes.NewBuffer()
+
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		runner := localexec.NewRunner(fakeContainers, fakeImageFetcher, filepath.Join(tmpDir, "work"), cacheDir)
+		exitCode, runErr = runner.Run(ctx, task, stdout, stderr)
+	})
+
+	It("returns the exit status of the task", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(exitCode).To(Equal(3))
+		Expect(stdout).To(gbytes.Say("hello"))
+	})
+
+	It("fetches the task's image", func() {
+		Expect(fakeImageFetcher.FetchCallCount()).To(Equal(1))
+		_, imageResource := fakeImageFetcher.FetchArgsForCall(0)
+		Expect(imageResource).To(Equal(*task.Config.ImageResource))
+	})
+
+	It("creates a container with the task's image, params and limits", func() {
+		Expect(fakeContainers.CreateCallCount()).To(Equal(1))
+		spec := fakeContainers.CreateArgsForCall(0)
+
+		Expect(spec.RootFSPath).To(Equal("raw:///some/rootfs"))
+		Expect(spec.Env).To(Equal([]string{"PATH=/usr/bin", "FOO=bar"}))
+		Expect(spec.Limits.CPU.LimitInShares).To(Equal(uint64(512)))
+		Expect(spec.Limits.Memory.LimitInBytes).To(Equal(uint64(1024)))
+	})
+
+	It("mounts a copy of each input, the outputs and the caches", func() {
+		spec := fakeContainers.CreateArgsForCall(0)
+		Expect(spec.BindMounts).To(HaveLen(4))
+
+		artifactsRoot := filepath.Dir(spec.BindMounts[0].DstPath)
+		Expect(artifactsRoot).To(HavePrefix("/tmp/build/"))
+
+		Expect(spec.BindMounts[0].DstPath).To(Equal(artifactsRoot + "/some-input"))
+		Expect(spec.BindMounts[0].SrcPath).ToNot(Equal(inputDir))
+
+		Expect(spec.BindMounts[1].DstPath).To(Equal(artifactsRoot + "/out"))
+		Expect(spec.BindMounts[1].SrcPath).To(Equal(filepath.Join(tmpDir, "output")))
+		Expect(filepath.Join(tmpDir, "output")).To(BeADirectory())
+
+		Expect(spec.BindMounts[2].DstPath).To(Equal(artifactsRoot + "/unmapped-output"))
+
+		Expect(spec.BindMounts[3].DstPath).To(Equal(artifactsRoot + "/some-cache"))
+		Expect(spec.BindMounts[3].SrcPath).To(Equal(filepath.Join(cacheDir, "some-cache")))
+		Expect(filepath.Join(cacheDir, "some-cache")).To(BeADirectory())
+	})
+
+	It("runs the task's process in its working directory as the image's user", func() {
+		Expect(fakeContainer.RunCallCount()).To(Equal(1))
+		processSpec, _ := fakeContainer.RunArgsForCall(0)
+
+		spec := fakeContainers.CreateArgsForCall(0)
+		Expect(processSpec.Path).To(Equal("sh"))
+		Expect(processSpec.Args).To(Equal([]string{"-c", "echo hello"}))
+		Expect(processSpec.Dir).To(Equal(spec.BindMounts[0].DstPath))
+		Expect(processSpec.User).To(Equal("image-user"))
+	})
+
+	It("destroys the container and the copied inputs", func() {
+		Expect(fakeContainers.DestroyCallCount()).To(Equal(1))
+		Expect(fakeContainers.DestroyArgsForCall(0)).To(Equal("some-handle"))
+
+		entries, err := ioutil.ReadDir(filepath.Join(tmpDir, "work"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	Context("when a local rootfs is given", func() {
+		BeforeEach(func() {
+			task.Rootfs = "/local/rootfs"
+			task.Config.Run.User = "task-user"
+		})
+
+		It("uses it instead of fetching the image", func() {
+			Expect(fakeImageFetcher.FetchCallCount()).To(BeZero())
+
+			spec := fakeContainers.CreateArgsForCall(0)
+			Expect(spec.RootFSPath).To(Equal("raw:///local/rootfs"))
+			Expect(spec.Env).To(Equal([]string{"FOO=bar"}))
+
+			processSpec, _ := fakeContainer.RunArgsForCall(0)
+			Expect(processSpec.User).To(Equal("task-user"))
+		})
+	})
+
+	Context("when the task has no image", func() {
+		BeforeEach(func() {
+			task.Config.ImageResource = nil
+		})
+
+		It("errors", func() {
+			Expect(runErr).To(MatchError(ContainSubstring("--local-rootfs")))
+		})
+	})
+
+	Context("when a required input is missing", func() {
+		BeforeEach(func() {
+			task.Inputs = map[string]string{}
+		})
+
+		It("errors without creating a container", func() {
+			Expect(runErr).To(Equal(localexec.MissingInputsError{Inputs: []string{"some-input"}}))
+			Expect(fakeContainers.CreateCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when fetching the image fails", func() {
+		BeforeEach(func() {
+			fakeImageFetcher.FetchReturns("", localexec.ImageMetadata{}, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+
+	Context("when the context is canceled", func() {
+		BeforeEach(func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(context.Background())
+
+			signaled := make(chan struct{})
+			fakeProcess.SignalStub = func(garden.Signal) error {
+				close(signaled)
+				return nil
+			}
+			fakeProcess.WaitStub = func() (int, error) {
+				cancel()
+				<-signaled
+				return 143, nil
+			}
+		})
+
+		It("terminates the process", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(143))
+			Expect(fakeProcess.SignalArgsForCall(fakeProcess.SignalCallCount() - 1)).To(Equal(garden.SignalTerminate))
+		})
+	})
+
+	Context("when the container is created", func() {
+		var copiedContents []byte
+
+		BeforeEach(func() {
+			fakeContainers.CreateStub = func(spec garden.ContainerSpec) (garden.Container, error) {
+				var err error
+				copiedContents, err = ioutil.ReadFile(filepath.Join(spec.BindMounts[0].SrcPath, "file"))
+				Expect(err).ToNot(HaveOccurred())
+				return fakeContainer, nil
+			}
+		})
+
+		It("has copied the input contents", func() {
+			Expect(string(copiedContents)).To(Equal("contents"))
+		})
+	})
+})
@@ -27,6 +27,7 @@ type ATCConfig struct {
 	Target           string
 	SkipInteraction  bool
 	CheckCredentials bool
+	Message          string
 	CommandWarnings  []concourse.ConfigWarning
 	GivenTeamName    string
 }
@@ -62,6 +63,11 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
+	err = atc.KeepMatrixValueText([]byte(evaluatedTemplate), &newConfig)
+	if err != nil {
+		return err
+	}
+
 	configWarnings, _ := configvalidate.Validate(newConfig)
 	for _, w := range configWarnings {
 		atcConfig.CommandWarnings = append(atcConfig.CommandWarnings, concourse.ConfigWarning{
@@ -70,6 +76,12 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		})
 	}
 
+	// the server stores each expansion of a job's matrix as a job of its own
+	newConfig, err = newConfig.ExpandJobMatrices()
+	if err != nil {
+		return err
+	}
+
 	diffExists := diff(existingConfig, newConfig)
 
 	if len(atcConfig.CommandWarnings) > 0 {
@@ -106,11 +118,12 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return nil
 	}
 
-	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfig(
+	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfigWithMessage(
 		atcConfig.PipelineRef,
 		existingConfigVersion,
 		evaluatedTemplate,
 		atcConfig.CheckCredentials,
+		atcConfig.Message,
 	)
 	if err != nil {
 		return err
@@ -121,8 +134,22 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
-	if len(warnings) > 0 {
-		displayhelpers.ShowWarnings(warnings)
+	var otherWarnings []concourse.ConfigWarning
+	var policyWarnings []string
+	for _, warning := range warnings {
+		if warning.Type == concourse.PolicyWarningType {
+			policyWarnings = append(policyWarnings, warning.Message)
+		} else {
+			otherWarnings = append(otherWarnings, warning)
+		}
+	}
+
+	if len(otherWarnings) > 0 {
+		displayhelpers.ShowWarnings(otherWarnings)
+	}
+
+	if len(policyWarnings) > 0 {
+		displayhelpers.ShowErrors("pipeline config violates policies, which are not yet enforced", policyWarnings)
 	}
 
 	atcConfig.showPipelineUpdateResult(updatedPipeline, created, updated)
@@ -1,7 +1,10 @@
 package commands
 
 import (
+	"fmt"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -16,6 +19,7 @@ type JobsCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
 	Json     bool                     `long:"json" description:"Print command result as JSON"`
 	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+	Flaky    bool                     `long:"flaky" description:"Only show jobs whose builds failed and then succeeded with the same inputs, most flaky first"`
 }
 
 func (command *JobsCommand) Execute([]string) error {
@@ -49,6 +53,10 @@ func (command *JobsCommand) Execute([]string) error {
 		return err
 	}
 
+	if command.Flaky {
+		jobs = flakyJobs(jobs)
+	}
+
 	if command.Json {
 		err = displayhelpers.JsonPrint(jobs)
 		if err != nil {
@@ -57,7 +65,15 @@ func (command *JobsCommand) Execute([]string) error {
 		return nil
 	}
 
+	scheduled := anyScheduled(jobs)
+
 	headers = []string{"name", "paused", "status", "next"}
+	if scheduled {
+		headers = append(headers, "scheduled")
+	}
+	if command.Flaky {
+		headers = append(headers, "flakiness")
+	}
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -93,8 +109,52 @@ func (command *JobsCommand) Execute([]string) error {
 		}
 		row = append(row, nextColumn)
 
+		if scheduled {
+			var scheduledColumn ui.TableCell
+			if p.NextFireTime != 0 {
+				scheduledColumn.Contents = time.Unix(p.NextFireTime, 0).Local().Format(timeDateLayout)
+			} else {
+				scheduledColumn.Contents = "n/a"
+			}
+			row = append(row, scheduledColumn)
+		}
+
+		if command.Flaky {
+			row = append(row, ui.TableCell{
+				Contents: fmt.Sprintf("%.0f%% (%d/%d builds)", p.Flakiness.Score*100, p.Flakiness.FlakyBuilds, p.Flakiness.AnalyzedBuilds),
+				Color:    color.New(color.FgYellow),
+			})
+		}
+
 		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+// anyScheduled returns whether any of the jobs will be triggered by a
+// schedule, in which case their next fire times are shown.
+func anyScheduled(jobs []atc.Job) bool {
+	for _, job := range jobs {
+		if job.NextFireTime != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flakyJobs(jobs []atc.Job) []atc.Job {
+	flaky := []atc.Job{}
+	for _, job := range jobs {
+		if job.Flakiness != nil && job.Flakiness.FlakyBuilds > 0 {
+			flaky = append(flaky, job)
+		}
+	}
+
+	sort.SliceStable(flaky, func(i, j int) bool {
+		return flaky[i].Flakiness.Score > flaky[j].Flakiness.Score
+	})
+
+	return flaky
+}
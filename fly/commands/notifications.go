@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type NotificationsCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get notification deliveries for this pipeline"`
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of deliveries you want to limit the return to"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *NotificationsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	deliveries, found, err := target.Team().PipelineNotifications(pipelineRef, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(deliveries)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := []string{"id", "job", "build", "sink", "status", "attempts", "updated", "last error"}
+	table := ui.Table{Headers: ui.TableRow{}}
+	for _, h := range headers {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	for _, delivery := range deliveries {
+		lastErrorCell := ui.TableCell{Contents: delivery.LastError}
+		if delivery.LastError == "" {
+			lastErrorCell = ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			ui.TableCell{Contents: strconv.Itoa(delivery.ID)},
+			ui.TableCell{Contents: delivery.JobName},
+			ui.TableCell{Contents: delivery.BuildName},
+			ui.TableCell{Contents: delivery.Sink},
+			notificationStatusCell(delivery.Status),
+			ui.TableCell{Contents: strconv.Itoa(delivery.Attempts)},
+			ui.TableCell{Contents: time.Unix(delivery.UpdatedAt, 0).Local().Format(timeDateLayout)},
+			lastErrorCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func notificationStatusCell(status atc.NotificationDeliveryStatus) ui.TableCell {
+	cell := ui.TableCell{Contents: string(status)}
+
+	switch status {
+	case atc.NotificationDeliveryPending:
+		cell.Color = ui.PendingColor
+	case atc.NotificationDeliverySucceeded:
+		cell.Color = ui.SucceededColor
+	case atc.NotificationDeliveryFailed:
+		cell.Color = ui.FailedColor
+	}
+
+	return cell
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type PipelineHistoryCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get the config history of this pipeline"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *PipelineHistoryCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	revisions, found, err := team.PipelineConfigHistory(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(revisions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := []string{"revision", "saved at", "author", "message"}
+	table := ui.Table{Headers: ui.TableRow{}}
+	for _, h := range headers {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	for _, revision := range revisions {
+		authorCell := ui.TableCell{Contents: revision.Author}
+		if revision.Author == "" {
+			authorCell = ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		}
+
+		messageCell := ui.TableCell{Contents: revision.Message}
+		if revision.Message == "" {
+			messageCell = ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			ui.TableCell{Contents: strconv.Itoa(revision.Revision)},
+			ui.TableCell{Contents: time.Unix(revision.SavedAt, 0).Local().Format(timeDateLayout)},
+			authorCell,
+			messageCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
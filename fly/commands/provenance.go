@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ProvenanceCommand struct {
+	Job       flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get the build's provenance for"`
+	Build     string              `short:"b" long:"build" required:"true" description:"If job is specified: build number. If job not specified: build id"`
+	Statement bool                `long:"statement" description:"Print the decoded provenance statement rather than the signed envelope"`
+}
+
+func (command *ProvenanceCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var buildID int
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		buildID, err = strconv.Atoi(command.Build)
+		if err != nil {
+			return fmt.Errorf("build id must be a number when no job is specified")
+		}
+	} else {
+		build, exists, err := target.Team().JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("build does not exist")
+		}
+
+		buildID = build.ID
+	}
+
+	envelope, found, err := target.Client().BuildProvenance(buildID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("build has no provenance")
+	}
+
+	if !command.Statement {
+		return displayhelpers.JsonPrint(envelope)
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return err
+	}
+
+	var statement atc.ProvenanceStatement
+	err = json.Unmarshal(payload, &statement)
+	if err != nil {
+		return err
+	}
+
+	return displayhelpers.JsonPrint(statement)
+}
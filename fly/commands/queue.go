@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type QueueCommand struct {
+	Teams []string `short:"n" long:"team" description:"Show queued builds for these teams"`
+	Json  bool     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *QueueCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	queue, err := target.Client().ListBuildQueue()
+	if err != nil {
+		return err
+	}
+
+	if len(command.Teams) > 0 {
+		queue = command.filterTeams(queue)
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(queue)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "priority", Color: color.New(color.Bold)},
+			{Contents: "reason", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "team", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, b := range queue {
+		var names []string
+		if b.PipelineName != "" {
+			pipelineRef := atc.PipelineRef{
+				Name:         b.PipelineName,
+				InstanceVars: b.PipelineInstanceVars,
+			}
+
+			names = append(names, pipelineRef.String())
+		}
+
+		if b.JobName != "" {
+			names = append(names, b.JobName)
+		}
+
+		names = append(names, b.Name)
+
+		priority := b.Priority
+		if priority == "" {
+			priority = atc.JobPriorityNormal
+		}
+
+		var reasonCell ui.TableCell
+		if b.Reason == "" {
+			reasonCell.Contents = "n/a"
+			reasonCell.Color = color.New(color.Faint)
+		} else {
+			reasonCell.Contents = string(b.Reason)
+		}
+
+		var createdCell ui.TableCell
+		if b.CreateTime == 0 {
+			createdCell.Contents = "n/a"
+		} else {
+			createdCell.Contents = time.Unix(b.CreateTime, 0).Local().Format(timeDateLayout)
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(b.ID)},
+			{Contents: strings.Join(names, "/")},
+			ui.BuildStatusCell(b.Status),
+			{Contents: string(priority)},
+			reasonCell,
+			createdCell,
+			{Contents: b.TeamName},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *QueueCommand) filterTeams(queue []atc.QueuedBuild) []atc.QueuedBuild {
+	teams := map[string]bool{}
+	for _, team := range command.Teams {
+		teams[team] = true
+	}
+
+	filtered := []atc.QueuedBuild{}
+	for _, b := range queue {
+		if teams[b.TeamName] {
+			filtered = append(filtered, b)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/vito/go-interact/interact"
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type RollbackPipelineCommand struct {
+	Pipeline        flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to roll back"`
+	To              int                      `long:"to" required:"true" description:"Revision whose config to restore"`
+	SkipInteractive bool                     `short:"n" long:"non-interactive" description:"Skips interactions, uses default values"`
+	Team            string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *RollbackPipelineCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	restoredConfig, err := configRevision(team, pipelineRef, command.To)
+	if err != nil {
+		return err
+	}
+
+	existingConfig, existingConfigVersion, found, err := team.PipelineConfig(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
+	}
+
+	if !existingConfig.Diff(os.Stdout, restoredConfig) {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	if !command.SkipInteractive {
+		confirm := false
+		err = interact.NewInteraction("roll back?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	payload, err := yaml.Marshal(restoredConfig)
+	if err != nil {
+		return err
+	}
+
+	_, _, warnings, err := team.CreateOrUpdatePipelineConfigWithMessage(
+		pipelineRef,
+		existingConfigVersion,
+		payload,
+		false,
+		fmt.Sprintf("rollback to revision %d", command.To),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	fmt.Printf("rolled back '%s' to revision %d\n", pipelineRef.String(), command.To)
+
+	return nil
+}
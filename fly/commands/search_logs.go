@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type SearchLogsCommand struct {
+	Args struct {
+		Query string `positional-arg-name:"QUERY" required:"true" description:"Text to search the build logs for, ignoring case"`
+	} `positional-args:"yes"`
+
+	Pipeline *flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Only search the builds of this pipeline"`
+	Job      flaghelpers.JobFlag       `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Only search the builds of this job"`
+	Since    string                    `long:"since" description:"Only search builds started after this time"`
+	Until    string                    `long:"until" description:"Only search builds started before this time"`
+	Context  int                       `short:"C" long:"context" default:"2" description:"Number of lines to show around each match"`
+	Count    int                       `short:"c" long:"count" default:"100" description:"Maximum number of matching lines to show"`
+	Team     string                    `long:"team" description:"Name of the team whose builds to search, if different from the target default"`
+	Json     bool                      `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *SearchLogsCommand) Execute([]string) error {
+	search, err := command.search()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	matches, err := team.SearchBuildLogs(search)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(matches)
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	command.display(stdout, matches)
+
+	return nil
+}
+
+func (command *SearchLogsCommand) search() (concourse.BuildLogSearch, error) {
+	search := concourse.BuildLogSearch{
+		Query:   command.Args.Query,
+		Context: command.Context,
+		Limit:   command.Count,
+	}
+
+	if command.Pipeline != nil && command.Job.JobName != "" {
+		return search, errors.New("Cannot specify both --pipeline and --job")
+	}
+
+	if command.Pipeline != nil {
+		_, err := command.Pipeline.Validate()
+		if err != nil {
+			return search, err
+		}
+
+		pipelineRef := command.Pipeline.Ref()
+		search.Pipeline = &pipelineRef
+	}
+
+	if command.Job.JobName != "" {
+		search.Pipeline = &command.Job.PipelineRef
+		search.Job = command.Job.JobName
+	}
+
+	var err error
+	if command.Since != "" {
+		search.Since, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return search, errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		search.Until, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return search, errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Since != "" && command.Until != "" && search.Since.After(search.Until) {
+		return search, errors.New("Cannot have --since after --until")
+	}
+
+	return search, nil
+}
+
+// display prints the matches grouped by build and step, grep-style: the
+// matching lines are highlighted and the context around them is dimmed.
+func (command *SearchLogsCommand) display(w io.Writer, matches []atc.BuildLogMatch) {
+	bold := color.New(color.Bold)
+	faint := color.New(color.Faint)
+
+	var lastBuild int
+	var lastOrigin string
+	for i, match := range matches {
+		if match.BuildID != lastBuild || match.Origin != lastOrigin {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+
+			fmt.Fprintf(w, "%s %s\n",
+				bold.Sprintf("build %s", buildLogMatchName(match)),
+				faint.Sprintf("(id %d, step %s)", match.BuildID, match.Origin),
+			)
+
+			lastBuild = match.BuildID
+			lastOrigin = match.Origin
+		} else if len(match.Before) > 0 {
+			fmt.Fprintln(w, faint.Sprint("--"))
+		}
+
+		for _, line := range match.Before {
+			fmt.Fprintln(w, faint.Sprint("  "+line))
+		}
+
+		fmt.Fprintln(w, bold.Sprint("> ")+highlightQuery(match.Line, command.Args.Query))
+
+		for _, line := range match.After {
+			fmt.Fprintln(w, faint.Sprint("  "+line))
+		}
+	}
+}
+
+func buildLogMatchName(match atc.BuildLogMatch) string {
+	var names []string
+	if match.PipelineName != "" {
+		pipelineRef := atc.PipelineRef{
+			Name:         match.PipelineName,
+			InstanceVars: match.PipelineInstanceVars,
+		}
+
+		names = append(names, pipelineRef.String())
+	}
+
+	if match.JobName != "" {
+		names = append(names, match.JobName)
+	}
+
+	if len(names) == 0 {
+		return "#" + match.BuildName
+	}
+
+	return strings.Join(names, "/") + " #" + match.BuildName
+}
+
+func highlightQuery(line string, query string) string {
+	index := strings.Index(strings.ToLower(line), strings.ToLower(query))
+	end := index + len(query)
+	if index < 0 || end > len(line) {
+		return line
+	}
+
+	return line[:index] + color.New(color.FgRed, color.Bold).Sprint(line[index:end]) + line[end:]
+}
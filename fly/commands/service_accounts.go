@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type ServiceAccountsCommand struct {
+	List   ListServiceAccountsCommand  `command:"list"   alias:"ls" description:"List the service accounts of a team"`
+	Create CreateServiceAccountCommand `command:"create" description:"Create a service account"`
+	Delete DeleteServiceAccountCommand `command:"delete" description:"Delete a service account along with all of its tokens"`
+}
+
+type ListServiceAccountsCommand struct {
+	Team string `long:"team" description:"Name of the team whose service accounts to list, if different from the target default"`
+	Json bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ListServiceAccountsCommand) Execute([]string) error {
+	team, err := serviceAccountTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	accounts, err := team.ListServiceAccounts()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(accounts)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "tokens", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, account := range accounts {
+		var tokenNames []string
+		for _, token := range account.Tokens {
+			tokenNames = append(tokenNames, token.Name)
+		}
+
+		tokensCell := ui.TableCell{Contents: strings.Join(tokenNames, ",")}
+		if len(tokenNames) == 0 {
+			tokensCell.Contents = "none"
+			tokensCell.Color = color.New(color.Faint)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: account.Name},
+			{Contents: time.Unix(account.CreatedAt, 0).Local().Format(timeDateLayout)},
+			tokensCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type CreateServiceAccountCommand struct {
+	Name string `short:"a" long:"service-account" required:"true" description:"Name of the service account to create"`
+	Team string `long:"team" description:"Name of the team to create the service account in, if different from the target default"`
+}
+
+func (command *CreateServiceAccountCommand) Execute([]string) error {
+	team, err := serviceAccountTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	created, err := team.CreateServiceAccount(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if created {
+		fmt.Printf("created service account '%s'\n", command.Name)
+	} else {
+		fmt.Printf("service account '%s' already exists\n", command.Name)
+	}
+
+	return nil
+}
+
+type DeleteServiceAccountCommand struct {
+	Name string `short:"a" long:"service-account" required:"true" description:"Name of the service account to delete"`
+	Team string `long:"team" description:"Name of the team the service account belongs to, if different from the target default"`
+}
+
+func (command *DeleteServiceAccountCommand) Execute([]string) error {
+	team, err := serviceAccountTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	found, err := team.DeleteServiceAccount(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("service account '%s' not found on team %s", command.Name, team.Name())
+	}
+
+	fmt.Printf("deleted service account '%s'\n", command.Name)
+
+	return nil
+}
+
+func serviceAccountTeam(teamName string) (concourse.Team, error) {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return nil, err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if teamName != "" {
+		return target.FindTeam(teamName)
+	}
+
+	return target.Team(), nil
+}
@@ -30,6 +30,8 @@ type SetPipelineCommand struct {
 
 	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
 
+	Message string `short:"m"  long:"message"  description:"Describe the change, to be recorded in the pipeline's config history"`
+
 	Team string `long:"team"              description:"Name of the team to which the pipeline belongs, if different from the target default"`
 }
 
@@ -102,6 +104,7 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive || command.Config.FromStdin(),
 		CheckCredentials: command.CheckCredentials,
+		Message:          command.Message,
 		CommandWarnings:  warnings,
 		GivenTeamName:    command.Team,
 	}
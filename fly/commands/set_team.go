@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -29,6 +30,43 @@ type SetTeamCommand struct {
 	Team            flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
 	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
 	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+	QuotaFlags      QuotaFlags           `group:"Quota"`
+}
+
+// QuotaFlags replace the team's whole quota when any of them are given; limits
+// which are not given become unlimited. The quota is left untouched otherwise.
+type QuotaFlags struct {
+	MaxConcurrentBuilds *int             `long:"quota-max-concurrent-builds" description:"Maximum number of builds the team may run at once (0 for unlimited)"`
+	MaxContainers       *int             `long:"quota-max-containers" description:"Hold back new builds while the team has this many containers on the workers (0 for unlimited)"`
+	MaxVolumeDisk       *atc.MemoryLimit `long:"quota-max-volume-disk" description:"Hold back new builds while the team's volumes use this much disk, e.g. 10GB (0 for unlimited)"`
+	MaxTaskCacheSize    *atc.MemoryLimit `long:"quota-max-task-cache-size" description:"Maximum size of the team's task caches in the remote cache tier, e.g. 50GB (0 for unlimited)"`
+	TaskCacheMaxAge     *time.Duration   `long:"quota-task-cache-max-age" description:"Evict the team's task caches from the remote cache tier once unused for this long, e.g. 168h (0 to keep forever)"`
+}
+
+func (flags QuotaFlags) Quota() *atc.TeamQuota {
+	if flags.MaxConcurrentBuilds == nil && flags.MaxContainers == nil && flags.MaxVolumeDisk == nil &&
+		flags.MaxTaskCacheSize == nil && flags.TaskCacheMaxAge == nil {
+		return nil
+	}
+
+	var quota atc.TeamQuota
+	if flags.MaxConcurrentBuilds != nil {
+		quota.MaxConcurrentBuilds = *flags.MaxConcurrentBuilds
+	}
+	if flags.MaxContainers != nil {
+		quota.MaxContainers = *flags.MaxContainers
+	}
+	if flags.MaxVolumeDisk != nil {
+		quota.MaxVolumeDisk = uint64(*flags.MaxVolumeDisk)
+	}
+	if flags.MaxTaskCacheSize != nil {
+		quota.MaxTaskCacheSize = uint64(*flags.MaxTaskCacheSize)
+	}
+	if flags.TaskCacheMaxAge != nil && *flags.TaskCacheMaxAge != 0 {
+		quota.TaskCacheMaxAge = flags.TaskCacheMaxAge.String()
+	}
+
+	return &quota
 }
 
 func (command *SetTeamCommand) Validate() ([]concourse.ConfigWarning, error) {
@@ -43,6 +81,15 @@ func (command *SetTeamCommand) Validate() ([]concourse.ConfigWarning, error) {
 			Message: warning.Message,
 		})
 	}
+
+	quota := command.QuotaFlags.Quota()
+	if quota != nil {
+		err := quota.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return warnings, nil
 }
 
@@ -68,6 +115,12 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	customRoles, err := command.AuthFlags.CustomRoles()
+	if err != nil {
+		fmt.Fprintln(ui.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -101,6 +154,30 @@ func (command *SetTeamCommand) Execute([]string) error {
 		} else {
 			fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
 		}
+
+		if actions, ok := customRoles[role]; ok {
+			fmt.Println()
+			fmt.Printf("  actions:\n")
+			for _, action := range actions {
+				fmt.Printf("  - %s\n", action)
+			}
+		}
+	}
+
+	quota := command.QuotaFlags.Quota()
+	if quota != nil {
+		fmt.Println()
+		fmt.Println("quota:")
+		fmt.Printf("  max concurrent builds: %s\n", quotaLimit(uint64(quota.MaxConcurrentBuilds)))
+		fmt.Printf("  max containers: %s\n", quotaLimit(uint64(quota.MaxContainers)))
+		fmt.Printf("  max volume disk: %s\n", quotaLimit(quota.MaxVolumeDisk))
+		fmt.Printf("  max task cache size: %s\n", quotaLimit(quota.MaxTaskCacheSize))
+
+		if quota.TaskCacheMaxAge == "" {
+			fmt.Printf("  task cache max age: %s\n", ui.OffColor.Sprint("unlimited"))
+		} else {
+			fmt.Printf("  task cache max age: %s\n", quota.TaskCacheMaxAge)
+		}
 	}
 
 	if len(warnings) > 0 {
@@ -120,7 +197,7 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
+	team := atc.Team{Auth: authRoles, Quota: quota, CustomRoles: customRoles}
 
 	_, created, updated, warnings, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
@@ -139,3 +216,11 @@ func (command *SetTeamCommand) Execute([]string) error {
 
 	return nil
 }
+
+func quotaLimit(limit uint64) string {
+	if limit == 0 {
+		return ui.OffColor.Sprint("unlimited")
+	}
+
+	return fmt.Sprint(limit)
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type TokensCommand struct {
+	List   ListTokensCommand  `command:"list"   alias:"ls" description:"List the tokens of a team's service accounts"`
+	Create CreateTokenCommand `command:"create" description:"Create a token for a service account"`
+	Revoke RevokeTokenCommand `command:"revoke" description:"Revoke a service account token"`
+}
+
+type ListTokensCommand struct {
+	ServiceAccount string `short:"a" long:"service-account" description:"Only list the tokens of this service account"`
+	Team           string `long:"team" description:"Name of the team whose tokens to list, if different from the target default"`
+	Json           bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ListTokensCommand) Execute([]string) error {
+	team, err := serviceAccountTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	accounts, err := team.ListServiceAccounts()
+	if err != nil {
+		return err
+	}
+
+	if command.ServiceAccount != "" {
+		var filtered []atc.ServiceAccount
+		for _, account := range accounts {
+			if account.Name == command.ServiceAccount {
+				filtered = append(filtered, account)
+			}
+		}
+
+		if len(filtered) == 0 {
+			return fmt.Errorf("service account '%s' not found on team %s", command.ServiceAccount, team.Name())
+		}
+
+		accounts = filtered
+	}
+
+	if command.Json {
+		tokens := map[string][]atc.ServiceAccountToken{}
+		for _, account := range accounts {
+			tokens[account.Name] = account.Tokens
+		}
+
+		err = displayhelpers.JsonPrint(tokens)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "service account", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "role", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "expires", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, account := range accounts {
+		for _, token := range account.Tokens {
+			expiresCell := ui.TableCell{Contents: "never", Color: color.New(color.Faint)}
+			if token.ExpiresAt != 0 {
+				expiresCell = ui.TableCell{Contents: time.Unix(token.ExpiresAt, 0).Local().Format(timeDateLayout)}
+				if time.Unix(token.ExpiresAt, 0).Before(time.Now()) {
+					expiresCell.Color = color.New(color.FgRed)
+				}
+			}
+
+			table.Data = append(table.Data, ui.TableRow{
+				{Contents: account.Name},
+				{Contents: token.Name},
+				{Contents: token.Role},
+				{Contents: time.Unix(token.CreatedAt, 0).Local().Format(timeDateLayout)},
+				expiresCell,
+			})
+		}
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type CreateTokenCommand struct {
+	ServiceAccount string        `short:"a" long:"service-account" required:"true" description:"Name of the service account to create the token for"`
+	Name           string        `short:"n" long:"name" required:"true" description:"Name of the token, unique within the service account"`
+	Role           string        `short:"r" long:"role" default:"viewer" description:"Role granted to the token on the team (owner, member, pipeline-operator or viewer). Tokens never make their holder an admin, even as owner of an admin team"`
+	ExpiresIn      time.Duration `long:"expires-in" description:"Duration after which the token expires, e.g. 720h; tokens never expire by default"`
+	Team           string        `long:"team" description:"Name of the team the service account belongs to, if different from the target default"`
+}
+
+func (command *CreateTokenCommand) Execute([]string) error {
+	team, err := serviceAccountTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	request := atc.ServiceAccountToken{
+		Name: command.Name,
+		Role: command.Role,
+	}
+
+	if command.ExpiresIn > 0 {
+		request.ExpiresAt = time.Now().Add(command.ExpiresIn).Unix()
+	}
+
+	token, found, err := team.CreateServiceAccountToken(command.ServiceAccount, request)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("service account '%s' not found on team %s", command.ServiceAccount, team.Name())
+	}
+
+	fmt.Fprintln(ui.Stderr, ui.WarningColor("store this token now; it will not be shown again"))
+	fmt.Println(token.Token)
+
+	return nil
+}
+
+type RevokeTokenCommand struct {
+	ServiceAccount string `short:"a" long:"service-account" required:"true" description:"Name of the service account the token belongs to"`
+	Name           string `short:"n" long:"name" required:"true" description:"Name of the token to revoke"`
+	Team           string `long:"team" description:"Name of the team the service account belongs to, if different from the target default"`
+}
+
+func (command *RevokeTokenCommand) Execute([]string) error {
+	team, err := serviceAccountTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	found, err := team.RevokeServiceAccountToken(command.ServiceAccount, command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("token '%s' not found on service account '%s'", command.Name, command.ServiceAccount)
+	}
+
+	fmt.Printf("revoked token '%s'\n", command.Name)
+
+	return nil
+}
@@ -12,7 +12,8 @@ import (
 )
 
 type UserinfoCommand struct {
-	Json bool `long:"json" description:"Print command result as JSON"`
+	Actions bool `long:"actions" description:"Show the actions the user may perform on each team"`
+	Json    bool `long:"json" description:"Print command result as JSON"`
 }
 
 func (command *UserinfoCommand) Execute([]string) error {
@@ -39,6 +40,10 @@ func (command *UserinfoCommand) Execute([]string) error {
 		return nil
 	}
 
+	if command.Actions {
+		return command.renderActions(userinfo.TeamActions)
+	}
+
 	headers := ui.TableRow{
 		{Contents: "username", Color: color.New(color.Bold)},
 		{Contents: "team/role", Color: color.New(color.Bold)},
@@ -64,3 +69,29 @@ func (command *UserinfoCommand) Execute([]string) error {
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+func (command *UserinfoCommand) renderActions(teamActions map[string][]string) error {
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "actions", Color: color.New(color.Bold)},
+		},
+	}
+
+	for team, actions := range teamActions {
+		actionsCell := ui.TableCell{Contents: strings.Join(actions, ",")}
+		if len(actions) == 0 {
+			actionsCell.Contents = "none"
+			actionsCell.Color = color.New(color.Faint)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: team},
+			actionsCell,
+		})
+	}
+
+	sort.Sort(table.Data)
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
 )
 
 type WatchCommand struct {
@@ -84,6 +85,7 @@ func (command *WatchCommand) Execute(args []string) error {
 	}
 
 	var buildId int
+	var cause *atc.BuildCause
 	client := target.Client()
 	if command.Job.JobName != "" || command.Build == "" && command.Url == "" {
 		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineRef)
@@ -91,6 +93,7 @@ func (command *WatchCommand) Execute(args []string) error {
 			return err
 		}
 		buildId = build.ID
+		cause = build.Cause
 	} else if command.Build != "" {
 		buildId, err = strconv.Atoi(command.Build)
 
@@ -110,6 +113,11 @@ func (command *WatchCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cause != nil {
+		fmt.Println(ui.Embolden("cause:"), cause.String())
+		fmt.Println("")
+	}
+
 	renderOptions := eventstream.RenderOptions{
 		ShowTimestamp:            command.Timestamp,
 		IgnoreEventParsingErrors: command.IgnoreEventParsingErrors,
@@ -44,6 +44,20 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			dstImpl.SetTimestamp(e.Time)
 			fmt.Fprintf(dstImpl, "\x1b[1mno suitable workers found, waiting for worker...\x1b[0m\n")
 
+		case event.WaitingForQuota:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mteam quota %s reached, waiting for quota...\x1b[0m\n", e.Quota)
+
+		case event.PolicyCheck:
+			dstImpl.SetTimestamp(e.Time)
+			if e.Warned {
+				fmt.Fprintf(dstImpl, "\x1b[1mpolicy check warning:\x1b[0m %s: %s\n", e.Action, strings.Join(e.Reasons, ", "))
+			} else if e.Allowed {
+				fmt.Fprintf(dstImpl, "\x1b[1mpolicy check passed:\x1b[0m %s\n", e.Action)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mpolicy check failed:\x1b[0m %s: %s\n", e.Action, ui.ErroredColor.Sprint(strings.Join(e.Reasons, ", ")))
+			}
+
 		case event.SelectedWorker:
 			dstImpl.SetTimestamp(e.Time)
 			fmt.Fprintf(dstImpl, "\x1b[1mselected worker:\x1b[0m %s\n", e.WorkerName)
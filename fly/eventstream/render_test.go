@@ -318,6 +318,66 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a WaitingForQuota event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.WaitingForQuota{
+				Time:  time.Now().Unix(),
+				Quota: "max_containers",
+			}
+		})
+
+		It("prints which quota the step is waiting for", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mteam quota max_containers reached, waiting for quota...\x1b[0m\n"))
+		})
+	})
+
+	Context("when a PolicyCheck event is received", func() {
+		Context("when the check passed", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.PolicyCheck{
+					Time:    time.Now().Unix(),
+					Action:  "RunTask",
+					Allowed: true,
+				}
+			})
+
+			It("prints the checked action", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mpolicy check passed:\x1b[0m RunTask\n"))
+			})
+		})
+
+		Context("when the check was only warned about", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.PolicyCheck{
+					Time:    time.Now().Unix(),
+					Action:  "RunTask",
+					Allowed: true,
+					Warned:  true,
+					Reasons: []string{"no privileged tasks"},
+				}
+			})
+
+			It("prints the reasons as a warning", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mpolicy check warning:\x1b[0m RunTask: no privileged tasks\n"))
+			})
+		})
+
+		Context("when the check failed", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.PolicyCheck{
+					Time:    time.Now().Unix(),
+					Action:  "RunTask",
+					Allowed: false,
+					Reasons: []string{"no privileged tasks", "no root"},
+				}
+			})
+
+			It("prints the reasons", func() {
+				Expect(out).To(gbytes.Say(`policy check failed:.* RunTask: .*no privileged tasks, no root`))
+			})
+		})
+	})
+
 	Context("when a SelectedWorker event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.SelectedWorker{
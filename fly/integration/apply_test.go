@@ -0,0 +1,187 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("apply", func() {
+		var (
+			dir         string
+			clusterPath string
+			flyCmd      *exec.Cmd
+			args        []string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "fly-apply")
+			Expect(err).NotTo(HaveOccurred())
+
+			clusterPath = filepath.Join(dir, "cluster.yml")
+			err = ioutil.WriteFile(clusterPath, []byte(`
+teams:
+- name: main
+  pipelines:
+  - name: some-pipeline
+    file: some-pipeline.yml
+    vars: {job_name: some-job}
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(dir, "some-pipeline.yml"), []byte(`
+jobs:
+- name: ((job_name))
+  plan: []
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			args = []string{"-t", targetName, "apply", "-f", clusterPath}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		JustBeforeEach(func() {
+			flyCmd = exec.Command(flyPath, args...)
+		})
+
+		serveCluster := func(existingJob string) {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams"),
+					ghttp.RespondWithJSONEncoded(200, []atc.Team{{ID: 1, Name: "main"}}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+					ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{{Name: "some-pipeline", TeamName: "main"}}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+					ghttp.RespondWithJSONEncoded(200, atc.ConfigResponse{
+						Config: atc.Config{
+							Jobs: atc.JobConfigs{{Name: existingJob, PlanSequence: []atc.Step{}}},
+						},
+					}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+				),
+			)
+		}
+
+		Context("when nothing has drifted", func() {
+			BeforeEach(func() {
+				serveCluster("some-job")
+			})
+
+			It("exits 0", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("no changes to apply"))
+			})
+
+			Context("with --check", func() {
+				BeforeEach(func() {
+					args = append(args, "--check")
+				})
+
+				It("exits 0", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("no changes to apply"))
+				})
+			})
+		})
+
+		Context("when the pipeline has drifted", func() {
+			BeforeEach(func() {
+				serveCluster("other-job")
+			})
+
+			Context("with --dry-run", func() {
+				BeforeEach(func() {
+					args = append(args, "--dry-run")
+				})
+
+				It("shows the diff and exits 0 without applying it", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("team main has changed:"))
+					Expect(sess.Out).To(gbytes.Say("pipeline some-pipeline has changed:"))
+					Expect(sess.Out).To(gbytes.Say("job other-job has been removed:"))
+					Expect(sess.Out).To(gbytes.Say("job some-job has been added:"))
+					Expect(sess.Out).To(gbytes.Say("set pipeline main/some-pipeline"))
+					Expect(sess.Out).ToNot(gbytes.Say("changes applied"))
+				})
+			})
+
+			Context("with --check", func() {
+				BeforeEach(func() {
+					args = append(args, "--check")
+				})
+
+				It("shows the diff and exits 2 without applying it", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(2))
+					Expect(sess.Out).To(gbytes.Say("set pipeline main/some-pipeline"))
+					Expect(sess.Out).To(gbytes.Say("drift detected"))
+				})
+			})
+
+			Context("when applying", func() {
+				BeforeEach(func() {
+					args = append(args, "--non-interactive")
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+							ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+							ghttp.RespondWith(http.StatusOK, `{}`),
+						),
+					)
+				})
+
+				It("sets the pipeline and exits 0", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("changes applied"))
+				})
+			})
+		})
+
+		Context("when the cluster config is invalid", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(clusterPath, []byte(`teams: []`), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("exits 1", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("cluster config must declare at least one team"))
+			})
+		})
+	})
+})
@@ -0,0 +1,135 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("audit", func() {
+		var (
+			flyCmd *exec.Cmd
+			query  string
+		)
+
+		events := []atc.AuditEvent{
+			{
+				ID:     2,
+				Time:   200,
+				User:   "some-user",
+				Team:   "main",
+				Action: atc.SaveConfig,
+				Target: "/api/v1/teams/main/pipelines/some-pipeline/config",
+				Status: 200,
+			},
+			{
+				ID:     1,
+				Time:   100,
+				Action: atc.ListWorkers,
+				Target: "/api/v1/workers",
+				Status: 401,
+			},
+		}
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "audit")
+			query = "limit=50"
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/audit", query),
+					ghttp.RespondWithJSONEncoded(200, events),
+				),
+			)
+		})
+
+		It("shows the events", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "time", Color: color.New(color.Bold)},
+					{Contents: "user", Color: color.New(color.Bold)},
+					{Contents: "team", Color: color.New(color.Bold)},
+					{Contents: "action", Color: color.New(color.Bold)},
+					{Contents: "target", Color: color.New(color.Bold)},
+					{Contents: "status", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: time.Unix(200, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "some-user"}, {Contents: "main"}, {Contents: "SaveConfig"}, {Contents: "/api/v1/teams/main/pipelines/some-pipeline/config"}, {Contents: "200"}},
+					{{Contents: time.Unix(100, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "anonymous", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "ListWorkers"}, {Contents: "/api/v1/workers"}, {Contents: "401", Color: color.New(color.FgRed)}},
+				},
+			}))
+		})
+
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--team", "main", "--user", "some-user", "--action", "SaveConfig", "--count", "10")
+				query = "action=SaveConfig&limit=10&team=main&user=some-user"
+			})
+
+			It("sends them to the API", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints the events as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{
+						"id": 2,
+						"time": 200,
+						"user": "some-user",
+						"team": "main",
+						"action": "SaveConfig",
+						"target": "/api/v1/teams/main/pipelines/some-pipeline/config",
+						"status": 200
+					},
+					{
+						"id": 1,
+						"time": 100,
+						"user": "",
+						"action": "ListWorkers",
+						"target": "/api/v1/workers",
+						"status": 401
+					}
+				]`))
+			})
+		})
+
+		Context("when the since date is malformed", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--since", "yesterday")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("since time should be in the format: yyyy-mm-dd"))
+			})
+		})
+	})
+})
@@ -418,6 +418,76 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when passing the cause argument", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "--cause")
+
+				expectedURL = "/api/v1/builds"
+				queryParams = []string{"limit=50"}
+
+				expectedHeaders = append(expectedHeaders, ui.TableCell{Contents: "cause", Color: color.New(color.Bold)})
+
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{
+					{
+						ID:       2,
+						JobName:  "some-job",
+						Name:     "62",
+						Status:   "pending",
+						TeamName: "team1",
+						Cause: &atc.BuildCause{
+							Type: atc.BuildCauseInputs,
+							Inputs: []atc.BuildCauseInput{
+								{
+									Name:   "some-input",
+									Passed: []atc.BuildCauseBuild{{ID: 1, Name: "12", JobName: "upstream-job"}},
+								},
+							},
+						},
+					},
+					{
+						ID:       1,
+						JobName:  "some-job",
+						Name:     "61",
+						Status:   "pending",
+						TeamName: "team1",
+					},
+				}
+			})
+
+			It("shows why each build was created", func() {
+				Eventually(session.Out).Should(PrintTable(ui.Table{
+					Headers: expectedHeaders,
+					Data: []ui.TableRow{
+						{
+							{Contents: "2"},
+							{Contents: "some-job/62"},
+							{Contents: "pending"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
+							{Contents: "team1"},
+							{Contents: "system"},
+							{Contents: "new versions of some-input (passed upstream-job #12)"},
+						},
+						{
+							{Contents: "1"},
+							{Contents: "some-job/61"},
+							{Contents: "pending"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
+							{Contents: "team1"},
+							{Contents: "system"},
+							{Contents: "n/a"},
+						},
+					},
+				}))
+
+				Eventually(session).Should(gexec.Exit(0))
+			})
+		})
+
 		Context("when passing the job argument", func() {
 			BeforeEach(func() {
 				cmdArgs = append(cmdArgs, "-j")
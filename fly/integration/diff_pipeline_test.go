@@ -0,0 +1,103 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("diff-pipeline", func() {
+		var (
+			oldConfig atc.Config
+			newConfig atc.Config
+		)
+
+		BeforeEach(func() {
+			oldConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}},
+			}
+
+			newConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}, {Name: "other-job"}},
+			}
+		})
+
+		Context("when --to is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config/history/1"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigRevision{Revision: 1, Config: &oldConfig}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config/history/2"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigRevision{Revision: 2, Config: &newConfig}),
+					),
+				)
+			})
+
+			It("shows the changes between the revisions", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "-p", "pipeline", "--from", "1", "--to", "2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("job other-job has been added"))
+			})
+		})
+
+		Context("when --to is not given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config/history/1"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigRevision{Revision: 1, Config: &oldConfig}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: oldConfig}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+					),
+				)
+			})
+
+			It("compares against the current config", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "-p", "pipeline", "--from", "1")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("no changes"))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config/history/7"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "-p", "pipeline", "--from", "7")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("revision 7 of pipeline 'pipeline' not found"))
+			})
+		})
+	})
+})
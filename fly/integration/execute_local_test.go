@@ -0,0 +1,79 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --local", func() {
+		var (
+			tmpdir         string
+			taskConfigPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-execute-local")
+			Expect(err).NotTo(HaveOccurred())
+
+			taskConfigPath = filepath.Join(tmpdir, "task.yml")
+
+			err = ioutil.WriteFile(
+				taskConfigPath,
+				[]byte(`---
+platform: linux
+
+image_resource:
+  type: registry-image
+  source:
+    repository: busybox
+
+inputs:
+- name: some-input
+
+run:
+  path: ls
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		Context("when a required input is not provided", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--local", "-c", taskConfigPath)
+				flyCmd.Dir = tmpdir
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("missing required input `some-input`"))
+			})
+		})
+
+		Context("when given flags that need a Concourse", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "--local", "-c", taskConfigPath, "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--inputs-from cannot be used with --local"))
+			})
+		})
+	})
+})
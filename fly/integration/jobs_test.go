@@ -1,9 +1,11 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os/exec"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/ui"
@@ -171,6 +173,125 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when --flaky is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "--pipeline", "pipeline", "--flaky")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(200, []atc.Job{
+							{
+								ID:            1,
+								Name:          "stable-job",
+								Flakiness:     &atc.JobFlakiness{AnalyzedBuilds: 10},
+								FinishedBuild: &atc.Build{Status: atc.StatusSucceeded},
+							},
+							{
+								ID:            2,
+								Name:          "somewhat-flaky-job",
+								Flakiness:     &atc.JobFlakiness{Score: 0.1, FlakyBuilds: 1, AnalyzedBuilds: 10},
+								FinishedBuild: &atc.Build{Status: atc.StatusSucceeded},
+							},
+							{
+								ID:   3,
+								Name: "unanalyzed-job",
+							},
+							{
+								ID:            4,
+								Name:          "very-flaky-job",
+								Flakiness:     &atc.JobFlakiness{Score: 0.5, FlakyBuilds: 2, AnalyzedBuilds: 4},
+								FinishedBuild: &atc.Build{Status: atc.StatusFailed},
+							},
+						}),
+					),
+				)
+			})
+
+			It("shows only the flaky jobs, most flaky first", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "paused", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "next", Color: color.New(color.Bold)},
+						{Contents: "flakiness", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "very-flaky-job"}, {Contents: "no"}, {Contents: "failed", Color: color.New(color.FgRed)}, {Contents: "n/a"}, {Contents: "50% (2/4 builds)", Color: color.New(color.FgYellow)}},
+						{{Contents: "somewhat-flaky-job"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "n/a"}, {Contents: "10% (1/10 builds)", Color: color.New(color.FgYellow)}},
+					},
+				}))
+			})
+
+			Context("when --json is also given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints only the flaky jobs", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					var jobs []atc.Job
+					Expect(json.Unmarshal(sess.Out.Contents(), &jobs)).To(Succeed())
+					Expect(jobs).To(HaveLen(2))
+					Expect(jobs[0].Name).To(Equal("very-flaky-job"))
+					Expect(jobs[1].Name).To(Equal("somewhat-flaky-job"))
+				})
+			})
+		})
+
+		Context("when some jobs have a schedule", func() {
+			var nextFireTime time.Time
+
+			BeforeEach(func() {
+				nextFireTime = time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+
+				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "--pipeline", "pipeline")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(200, []atc.Job{
+							{
+								ID:           1,
+								Name:         "nightly-job",
+								NextFireTime: nextFireTime.Unix(),
+							},
+							{
+								ID:   2,
+								Name: "other-job",
+							},
+						}),
+					),
+				)
+			})
+
+			It("shows the next fire time of each job", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "paused", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "next", Color: color.New(color.Bold)},
+						{Contents: "scheduled", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "nightly-job"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: nextFireTime.Local().Format("2006-01-02@15:04:05-0700")}},
+						{{Contents: "other-job"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}},
+					},
+				}))
+			})
+		})
+
 		Context("when the api returns an internal server error", func() {
 			BeforeEach(func() {
 				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "-p", "pipeline")
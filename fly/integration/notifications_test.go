@@ -0,0 +1,164 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("notifications", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		Context("when pipeline name is not specified", func() {
+			It("fails and says pipeline name is required", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "notifications")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("p", "pipeline") + "' was not specified"))
+			})
+		})
+
+		Context("when deliveries are returned from the API", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "notifications", "--pipeline", "pipeline/branch:master", "--count", "2")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/notifications", "limit=2&vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(200, []atc.NotificationDelivery{
+							{
+								ID:           2,
+								TeamName:     teamName,
+								PipelineName: "pipeline",
+								JobName:      "deploy",
+								BuildName:    "12",
+								BuildStatus:  atc.StatusFailed,
+								Sink:         "pager",
+								Status:       atc.NotificationDeliveryFailed,
+								Attempts:     5,
+								LastError:    "unexpected response 500: oops",
+								UpdatedAt:    100,
+							},
+							{
+								ID:           1,
+								TeamName:     teamName,
+								PipelineName: "pipeline",
+								JobName:      "deploy",
+								BuildName:    "12",
+								BuildStatus:  atc.StatusFailed,
+								Sink:         "slack",
+								Status:       atc.NotificationDeliverySucceeded,
+								Attempts:     1,
+								UpdatedAt:    50,
+							},
+						}),
+					),
+				)
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 2,
+							"team_name": "main",
+							"pipeline_id": 0,
+							"pipeline_name": "pipeline",
+							"job_name": "deploy",
+							"build_id": 0,
+							"build_name": "12",
+							"build_status": "failed",
+							"sink": "pager",
+							"status": "failed",
+							"attempts": 5,
+							"last_error": "unexpected response 500: oops",
+							"created_at": 0,
+							"updated_at": 100
+						},
+						{
+							"id": 1,
+							"team_name": "main",
+							"pipeline_id": 0,
+							"pipeline_name": "pipeline",
+							"job_name": "deploy",
+							"build_id": 0,
+							"build_name": "12",
+							"build_status": "failed",
+							"sink": "slack",
+							"status": "succeeded",
+							"attempts": 1,
+							"created_at": 0,
+							"updated_at": 50
+						}
+					]`))
+				})
+			})
+
+			It("shows the pipeline's notification deliveries", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "job", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "sink", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "attempts", Color: color.New(color.Bold)},
+						{Contents: "updated", Color: color.New(color.Bold)},
+						{Contents: "last error", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: "deploy"}, {Contents: "12"}, {Contents: "pager"}, {Contents: "failed", Color: color.New(color.FgRed)}, {Contents: "5"}, {Contents: time.Unix(100, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "unexpected response 500: oops"}},
+						{{Contents: "1"}, {Contents: "deploy"}, {Contents: "12"}, {Contents: "slack"}, {Contents: "succeeded", Color: color.New(color.FgGreen)}, {Contents: "1"}, {Contents: time.Unix(50, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "n/a", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "notifications", "-p", "pipeline")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/notifications"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("pipeline 'pipeline' not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,116 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("pipeline-history", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		Context("when pipeline name is not specified", func() {
+			It("fails and says pipeline name is required", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("p", "pipeline") + "' was not specified"))
+			})
+		})
+
+		Context("when revisions are returned from the API", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pipeline-history", "--pipeline", "pipeline/branch:master")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config/history", "vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(200, []atc.PipelineConfigRevision{
+							{Revision: 2, Author: "some-user", Message: "add a job", SavedAt: 100},
+							{Revision: 1, SavedAt: 50},
+						}),
+					),
+				)
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"revision": 2,
+							"author": "some-user",
+							"message": "add a job",
+							"saved_at": 100
+						},
+						{
+							"revision": 1,
+							"saved_at": 50
+						}
+					]`))
+				})
+			})
+
+			It("shows the pipeline's config revisions", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "revision", Color: color.New(color.Bold)},
+						{Contents: "saved at", Color: color.New(color.Bold)},
+						{Contents: "author", Color: color.New(color.Bold)},
+						{Contents: "message", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: time.Unix(100, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "some-user"}, {Contents: "add a job"}},
+						{{Contents: "1"}, {Contents: time.Unix(50, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "pipeline")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config/history"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("pipeline 'pipeline' not found"))
+			})
+		})
+	})
+})
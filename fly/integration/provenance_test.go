@@ -0,0 +1,131 @@
+package integration_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Provenance", func() {
+	var (
+		statement atc.ProvenanceStatement
+		envelope  atc.ProvenanceEnvelope
+	)
+
+	BeforeEach(func() {
+		statement = atc.ProvenanceStatement{
+			Type:          atc.ProvenanceStatementType,
+			PredicateType: atc.ProvenancePredicateType,
+			Subject: []atc.ProvenanceSubject{
+				{Name: "some-image", Digest: map[string]string{"sha256": "feedface"}},
+			},
+		}
+
+		payload, err := json.Marshal(statement)
+		Expect(err).NotTo(HaveOccurred())
+
+		envelope = atc.ProvenanceEnvelope{
+			PayloadType: atc.ProvenancePayloadType,
+			Payload:     base64.StdEncoding.EncodeToString(payload),
+			Signatures: []atc.ProvenanceSignature{
+				{KeyID: "some-key", Sig: "c29tZS1zaWc="},
+			},
+		}
+	})
+
+	Context("when the build id is specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/provenance"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, envelope),
+				),
+			)
+		})
+
+		It("prints the signed envelope", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "provenance", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			expected, err := json.Marshal(envelope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Out.Contents()).To(MatchJSON(expected))
+		})
+
+		It("prints the decoded statement when --statement is given", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "provenance", "-b", "23", "--statement")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			expected, err := json.Marshal(statement)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Out.Contents()).To(MatchJSON(expected))
+		})
+	})
+
+	Context("when the job and build name are specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 23, Name: "42"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/provenance"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, envelope),
+				),
+			)
+		})
+
+		It("prints the provenance of the job's build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "provenance", "-j", "some-pipeline/some-job", "-b", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			expected, err := json.Marshal(envelope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Out.Contents()).To(MatchJSON(expected))
+		})
+	})
+
+	Context("when the build has no provenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/provenance"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "provenance", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("build has no provenance"))
+		})
+	})
+})
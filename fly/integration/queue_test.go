@@ -0,0 +1,164 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("queue", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "queue")
+		})
+
+		Context("when queued builds are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/queue"),
+						ghttp.RespondWithJSONEncoded(200, []atc.QueuedBuild{
+							{
+								ID:           3,
+								Name:         "7",
+								Status:       atc.StatusPending,
+								TeamName:     "main",
+								PipelineName: "pipeline",
+								JobName:      "deploy",
+								Priority:     atc.JobPriorityHigh,
+								Reason:       atc.QueueReasonSerialGroup,
+								CreateTime:   100,
+							},
+							{
+								ID:           1,
+								Name:         "2",
+								Status:       atc.StatusStarted,
+								TeamName:     "other-team",
+								PipelineName: "pipeline",
+								JobName:      "unit",
+								Reason:       atc.QueueReasonNoWorker,
+								CreateTime:   50,
+							},
+							{
+								ID:           2,
+								Name:         "4",
+								Status:       atc.StatusPending,
+								TeamName:     "main",
+								PipelineName: "pipeline",
+								JobName:      "lint",
+								Priority:     atc.JobPriorityLow,
+							},
+						}),
+					),
+				)
+			})
+
+			It("shows the queued builds in order", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "priority", Color: color.New(color.Bold)},
+						{Contents: "reason", Color: color.New(color.Bold)},
+						{Contents: "created", Color: color.New(color.Bold)},
+						{Contents: "team", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "3"}, {Contents: "pipeline/deploy/7"}, {Contents: "pending"}, {Contents: "high"}, {Contents: "serial-group"}, {Contents: time.Unix(100, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "main"}},
+						{{Contents: "1"}, {Contents: "pipeline/unit/2"}, {Contents: "started", Color: color.New(color.FgYellow)}, {Contents: "normal"}, {Contents: "no-worker"}, {Contents: time.Unix(50, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "other-team"}},
+						{{Contents: "2"}, {Contents: "pipeline/lint/4"}, {Contents: "pending"}, {Contents: "low"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "n/a"}, {Contents: "main"}},
+					},
+				}))
+			})
+
+			Context("when --team is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--team", "other-team")
+				})
+
+				It("only shows the builds of that team", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "id", Color: color.New(color.Bold)},
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "status", Color: color.New(color.Bold)},
+							{Contents: "priority", Color: color.New(color.Bold)},
+							{Contents: "reason", Color: color.New(color.Bold)},
+							{Contents: "created", Color: color.New(color.Bold)},
+							{Contents: "team", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "1"}, {Contents: "pipeline/unit/2"}, {Contents: "started", Color: color.New(color.FgYellow)}, {Contents: "normal"}, {Contents: "no-worker"}, {Contents: time.Unix(50, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "other-team"}},
+						},
+					}))
+				})
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json", "--team", "other-team")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 1,
+							"name": "2",
+							"status": "started",
+							"team_name": "other-team",
+							"pipeline_name": "pipeline",
+							"job_name": "unit",
+							"priority": "",
+							"reason": "no-worker",
+							"create_time": 50
+						}
+					]`))
+				})
+			})
+		})
+
+		Context("when the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/queue"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("rollback-pipeline", func() {
+		var (
+			oldConfig     atc.Config
+			currentConfig atc.Config
+		)
+
+		BeforeEach(func() {
+			oldConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}},
+			}
+
+			currentConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}, {Name: "broken-job"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config/history/1"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigRevision{Revision: 1, Config: &oldConfig}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/config"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: currentConfig}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+				),
+			)
+		})
+
+		Context("when the rollback is saved", func() {
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/pipeline/config", "message=rollback+to+revision+1"),
+						ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+						func(w http.ResponseWriter, r *http.Request) {
+							body, err := ioutil.ReadAll(r.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							var savedConfig atc.Config
+							err = yaml.Unmarshal(body, &savedConfig)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(savedConfig).To(Equal(oldConfig))
+						},
+						ghttp.RespondWith(http.StatusOK, "{}"),
+					),
+				)
+			})
+
+			It("restores the config of the revision", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "pipeline", "--to", "1", "-n")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("job broken-job has been removed"))
+				Expect(sess.Out).To(gbytes.Say("rolled back 'pipeline' to revision 1"))
+			})
+		})
+
+		Context("when the revision matches the current config", func() {
+			BeforeEach(func() {
+				currentConfig = oldConfig
+			})
+
+			It("does not save anything", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "pipeline", "--to", "1", "-n")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("no changes to apply"))
+			})
+		})
+	})
+})
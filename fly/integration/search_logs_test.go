@@ -0,0 +1,129 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("search-logs", func() {
+		var (
+			flyCmd  *exec.Cmd
+			matches []atc.BuildLogMatch
+		)
+
+		BeforeEach(func() {
+			matches = []atc.BuildLogMatch{
+				{
+					BuildID:      42,
+					BuildName:    "7",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					Origin:       "some-task",
+					LogLineMatch: atc.LogLineMatch{
+						Line:   "error: connection refused",
+						Before: []string{"dialing db"},
+						After:  []string{"retrying"},
+					},
+				},
+				{
+					BuildID:   40,
+					BuildName: "40",
+					Origin:    "some-other-task",
+					LogLineMatch: atc.LogLineMatch{
+						Line: "connection refused",
+					},
+				},
+			}
+		})
+
+		Context("when searching the whole team", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "connection refused", "-C", "1")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search", "context=1&limit=100&q=connection+refused"),
+						ghttp.RespondWithJSONEncoded(200, matches),
+					),
+				)
+			})
+
+			It("prints each matching line under its build and step", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say(`build some-pipeline/some-job #7 \(id 42, step some-task\)`))
+				Expect(sess.Out).To(gbytes.Say(`  dialing db`))
+				Expect(sess.Out).To(gbytes.Say(`> error: connection refused`))
+				Expect(sess.Out).To(gbytes.Say(`  retrying`))
+				Expect(sess.Out).To(gbytes.Say(`build #40 \(id 40, step some-other-task\)`))
+				Expect(sess.Out).To(gbytes.Say(`> connection refused`))
+			})
+		})
+
+		Context("when searching the builds of a job", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "oops", "-j", "some-pipeline/some-job", "--json")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search", "context=2&job=some-job&limit=100&pipeline=some-pipeline&q=oops"),
+						ghttp.RespondWithJSONEncoded(200, matches[:1]),
+					),
+				)
+			})
+
+			It("prints the matches as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var printed []atc.BuildLogMatch
+				Expect(json.Unmarshal(sess.Out.Contents(), &printed)).To(Succeed())
+				Expect(printed).To(Equal(matches[:1]))
+			})
+		})
+
+		Context("when both --pipeline and --job are given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "oops", "-p", "some-pipeline", "-j", "some-pipeline/some-job")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("Cannot specify both --pipeline and --job"))
+			})
+		})
+
+		Context("when the search fails", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "oops")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
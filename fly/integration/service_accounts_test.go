@@ -0,0 +1,230 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	accounts := []atc.ServiceAccount{
+		{
+			Name:      "deployer",
+			TeamName:  "main",
+			CreatedAt: 100,
+			Tokens: []atc.ServiceAccountToken{
+				{Name: "ci", Role: "member", CreatedAt: 100},
+				{Name: "release", Role: "owner", CreatedAt: 200, ExpiresAt: 4102444800},
+			},
+		},
+		{
+			Name:      "reporter",
+			TeamName:  "main",
+			CreatedAt: 200,
+		},
+	}
+
+	format := func(unix int64) string {
+		return time.Unix(unix, 0).Local().Format("2006-01-02@15:04:05-0700")
+	}
+
+	Describe("service-accounts list", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/service-accounts"),
+					ghttp.RespondWithJSONEncoded(200, accounts),
+				),
+			)
+		})
+
+		It("shows the service accounts", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "service-accounts", "list")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+					{Contents: "tokens", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: "deployer"}, {Contents: format(100)}, {Contents: "ci,release"}},
+					{{Contents: "reporter"}, {Contents: format(200)}, {Contents: "none", Color: color.New(color.Faint)}},
+				},
+			}))
+		})
+	})
+
+	Describe("service-accounts create", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/service-accounts/deployer"),
+					ghttp.RespondWith(http.StatusCreated, ""),
+				),
+			)
+		})
+
+		It("creates the service account", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "service-accounts", "create", "-a", "deployer")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("created service account 'deployer'"))
+		})
+	})
+
+	Describe("service-accounts delete", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/service-accounts/deployer"),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+		})
+
+		Context("when the service account exists", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("deletes it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "service-accounts", "delete", "-a", "deployer")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("deleted service account 'deployer'"))
+			})
+		})
+
+		Context("when the service account does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "service-accounts", "delete", "-a", "deployer")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("service account 'deployer' not found on team main"))
+			})
+		})
+	})
+
+	Describe("tokens list", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/service-accounts"),
+					ghttp.RespondWithJSONEncoded(200, accounts),
+				),
+			)
+		})
+
+		It("shows the tokens of every service account", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "list")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "service account", Color: color.New(color.Bold)},
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "role", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: "deployer"}, {Contents: "ci"}, {Contents: "member"}, {Contents: format(100)}, {Contents: "never", Color: color.New(color.Faint)}},
+					{{Contents: "deployer"}, {Contents: "release"}, {Contents: "owner"}, {Contents: format(200)}, {Contents: format(4102444800)}},
+				},
+			}))
+		})
+
+		Context("when the service account does not exist", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "list", "-a", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("service account 'bogus' not found on team main"))
+			})
+		})
+	})
+
+	Describe("tokens create", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/service-accounts/deployer/tokens"),
+					func(w http.ResponseWriter, r *http.Request) {
+						var request atc.ServiceAccountToken
+						Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+						Expect(request.Name).To(Equal("ci"))
+						Expect(request.Role).To(Equal("member"))
+						Expect(request.ExpiresAt).To(BeNumerically("~", time.Now().Add(time.Hour).Unix(), 60))
+					},
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.ServiceAccountToken{
+						Name:  "ci",
+						Role:  "member",
+						Token: "csa_some-token",
+					}),
+				),
+			)
+		})
+
+		It("prints the token", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "create", "-a", "deployer", "-n", "ci", "-r", "member", "--expires-in", "1h")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("csa_some-token"))
+			Expect(sess.Err).To(gbytes.Say("it will not be shown again"))
+		})
+	})
+
+	Describe("tokens revoke", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/service-accounts/deployer/tokens/ci"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("revokes the token", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "revoke", "-a", "deployer", "-n", "ci")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("revoked token 'ci'"))
+		})
+	})
+})
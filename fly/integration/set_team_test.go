@@ -758,6 +758,134 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 		})
+
+		Describe("quota", func() {
+			Context("when quota flags are given", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--quota-max-containers", "10",
+						"--quota-max-volume-disk", "1KB",
+						"--quota-max-task-cache-size", "2KB",
+						"--quota-task-cache-max-age", "168h",
+					}
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"auth": {
+									"owner":{
+										"users": [
+											"local:brock-obama"
+										],
+										"groups": []
+									}
+								},
+								"quota": {
+									"max_containers": 10,
+									"max_volume_disk": 1024,
+									"max_task_cache_size": 2048,
+									"task_cache_max_age": "168h0m0s"
+								}
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("shows and sends the quota", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("quota:"))
+					Eventually(sess.Out).Should(gbytes.Say("max concurrent builds: unlimited"))
+					Eventually(sess.Out).Should(gbytes.Say("max containers: 10"))
+					Eventually(sess.Out).Should(gbytes.Say("max volume disk: 1024"))
+					Eventually(sess.Out).Should(gbytes.Say("max task cache size: 2048"))
+					Eventually(sess.Out).Should(gbytes.Say("task cache max age: 168h0m0s"))
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when a quota limit is negative", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--quota-max-containers", "-1",
+					}
+				})
+
+				It("returns an error", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("quota for the team must not have negative limits"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+		})
+
+		Describe("custom roles", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_custom_roles.yml"}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner": {
+									"users": ["local:some-owner"],
+									"groups": []
+								},
+								"releaser": {
+									"users": ["local:some-releaser"],
+									"groups": []
+								}
+							},
+							"custom_roles": {
+								"releaser": ["CreateJobBuild", "PinResourceVersion", "UnpinResource"]
+							}
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows and sends the custom roles", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("role releaser:"))
+				Eventually(sess.Out).Should(gbytes.Say("- local:some-releaser"))
+				Eventually(sess.Out).Should(gbytes.Say("actions:"))
+				Eventually(sess.Out).Should(gbytes.Say("- CreateJobBuild"))
+				Eventually(sess.Out).Should(gbytes.Say("- PinResourceVersion"))
+				Eventually(sess.Out).Should(gbytes.Say("- UnpinResource"))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
 	})
 })
 
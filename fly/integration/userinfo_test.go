@@ -39,6 +39,10 @@ var _ = Describe("Fly CLI", func() {
 								"other_team": {"owner"},
 								"test_team":  {"owner", "viewer"},
 							},
+							"team_actions": map[string][]string{
+								"other_team": {"GetPipeline", "SaveConfig"},
+								"test_team":  {"CreateJobBuild"},
+							},
 							"connector": "some-connector",
 							"display_user_id": "test_id",
 						}),
@@ -62,6 +66,29 @@ var _ = Describe("Fly CLI", func() {
 				}))
 			})
 
+			Context("when --actions is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--actions")
+				})
+
+				It("shows the actions the user may perform on each team", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "team", Color: color.New(color.Bold)},
+							{Contents: "actions", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "other_team"}, {Contents: "GetPipeline,SaveConfig"}},
+							{{Contents: "test_team"}, {Contents: "CreateJobBuild"}},
+						},
+					}))
+				})
+			})
+
 			Context("when --json is given", func() {
 				BeforeEach(func() {
 					flyCmd.Args = append(flyCmd.Args, "--json")
@@ -84,6 +111,10 @@ var _ = Describe("Fly CLI", func() {
 								"other_team": ["owner"],
 								"test_team": ["owner", "viewer"]
 							},
+							"team_actions": {
+								"other_team": ["GetPipeline", "SaveConfig"],
+								"test_team": ["CreateJobBuild"]
+							},
 							"connector": "some-connector",
 							"display_user_id": "test_id"
 					}`))
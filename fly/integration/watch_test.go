@@ -240,5 +240,40 @@ var _ = Describe("Watching", func() {
 				watch("--url", atcServer.URL()+"/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3?"+webQueryParams)
 			})
 		})
+
+		Context("when the build has a cause", func() {
+			BeforeEach(func() {
+				expectedURL = "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/3"
+				expectedResponse = atc.Build{
+					ID:      3,
+					Name:    "3",
+					Status:  "started",
+					JobName: "some-job",
+					Cause: &atc.BuildCause{
+						Type:      atc.BuildCauseManual,
+						CreatedBy: "some-user",
+					},
+				}
+			})
+
+			It("prints the cause before the build's output", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--job", "some-pipeline/branch:master/some-job", "--build", "3")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(streaming).Should(BeClosed())
+				Eventually(sess.Out).Should(gbytes.Say("cause: manually triggered by some-user"))
+
+				events <- event.Log{Payload: "sup"}
+
+				Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+				close(events)
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
 	})
 })
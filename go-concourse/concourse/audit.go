@@ -0,0 +1,62 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+// AuditEventFilter narrows down the audit events returned; empty fields match
+// any event.
+type AuditEventFilter struct {
+	Team   string
+	User   string
+	Action string
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+}
+
+func (client *client) ListAuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error) {
+	queryParams := url.Values{}
+
+	if filter.Team != "" {
+		queryParams.Add("team", filter.Team)
+	}
+
+	if filter.User != "" {
+		queryParams.Add("user", filter.User)
+	}
+
+	if filter.Action != "" {
+		queryParams.Add("action", filter.Action)
+	}
+
+	if !filter.Since.IsZero() {
+		queryParams.Add("since", strconv.FormatInt(filter.Since.Unix(), 10))
+	}
+
+	if !filter.Until.IsZero() {
+		queryParams.Add("until", strconv.FormatInt(filter.Until.Unix(), 10))
+	}
+
+	if filter.Limit > 0 {
+		queryParams.Add("limit", strconv.Itoa(filter.Limit))
+	}
+
+	var events []atc.AuditEvent
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListAuditEvents,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &events,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,84 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Audit", func() {
+	Describe("ListAuditEvents", func() {
+		expectedEvents := []atc.AuditEvent{
+			{
+				ID:     1,
+				Time:   1620945615,
+				User:   "some-user",
+				Team:   "main",
+				Action: atc.SaveConfig,
+				Target: "/api/v1/teams/main/pipelines/some-pipeline/config",
+				Status: http.StatusOK,
+			},
+		}
+
+		Context("when no filters are given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents),
+					),
+				)
+			})
+
+			It("returns the events", func() {
+				events, err := client.ListAuditEvents(concourse.AuditEventFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(Equal(expectedEvents))
+			})
+		})
+
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit", "action=SaveConfig&limit=5&since=1620945000&team=main&until=1620946000&user=some-user"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents),
+					),
+				)
+			})
+
+			It("sends them as query parameters", func() {
+				_, err := client.ListAuditEvents(concourse.AuditEventFilter{
+					Team:   "main",
+					User:   "some-user",
+					Action: atc.SaveConfig,
+					Since:  time.Unix(1620945000, 0),
+					Until:  time.Unix(1620946000, 0),
+					Limit:  5,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit"),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := client.ListAuditEvents(concourse.AuditEventFilter{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
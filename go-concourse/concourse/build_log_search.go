@@ -0,0 +1,77 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// BuildLogSearch narrows down a search through a team's build logs. Zero
+// values are left out of the request.
+type BuildLogSearch struct {
+	Query string
+
+	Pipeline *atc.PipelineRef
+	Job      string
+	Since    time.Time
+	Until    time.Time
+
+	Context int
+	Limit   int
+}
+
+func (search BuildLogSearch) QueryParams() url.Values {
+	queryParams := url.Values{}
+	queryParams.Set(atc.BuildLogSearchQueryText, search.Query)
+
+	if search.Pipeline != nil {
+		queryParams.Set(atc.BuildLogSearchQueryPipeline, search.Pipeline.Name)
+		queryParams = merge(queryParams, search.Pipeline.QueryParams())
+	}
+
+	if search.Job != "" {
+		queryParams.Set(atc.BuildLogSearchQueryJob, search.Job)
+	}
+
+	if !search.Since.IsZero() {
+		queryParams.Set(atc.BuildLogSearchQuerySince, strconv.FormatInt(search.Since.Unix(), 10))
+	}
+
+	if !search.Until.IsZero() {
+		queryParams.Set(atc.BuildLogSearchQueryUntil, strconv.FormatInt(search.Until.Unix(), 10))
+	}
+
+	if search.Context > 0 {
+		queryParams.Set(atc.BuildLogSearchQueryContext, strconv.Itoa(search.Context))
+	}
+
+	if search.Limit > 0 {
+		queryParams.Set(atc.BuildLogSearchQueryLimit, strconv.Itoa(search.Limit))
+	}
+
+	return queryParams
+}
+
+func (team *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	var matches []atc.BuildLogMatch
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.SearchBuildLogs,
+		Params:      params,
+		Query:       search.QueryParams(),
+	}, &internal.Response{
+		Result: &matches,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
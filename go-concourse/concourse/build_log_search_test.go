@@ -0,0 +1,96 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Log Search", func() {
+	Describe("SearchBuildLogs", func() {
+		var expectedMatches []atc.BuildLogMatch
+
+		BeforeEach(func() {
+			expectedMatches = []atc.BuildLogMatch{
+				{
+					BuildID:      42,
+					BuildName:    "7",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					Origin:       "some-origin",
+					Time:         100,
+					LogLineMatch: atc.LogLineMatch{
+						Line:  "error: connection refused",
+						After: []string{"retrying"},
+					},
+				},
+			}
+		})
+
+		Context("when only the query is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/builds/search", "q=connection+refused"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMatches),
+					),
+				)
+			})
+
+			It("returns the matches", func() {
+				matches, err := team.SearchBuildLogs(concourse.BuildLogSearch{Query: "connection refused"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(matches).To(Equal(expectedMatches))
+			})
+		})
+
+		Context("when the search is narrowed down", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/builds/search", "context=2&job=some-job&limit=5&pipeline=some-pipeline&q=oops&since=100&until=200&vars.branch=%22main%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMatches),
+					),
+				)
+			})
+
+			It("sends the filters along", func() {
+				_, err := team.SearchBuildLogs(concourse.BuildLogSearch{
+					Query: "oops",
+					Pipeline: &atc.PipelineRef{
+						Name:         "some-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "main"},
+					},
+					Job:     "some-job",
+					Since:   time.Unix(100, 0),
+					Until:   time.Unix(200, 0),
+					Context: 2,
+					Limit:   5,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the search is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/builds/search"),
+						ghttp.RespondWith(http.StatusBadRequest, "query must not be empty"),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.SearchBuildLogs(concourse.BuildLogSearch{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
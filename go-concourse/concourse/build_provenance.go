@@ -0,0 +1,32 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) BuildProvenance(buildID int) (atc.ProvenanceEnvelope, bool, error) {
+	params := rata.Params{
+		"build_id": strconv.Itoa(buildID),
+	}
+
+	var envelope atc.ProvenanceEnvelope
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetBuildProvenance,
+		Params:      params,
+	}, &internal.Response{
+		Result: &envelope,
+	})
+
+	switch err.(type) {
+	case nil:
+		return envelope, true, nil
+	case internal.ResourceNotFoundError:
+		return envelope, false, nil
+	default:
+		return envelope, false, err
+	}
+}
@@ -0,0 +1,59 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Provenance", func() {
+	Describe("BuildProvenance", func() {
+		expectedURL := "/api/v1/builds/1234/provenance"
+
+		Context("when the build has provenance", func() {
+			expectedEnvelope := atc.ProvenanceEnvelope{
+				PayloadType: atc.ProvenancePayloadType,
+				Payload:     "c29tZS1wYXlsb2Fk",
+				Signatures: []atc.ProvenanceSignature{
+					{KeyID: "some-key", Sig: "c29tZS1zaWc="},
+				},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEnvelope),
+					),
+				)
+			})
+
+			It("returns the signed envelope", func() {
+				envelope, found, err := client.BuildProvenance(1234)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(envelope).To(Equal(expectedEnvelope))
+			})
+		})
+
+		Context("when the build does not exist or has no provenance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.BuildProvenance(1234)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -21,6 +21,8 @@ type Client interface {
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
+	BuildProvenance(buildID int) (atc.ProvenanceEnvelope, bool, error)
+	ListBuildQueue() ([]atc.QueuedBuild, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
@@ -34,6 +36,7 @@ type Client interface {
 	Team(teamName string) Team
 	UserInfo() (atc.UserInfo, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	ListAuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error)
 }
 
 type client struct {
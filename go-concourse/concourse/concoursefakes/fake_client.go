@@ -66,6 +66,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildProvenanceStub        func(int) (atc.ProvenanceEnvelope, bool, error)
+	buildProvenanceMutex       sync.RWMutex
+	buildProvenanceArgsForCall []struct {
+		arg1 int
+	}
+	buildProvenanceReturns struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
+	buildProvenanceReturnsOnCall map[int]struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
 	BuildResourcesStub        func(int) (atc.BuildInputsOutputs, bool, error)
 	buildResourcesMutex       sync.RWMutex
 	buildResourcesArgsForCall []struct {
@@ -183,6 +198,19 @@ type FakeClient struct {
 		result1 []atc.Job
 		result2 error
 	}
+	ListAuditEventsStub        func(concourse.AuditEventFilter) ([]atc.AuditEvent, error)
+	listAuditEventsMutex       sync.RWMutex
+	listAuditEventsArgsForCall []struct {
+		arg1 concourse.AuditEventFilter
+	}
+	listAuditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}
+	listAuditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}
 	ListBuildArtifactsStub        func(string) ([]atc.WorkerArtifact, error)
 	listBuildArtifactsMutex       sync.RWMutex
 	listBuildArtifactsArgsForCall []struct {
@@ -196,6 +224,18 @@ type FakeClient struct {
 		result1 []atc.WorkerArtifact
 		result2 error
 	}
+	ListBuildQueueStub        func() ([]atc.QueuedBuild, error)
+	listBuildQueueMutex       sync.RWMutex
+	listBuildQueueArgsForCall []struct {
+	}
+	listBuildQueueReturns struct {
+		result1 []atc.QueuedBuild
+		result2 error
+	}
+	listBuildQueueReturnsOnCall map[int]struct {
+		result1 []atc.QueuedBuild
+		result2 error
+	}
 	ListPipelinesStub        func() ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
@@ -553,6 +593,73 @@ func (fake *FakeClient) BuildPlanReturnsOnCall(i int, result1 atc.PublicBuildPla
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildProvenance(arg1 int) (atc.ProvenanceEnvelope, bool, error) {
+	fake.buildProvenanceMutex.Lock()
+	ret, specificReturn := fake.buildProvenanceReturnsOnCall[len(fake.buildProvenanceArgsForCall)]
+	fake.buildProvenanceArgsForCall = append(fake.buildProvenanceArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.BuildProvenanceStub
+	fakeReturns := fake.buildProvenanceReturns
+	fake.recordInvocation("BuildProvenance", []interface{}{arg1})
+	fake.buildProvenanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildProvenanceCallCount() int {
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
+	return len(fake.buildProvenanceArgsForCall)
+}
+
+func (fake *FakeClient) BuildProvenanceCalls(stub func(int) (atc.ProvenanceEnvelope, bool, error)) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = stub
+}
+
+func (fake *FakeClient) BuildProvenanceArgsForCall(i int) int {
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
+	argsForCall := fake.buildProvenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildProvenanceReturns(result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = nil
+	fake.buildProvenanceReturns = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildProvenanceReturnsOnCall(i int, result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = nil
+	if fake.buildProvenanceReturnsOnCall == nil {
+		fake.buildProvenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.ProvenanceEnvelope
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildProvenanceReturnsOnCall[i] = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) BuildResources(arg1 int) (atc.BuildInputsOutputs, bool, error) {
 	fake.buildResourcesMutex.Lock()
 	ret, specificReturn := fake.buildResourcesReturnsOnCall[len(fake.buildResourcesArgsForCall)]
@@ -1109,6 +1216,70 @@ func (fake *FakeClient) ListAllJobsReturnsOnCall(i int, result1 []atc.Job, resul
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListAuditEvents(arg1 concourse.AuditEventFilter) ([]atc.AuditEvent, error) {
+	fake.listAuditEventsMutex.Lock()
+	ret, specificReturn := fake.listAuditEventsReturnsOnCall[len(fake.listAuditEventsArgsForCall)]
+	fake.listAuditEventsArgsForCall = append(fake.listAuditEventsArgsForCall, struct {
+		arg1 concourse.AuditEventFilter
+	}{arg1})
+	stub := fake.ListAuditEventsStub
+	fakeReturns := fake.listAuditEventsReturns
+	fake.recordInvocation("ListAuditEvents", []interface{}{arg1})
+	fake.listAuditEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListAuditEventsCallCount() int {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	return len(fake.listAuditEventsArgsForCall)
+}
+
+func (fake *FakeClient) ListAuditEventsCalls(stub func(concourse.AuditEventFilter) ([]atc.AuditEvent, error)) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = stub
+}
+
+func (fake *FakeClient) ListAuditEventsArgsForCall(i int) concourse.AuditEventFilter {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	argsForCall := fake.listAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListAuditEventsReturns(result1 []atc.AuditEvent, result2 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	fake.listAuditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListAuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	if fake.listAuditEventsReturnsOnCall == nil {
+		fake.listAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 error
+		})
+	}
+	fake.listAuditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListBuildArtifacts(arg1 string) ([]atc.WorkerArtifact, error) {
 	fake.listBuildArtifactsMutex.Lock()
 	ret, specificReturn := fake.listBuildArtifactsReturnsOnCall[len(fake.listBuildArtifactsArgsForCall)]
@@ -1173,6 +1344,62 @@ func (fake *FakeClient) ListBuildArtifactsReturnsOnCall(i int, result1 []atc.Wor
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListBuildQueue() ([]atc.QueuedBuild, error) {
+	fake.listBuildQueueMutex.Lock()
+	ret, specificReturn := fake.listBuildQueueReturnsOnCall[len(fake.listBuildQueueArgsForCall)]
+	fake.listBuildQueueArgsForCall = append(fake.listBuildQueueArgsForCall, struct {
+	}{})
+	stub := fake.ListBuildQueueStub
+	fakeReturns := fake.listBuildQueueReturns
+	fake.recordInvocation("ListBuildQueue", []interface{}{})
+	fake.listBuildQueueMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListBuildQueueCallCount() int {
+	fake.listBuildQueueMutex.RLock()
+	defer fake.listBuildQueueMutex.RUnlock()
+	return len(fake.listBuildQueueArgsForCall)
+}
+
+func (fake *FakeClient) ListBuildQueueCalls(stub func() ([]atc.QueuedBuild, error)) {
+	fake.listBuildQueueMutex.Lock()
+	defer fake.listBuildQueueMutex.Unlock()
+	fake.ListBuildQueueStub = stub
+}
+
+func (fake *FakeClient) ListBuildQueueReturns(result1 []atc.QueuedBuild, result2 error) {
+	fake.listBuildQueueMutex.Lock()
+	defer fake.listBuildQueueMutex.Unlock()
+	fake.ListBuildQueueStub = nil
+	fake.listBuildQueueReturns = struct {
+		result1 []atc.QueuedBuild
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListBuildQueueReturnsOnCall(i int, result1 []atc.QueuedBuild, result2 error) {
+	fake.listBuildQueueMutex.Lock()
+	defer fake.listBuildQueueMutex.Unlock()
+	fake.ListBuildQueueStub = nil
+	if fake.listBuildQueueReturnsOnCall == nil {
+		fake.listBuildQueueReturnsOnCall = make(map[int]struct {
+			result1 []atc.QueuedBuild
+			result2 error
+		})
+	}
+	fake.listBuildQueueReturnsOnCall[i] = struct {
+		result1 []atc.QueuedBuild
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListPipelines() ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
@@ -1648,6 +1875,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildEventsMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
@@ -1666,8 +1895,12 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listActiveUsersSinceMutex.RUnlock()
 	fake.listAllJobsMutex.RLock()
 	defer fake.listAllJobsMutex.RUnlock()
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
 	fake.listBuildArtifactsMutex.RLock()
 	defer fake.listBuildArtifactsMutex.RUnlock()
+	fake.listBuildQueueMutex.RLock()
+	defer fake.listBuildQueueMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
 	fake.listTeamsMutex.RLock()
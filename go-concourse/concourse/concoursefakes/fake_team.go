@@ -239,6 +239,27 @@ type FakeTeam struct {
 		result3 []concourse.ConfigWarning
 		result4 error
 	}
+	CreateOrUpdatePipelineConfigWithMessageStub        func(atc.PipelineRef, string, []byte, bool, string) (bool, bool, []concourse.ConfigWarning, error)
+	createOrUpdatePipelineConfigWithMessageMutex       sync.RWMutex
+	createOrUpdatePipelineConfigWithMessageArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 []byte
+		arg4 bool
+		arg5 string
+	}
+	createOrUpdatePipelineConfigWithMessageReturns struct {
+		result1 bool
+		result2 bool
+		result3 []concourse.ConfigWarning
+		result4 error
+	}
+	createOrUpdatePipelineConfigWithMessageReturnsOnCall map[int]struct {
+		result1 bool
+		result2 bool
+		result3 []concourse.ConfigWarning
+		result4 error
+	}
 	CreatePipelineBuildStub        func(atc.PipelineRef, atc.Plan) (atc.Build, error)
 	createPipelineBuildMutex       sync.RWMutex
 	createPipelineBuildArgsForCall []struct {
@@ -253,6 +274,35 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	CreateServiceAccountStub        func(string) (bool, error)
+	createServiceAccountMutex       sync.RWMutex
+	createServiceAccountArgsForCall []struct {
+		arg1 string
+	}
+	createServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	createServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateServiceAccountTokenStub        func(string, atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error)
+	createServiceAccountTokenMutex       sync.RWMutex
+	createServiceAccountTokenArgsForCall []struct {
+		arg1 string
+		arg2 atc.ServiceAccountToken
+	}
+	createServiceAccountTokenReturns struct {
+		result1 atc.ServiceAccountToken
+		result2 bool
+		result3 error
+	}
+	createServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 atc.ServiceAccountToken
+		result2 bool
+		result3 error
+	}
 	DeletePipelineStub        func(atc.PipelineRef) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
@@ -266,6 +316,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	DeleteServiceAccountStub        func(string) (bool, error)
+	deleteServiceAccountMutex       sync.RWMutex
+	deleteServiceAccountArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DestroyTeamStub        func(string) error
 	destroyTeamMutex       sync.RWMutex
 	destroyTeamArgsForCall []struct {
@@ -472,6 +535,18 @@ type FakeTeam struct {
 		result1 []atc.Resource
 		result2 error
 	}
+	ListServiceAccountsStub        func() ([]atc.ServiceAccount, error)
+	listServiceAccountsMutex       sync.RWMutex
+	listServiceAccountsArgsForCall []struct {
+	}
+	listServiceAccountsReturns struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}
+	listServiceAccountsReturnsOnCall map[int]struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}
 	ListVolumesStub        func() ([]atc.Volume, error)
 	listVolumesMutex       sync.RWMutex
 	listVolumesArgsForCall []struct {
@@ -597,6 +672,53 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineConfigHistoryStub        func(atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error)
+	pipelineConfigHistoryMutex       sync.RWMutex
+	pipelineConfigHistoryArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	pipelineConfigHistoryReturns struct {
+		result1 []atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	pipelineConfigHistoryReturnsOnCall map[int]struct {
+		result1 []atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	PipelineConfigRevisionStub        func(atc.PipelineRef, int) (atc.PipelineConfigRevision, bool, error)
+	pipelineConfigRevisionMutex       sync.RWMutex
+	pipelineConfigRevisionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	pipelineConfigRevisionReturns struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	pipelineConfigRevisionReturnsOnCall map[int]struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	PipelineNotificationsStub        func(atc.PipelineRef, int) ([]atc.NotificationDelivery, bool, error)
+	pipelineNotificationsMutex       sync.RWMutex
+	pipelineNotificationsArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	pipelineNotificationsReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	pipelineNotificationsReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
 	RenamePipelineStub        func(string, string) (bool, []concourse.ConfigWarning, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -680,6 +802,20 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	RevokeServiceAccountTokenStub        func(string, string) (bool, error)
+	revokeServiceAccountTokenMutex       sync.RWMutex
+	revokeServiceAccountTokenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	revokeServiceAccountTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ScheduleJobStub        func(atc.PipelineRef, string) (bool, error)
 	scheduleJobMutex       sync.RWMutex
 	scheduleJobArgsForCall []struct {
@@ -694,6 +830,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SearchBuildLogsStub        func(concourse.BuildLogSearch) ([]atc.BuildLogMatch, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 concourse.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
 	SetPinCommentStub        func(atc.PipelineRef, string, string) (bool, error)
 	setPinCommentMutex       sync.RWMutex
 	setPinCommentArgsForCall []struct {
@@ -1720,6 +1869,8 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 atc.PipelineRef, arg2 st
 func (fake *FakeTeam) CreateOrUpdatePipelineConfigCallCount() int {
 	fake.createOrUpdatePipelineConfigMutex.RLock()
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
+	fake.createOrUpdatePipelineConfigWithMessageMutex.RLock()
+	defer fake.createOrUpdatePipelineConfigWithMessageMutex.RUnlock()
 	return len(fake.createOrUpdatePipelineConfigArgsForCall)
 }
 
@@ -1732,6 +1883,8 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfigCalls(stub func(atc.PipelineRe
 func (fake *FakeTeam) CreateOrUpdatePipelineConfigArgsForCall(i int) (atc.PipelineRef, string, []byte, bool) {
 	fake.createOrUpdatePipelineConfigMutex.RLock()
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
+	fake.createOrUpdatePipelineConfigWithMessageMutex.RLock()
+	defer fake.createOrUpdatePipelineConfigWithMessageMutex.RUnlock()
 	argsForCall := fake.createOrUpdatePipelineConfigArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
@@ -1768,6 +1921,85 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfigReturnsOnCall(i int, result1 b
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigWithMessage(arg1 atc.PipelineRef, arg2 string, arg3 []byte, arg4 bool, arg5 string) (bool, bool, []concourse.ConfigWarning, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.createOrUpdatePipelineConfigWithMessageMutex.Lock()
+	ret, specificReturn := fake.createOrUpdatePipelineConfigWithMessageReturnsOnCall[len(fake.createOrUpdatePipelineConfigWithMessageArgsForCall)]
+	fake.createOrUpdatePipelineConfigWithMessageArgsForCall = append(fake.createOrUpdatePipelineConfigWithMessageArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 []byte
+		arg4 bool
+		arg5 string
+	}{arg1, arg2, arg3Copy, arg4, arg5})
+	stub := fake.CreateOrUpdatePipelineConfigWithMessageStub
+	fakeReturns := fake.createOrUpdatePipelineConfigWithMessageReturns
+	fake.recordInvocation("CreateOrUpdatePipelineConfigWithMessage", []interface{}{arg1, arg2, arg3Copy, arg4, arg5})
+	fake.createOrUpdatePipelineConfigWithMessageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigWithMessageCallCount() int {
+	fake.createOrUpdatePipelineConfigWithMessageMutex.RLock()
+	defer fake.createOrUpdatePipelineConfigWithMessageMutex.RUnlock()
+	return len(fake.createOrUpdatePipelineConfigWithMessageArgsForCall)
+}
+
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigWithMessageCalls(stub func(atc.PipelineRef, string, []byte, bool, string) (bool, bool, []concourse.ConfigWarning, error)) {
+	fake.createOrUpdatePipelineConfigWithMessageMutex.Lock()
+	defer fake.createOrUpdatePipelineConfigWithMessageMutex.Unlock()
+	fake.CreateOrUpdatePipelineConfigWithMessageStub = stub
+}
+
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigWithMessageArgsForCall(i int) (atc.PipelineRef, string, []byte, bool, string) {
+	fake.createOrUpdatePipelineConfigWithMessageMutex.RLock()
+	defer fake.createOrUpdatePipelineConfigWithMessageMutex.RUnlock()
+	argsForCall := fake.createOrUpdatePipelineConfigWithMessageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigWithMessageReturns(result1 bool, result2 bool, result3 []concourse.ConfigWarning, result4 error) {
+	fake.createOrUpdatePipelineConfigWithMessageMutex.Lock()
+	defer fake.createOrUpdatePipelineConfigWithMessageMutex.Unlock()
+	fake.CreateOrUpdatePipelineConfigWithMessageStub = nil
+	fake.createOrUpdatePipelineConfigWithMessageReturns = struct {
+		result1 bool
+		result2 bool
+		result3 []concourse.ConfigWarning
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigWithMessageReturnsOnCall(i int, result1 bool, result2 bool, result3 []concourse.ConfigWarning, result4 error) {
+	fake.createOrUpdatePipelineConfigWithMessageMutex.Lock()
+	defer fake.createOrUpdatePipelineConfigWithMessageMutex.Unlock()
+	fake.CreateOrUpdatePipelineConfigWithMessageStub = nil
+	if fake.createOrUpdatePipelineConfigWithMessageReturnsOnCall == nil {
+		fake.createOrUpdatePipelineConfigWithMessageReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 bool
+			result3 []concourse.ConfigWarning
+			result4 error
+		})
+	}
+	fake.createOrUpdatePipelineConfigWithMessageReturnsOnCall[i] = struct {
+		result1 bool
+		result2 bool
+		result3 []concourse.ConfigWarning
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeTeam) CreatePipelineBuild(arg1 atc.PipelineRef, arg2 atc.Plan) (atc.Build, error) {
 	fake.createPipelineBuildMutex.Lock()
 	ret, specificReturn := fake.createPipelineBuildReturnsOnCall[len(fake.createPipelineBuildArgsForCall)]
@@ -1833,6 +2065,138 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateServiceAccount(arg1 string) (bool, error) {
+	fake.createServiceAccountMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountReturnsOnCall[len(fake.createServiceAccountArgsForCall)]
+	fake.createServiceAccountArgsForCall = append(fake.createServiceAccountArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CreateServiceAccountStub
+	fakeReturns := fake.createServiceAccountReturns
+	fake.recordInvocation("CreateServiceAccount", []interface{}{arg1})
+	fake.createServiceAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateServiceAccountCallCount() int {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	return len(fake.createServiceAccountArgsForCall)
+}
+
+func (fake *FakeTeam) CreateServiceAccountCalls(stub func(string) (bool, error)) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = stub
+}
+
+func (fake *FakeTeam) CreateServiceAccountArgsForCall(i int) string {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	argsForCall := fake.createServiceAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateServiceAccountReturns(result1 bool, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	fake.createServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	if fake.createServiceAccountReturnsOnCall == nil {
+		fake.createServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.createServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountToken(arg1 string, arg2 atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountTokenReturnsOnCall[len(fake.createServiceAccountTokenArgsForCall)]
+	fake.createServiceAccountTokenArgsForCall = append(fake.createServiceAccountTokenArgsForCall, struct {
+		arg1 string
+		arg2 atc.ServiceAccountToken
+	}{arg1, arg2})
+	stub := fake.CreateServiceAccountTokenStub
+	fakeReturns := fake.createServiceAccountTokenReturns
+	fake.recordInvocation("CreateServiceAccountToken", []interface{}{arg1, arg2})
+	fake.createServiceAccountTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenCallCount() int {
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
+	return len(fake.createServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenCalls(stub func(string, atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error)) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = stub
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenArgsForCall(i int) (string, atc.ServiceAccountToken) {
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.createServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenReturns(result1 atc.ServiceAccountToken, result2 bool, result3 error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = nil
+	fake.createServiceAccountTokenReturns = struct {
+		result1 atc.ServiceAccountToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenReturnsOnCall(i int, result1 atc.ServiceAccountToken, result2 bool, result3 error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = nil
+	if fake.createServiceAccountTokenReturnsOnCall == nil {
+		fake.createServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 atc.ServiceAccountToken
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 atc.ServiceAccountToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) DeletePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
@@ -1897,6 +2261,70 @@ func (fake *FakeTeam) DeletePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DeleteServiceAccount(arg1 string) (bool, error) {
+	fake.deleteServiceAccountMutex.Lock()
+	ret, specificReturn := fake.deleteServiceAccountReturnsOnCall[len(fake.deleteServiceAccountArgsForCall)]
+	fake.deleteServiceAccountArgsForCall = append(fake.deleteServiceAccountArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteServiceAccountStub
+	fakeReturns := fake.deleteServiceAccountReturns
+	fake.recordInvocation("DeleteServiceAccount", []interface{}{arg1})
+	fake.deleteServiceAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteServiceAccountCallCount() int {
+	fake.deleteServiceAccountMutex.RLock()
+	defer fake.deleteServiceAccountMutex.RUnlock()
+	return len(fake.deleteServiceAccountArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteServiceAccountCalls(stub func(string) (bool, error)) {
+	fake.deleteServiceAccountMutex.Lock()
+	defer fake.deleteServiceAccountMutex.Unlock()
+	fake.DeleteServiceAccountStub = stub
+}
+
+func (fake *FakeTeam) DeleteServiceAccountArgsForCall(i int) string {
+	fake.deleteServiceAccountMutex.RLock()
+	defer fake.deleteServiceAccountMutex.RUnlock()
+	argsForCall := fake.deleteServiceAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteServiceAccountReturns(result1 bool, result2 error) {
+	fake.deleteServiceAccountMutex.Lock()
+	defer fake.deleteServiceAccountMutex.Unlock()
+	fake.DeleteServiceAccountStub = nil
+	fake.deleteServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteServiceAccountMutex.Lock()
+	defer fake.deleteServiceAccountMutex.Unlock()
+	fake.DeleteServiceAccountStub = nil
+	if fake.deleteServiceAccountReturnsOnCall == nil {
+		fake.deleteServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DestroyTeam(arg1 string) error {
 	fake.destroyTeamMutex.Lock()
 	ret, specificReturn := fake.destroyTeamReturnsOnCall[len(fake.destroyTeamArgsForCall)]
@@ -2856,6 +3284,62 @@ func (fake *FakeTeam) ListResourcesReturnsOnCall(i int, result1 []atc.Resource,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListServiceAccounts() ([]atc.ServiceAccount, error) {
+	fake.listServiceAccountsMutex.Lock()
+	ret, specificReturn := fake.listServiceAccountsReturnsOnCall[len(fake.listServiceAccountsArgsForCall)]
+	fake.listServiceAccountsArgsForCall = append(fake.listServiceAccountsArgsForCall, struct {
+	}{})
+	stub := fake.ListServiceAccountsStub
+	fakeReturns := fake.listServiceAccountsReturns
+	fake.recordInvocation("ListServiceAccounts", []interface{}{})
+	fake.listServiceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListServiceAccountsCallCount() int {
+	fake.listServiceAccountsMutex.RLock()
+	defer fake.listServiceAccountsMutex.RUnlock()
+	return len(fake.listServiceAccountsArgsForCall)
+}
+
+func (fake *FakeTeam) ListServiceAccountsCalls(stub func() ([]atc.ServiceAccount, error)) {
+	fake.listServiceAccountsMutex.Lock()
+	defer fake.listServiceAccountsMutex.Unlock()
+	fake.ListServiceAccountsStub = stub
+}
+
+func (fake *FakeTeam) ListServiceAccountsReturns(result1 []atc.ServiceAccount, result2 error) {
+	fake.listServiceAccountsMutex.Lock()
+	defer fake.listServiceAccountsMutex.Unlock()
+	fake.ListServiceAccountsStub = nil
+	fake.listServiceAccountsReturns = struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListServiceAccountsReturnsOnCall(i int, result1 []atc.ServiceAccount, result2 error) {
+	fake.listServiceAccountsMutex.Lock()
+	defer fake.listServiceAccountsMutex.Unlock()
+	fake.ListServiceAccountsStub = nil
+	if fake.listServiceAccountsReturnsOnCall == nil {
+		fake.listServiceAccountsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ServiceAccount
+			result2 error
+		})
+	}
+	fake.listServiceAccountsReturnsOnCall[i] = struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListVolumes() ([]atc.Volume, error) {
 	fake.listVolumesMutex.Lock()
 	ret, specificReturn := fake.listVolumesReturnsOnCall[len(fake.listVolumesArgsForCall)]
@@ -3434,6 +3918,209 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineConfigHistory(arg1 atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error) {
+	fake.pipelineConfigHistoryMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigHistoryReturnsOnCall[len(fake.pipelineConfigHistoryArgsForCall)]
+	fake.pipelineConfigHistoryArgsForCall = append(fake.pipelineConfigHistoryArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	stub := fake.PipelineConfigHistoryStub
+	fakeReturns := fake.pipelineConfigHistoryReturns
+	fake.recordInvocation("PipelineConfigHistory", []interface{}{arg1})
+	fake.pipelineConfigHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryCallCount() int {
+	fake.pipelineConfigHistoryMutex.RLock()
+	defer fake.pipelineConfigHistoryMutex.RUnlock()
+	return len(fake.pipelineConfigHistoryArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryCalls(stub func(atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error)) {
+	fake.pipelineConfigHistoryMutex.Lock()
+	defer fake.pipelineConfigHistoryMutex.Unlock()
+	fake.PipelineConfigHistoryStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryArgsForCall(i int) atc.PipelineRef {
+	fake.pipelineConfigHistoryMutex.RLock()
+	defer fake.pipelineConfigHistoryMutex.RUnlock()
+	argsForCall := fake.pipelineConfigHistoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryReturns(result1 []atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.pipelineConfigHistoryMutex.Lock()
+	defer fake.pipelineConfigHistoryMutex.Unlock()
+	fake.PipelineConfigHistoryStub = nil
+	fake.pipelineConfigHistoryReturns = struct {
+		result1 []atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryReturnsOnCall(i int, result1 []atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.pipelineConfigHistoryMutex.Lock()
+	defer fake.pipelineConfigHistoryMutex.Unlock()
+	fake.PipelineConfigHistoryStub = nil
+	if fake.pipelineConfigHistoryReturnsOnCall == nil {
+		fake.pipelineConfigHistoryReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineConfigRevision
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigHistoryReturnsOnCall[i] = struct {
+		result1 []atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigRevision(arg1 atc.PipelineRef, arg2 int) (atc.PipelineConfigRevision, bool, error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigRevisionReturnsOnCall[len(fake.pipelineConfigRevisionArgsForCall)]
+	fake.pipelineConfigRevisionArgsForCall = append(fake.pipelineConfigRevisionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.PipelineConfigRevisionStub
+	fakeReturns := fake.pipelineConfigRevisionReturns
+	fake.recordInvocation("PipelineConfigRevision", []interface{}{arg1, arg2})
+	fake.pipelineConfigRevisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionCallCount() int {
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	return len(fake.pipelineConfigRevisionArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionCalls(stub func(atc.PipelineRef, int) (atc.PipelineConfigRevision, bool, error)) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	argsForCall := fake.pipelineConfigRevisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionReturns(result1 atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = nil
+	fake.pipelineConfigRevisionReturns = struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionReturnsOnCall(i int, result1 atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = nil
+	if fake.pipelineConfigRevisionReturnsOnCall == nil {
+		fake.pipelineConfigRevisionReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineConfigRevision
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigRevisionReturnsOnCall[i] = struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineNotifications(arg1 atc.PipelineRef, arg2 int) ([]atc.NotificationDelivery, bool, error) {
+	fake.pipelineNotificationsMutex.Lock()
+	ret, specificReturn := fake.pipelineNotificationsReturnsOnCall[len(fake.pipelineNotificationsArgsForCall)]
+	fake.pipelineNotificationsArgsForCall = append(fake.pipelineNotificationsArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.PipelineNotificationsStub
+	fakeReturns := fake.pipelineNotificationsReturns
+	fake.recordInvocation("PipelineNotifications", []interface{}{arg1, arg2})
+	fake.pipelineNotificationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineNotificationsCallCount() int {
+	fake.pipelineNotificationsMutex.RLock()
+	defer fake.pipelineNotificationsMutex.RUnlock()
+	return len(fake.pipelineNotificationsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineNotificationsCalls(stub func(atc.PipelineRef, int) ([]atc.NotificationDelivery, bool, error)) {
+	fake.pipelineNotificationsMutex.Lock()
+	defer fake.pipelineNotificationsMutex.Unlock()
+	fake.PipelineNotificationsStub = stub
+}
+
+func (fake *FakeTeam) PipelineNotificationsArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.pipelineNotificationsMutex.RLock()
+	defer fake.pipelineNotificationsMutex.RUnlock()
+	argsForCall := fake.pipelineNotificationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineNotificationsReturns(result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.pipelineNotificationsMutex.Lock()
+	defer fake.pipelineNotificationsMutex.Unlock()
+	fake.PipelineNotificationsStub = nil
+	fake.pipelineNotificationsReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineNotificationsReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.pipelineNotificationsMutex.Lock()
+	defer fake.pipelineNotificationsMutex.Unlock()
+	fake.PipelineNotificationsStub = nil
+	if fake.pipelineNotificationsReturnsOnCall == nil {
+		fake.pipelineNotificationsReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineNotificationsReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, []concourse.ConfigWarning, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -3777,6 +4464,71 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) RevokeServiceAccountToken(arg1 string, arg2 string) (bool, error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.revokeServiceAccountTokenReturnsOnCall[len(fake.revokeServiceAccountTokenArgsForCall)]
+	fake.revokeServiceAccountTokenArgsForCall = append(fake.revokeServiceAccountTokenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RevokeServiceAccountTokenStub
+	fakeReturns := fake.revokeServiceAccountTokenReturns
+	fake.recordInvocation("RevokeServiceAccountToken", []interface{}{arg1, arg2})
+	fake.revokeServiceAccountTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenCallCount() int {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	return len(fake.revokeServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenCalls(stub func(string, string) (bool, error)) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenArgsForCall(i int) (string, string) {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.revokeServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenReturns(result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	fake.revokeServiceAccountTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	if fake.revokeServiceAccountTokenReturnsOnCall == nil {
+		fake.revokeServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ScheduleJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.scheduleJobMutex.Lock()
 	ret, specificReturn := fake.scheduleJobReturnsOnCall[len(fake.scheduleJobArgsForCall)]
@@ -3842,6 +4594,70 @@ func (fake *FakeTeam) ScheduleJobReturnsOnCall(i int, result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(arg1 concourse.BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 concourse.BuildLogSearch
+	}{arg1})
+	stub := fake.SearchBuildLogsStub
+	fakeReturns := fake.searchBuildLogsReturns
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsCalls(stub func(concourse.BuildLogSearch) ([]atc.BuildLogMatch, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) concourse.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildLogMatch
+			result2 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) SetPinComment(arg1 atc.PipelineRef, arg2 string, arg3 string) (bool, error) {
 	fake.setPinCommentMutex.Lock()
 	ret, specificReturn := fake.setPinCommentReturnsOnCall[len(fake.setPinCommentArgsForCall)]
@@ -4202,10 +5018,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOrUpdateMutex.RUnlock()
 	fake.createOrUpdatePipelineConfigMutex.RLock()
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
+	fake.createOrUpdatePipelineConfigWithMessageMutex.RLock()
+	defer fake.createOrUpdatePipelineConfigWithMessageMutex.RUnlock()
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
+	fake.deleteServiceAccountMutex.RLock()
+	defer fake.deleteServiceAccountMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
@@ -4236,6 +5060,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listPipelinesMutex.RUnlock()
 	fake.listResourcesMutex.RLock()
 	defer fake.listResourcesMutex.RUnlock()
+	fake.listServiceAccountsMutex.RLock()
+	defer fake.listServiceAccountsMutex.RUnlock()
 	fake.listVolumesMutex.RLock()
 	defer fake.listVolumesMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -4254,6 +5080,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineConfigHistoryMutex.RLock()
+	defer fake.pipelineConfigHistoryMutex.RUnlock()
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	fake.pipelineNotificationsMutex.RLock()
+	defer fake.pipelineNotificationsMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -4264,8 +5096,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
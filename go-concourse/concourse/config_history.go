@@ -0,0 +1,60 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) PipelineConfigHistory(pipelineRef atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	var revisions []atc.PipelineConfigRevision
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListPipelineConfigHistory,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &revisions,
+	})
+
+	switch err.(type) {
+	case nil:
+		return revisions, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) PipelineConfigRevision(pipelineRef atc.PipelineRef, revision int) (atc.PipelineConfigRevision, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+		"revision":      strconv.Itoa(revision),
+	}
+
+	var configRevision atc.PipelineConfigRevision
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetPipelineConfigRevision,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &configRevision,
+	})
+
+	switch err.(type) {
+	case nil:
+		return configRevision, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.PipelineConfigRevision{}, false, nil
+	default:
+		return atc.PipelineConfigRevision{}, false, err
+	}
+}
@@ -0,0 +1,111 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Config History", func() {
+	var pipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+	Describe("team.PipelineConfigHistory", func() {
+		var expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/config/history"
+
+		Context("when the pipeline exists", func() {
+			var expectedRevisions []atc.PipelineConfigRevision
+
+			BeforeEach(func() {
+				expectedRevisions = []atc.PipelineConfigRevision{
+					{Revision: 2, Author: "some-user", Message: "add a job", SavedAt: 100},
+					{Revision: 1, SavedAt: 1},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedRevisions),
+					),
+				)
+			})
+
+			It("returns the pipeline's config revisions", func() {
+				revisions, found, err := team.PipelineConfigHistory(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(revisions).To(Equal(expectedRevisions))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineConfigHistory(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("team.PipelineConfigRevision", func() {
+		var expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/config/history/3"
+
+		Context("when the revision exists", func() {
+			var expectedRevision atc.PipelineConfigRevision
+
+			BeforeEach(func() {
+				expectedRevision = atc.PipelineConfigRevision{
+					Revision: 3,
+					Author:   "some-user",
+					SavedAt:  100,
+					Config: &atc.Config{
+						Jobs: atc.JobConfigs{{Name: "some-job"}},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedRevision),
+					),
+				)
+			})
+
+			It("returns the revision with its config", func() {
+				revision, found, err := team.PipelineConfigRevision(pipelineRef, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(revision).To(Equal(expectedRevision))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineConfigRevision(pipelineRef, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -44,6 +44,10 @@ func (team *team) PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, strin
 	}
 }
 
+// PolicyWarningType is the type of warnings about policies which the config
+// violates, but which are not enforced.
+const PolicyWarningType = "policy"
+
 type ConfigWarning struct {
 	Type    string `json:"type"`
 	Message string `json:"message"`
@@ -55,6 +59,13 @@ type setConfigResponse struct {
 }
 
 func (team *team) CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error) {
+	return team.CreateOrUpdatePipelineConfigWithMessage(pipelineRef, configVersion, passedConfig, checkCredentials, "")
+}
+
+// CreateOrUpdatePipelineConfigWithMessage saves the pipeline config like
+// CreateOrUpdatePipelineConfig, recording the message alongside the new
+// revision in the pipeline's config history.
+func (team *team) CreateOrUpdatePipelineConfigWithMessage(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool, message string) (bool, bool, []ConfigWarning, error) {
 	params := rata.Params{
 		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.Name(),
@@ -64,6 +75,9 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, conf
 	if checkCredentials {
 		queryParams.Add(atc.SaveConfigCheckCreds, "")
 	}
+	if message != "" {
+		queryParams.Set(atc.SaveConfigMessage, message)
+	}
 
 	response, err := team.httpAgent.Send(internal.Request{
 		ReturnResponseBody: true,
@@ -90,6 +104,12 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, conf
 		if err != nil {
 			return false, false, []ConfigWarning{}, err
 		}
+		for _, reason := range response.Header.Values(atc.PolicyCheckWarningHeader) {
+			configResponse.Warnings = append(configResponse.Warnings, ConfigWarning{
+				Type:    PolicyWarningType,
+				Message: reason,
+			})
+		}
 		created := response.StatusCode == http.StatusCreated
 		return created, !created, configResponse.Warnings, nil
 	case http.StatusBadRequest:
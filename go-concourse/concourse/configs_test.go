@@ -175,8 +175,9 @@ var _ = Describe("ATC Handler Configs", func() {
 			expectedVersion string
 			expectedConfig  []byte
 
-			returnHeader int
-			returnBody   []byte
+			returnHeader   int
+			returnBody     []byte
+			returnWarnings []string
 
 			checkCredentials bool
 		)
@@ -188,6 +189,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			expectedPath := "/api/v1/teams/some-team/pipelines/mypipeline/config"
 
 			checkCredentials = false
+			returnWarnings = nil
 
 			atcServer.RouteToHandler("PUT", expectedPath,
 				ghttp.CombineHandlers(
@@ -204,6 +206,10 @@ var _ = Describe("ATC Handler Configs", func() {
 
 						Expect(receivedConfig).To(Equal(expectedConfig))
 
+						for _, warning := range returnWarnings {
+							w.Header().Add(atc.PolicyCheckWarningHeader, warning)
+						}
+
 						w.WriteHeader(returnHeader)
 						w.Write(returnBody)
 					},
@@ -282,6 +288,16 @@ var _ = Describe("ATC Handler Configs", func() {
 					Expect(atcServer.ReceivedRequests()[0].URL.RawQuery).To(Equal("check_creds="))
 				})
 			})
+
+			Context("when a message is given", func() {
+				It("submits it as the message query param", func() {
+					_, _, _, err := team.CreateOrUpdatePipelineConfigWithMessage(pipelineRef, expectedVersion, expectedConfig, checkCredentials, "add a job")
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+					Expect(atcServer.ReceivedRequests()[0].URL.RawQuery).To(Equal("message=add+a+job"))
+				})
+			})
 		})
 
 		Context("when updating a config", func() {
@@ -357,6 +373,24 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 		})
 
+		Context("when the config violates policies in warn mode", func() {
+			BeforeEach(func() {
+				returnHeader = http.StatusOK
+				returnBody = []byte(`{"warnings":[]}`)
+				returnWarnings = []string{"no privileged tasks", "no public jobs"}
+			})
+
+			It("returns the violations as warnings", func() {
+				_, updated, warnings, err := team.CreateOrUpdatePipelineConfig(pipelineRef, expectedVersion, expectedConfig, checkCredentials)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated).To(BeTrue())
+				Expect(warnings).To(Equal([]concourse.ConfigWarning{
+					{Type: "policy", Message: "no privileged tasks"},
+					{Type: "policy", Message: "no public jobs"},
+				}))
+			})
+		})
+
 		Context("when setting config returns bad request", func() {
 			BeforeEach(func() {
 				returnHeader = http.StatusBadRequest
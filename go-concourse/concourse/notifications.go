@@ -0,0 +1,40 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) PipelineNotifications(pipelineRef atc.PipelineRef, limit int) ([]atc.NotificationDelivery, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	queryParams := url.Values{}
+	if limit > 0 {
+		queryParams.Set("limit", strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.NotificationDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListPipelineNotifications,
+		Params:      params,
+		Query:       merge(queryParams, pipelineRef.QueryParams()),
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+
+	switch err.(type) {
+	case nil:
+		return deliveries, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
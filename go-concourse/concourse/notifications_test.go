@@ -0,0 +1,75 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Notifications", func() {
+	Describe("team.PipelineNotifications", func() {
+		var (
+			expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/notifications"
+			pipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+		)
+
+		Context("when the pipeline exists", func() {
+			var expectedDeliveries []atc.NotificationDelivery
+
+			BeforeEach(func() {
+				expectedDeliveries = []atc.NotificationDelivery{
+					{
+						ID:       2,
+						JobName:  "some-job",
+						Sink:     "some-sink",
+						Status:   atc.NotificationDeliverySucceeded,
+						Attempts: 1,
+					},
+					{
+						ID:        1,
+						JobName:   "some-job",
+						Sink:      "other-sink",
+						Status:    atc.NotificationDeliveryFailed,
+						Attempts:  5,
+						LastError: "nope",
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=10&vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+					),
+				)
+			})
+
+			It("returns the pipeline's notification deliveries", func() {
+				deliveries, found, err := team.PipelineNotifications(pipelineRef, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(deliveries).To(Equal(expectedDeliveries))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineNotifications(pipelineRef, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
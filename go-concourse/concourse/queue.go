@@ -0,0 +1,16 @@
+package concourse
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+func (client *client) ListBuildQueue() ([]atc.QueuedBuild, error) {
+	var queue []atc.QueuedBuild
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListBuildQueue,
+	}, &internal.Response{
+		Result: &queue,
+	})
+	return queue, err
+}
@@ -0,0 +1,57 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Queue", func() {
+	Describe("ListBuildQueue", func() {
+		var expectedQueue []atc.QueuedBuild
+
+		BeforeEach(func() {
+			expectedURL := "/api/v1/queue"
+
+			expectedQueue = []atc.QueuedBuild{
+				{
+					ID:           2,
+					Name:         "1",
+					Status:       atc.StatusPending,
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					Priority:     atc.JobPriorityHigh,
+					Reason:       atc.QueueReasonMaxInFlight,
+				},
+				{
+					ID:           1,
+					Name:         "3",
+					Status:       atc.StatusStarted,
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					JobName:      "some-other-job",
+					Priority:     atc.JobPriorityLow,
+					Reason:       atc.QueueReasonNoWorker,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedQueue),
+				),
+			)
+		})
+
+		It("returns the queued builds", func() {
+			queue, err := client.ListBuildQueue()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(queue).To(Equal(expectedQueue))
+		})
+	})
+})
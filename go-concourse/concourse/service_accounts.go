@@ -0,0 +1,111 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListServiceAccounts() ([]atc.ServiceAccount, error) {
+	var accounts []atc.ServiceAccount
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListServiceAccounts,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &accounts,
+	})
+
+	return accounts, err
+}
+
+// CreateServiceAccount creates the named service account, returning whether
+// it was newly created.
+func (team *team) CreateServiceAccount(name string) (bool, error) {
+	response := internal.Response{}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.CreateServiceAccount,
+		Params: rata.Params{
+			"team_name":            team.Name(),
+			"service_account_name": name,
+		},
+	}, &response)
+	if err != nil {
+		return false, err
+	}
+
+	return response.Created, nil
+}
+
+func (team *team) DeleteServiceAccount(name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DeleteServiceAccount,
+		Params: rata.Params{
+			"team_name":            team.Name(),
+			"service_account_name": name,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// CreateServiceAccountToken creates a token for the named service account.
+// The returned token is the only time its secret value is made available.
+func (team *team) CreateServiceAccountToken(accountName string, token atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error) {
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return atc.ServiceAccountToken{}, false, err
+	}
+
+	var created atc.ServiceAccountToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateServiceAccountToken,
+		Params: rata.Params{
+			"team_name":            team.Name(),
+			"service_account_name": accountName,
+		},
+		Body:   bytes.NewBuffer(payload),
+		Header: http.Header{"Content-Type": {"application/json"}},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	switch err.(type) {
+	case nil:
+		return created, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.ServiceAccountToken{}, false, nil
+	default:
+		return atc.ServiceAccountToken{}, false, err
+	}
+}
+
+func (team *team) RevokeServiceAccountToken(accountName string, tokenName string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RevokeServiceAccountToken,
+		Params: rata.Params{
+			"team_name":            team.Name(),
+			"service_account_name": accountName,
+			"token_name":           tokenName,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,212 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Service Accounts", func() {
+	Describe("team.ListServiceAccounts", func() {
+		var expectedAccounts []atc.ServiceAccount
+
+		BeforeEach(func() {
+			expectedAccounts = []atc.ServiceAccount{
+				{
+					Name:     "deployer",
+					TeamName: "some-team",
+					Tokens:   []atc.ServiceAccountToken{{Name: "ci", Role: "member"}},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/service-accounts"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedAccounts),
+				),
+			)
+		})
+
+		It("returns the team's service accounts", func() {
+			accounts, err := team.ListServiceAccounts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(accounts).To(Equal(expectedAccounts))
+		})
+	})
+
+	Describe("team.CreateServiceAccount", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/service-accounts/deployer"),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+		})
+
+		Context("when the account is created", func() {
+			BeforeEach(func() {
+				status = http.StatusCreated
+			})
+
+			It("returns true", func() {
+				created, err := team.CreateServiceAccount("deployer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+		})
+
+		Context("when the account already exists", func() {
+			BeforeEach(func() {
+				status = http.StatusOK
+			})
+
+			It("returns false", func() {
+				created, err := team.CreateServiceAccount("deployer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("team.DeleteServiceAccount", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/service-accounts/deployer"),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+		})
+
+		Context("when the account is deleted", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("returns true", func() {
+				found, err := team.DeleteServiceAccount("deployer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the account does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				found, err := team.DeleteServiceAccount("deployer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("team.CreateServiceAccountToken", func() {
+		var expectedURL = "/api/v1/teams/some-team/service-accounts/deployer/tokens"
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", expectedURL),
+						ghttp.VerifyJSONRepresenting(atc.ServiceAccountToken{Name: "ci", Role: "member", ExpiresAt: 1621032015}),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.ServiceAccountToken{
+							Name:      "ci",
+							Role:      "member",
+							ExpiresAt: 1621032015,
+							Token:     "csa_some-token",
+						}),
+					),
+				)
+			})
+
+			It("returns the created token", func() {
+				token, found, err := team.CreateServiceAccountToken("deployer", atc.ServiceAccountToken{Name: "ci", Role: "member", ExpiresAt: 1621032015})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(token.Token).To(Equal("csa_some-token"))
+			})
+		})
+
+		Context("when the account does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.CreateServiceAccountToken("deployer", atc.ServiceAccountToken{Name: "ci", Role: "member"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the token already exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", expectedURL),
+						ghttp.RespondWith(http.StatusConflict, "token 'ci' already exists"),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, _, err := team.CreateServiceAccountToken("deployer", atc.ServiceAccountToken{Name: "ci", Role: "member"})
+				Expect(err).To(MatchError(ContainSubstring("token 'ci' already exists")))
+			})
+		})
+	})
+
+	Describe("team.RevokeServiceAccountToken", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/service-accounts/deployer/tokens/ci"),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+		})
+
+		Context("when the token is revoked", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("returns true", func() {
+				found, err := team.RevokeServiceAccountToken("deployer", "ci")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				found, err := team.RevokeServiceAccountToken("deployer", "ci")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -31,6 +31,10 @@ type Team interface {
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	CreateOrUpdatePipelineConfigWithMessage(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool, message string) (bool, bool, []ConfigWarning, error)
+	PipelineNotifications(pipelineRef atc.PipelineRef, limit int) ([]atc.NotificationDelivery, bool, error)
+	PipelineConfigHistory(pipelineRef atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error)
+	PipelineConfigRevision(pipelineRef atc.PipelineRef, revision int) (atc.PipelineConfigRevision, bool, error)
 
 	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
 
@@ -70,8 +74,15 @@ type Team interface {
 	ListVolumes() ([]atc.Volume, error)
 	CreateBuild(plan atc.Plan) (atc.Build, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
+	SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error)
 	OrderingPipelines(pipelineNames []string) error
 
+	ListServiceAccounts() ([]atc.ServiceAccount, error)
+	CreateServiceAccount(name string) (bool, error)
+	DeleteServiceAccount(name string) (bool, error)
+	CreateServiceAccountToken(accountName string, token atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error)
+	RevokeServiceAccountToken(accountName string, tokenName string) (bool, error)
+
 	CreateArtifact(io.Reader, string, []string) (atc.WorkerArtifact, error)
 	GetArtifact(int) (io.ReadCloser, error)
 }
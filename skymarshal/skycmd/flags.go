@@ -150,6 +150,46 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 	return auth, nil
 }
 
+// CustomRoles returns the roles defined by the team in its configuration
+// file, i.e. those listing the actions they permit. Users and groups are
+// granted them through Format like any other role.
+func (flag *AuthTeamFlags) CustomRoles() (atc.CustomRoles, error) {
+	path := flag.Config.Path()
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var data struct {
+		Roles []struct {
+			Name    string   `json:"name"`
+			Actions []string `json:"actions"`
+		} `json:"roles"`
+	}
+	if err = yaml.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	var roles atc.CustomRoles
+	for _, role := range data.Roles {
+		if len(role.Actions) == 0 {
+			continue
+		}
+
+		if roles == nil {
+			roles = atc.CustomRoles{}
+		}
+
+		roles[role.Name] = role.Actions
+	}
+
+	return roles, nil
+}
+
 // When formatting team config from the command line flags, the connector's
 // TeamConfig has already been populated by the flags library. All we need to
 // do is grab the teamConfig object and extract the users and groups.
@@ -2,9 +2,12 @@ package skycmd_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/skymarshal/skycmd"
+	"github.com/concourse/flag"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -72,3 +75,73 @@ var _ = Describe("skyDisplayUserIdGenerator", func() {
 		})
 	})
 })
+
+var _ = Describe("AuthTeamFlags", func() {
+	Describe("CustomRoles", func() {
+		var (
+			authFlags skycmd.AuthTeamFlags
+			config    string
+		)
+
+		JustBeforeEach(func() {
+			file, err := ioutil.TempFile("", "team-config")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = file.WriteString(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+
+			authFlags = skycmd.AuthTeamFlags{Config: flag.File(file.Name())}
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(authFlags.Config.Path())).To(Succeed())
+		})
+
+		Context("when roles list actions", func() {
+			BeforeEach(func() {
+				config = `
+roles:
+- name: owner
+  local:
+    users: [admin]
+- name: releaser
+  actions: [CreateJobBuild, PinResourceVersion]
+  local:
+    users: [some-user]
+`
+			})
+
+			It("returns them as custom roles", func() {
+				roles, err := authFlags.CustomRoles()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(roles).To(Equal(atc.CustomRoles{
+					"releaser": {"CreateJobBuild", "PinResourceVersion"},
+				}))
+			})
+
+			It("still grants the custom roles to their users", func() {
+				auth, err := authFlags.Format()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(auth["releaser"]["users"]).To(ConsistOf("local:some-user"))
+			})
+		})
+
+		Context("when no roles list actions", func() {
+			BeforeEach(func() {
+				config = `
+roles:
+- name: owner
+  local:
+    users: [admin]
+`
+			})
+
+			It("returns no custom roles", func() {
+				roles, err := authFlags.CustomRoles()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(roles).To(BeNil())
+			})
+		})
+	})
+})
@@ -6,6 +6,11 @@ import (
 )
 
 //go:generate counterfeiter . Middleware
+
+// Middleware keeps the tokens of a browser session in cookies. Service account
+// tokens never go through it: non-browser clients send them in the
+// Authorization header, which the API verifies as-is, so there is no session
+// for a cookie to hold.
 type Middleware interface {
 	SetAuthToken(http.ResponseWriter, string, time.Time) error
 	UnsetAuthToken(http.ResponseWriter)
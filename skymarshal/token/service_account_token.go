@@ -0,0 +1,21 @@
+package token
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+// GenerateServiceAccountToken returns a new random service account token.
+// Unlike access tokens, it carries no expiry of its own; that is kept with
+// its hash in the database.
+func GenerateServiceAccountToken() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return db.ServiceAccountTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,20 @@
+package token_test
+
+import (
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Account Tokens", func() {
+	It("generates distinct tokens marked as service account tokens", func() {
+		first, err := token.GenerateServiceAccountToken()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).To(HavePrefix(db.ServiceAccountTokenPrefix))
+
+		second, err := token.GenerateServiceAccountToken()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).NotTo(Equal(first))
+	})
+})
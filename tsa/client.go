@@ -323,6 +323,27 @@ func (client *Client) ReportVolumes(ctx context.Context, handles []string) error
 	return client.run(ctx, sshClient, strings.Join(command, " "), os.Stdout)
 }
 
+// ReportVolumeSizes invokes the 'report-volume-sizes' command, sending the
+// disk usage in bytes of each of the worker's volumes to Concourse.
+func (client *Client) ReportVolumeSizes(ctx context.Context, sizes map[string]uint64) error {
+	logger := lagerctx.FromContext(ctx)
+
+	sshClient, _, err := client.dial(ctx, 0)
+	if err != nil {
+		logger.Error("failed-to-dial", err)
+		return err
+	}
+
+	defer sshClient.Close()
+
+	command := []string{"report-volume-sizes"}
+	for handle, size := range sizes {
+		command = append(command, fmt.Sprintf("%s=%d", handle, size))
+	}
+
+	return client.run(ctx, sshClient, strings.Join(command, " "), os.Stdout)
+}
+
 func (client *Client) dial(ctx context.Context, idleTimeout time.Duration) (*ssh.Client, *net.TCPConn, error) {
 	logger := lagerctx.WithSession(ctx, "dial")
 
@@ -9,5 +9,6 @@ const (
 
 	ReportContainers      = "report-containers"
 	ReportVolumes         = "report-volumes"
+	ReportVolumeSizes     = "report-volume-sizes"
 	ResourceActionMissing = "resource-type-missing"
 )
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -538,6 +539,26 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 			server:        server,
 			volumeHandles: args,
 		}
+	case tsa.ReportVolumeSizes:
+		sizes := map[string]uint64{}
+		for _, arg := range args {
+			segs := strings.SplitN(arg, "=", 2)
+			if len(segs) != 2 {
+				return nil, "", fmt.Errorf("invalid volume size: %s", arg)
+			}
+
+			size, err := strconv.ParseUint(segs[1], 10, 64)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid volume size: %s", arg)
+			}
+
+			sizes[segs[0]] = size
+		}
+
+		req = reportVolumeSizesRequest{
+			server:      server,
+			volumeSizes: sizes,
+		}
 	default:
 		return nil, "", fmt.Errorf("unknown command: %s", command)
 	}
@@ -23,6 +23,7 @@ type WorkerStatus struct {
 	HTTPClient       *http.Client
 	ContainerHandles []string
 	VolumeHandles    []string
+	VolumeSizes      map[string]uint64
 }
 
 func (l *WorkerStatus) WorkerStatus(ctx context.Context, worker atc.Worker, resourceAction string) error {
@@ -60,6 +61,19 @@ func (l *WorkerStatus) WorkerStatus(ctx context.Context, worker atc.Worker, reso
 
 		request, err = l.ATCEndpoint.CreateRequest(atc.ReportWorkerVolumes, nil, bytes.NewBuffer(handlesBytes))
 
+		if err != nil {
+			logger.Error("failed-to-construct-request", err)
+			return err
+		}
+	case ReportVolumeSizes:
+		handlesBytes, err = json.Marshal(l.VolumeSizes)
+		if err != nil {
+			logger.Error("failed-to-encode-request-body", err)
+			return err
+		}
+
+		request, err = l.ATCEndpoint.CreateRequest(atc.ReportVolumeSizes, nil, bytes.NewBuffer(handlesBytes))
+
 		if err != nil {
 			logger.Error("failed-to-construct-request", err)
 			return err
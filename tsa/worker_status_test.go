@@ -259,4 +259,39 @@ var _ = Describe("Worker Status Test", func() {
 			})
 		})
 	})
+
+	Context("Volume sizes", func() {
+		BeforeEach(func() {
+			workerStatus.VolumeSizes = map[string]uint64{"handle1": 1024, "handle2": 0}
+
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/volumes/sizes", "worker_name=some-worker"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer yo"),
+				ghttp.VerifyJSON(`{"handle1":1024,"handle2":0}`),
+				ghttp.RespondWith(204, nil, nil),
+			))
+		})
+
+		It("reports the size of each volume to the ATC", func() {
+			err := workerStatus.WorkerStatus(ctx, worker, tsa.ReportVolumeSizes)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the ATC responds with non 200", func() {
+			BeforeEach(func() {
+				fakeATC.Reset()
+				fakeATC.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/volumes/sizes"),
+					ghttp.RespondWith(500, nil, nil),
+				))
+			})
+
+			It("errors", func() {
+				err := workerStatus.WorkerStatus(ctx, worker, tsa.ReportVolumeSizes)
+				Expect(err).To(MatchError(ContainSubstring("bad-response (500)")))
+			})
+		})
+	})
 })
@@ -165,6 +165,33 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        WaitingForQuota maybeOrigin quota time ->
+            case maybeOrigin of
+                Just origin ->
+                    ( updateStep origin.id (setRunning << appendStepLog ("\u{001B}[1mteam quota " ++ quota ++ " reached, waiting for quota...\u{001B}[0m\n") time) model
+                    , effects
+                    )
+
+                -- builds waiting to be scheduled have no step to log to
+                Nothing ->
+                    ( model, effects )
+
+        PolicyCheck origin action allowed warned reasons time ->
+            let
+                message =
+                    if warned then
+                        "\u{001B}[1mpolicy check warning: \u{001B}[0m" ++ action ++ ": " ++ String.join ", " reasons ++ "\n"
+
+                    else if allowed then
+                        "\u{001B}[1mpolicy check passed: \u{001B}[0m" ++ action ++ "\n"
+
+                    else
+                        "\u{001B}[1mpolicy check failed: \u{001B}[0m" ++ action ++ ": " ++ String.join ", " reasons ++ "\n"
+            in
+            ( updateStep origin.id (setRunning << appendStepLog message time) model
+            , effects
+            )
+
         SelectedWorker origin output time ->
             ( updateStep origin.id (setRunning << appendStepLog ("\u{001B}[1mselected worker: \u{001B}[0m" ++ output ++ "\n") time) model
             , effects
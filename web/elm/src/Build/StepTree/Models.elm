@@ -202,6 +202,8 @@ type BuildEvent
     | SetPipelineChanged Origin Bool
     | Log Origin String (Maybe Time.Posix)
     | WaitingForWorker Origin (Maybe Time.Posix)
+    | WaitingForQuota (Maybe Origin) String (Maybe Time.Posix)
+    | PolicyCheck Origin String Bool Bool (List String) (Maybe Time.Posix)
     | SelectedWorker Origin String (Maybe Time.Posix)
     | Error Origin String Time.Posix
     | ImageCheck Origin Concourse.BuildPlan
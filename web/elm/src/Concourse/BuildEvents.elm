@@ -94,6 +94,27 @@ decodeBuildEvent =
                                 (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
                             )
 
+                    "waiting-for-quota" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 WaitingForQuota
+                                (Json.Decode.maybe <| Json.Decode.field "origin" <| Json.Decode.lazy (\_ -> decodeOrigin))
+                                (Json.Decode.field "quota" Json.Decode.string)
+                                (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "policy-check" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map6 PolicyCheck
+                                (Json.Decode.field "origin" <| Json.Decode.lazy (\_ -> decodeOrigin))
+                                (Json.Decode.field "action" Json.Decode.string)
+                                (Json.Decode.field "allowed" Json.Decode.bool)
+                                (Json.Decode.map (Maybe.withDefault False) <| Json.Decode.maybe <| Json.Decode.field "warned" Json.Decode.bool)
+                                (Json.Decode.map (Maybe.withDefault []) <| Json.Decode.maybe <| Json.Decode.field "reasons" <| Json.Decode.list Json.Decode.string)
+                                (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     "selected-worker" ->
                         Json.Decode.field
                             "data"
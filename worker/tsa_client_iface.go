@@ -19,5 +19,6 @@ type TSAClient interface {
 	ContainersToDestroy(context.Context) ([]string, error)
 
 	ReportVolumes(context.Context, []string) error
+	ReportVolumeSizes(context.Context, map[string]uint64) error
 	VolumesToDestroy(context.Context) ([]string, error)
 }
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -70,6 +71,22 @@ func (sweeper *volumeSweeper) sweep(logger lager.Logger) {
 		if err != nil {
 			logger.Error("failed-to-report-volumes", err)
 		}
+
+		sizes := map[string]uint64{}
+		for _, volume := range volumes {
+			size, err := diskUsage(volume.Path())
+			if err != nil {
+				logger.WithData(lager.Data{"handle": volume.Handle()}).Error("failed-to-measure-volume", err)
+				continue
+			}
+
+			sizes[volume.Handle()] = size
+		}
+
+		err = sweeper.tsaClient.ReportVolumeSizes(ctx, sizes)
+		if err != nil {
+			logger.Error("failed-to-report-volume-sizes", err)
+		}
 	}
 
 	volumeHandles, err := sweeper.tsaClient.VolumesToDestroy(ctx)
@@ -96,3 +113,27 @@ func (sweeper *volumeSweeper) sweep(logger lager.Logger) {
 		wg.Wait()
 	}
 }
+
+// diskUsage returns the total size of the regular files under path. Files
+// which disappear while walking, e.g. because the volume is being destroyed,
+// are not counted.
+func diskUsage(path string) (uint64, error) {
+	var size uint64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if info.Mode().IsRegular() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size, err
+}
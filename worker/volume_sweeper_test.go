@@ -0,0 +1,95 @@
+package worker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/baggageclaim/baggageclaimfakes"
+	"github.com/concourse/concourse/worker"
+	"github.com/concourse/concourse/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Volume Sweeper", func() {
+	const (
+		sweepInterval = 100 * time.Millisecond
+		maxInFlight   = uint16(1)
+	)
+
+	var (
+		testLogger = lagertest.NewTestLogger("volume-sweeper")
+
+		fakeTSAClient          *workerfakes.FakeTSAClient
+		fakeBaggageclaimClient *baggageclaimfakes.FakeClient
+
+		volumesDir string
+
+		osSignal chan os.Signal
+		exited   chan struct{}
+	)
+
+	BeforeEach(func() {
+		var err error
+		volumesDir, err = ioutil.TempDir("", "volume-sweeper")
+		Expect(err).ToNot(HaveOccurred())
+
+		osSignal = make(chan os.Signal)
+		exited = make(chan struct{})
+
+		fakeTSAClient = new(workerfakes.FakeTSAClient)
+		fakeBaggageclaimClient = new(baggageclaimfakes.FakeClient)
+	})
+
+	JustBeforeEach(func() {
+		sweeper := worker.NewVolumeSweeper(testLogger, sweepInterval, fakeTSAClient, fakeBaggageclaimClient, maxInFlight)
+
+		go func() {
+			_ = sweeper.Run(osSignal, make(chan struct{}))
+			close(exited)
+		}()
+	})
+
+	AfterEach(func() {
+		close(osSignal)
+		<-exited
+		os.RemoveAll(volumesDir)
+	})
+
+	Context("when the worker has volumes", func() {
+		BeforeEach(func() {
+			populated := filepath.Join(volumesDir, "populated")
+			Expect(os.MkdirAll(filepath.Join(populated, "nested"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(populated, "some-file"), make([]byte, 100), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(populated, "nested", "other-file"), make([]byte, 23), 0644)).To(Succeed())
+
+			empty := filepath.Join(volumesDir, "empty")
+			Expect(os.MkdirAll(empty, 0755)).To(Succeed())
+
+			populatedVolume := new(baggageclaimfakes.FakeVolume)
+			populatedVolume.HandleReturns("populated-handle")
+			populatedVolume.PathReturns(populated)
+
+			emptyVolume := new(baggageclaimfakes.FakeVolume)
+			emptyVolume.HandleReturns("empty-handle")
+			emptyVolume.PathReturns(empty)
+
+			fakeBaggageclaimClient.ListVolumesReturns(baggageclaim.Volumes{populatedVolume, emptyVolume}, nil)
+		})
+
+		It("reports the disk usage of each volume", func() {
+			Eventually(fakeTSAClient.ReportVolumeSizesCallCount).Should(BeNumerically(">=", 1))
+
+			_, sizes := fakeTSAClient.ReportVolumeSizesArgsForCall(0)
+			Expect(sizes).To(Equal(map[string]uint64{
+				"populated-handle": 123,
+				"empty-handle":     0,
+			}))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package workercmd
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -11,12 +13,17 @@ type WorkerConfig struct {
 	Tags     []string `long:"tag"   description:"A tag to set during registration. Can be specified multiple times."`
 	TeamName string   `long:"team"  description:"The name of the team that this worker will be assigned to."`
 
+	Labels []LabelFlag `long:"label" value-name:"KEY=VALUE" description:"A label to set during registration, matched against the node_selector of steps and resources. Can be specified multiple times."`
+
 	HTTPProxy  string `long:"http-proxy"  env:"http_proxy"                  description:"HTTP proxy endpoint to use for containers."`
 	HTTPSProxy string `long:"https-proxy" env:"https_proxy"                 description:"HTTPS proxy endpoint to use for containers."`
 	NoProxy    string `long:"no-proxy"    env:"no_proxy"                    description:"Blacklist of addresses to skip the proxy when reaching."`
 
 	Ephemeral bool `long:"ephemeral" description:"If set, the worker will be immediately removed upon stalling."`
 
+	AllocatableCPU    atc.CPULimit    `long:"allocatable-cpu"    description:"Total CPU shares available to containers on this worker. Used by the available-resources placement strategy. 0 means not advertised."`
+	AllocatableMemory atc.MemoryLimit `long:"allocatable-memory" description:"Total memory available to containers on this worker, e.g. 16GB. Used by the available-resources placement strategy. 0 means not advertised."`
+
 	Version string `long:"version" hidden:"true" description:"Version of the worker. This is normally baked in to the binary, so this flag is hidden."`
 }
 
@@ -31,5 +38,40 @@ func (c WorkerConfig) Worker() atc.Worker {
 		HTTPSProxyURL: c.HTTPSProxy,
 		NoProxy:       c.NoProxy,
 		Ephemeral:     c.Ephemeral,
+
+		AllocatableCPU:    uint64(c.AllocatableCPU),
+		AllocatableMemory: uint64(c.AllocatableMemory),
+
+		Labels: c.workerLabels(),
+	}
+}
+
+func (c WorkerConfig) workerLabels() atc.WorkerLabels {
+	if len(c.Labels) == 0 {
+		return nil
+	}
+
+	labels := atc.WorkerLabels{}
+	for _, label := range c.Labels {
+		labels[label.Key] = label.Value
 	}
+
+	return labels
+}
+
+type LabelFlag struct {
+	Key   string
+	Value string
+}
+
+func (label *LabelFlag) UnmarshalFlag(value string) error {
+	kv := strings.SplitN(value, "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return fmt.Errorf("invalid label '%s' (must be key=value)", value)
+	}
+
+	label.Key = kv[0]
+	label.Value = kv[1]
+
+	return nil
 }
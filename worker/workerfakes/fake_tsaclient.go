@@ -69,6 +69,18 @@ type FakeTSAClient struct {
 	reportContainersReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReportVolumeSizesStub        func(context.Context, map[string]uint64) error
+	reportVolumeSizesMutex       sync.RWMutex
+	reportVolumeSizesArgsForCall []struct {
+		arg1 context.Context
+		arg2 map[string]uint64
+	}
+	reportVolumeSizesReturns struct {
+		result1 error
+	}
+	reportVolumeSizesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReportVolumesStub        func(context.Context, []string) error
 	reportVolumesMutex       sync.RWMutex
 	reportVolumesArgsForCall []struct {
@@ -424,6 +436,68 @@ func (fake *FakeTSAClient) ReportContainersReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTSAClient) ReportVolumeSizes(arg1 context.Context, arg2 map[string]uint64) error {
+	fake.reportVolumeSizesMutex.Lock()
+	ret, specificReturn := fake.reportVolumeSizesReturnsOnCall[len(fake.reportVolumeSizesArgsForCall)]
+	fake.reportVolumeSizesArgsForCall = append(fake.reportVolumeSizesArgsForCall, struct {
+		arg1 context.Context
+		arg2 map[string]uint64
+	}{arg1, arg2})
+	stub := fake.ReportVolumeSizesStub
+	fakeReturns := fake.reportVolumeSizesReturns
+	fake.recordInvocation("ReportVolumeSizes", []interface{}{arg1, arg2})
+	fake.reportVolumeSizesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTSAClient) ReportVolumeSizesCallCount() int {
+	fake.reportVolumeSizesMutex.RLock()
+	defer fake.reportVolumeSizesMutex.RUnlock()
+	return len(fake.reportVolumeSizesArgsForCall)
+}
+
+func (fake *FakeTSAClient) ReportVolumeSizesCalls(stub func(context.Context, map[string]uint64) error) {
+	fake.reportVolumeSizesMutex.Lock()
+	defer fake.reportVolumeSizesMutex.Unlock()
+	fake.ReportVolumeSizesStub = stub
+}
+
+func (fake *FakeTSAClient) ReportVolumeSizesArgsForCall(i int) (context.Context, map[string]uint64) {
+	fake.reportVolumeSizesMutex.RLock()
+	defer fake.reportVolumeSizesMutex.RUnlock()
+	argsForCall := fake.reportVolumeSizesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTSAClient) ReportVolumeSizesReturns(result1 error) {
+	fake.reportVolumeSizesMutex.Lock()
+	defer fake.reportVolumeSizesMutex.Unlock()
+	fake.ReportVolumeSizesStub = nil
+	fake.reportVolumeSizesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTSAClient) ReportVolumeSizesReturnsOnCall(i int, result1 error) {
+	fake.reportVolumeSizesMutex.Lock()
+	defer fake.reportVolumeSizesMutex.Unlock()
+	fake.ReportVolumeSizesStub = nil
+	if fake.reportVolumeSizesReturnsOnCall == nil {
+		fake.reportVolumeSizesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reportVolumeSizesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTSAClient) ReportVolumes(arg1 context.Context, arg2 []string) error {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -629,6 +703,8 @@ func (fake *FakeTSAClient) Invocations() map[string][][]interface{} {
 	defer fake.registerMutex.RUnlock()
 	fake.reportContainersMutex.RLock()
 	defer fake.reportContainersMutex.RUnlock()
+	fake.reportVolumeSizesMutex.RLock()
+	defer fake.reportVolumeSizesMutex.RUnlock()
 	fake.reportVolumesMutex.RLock()
 	defer fake.reportVolumesMutex.RUnlock()
 	fake.retireMutex.RLock()